@@ -18,6 +18,12 @@ func (o *oVirtClient) CreateAffinityGroup(
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("creating affinity group in cluster %s", clusterID),
+		mutating(ResourceTypeAffinityGroup, "").
+			withParameters(map[string]string{
+				"cluster_id": string(clusterID),
+				"name":       name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -52,7 +58,7 @@ func (o *oVirtClient) CreateAffinityGroup(
 			addRequest.Group(
 				agBuilder.MustBuild(),
 			)
-			response, err := addRequest.Send()
+			response, err := addRequest.Query("correlation_id", requestCorrelationID(retries)).Send()
 			if err != nil {
 				return err
 			}
@@ -64,6 +70,9 @@ func (o *oVirtClient) CreateAffinityGroup(
 			return err
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeAffinityGroup, string(result.ID()), string(clusterID), MutationTypeCreated)
+	}
 	return result, err
 }
 
@@ -113,6 +122,7 @@ func (m *mockClient) CreateAffinityGroup(
 	}
 
 	m.affinityGroups[ag.ClusterID()][ag.id] = ag
+	m.mutationListeners.notify(ResourceTypeAffinityGroup, string(ag.id), string(clusterID), MutationTypeCreated)
 
 	return ag, nil
 }
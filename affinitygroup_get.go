@@ -8,10 +8,19 @@ func (o *oVirtClient) GetAffinityGroup(clusterID ClusterID, id AffinityGroupID,
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting affinity group %s", id),
+		reading(ResourceTypeAffinityGroup, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).AffinityGroupsService().GroupService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				ClustersService().
+				ClusterService(string(clusterID)).
+				AffinityGroupsService().
+				GroupService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -44,6 +53,7 @@ func (m *mockClient) GetAffinityGroup(clusterID ClusterID, id AffinityGroupID, r
 
 	err = retry(
 		fmt.Sprintf("getting affinity group %s from cluster %s", id, clusterID),
+		reading(ResourceTypeAffinityGroup, string(id)),
 		m.logger,
 		retries,
 		func() error {
@@ -10,10 +10,18 @@ func (o *oVirtClient) GetAffinityGroupByName(clusterID ClusterID, name string, r
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting affinity group %s", name),
+		reading(ResourceTypeAffinityGroup, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).AffinityGroupsService().List().Send()
+			response, err := o.conn.
+				SystemService().
+				ClustersService().
+				ClusterService(string(clusterID)).
+				AffinityGroupsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -62,6 +70,7 @@ func (m *mockClient) GetAffinityGroupByName(clusterID ClusterID, name string, re
 
 	err = retry(
 		fmt.Sprintf("getting affinity group %s from cluster %s", name, clusterID),
+		reading(ResourceTypeAffinityGroup, ""),
 		m.logger,
 		retries,
 		func() error {
@@ -11,10 +11,18 @@ func (o *oVirtClient) ListAffinityGroups(
 	result = []AffinityGroup{}
 	err = retry(
 		fmt.Sprintf("listing affinity groups in cluster %s", clusterID),
+		reading(ResourceTypeCluster, string(clusterID)),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).AffinityGroupsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				ClustersService().
+				ClusterService(string(clusterID)).
+				AffinityGroupsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -4,8 +4,12 @@ import "fmt"
 
 func (o *oVirtClient) RemoveAffinityGroup(clusterID ClusterID, id AffinityGroupID, retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	return retry(
+	err := retry(
 		fmt.Sprintf("removing affinity group %s from cluster %s", id, clusterID),
+		mutating(ResourceTypeAffinityGroup, string(id)).
+			withParameters(map[string]string{
+				"cluster_id": string(clusterID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -16,18 +20,24 @@ func (o *oVirtClient) RemoveAffinityGroup(clusterID ClusterID, id AffinityGroupI
 				AffinityGroupsService().
 				GroupService(string(id)).
 				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			return err
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeAffinityGroup, string(id), string(clusterID), MutationTypeRemoved)
+	}
+	return err
 }
 
 func (m *mockClient) RemoveAffinityGroup(clusterID ClusterID, id AffinityGroupID, retries ...RetryStrategy) error {
 
 	retries = defaultRetries(retries, defaultWriteTimeouts(m))
 
-	return retry(
+	err := retry(
 		fmt.Sprintf("removing affinity group %s from cluster %s", id, clusterID),
+		mutating(ResourceTypeAffinityGroup, string(id)),
 		m.logger,
 		retries,
 		func() error {
@@ -46,4 +56,8 @@ func (m *mockClient) RemoveAffinityGroup(clusterID ClusterID, id AffinityGroupID
 
 			return nil
 		})
+	if err == nil {
+		m.mutationListeners.notify(ResourceTypeAffinityGroup, string(id), string(clusterID), MutationTypeRemoved)
+	}
+	return err
 }
@@ -18,8 +18,13 @@ func (o *oVirtClient) AddVMToAffinityGroup(
 	if err != nil {
 		return wrap(err, EBug, "Failed to build SDK VM object")
 	}
-	return retry(
+	err = retry(
 		fmt.Sprintf("adding VM %s to affinity group %s", vmID, agID),
+		mutating(ResourceTypeAffinityGroup, string(agID)).
+			withParameters(map[string]string{
+				"cluster_id": string(clusterID),
+				"vm_id":      string(vmID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -32,6 +37,7 @@ func (o *oVirtClient) AddVMToAffinityGroup(
 				VmsService().
 				Add().
 				Vm(vm).
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			// Work around bug 1932320 on older oVirt versions.
 			if err != nil && !errors.Is(err, ovirtsdk4.XMLTagNotMatchError{ActualTag: "action", ExpectedTag: "vm"}) {
@@ -40,6 +46,10 @@ func (o *oVirtClient) AddVMToAffinityGroup(
 			return nil
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeAffinityGroup, string(agID), string(clusterID), MutationTypeUpdated)
+	}
+	return err
 }
 
 func (m *mockClient) AddVMToAffinityGroup(
@@ -67,5 +77,6 @@ func (m *mockClient) AddVMToAffinityGroup(
 	}
 
 	ag.vmids = append(ag.vmids, vmID)
+	m.mutationListeners.notify(ResourceTypeAffinityGroup, string(agID), string(clusterID), MutationTypeUpdated)
 	return nil
 }
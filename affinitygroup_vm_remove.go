@@ -11,8 +11,13 @@ func (o *oVirtClient) RemoveVMFromAffinityGroup(
 	retries ...RetryStrategy,
 ) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	return retry(
+	err := retry(
 		fmt.Sprintf("adding VM %s to affinity group %s", vmID, agID),
+		mutating(ResourceTypeAffinityGroup, string(agID)).
+			withParameters(map[string]string{
+				"cluster_id": string(clusterID),
+				"vm_id":      string(vmID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -25,10 +30,15 @@ func (o *oVirtClient) RemoveVMFromAffinityGroup(
 				VmsService().
 				VmService(string(vmID)).
 				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			return err
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeAffinityGroup, string(agID), string(clusterID), MutationTypeUpdated)
+	}
+	return err
 }
 
 func (m *mockClient) RemoveVMFromAffinityGroup(
@@ -52,6 +62,7 @@ func (m *mockClient) RemoveVMFromAffinityGroup(
 	for i, agVMID := range ag.vmids {
 		if vmID == agVMID {
 			ag.vmids = append(ag.vmids[0:i], ag.vmids[i+1:]...)
+			m.mutationListeners.notify(ResourceTypeAffinityGroup, string(agID), string(clusterID), MutationTypeUpdated)
 			return nil
 		}
 	}
@@ -0,0 +1,236 @@
+package ovirtclient
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// AuditSink receives an AuditRecord for every mutating call the client makes to the oVirt Engine. Configure it using
+// ExtraSettingsBuilder.WithAuditSink.
+//
+// Only the calls that may change something on the engine are recorded, such as creating, updating or starting
+// resources. Reading calls, including image downloads, are not recorded. Composite operations, for example creating
+// a VM with its NICs, produce one record for each call they make. Since the mock client does not send any API calls,
+// it does not produce records.
+//
+// The sink is called synchronously after each call, so it should return quickly. Errors returned from the sink are
+// logged, but do not change the result of the call.
+type AuditSink interface {
+	// Record stores the specified record.
+	Record(record AuditRecord) error
+}
+
+// AuditRecord describes a single mutating call made by the client.
+type AuditRecord interface {
+	// Operation describes the operation and its parameters, for example "creating VM test in cluster 123".
+	Operation() string
+	// ResourceType returns the type of the resource the call works on. Calls on the children of a resource, such as
+	// adding a tag to a VM, report the parent resource.
+	ResourceType() ResourceType
+	// ResourceID returns the ID of the resource the call works on, or an empty string if the call creates it.
+	ResourceID() string
+	// Parameters returns the arguments of the call other than the resource ID, for example the cluster_id and name
+	// when creating a VM. Optional parameters are not included. The returned map must not be modified.
+	Parameters() map[string]string
+	// ResultID returns the ID of the resource the call created, or an empty string if it did not create one or
+	// failed.
+	ResultID() string
+	// CorrelationID returns the correlation ID of the call. Calls without a correlation ID get a generated one, see
+	// CorrelationID.
+	CorrelationID() string
+	// StartTime returns the time the call started.
+	StartTime() time.Time
+	// Duration returns the time the call took, including retries.
+	Duration() time.Duration
+	// Err returns the error the call failed with, or nil if it succeeded.
+	Err() error
+}
+
+// NewJSONAuditSink creates an AuditSink writing each record to w as a single line of JSON.
+func NewJSONAuditSink(w io.Writer) AuditSink {
+	return &jsonAuditSink{
+		lock:    &sync.Mutex{},
+		encoder: json.NewEncoder(w),
+	}
+}
+
+type jsonAuditSink struct {
+	lock    *sync.Mutex
+	encoder *json.Encoder
+}
+
+type jsonAuditRecord struct {
+	Operation     string            `json:"operation"`
+	ResourceType  ResourceType      `json:"resource_type,omitempty"`
+	ResourceID    string            `json:"resource_id,omitempty"`
+	Parameters    map[string]string `json:"parameters,omitempty"`
+	ResultID      string            `json:"result_id,omitempty"`
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	StartTime     time.Time         `json:"start_time"`
+	DurationMS    int64             `json:"duration_ms"`
+	Success       bool              `json:"success"`
+	ErrorCode     ErrorCode         `json:"error_code,omitempty"`
+	Error         string            `json:"error,omitempty"`
+}
+
+func (j *jsonAuditSink) Record(record AuditRecord) error {
+	entry := jsonAuditRecord{
+		Operation:     record.Operation(),
+		ResourceType:  record.ResourceType(),
+		ResourceID:    record.ResourceID(),
+		Parameters:    record.Parameters(),
+		ResultID:      record.ResultID(),
+		CorrelationID: record.CorrelationID(),
+		StartTime:     record.StartTime(),
+		DurationMS:    record.Duration().Milliseconds(),
+		Success:       record.Err() == nil,
+	}
+	if err := record.Err(); err != nil {
+		entry.Error = err.Error()
+		var engineErr EngineError
+		if errors.As(err, &engineErr) {
+			entry.ErrorCode = engineErr.Code()
+		}
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if err := j.encoder.Encode(entry); err != nil {
+		return wrap(err, EUnidentified, "failed to write audit record for %s", entry.Operation)
+	}
+	return nil
+}
+
+type auditRecord struct {
+	operation     string
+	target        operationTarget
+	resultID      string
+	correlationID string
+	startTime     time.Time
+	duration      time.Duration
+	err           error
+}
+
+func (a auditRecord) Operation() string {
+	return a.operation
+}
+
+func (a auditRecord) ResourceType() ResourceType {
+	return a.target.resourceType
+}
+
+func (a auditRecord) ResourceID() string {
+	return a.target.resourceID
+}
+
+func (a auditRecord) Parameters() map[string]string {
+	return a.target.parameters
+}
+
+func (a auditRecord) ResultID() string {
+	return a.resultID
+}
+
+func (a auditRecord) CorrelationID() string {
+	return a.correlationID
+}
+
+func (a auditRecord) StartTime() time.Time {
+	return a.startTime
+}
+
+func (a auditRecord) Duration() time.Duration {
+	return a.duration
+}
+
+func (a auditRecord) Err() error {
+	return a.err
+}
+
+// auditStrategy carries the audit sink of the client to the retry function, which records the mutating calls. It
+// does not take part in the retry decisions itself.
+type auditStrategy struct {
+	callOption
+
+	sink AuditSink
+}
+
+// withClientAuditSink adds the audit sink of the client to the default retry strategies.
+func withClientAuditSink(client Client, retries []RetryStrategy) []RetryStrategy {
+	c, ok := client.(*oVirtClient)
+	if !ok {
+		return retries
+	}
+	v4, ok := c.extraSettings.(ExtraSettingsV4)
+	if !ok || v4.AuditSink() == nil {
+		return retries
+	}
+	return append(retries, &auditStrategy{sink: v4.AuditSink()})
+}
+
+// findAuditSink returns the first audit sink among the retry strategies, or nil if there is none.
+func findAuditSink(retries []RetryStrategy) AuditSink {
+	for _, r := range retries {
+		if a, ok := r.(*auditStrategy); ok {
+			return a.sink
+		}
+	}
+	return nil
+}
+
+// auditCall records a mutating call to the audit sink passed in the retry strategies when finished. A nil auditCall
+// does nothing, which is returned for calls that should not be recorded.
+type auditCall struct {
+	sink          AuditSink
+	logger        ovirtclientlog.Logger
+	action        string
+	target        operationTarget
+	correlationID string
+	startTime     time.Time
+}
+
+func startAudit(
+	action string,
+	target operationTarget,
+	correlationID string,
+	logger ovirtclientlog.Logger,
+	retries []RetryStrategy,
+) *auditCall {
+	sink := findAuditSink(retries)
+	if sink == nil || !target.mutating {
+		return nil
+	}
+	return &auditCall{
+		sink:          sink,
+		logger:        logger,
+		action:        action,
+		target:        target,
+		correlationID: correlationID,
+		startTime:     time.Now(),
+	}
+}
+
+func (a *auditCall) finish(err error) {
+	if a == nil {
+		return
+	}
+	record := auditRecord{
+		operation:     a.action,
+		target:        a.target,
+		correlationID: a.correlationID,
+		startTime:     a.startTime,
+		duration:      time.Since(a.startTime),
+		err:           err,
+	}
+	if err == nil && a.target.resultID != nil {
+		record.resultID = a.target.resultID()
+	}
+	if sinkErr := a.sink.Record(record); sinkErr != nil {
+		a.logger.Warningf("Failed to record audit entry for %s (%v)", a.action, sinkErr)
+	}
+}
@@ -0,0 +1,135 @@
+// This file contains tests for the internal audit recording in the retry function. It is therefore excluded from the
+// testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+type testAuditSink struct {
+	records []AuditRecord
+}
+
+func (t *testAuditSink) Record(record AuditRecord) error {
+	t.records = append(t.records, record)
+	return nil
+}
+
+func TestRetryRecordsMutatingCalls(t *testing.T) {
+	t.Parallel()
+
+	sink := &testAuditSink{}
+	retries := defaultRetries(
+		[]RetryStrategy{
+			&auditStrategy{sink: sink},
+			CorrelationID("audit-test"),
+		},
+		[]RetryStrategy{
+			MaxTries(1),
+		},
+	)
+	succeed := func() error { return nil }
+	if err := retry("getting VM 123", reading(ResourceTypeVM, "123"), nil, retries, succeed); err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	if err := retry("transferring image", reading(ResourceTypeDisk, "123"), nil, retries, succeed); err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	createTarget := mutating(ResourceTypeVM, "").
+		withParameters(map[string]string{"name": "test", "cluster_id": "456"}).
+		withResultID(func() string { return "789" })
+	if err := retry("creating VM test", createTarget, nil, retries, succeed); err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	removeTarget := mutating(ResourceTypeVM, "123").
+		withResultID(func() string {
+			t.Fatalf("the result ID of a failed call was requested")
+			return ""
+		})
+	err := retry("removing VM 123", removeTarget, nil, retries, func() error {
+		return newError(ENotFound, "VM not found")
+	})
+	if err == nil {
+		t.Fatalf("retry did not return the error")
+	}
+
+	if len(sink.records) != 2 {
+		t.Fatalf("incorrect number of audit records: %d instead of 2", len(sink.records))
+	}
+	created := sink.records[0]
+	if created.Operation() != "creating VM test" || created.Err() != nil {
+		t.Fatalf("incorrect first audit record: %s (%v)", created.Operation(), created.Err())
+	}
+	if created.ResourceType() != ResourceTypeVM || created.ResourceID() != "" || created.ResultID() != "789" {
+		t.Fatalf(
+			"incorrect resource in first audit record: %s %s -> %s",
+			created.ResourceType(),
+			created.ResourceID(),
+			created.ResultID(),
+		)
+	}
+	if created.Parameters()["name"] != "test" || created.Parameters()["cluster_id"] != "456" {
+		t.Fatalf("incorrect parameters in first audit record: %v", created.Parameters())
+	}
+	removed := sink.records[1]
+	if removed.Operation() != "removing VM 123" || !HasErrorCode(removed.Err(), ENotFound) {
+		t.Fatalf("incorrect second audit record: %s (%v)", removed.Operation(), removed.Err())
+	}
+	if removed.ResourceType() != ResourceTypeVM || removed.ResourceID() != "123" || removed.ResultID() != "" {
+		t.Fatalf(
+			"incorrect resource in second audit record: %s %s -> %s",
+			removed.ResourceType(),
+			removed.ResourceID(),
+			removed.ResultID(),
+		)
+	}
+	for _, record := range sink.records {
+		if record.CorrelationID() != "audit-test" {
+			t.Fatalf("incorrect correlation ID in audit record: %s", record.CorrelationID())
+		}
+	}
+}
+
+func TestJSONAuditSink(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	sink := NewJSONAuditSink(buf)
+	created := auditRecord{
+		operation: "creating VM test",
+		target:    mutating(ResourceTypeVM, "").withParameters(map[string]string{"cluster_id": "456"}),
+		resultID:  "789",
+	}
+	if err := sink.Record(created); err != nil {
+		t.Fatalf("failed to write audit record (%v)", err)
+	}
+	if err := sink.Record(auditRecord{operation: "removing VM 123", err: newError(ENotFound, "not found")}); err != nil {
+		t.Fatalf("failed to write audit record (%v)", err)
+	}
+
+	decoder := json.NewDecoder(buf)
+	for i, expected := range []jsonAuditRecord{
+		{
+			Operation:    "creating VM test",
+			ResourceType: ResourceTypeVM,
+			Parameters:   map[string]string{"cluster_id": "456"},
+			ResultID:     "789",
+			Success:      true,
+		},
+		{Operation: "removing VM 123", ErrorCode: ENotFound},
+	} {
+		entry := jsonAuditRecord{}
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatalf("failed to decode audit record %d (%v)", i, err)
+		}
+		if entry.Operation != expected.Operation || entry.Success != expected.Success ||
+			entry.ErrorCode != expected.ErrorCode || entry.ResourceType != expected.ResourceType ||
+			entry.ResultID != expected.ResultID || len(entry.Parameters) != len(expected.Parameters) ||
+			entry.Parameters["cluster_id"] != expected.Parameters["cluster_id"] {
+			t.Fatalf("incorrect audit record %d: %+v", i, entry)
+		}
+	}
+}
@@ -0,0 +1,408 @@
+package ovirtclient
+
+import (
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// BackupID is the identifier for VM backups.
+type BackupID string
+
+// CheckpointID is the identifier for VM checkpoints. Checkpoints are created by backups and can be used as a starting
+// point for incremental backups.
+type CheckpointID string
+
+// BackupClient is the client interface part that deals with the incremental backup API introduced in oVirt 4.4.
+//
+// A backup is started using StartBackup and then reaches the BackupPhaseReady phase. While the backup is ready the
+// disks can be downloaded using StartDownloadBackupDisk. Finally, the backup must be finalized using FinalizeBackup,
+// otherwise the VM stays in the backup state.
+type BackupClient interface {
+	// StartBackup starts a backup of the specified disks of a VM. If params contains a checkpoint ID the backup will
+	// be incremental and only contain the changes since that checkpoint. Use BackupParams() to obtain a buildable
+	// parameter structure. The returned backup will typically not be ready yet, use WaitForBackupPhase to wait for
+	// BackupPhaseReady.
+	StartBackup(
+		vmID VMID,
+		diskIDs []DiskID,
+		params OptionalBackupParameters,
+		retries ...RetryStrategy,
+	) (Backup, error)
+	// GetBackup returns a single backup of a VM.
+	GetBackup(vmID VMID, id BackupID, retries ...RetryStrategy) (Backup, error)
+	// ListBackups lists all backups of a VM.
+	ListBackups(vmID VMID, retries ...RetryStrategy) ([]Backup, error)
+	// WaitForBackupPhase waits for a backup to reach the specified phase. It returns an error if the backup reaches
+	// the BackupPhaseFailed phase while waiting for a different phase.
+	WaitForBackupPhase(vmID VMID, id BackupID, phase BackupPhase, retries ...RetryStrategy) (Backup, error)
+	// FinalizeBackup finishes a backup. This must be called once all disks have been downloaded to release the
+	// resources held by the backup. Use WaitForBackupPhase with BackupPhaseSucceeded to wait for the finalization to
+	// complete.
+	FinalizeBackup(vmID VMID, id BackupID, retries ...RetryStrategy) error
+	// StartDownloadBackupDisk starts downloading a disk that is part of a backup in the BackupPhaseReady phase. The
+	// image is always downloaded in the raw format. The caller MUST close the returned download, otherwise the backup
+	// cannot be finalized.
+	StartDownloadBackupDisk(
+		vmID VMID,
+		backupID BackupID,
+		diskID DiskID,
+		retries ...RetryStrategy,
+	) (ImageDownload, error)
+
+	// ListCheckpoints lists all checkpoints of a VM. The checkpoints can be used as a base for incremental backups.
+	ListCheckpoints(vmID VMID, retries ...RetryStrategy) ([]Checkpoint, error)
+}
+
+// OptionalBackupParameters holds the optional parameters for starting a backup.
+type OptionalBackupParameters interface {
+	// FromCheckpointID returns the checkpoint the backup should start from. If nil, a full backup is made.
+	FromCheckpointID() *CheckpointID
+	// RequireConsistency returns true if the backup should fail if the guest filesystems cannot be frozen.
+	RequireConsistency() *bool
+}
+
+// BuildableBackupParameters is a buildable version of OptionalBackupParameters.
+type BuildableBackupParameters interface {
+	OptionalBackupParameters
+
+	// WithFromCheckpointID sets the checkpoint the backup should start from, making it incremental.
+	WithFromCheckpointID(checkpointID CheckpointID) (BuildableBackupParameters, error)
+	// MustWithFromCheckpointID is identical to WithFromCheckpointID, but panics instead of returning an error.
+	MustWithFromCheckpointID(checkpointID CheckpointID) BuildableBackupParameters
+
+	// WithRequireConsistency sets if the backup should fail when the guest filesystems cannot be frozen.
+	WithRequireConsistency(requireConsistency bool) (BuildableBackupParameters, error)
+	// MustWithRequireConsistency is identical to WithRequireConsistency, but panics instead of returning an error.
+	MustWithRequireConsistency(requireConsistency bool) BuildableBackupParameters
+}
+
+// BackupParams creates a buildable set of OptionalBackupParameters for use with StartBackup.
+func BackupParams() BuildableBackupParameters {
+	return &backupParams{}
+}
+
+type backupParams struct {
+	fromCheckpointID   *CheckpointID
+	requireConsistency *bool
+}
+
+func (b *backupParams) FromCheckpointID() *CheckpointID {
+	return b.fromCheckpointID
+}
+
+func (b *backupParams) RequireConsistency() *bool {
+	return b.requireConsistency
+}
+
+func (b *backupParams) WithFromCheckpointID(checkpointID CheckpointID) (BuildableBackupParameters, error) {
+	if checkpointID == "" {
+		return b, newError(EBadArgument, "the checkpoint ID must not be empty")
+	}
+	b.fromCheckpointID = &checkpointID
+	return b, nil
+}
+
+func (b *backupParams) MustWithFromCheckpointID(checkpointID CheckpointID) BuildableBackupParameters {
+	builder, err := b.WithFromCheckpointID(checkpointID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (b *backupParams) WithRequireConsistency(requireConsistency bool) (BuildableBackupParameters, error) {
+	b.requireConsistency = &requireConsistency
+	return b, nil
+}
+
+func (b *backupParams) MustWithRequireConsistency(requireConsistency bool) BuildableBackupParameters {
+	builder, err := b.WithRequireConsistency(requireConsistency)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// BackupPhase is the phase a backup is in.
+type BackupPhase string
+
+const (
+	// BackupPhaseInitializing means the backup is being prepared.
+	BackupPhaseInitializing BackupPhase = "initializing"
+	// BackupPhaseStarting means the backup is being started on the host.
+	BackupPhaseStarting BackupPhase = "starting"
+	// BackupPhaseReady means the disks of the backup can be downloaded.
+	BackupPhaseReady BackupPhase = "ready"
+	// BackupPhaseFinalizing means the backup is being finalized.
+	BackupPhaseFinalizing BackupPhase = "finalizing"
+	// BackupPhaseSucceeded means the backup has been finalized successfully.
+	BackupPhaseSucceeded BackupPhase = "succeeded"
+	// BackupPhaseFailed means the backup has failed.
+	BackupPhaseFailed BackupPhase = "failed"
+)
+
+// BackupPhaseList is a list of BackupPhase values.
+type BackupPhaseList []BackupPhase
+
+// BackupPhaseValues returns all possible values for BackupPhase.
+func BackupPhaseValues() BackupPhaseList {
+	return []BackupPhase{
+		BackupPhaseInitializing,
+		BackupPhaseStarting,
+		BackupPhaseReady,
+		BackupPhaseFinalizing,
+		BackupPhaseSucceeded,
+		BackupPhaseFailed,
+	}
+}
+
+// Strings returns a list of strings.
+func (l BackupPhaseList) Strings() []string {
+	result := make([]string, len(l))
+	for i, phase := range l {
+		result[i] = string(phase)
+	}
+	return result
+}
+
+// BackupData is the core of a Backup, only exposing data functions.
+type BackupData interface {
+	// ID returns the identifier of the backup.
+	ID() BackupID
+	// VMID returns the ID of the VM being backed up.
+	VMID() VMID
+	// Phase returns the current phase of the backup.
+	Phase() BackupPhase
+	// DiskIDs returns the IDs of the disks that are part of the backup.
+	DiskIDs() []DiskID
+	// FromCheckpointID returns the checkpoint the backup started from. It returns nil for full backups.
+	FromCheckpointID() *CheckpointID
+	// ToCheckpointID returns the checkpoint created by this backup, which can be used as a starting point for the
+	// next incremental backup. It may return nil before the backup is ready.
+	ToCheckpointID() *CheckpointID
+}
+
+// Backup is a backup of a VM.
+type Backup interface {
+	BackupData
+
+	// WaitForPhase waits for the backup to reach the specified phase.
+	WaitForPhase(phase BackupPhase, retries ...RetryStrategy) (Backup, error)
+	// StartDownloadDisk starts downloading a disk that is part of this backup.
+	StartDownloadDisk(diskID DiskID, retries ...RetryStrategy) (ImageDownload, error)
+	// Finalize finishes the backup.
+	Finalize(retries ...RetryStrategy) error
+}
+
+func convertSDKBackup(sdkObject *ovirtsdk.Backup, vmID VMID, client Client) (Backup, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("backup", "id")
+	}
+	phase, ok := sdkObject.Phase()
+	if !ok {
+		return nil, newFieldNotFound("backup", "phase")
+	}
+	var diskIDs []DiskID
+	if sdkDisks, ok := sdkObject.Disks(); ok {
+		for _, sdkDisk := range sdkDisks.Slice() {
+			diskID, ok := sdkDisk.Id()
+			if !ok {
+				return nil, newFieldNotFound("disk on backup", "id")
+			}
+			diskIDs = append(diskIDs, DiskID(diskID))
+		}
+	}
+	var fromCheckpointID *CheckpointID
+	if checkpointID, ok := sdkObject.FromCheckpointId(); ok && checkpointID != "" {
+		c := CheckpointID(checkpointID)
+		fromCheckpointID = &c
+	}
+	var toCheckpointID *CheckpointID
+	if checkpointID, ok := sdkObject.ToCheckpointId(); ok && checkpointID != "" {
+		c := CheckpointID(checkpointID)
+		toCheckpointID = &c
+	}
+	return &backup{
+		client:           client,
+		id:               BackupID(id),
+		vmID:             vmID,
+		phase:            BackupPhase(phase),
+		diskIDs:          diskIDs,
+		fromCheckpointID: fromCheckpointID,
+		toCheckpointID:   toCheckpointID,
+	}, nil
+}
+
+type backup struct {
+	client Client
+
+	id               BackupID
+	vmID             VMID
+	phase            BackupPhase
+	diskIDs          []DiskID
+	fromCheckpointID *CheckpointID
+	toCheckpointID   *CheckpointID
+}
+
+func (b *backup) ID() BackupID {
+	return b.id
+}
+
+func (b *backup) VMID() VMID {
+	return b.vmID
+}
+
+func (b *backup) Phase() BackupPhase {
+	return b.phase
+}
+
+func (b *backup) DiskIDs() []DiskID {
+	return b.diskIDs
+}
+
+func (b *backup) FromCheckpointID() *CheckpointID {
+	return b.fromCheckpointID
+}
+
+func (b *backup) ToCheckpointID() *CheckpointID {
+	return b.toCheckpointID
+}
+
+func (b *backup) WaitForPhase(phase BackupPhase, retries ...RetryStrategy) (Backup, error) {
+	return b.client.WaitForBackupPhase(b.vmID, b.id, phase, retries...)
+}
+
+func (b *backup) StartDownloadDisk(diskID DiskID, retries ...RetryStrategy) (ImageDownload, error) {
+	return b.client.StartDownloadBackupDisk(b.vmID, b.id, diskID, retries...)
+}
+
+func (b *backup) Finalize(retries ...RetryStrategy) error {
+	return b.client.FinalizeBackup(b.vmID, b.id, retries...)
+}
+
+// withPhase returns a copy of the backup with the phase changed. This is used by the mock.
+func (b *backup) withPhase(phase BackupPhase) *backup {
+	return &backup{
+		client:           b.client,
+		id:               b.id,
+		vmID:             b.vmID,
+		phase:            phase,
+		diskIDs:          b.diskIDs,
+		fromCheckpointID: b.fromCheckpointID,
+		toCheckpointID:   b.toCheckpointID,
+	}
+}
+
+// CheckpointState is the state a checkpoint is in.
+type CheckpointState string
+
+const (
+	// CheckpointStateCreated means the checkpoint can be used for incremental backups.
+	CheckpointStateCreated CheckpointState = "created"
+	// CheckpointStateInvalid means the checkpoint cannot be used for incremental backups anymore.
+	CheckpointStateInvalid CheckpointState = "invalid"
+)
+
+// CheckpointStateList is a list of CheckpointState values.
+type CheckpointStateList []CheckpointState
+
+// CheckpointStateValues returns all possible values for CheckpointState.
+func CheckpointStateValues() CheckpointStateList {
+	return []CheckpointState{
+		CheckpointStateCreated,
+		CheckpointStateInvalid,
+	}
+}
+
+// Strings returns a list of strings.
+func (l CheckpointStateList) Strings() []string {
+	result := make([]string, len(l))
+	for i, state := range l {
+		result[i] = string(state)
+	}
+	return result
+}
+
+// Checkpoint is a point in time in the life of a VM that incremental backups can start from.
+type Checkpoint interface {
+	// ID returns the identifier of the checkpoint.
+	ID() CheckpointID
+	// VMID returns the ID of the VM the checkpoint belongs to.
+	VMID() VMID
+	// ParentID returns the ID of the previous checkpoint, or nil if this is the first checkpoint.
+	ParentID() *CheckpointID
+	// State returns the state of the checkpoint.
+	State() CheckpointState
+	// CreationDate returns the time the checkpoint was created.
+	CreationDate() time.Time
+	// DiskIDs returns the IDs of the disks the checkpoint covers.
+	DiskIDs() []DiskID
+}
+
+func convertSDKCheckpoint(sdkObject *ovirtsdk.Checkpoint, vmID VMID) (Checkpoint, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("checkpoint", "id")
+	}
+	state, ok := sdkObject.State()
+	if !ok {
+		return nil, newFieldNotFound("checkpoint", "state")
+	}
+	creationDate, _ := sdkObject.CreationDate()
+	var parentID *CheckpointID
+	if sdkParentID, ok := sdkObject.ParentId(); ok && sdkParentID != "" {
+		p := CheckpointID(sdkParentID)
+		parentID = &p
+	}
+	var diskIDs []DiskID
+	if sdkDisks, ok := sdkObject.Disks(); ok {
+		for _, sdkDisk := range sdkDisks.Slice() {
+			if diskID, ok := sdkDisk.Id(); ok {
+				diskIDs = append(diskIDs, DiskID(diskID))
+			}
+		}
+	}
+	return &checkpoint{
+		id:           CheckpointID(id),
+		vmID:         vmID,
+		parentID:     parentID,
+		state:        CheckpointState(state),
+		creationDate: creationDate,
+		diskIDs:      diskIDs,
+	}, nil
+}
+
+type checkpoint struct {
+	id           CheckpointID
+	vmID         VMID
+	parentID     *CheckpointID
+	state        CheckpointState
+	creationDate time.Time
+	diskIDs      []DiskID
+}
+
+func (c *checkpoint) ID() CheckpointID {
+	return c.id
+}
+
+func (c *checkpoint) VMID() VMID {
+	return c.vmID
+}
+
+func (c *checkpoint) ParentID() *CheckpointID {
+	return c.parentID
+}
+
+func (c *checkpoint) State() CheckpointState {
+	return c.state
+}
+
+func (c *checkpoint) CreationDate() time.Time {
+	return c.creationDate
+}
+
+func (c *checkpoint) DiskIDs() []DiskID {
+	return c.diskIDs
+}
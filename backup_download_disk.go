@@ -0,0 +1,112 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"context"
+	"sync"
+)
+
+func (o *oVirtClient) StartDownloadBackupDisk(
+	vmID VMID,
+	backupID BackupID,
+	diskID DiskID,
+	retries ...RetryStrategy,
+) (ImageDownload, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+
+	b, err := o.GetBackup(vmID, backupID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBackupDiskDownloadable(b, diskID); err != nil {
+		return nil, err
+	}
+
+	o.logger.Infof("Starting disk %s download from backup %s...", diskID, backupID)
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch disk for backup download")
+	}
+
+	realCtx, cancel := context.WithCancel(context.Background())
+
+	dl := &imageDownload{
+		disk:      disk,
+		lock:      &sync.Mutex{},
+		bytesRead: 0,
+		// Backups are always downloaded in raw format, so the download is as large as the virtual disk.
+		size:       disk.ProvisionedSize(),
+		lastError:  nil,
+		ctx:        realCtx,
+		cancel:     cancel,
+		conn:       o.conn,
+		done:       make(chan struct{}),
+		reader:     nil,
+		httpClient: o.httpClient,
+		createReq:  nil,
+		transfer:   nil,
+		cli:        o,
+		logger:     o.logger,
+		retries:    retries,
+		format:     ImageFormatRaw,
+		backupID:   backupID,
+	}
+	go dl.poll()
+	return dl, nil
+}
+
+func (m *mockClient) StartDownloadBackupDisk(
+	vmID VMID,
+	backupID BackupID,
+	diskID DiskID,
+	_ ...RetryStrategy,
+) (ImageDownload, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	b, ok := m.backups[backupID]
+	if !ok || b.vmID != vmID {
+		return nil, newError(ENotFound, "backup with ID %s not found on VM %s", backupID, vmID)
+	}
+	if err := checkBackupDiskDownloadable(b, diskID); err != nil {
+		return nil, err
+	}
+	disk, ok := m.disks[diskID]
+	if !ok {
+		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
+	}
+
+	dl := &mockImageDownload{
+		disk:      disk,
+		size:      0,
+		bytesRead: 0,
+		done:      make(chan struct{}),
+		lastError: nil,
+		lock:      &sync.Mutex{},
+		reader:    bytes.NewReader(disk.data),
+		// The engine only provides dirty extents if there is a checkpoint to compare to.
+		dirtyExtents: b.fromCheckpointID != nil,
+	}
+	go dl.prepare()
+
+	return dl, nil
+}
+
+// checkBackupDiskDownloadable checks if the backup is ready and contains the specified disk.
+func checkBackupDiskDownloadable(b Backup, diskID DiskID) error {
+	if b.Phase() != BackupPhaseReady {
+		return newError(
+			EConflict,
+			"backup %s is in phase %s, disks can only be downloaded in phase %s",
+			b.ID(),
+			b.Phase(),
+			BackupPhaseReady,
+		)
+	}
+	for _, backupDiskID := range b.DiskIDs() {
+		if backupDiskID == diskID {
+			return nil
+		}
+	}
+	return newError(ENotFound, "disk %s is not part of backup %s", diskID, b.ID())
+}
@@ -0,0 +1,60 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) FinalizeBackup(vmID VMID, id BackupID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("finalizing backup %s of VM %s", id, vmID),
+		mutating(ResourceTypeBackup, string(id)).
+			withParameters(map[string]string{
+				"vm_id": string(vmID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				BackupService(string(id)).
+				Finalize().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeBackup, string(id), string(vmID), MutationTypeUpdated)
+	}
+	return
+}
+
+func (m *mockClient) FinalizeBackup(vmID VMID, id BackupID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	b, ok := m.backups[id]
+	if !ok || b.vmID != vmID {
+		return newError(ENotFound, "backup with ID %s not found on VM %s", id, vmID)
+	}
+	if b.phase != BackupPhaseReady {
+		return newError(EConflict, "backup %s is in phase %s, cannot finalize", id, b.phase)
+	}
+	m.backups[id] = b.withPhase(BackupPhaseFinalizing)
+	go func() {
+		// Sleep to trigger potential race conditions / improper phase handling.
+		time.Sleep(time.Second)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if current, ok := m.backups[id]; ok && current.phase == BackupPhaseFinalizing {
+			m.backups[id] = current.withPhase(BackupPhaseSucceeded)
+		}
+	}()
+
+	m.mutationListeners.notify(ResourceTypeBackup, string(id), string(vmID), MutationTypeUpdated)
+	return nil
+}
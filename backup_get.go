@@ -0,0 +1,57 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetBackup(vmID VMID, id BackupID, retries ...RetryStrategy) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting backup %s of VM %s", id, vmID),
+		reading(ResourceTypeBackup, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				BackupService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Backup()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no backup returned when getting backup %s of VM %s",
+					id,
+					vmID,
+				)
+			}
+			result, err = convertSDKBackup(sdkObject, vmID, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert backup %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) GetBackup(vmID VMID, id BackupID, _ ...RetryStrategy) (Backup, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.backups[id]; ok && item.vmID == vmID {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "backup with ID %s not found on VM %s", id, vmID)
+}
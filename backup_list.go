@@ -0,0 +1,56 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListBackups(vmID VMID, retries ...RetryStrategy) (result []Backup, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []Backup{}
+	err = retry(
+		fmt.Sprintf("listing backups of VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Backups()
+			if !ok {
+				return nil
+			}
+			result = make([]Backup, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKBackup(sdkObject, vmID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert backup of VM %s during listing item #%d", vmID, i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListBackups(vmID VMID, _ ...RetryStrategy) ([]Backup, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := []Backup{}
+	for _, item := range m.backups {
+		if item.vmID == vmID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,174 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) StartBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	params OptionalBackupParameters,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if len(diskIDs) == 0 {
+		return nil, newError(EBadArgument, "at least one disk must be specified for a backup of VM %s", vmID)
+	}
+	if params == nil {
+		params = BackupParams()
+	}
+
+	sdkDisks := make([]*ovirtsdk.Disk, len(diskIDs))
+	diskIDStrings := make([]string, len(diskIDs))
+	for i, diskID := range diskIDs {
+		sdkDisks[i] = ovirtsdk.NewDiskBuilder().Id(string(diskID)).MustBuild()
+		diskIDStrings[i] = string(diskID)
+	}
+	backupBuilder := ovirtsdk.NewBackupBuilder().DisksOfAny(sdkDisks...)
+	if fromCheckpointID := params.FromCheckpointID(); fromCheckpointID != nil {
+		backupBuilder.FromCheckpointId(string(*fromCheckpointID))
+	}
+	sdkBackup, err := backupBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build backup for VM %s", vmID)
+	}
+
+	err = retry(
+		fmt.Sprintf("starting backup of VM %s", vmID),
+		mutating(ResourceTypeBackup, "").
+			withParameters(map[string]string{
+				"vm_id":    string(vmID),
+				"disk_ids": strings.Join(diskIDStrings, ","),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.SystemService().VmsService().VmService(string(vmID)).BackupsService().Add().Backup(sdkBackup)
+			if requireConsistency := params.RequireConsistency(); requireConsistency != nil {
+				req.RequireConsistency(*requireConsistency)
+			}
+			response, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Backup()
+			if !ok {
+				return newError(
+					EFieldMissing,
+					"no backup returned when starting backup of VM %s",
+					vmID,
+				)
+			}
+			result, err = convertSDKBackup(sdkObject, vmID, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert backup of VM %s",
+					vmID,
+				)
+			}
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeBackup, string(result.ID()), string(vmID), MutationTypeCreated)
+	}
+	return result, err
+}
+
+func (m *mockClient) StartBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	params OptionalBackupParameters,
+	_ ...RetryStrategy,
+) (Backup, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(diskIDs) == 0 {
+		return nil, newError(EBadArgument, "at least one disk must be specified for a backup of VM %s", vmID)
+	}
+	if params == nil {
+		params = BackupParams()
+	}
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, diskID := range diskIDs {
+		if !m.isDiskAttachedToVM(diskID, vmID) {
+			return nil, newError(ENotFound, "disk %s is not attached to VM %s", diskID, vmID)
+		}
+		if err := validateImageDiskOperation(m.disks[diskID], "backing up"); err != nil {
+			return nil, err
+		}
+	}
+	for _, existingBackup := range m.backups {
+		if existingBackup.vmID == vmID &&
+			existingBackup.phase != BackupPhaseSucceeded &&
+			existingBackup.phase != BackupPhaseFailed {
+			return nil, newError(EConflict, "VM %s already has a backup in progress (%s)", vmID, existingBackup.id)
+		}
+	}
+	if fromCheckpointID := params.FromCheckpointID(); fromCheckpointID != nil {
+		found := false
+		for _, c := range m.checkpointsByVM[vmID] {
+			if c.id == *fromCheckpointID && c.state == CheckpointStateCreated {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, newError(ENotFound, "checkpoint %s not found on VM %s", *fromCheckpointID, vmID)
+		}
+	}
+
+	toCheckpointID := CheckpointID(m.GenerateUUID())
+	b := &backup{
+		client:           m,
+		id:               BackupID(m.GenerateUUID()),
+		vmID:             vmID,
+		phase:            BackupPhaseInitializing,
+		diskIDs:          diskIDs,
+		fromCheckpointID: params.FromCheckpointID(),
+		toCheckpointID:   &toCheckpointID,
+	}
+	m.backups[b.id] = b
+	go m.readyBackup(b.id)
+
+	m.mutationListeners.notify(ResourceTypeBackup, string(b.id), string(vmID), MutationTypeCreated)
+	return b, nil
+}
+
+// readyBackup moves a mock backup into the ready phase after a delay and creates the checkpoint that later
+// incremental backups can start from.
+func (m *mockClient) readyBackup(id BackupID) {
+	// Sleep to trigger potential race conditions / improper phase handling.
+	time.Sleep(time.Second)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	b, ok := m.backups[id]
+	if !ok || b.phase != BackupPhaseInitializing {
+		return
+	}
+	m.backups[id] = b.withPhase(BackupPhaseReady)
+
+	var parentID *CheckpointID
+	if checkpoints := m.checkpointsByVM[b.vmID]; len(checkpoints) > 0 {
+		lastID := checkpoints[len(checkpoints)-1].id
+		parentID = &lastID
+	}
+	m.checkpointsByVM[b.vmID] = append(m.checkpointsByVM[b.vmID], &checkpoint{
+		id:           *b.toCheckpointID,
+		vmID:         b.vmID,
+		parentID:     parentID,
+		state:        CheckpointStateCreated,
+		creationDate: time.Now(),
+		diskIDs:      b.diskIDs,
+	})
+}
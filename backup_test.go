@@ -0,0 +1,143 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestBackupFullAndIncremental(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	fullBackup := assertCanRunBackup(t, client, vm.ID(), disk.ID(), nil)
+	if fullBackup.FromCheckpointID() != nil {
+		t.Fatalf("Full backup has a from checkpoint ID set (%s).", *fullBackup.FromCheckpointID())
+	}
+
+	checkpoints, err := client.ListCheckpoints(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list checkpoints of VM %s (%v)", vm.ID(), err)
+	}
+	if len(checkpoints) != 1 {
+		t.Fatalf("Unexpected number of checkpoints after full backup: %d", len(checkpoints))
+	}
+	if checkpoints[0].State() != ovirtclient.CheckpointStateCreated {
+		t.Fatalf("Checkpoint is in state %s instead of %s.", checkpoints[0].State(), ovirtclient.CheckpointStateCreated)
+	}
+
+	incrementalBackup := assertCanRunBackup(
+		t,
+		client,
+		vm.ID(),
+		disk.ID(),
+		ovirtclient.BackupParams().MustWithFromCheckpointID(checkpoints[0].ID()),
+	)
+	if incrementalBackup.FromCheckpointID() == nil || *incrementalBackup.FromCheckpointID() != checkpoints[0].ID() {
+		t.Fatalf("Incremental backup does not start from checkpoint %s.", checkpoints[0].ID())
+	}
+
+	backups, err := client.ListBackups(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list backups of VM %s (%v)", vm.ID(), err)
+	}
+	if len(backups) < 2 {
+		t.Fatalf("Expected at least 2 backups, got %d.", len(backups))
+	}
+}
+
+// assertCanReadAllocatedExtents reads all non-zero extents of a download and checks that the extents cover the
+// whole image.
+func assertCanReadAllocatedExtents(t *testing.T, download ovirtclient.ImageDownload) {
+	t.Helper()
+	extents, err := download.Extents(ovirtclient.ImageExtentContextZero)
+	if err != nil {
+		_ = download.Close()
+		t.Fatalf("Failed to fetch zero extents (%v)", err)
+	}
+	covered := uint64(0)
+	for _, extent := range extents {
+		if extent.Start() != covered {
+			_ = download.Close()
+			t.Fatalf("Extent starts at %d instead of %d.", extent.Start(), covered)
+		}
+		covered += extent.Length()
+		if extent.Zero() {
+			continue
+		}
+		reader, err := download.ReadRange(extent.Start(), extent.Length())
+		if err != nil {
+			_ = download.Close()
+			t.Fatalf("Failed to read extent at %d (%v)", extent.Start(), err)
+		}
+		data, err := io.ReadAll(reader)
+		_ = reader.Close()
+		if err != nil {
+			_ = download.Close()
+			t.Fatalf("Failed to read extent at %d (%v)", extent.Start(), err)
+		}
+		if uint64(len(data)) != extent.Length() {
+			_ = download.Close()
+			t.Fatalf("Read %d bytes from extent at %d instead of %d.", len(data), extent.Start(), extent.Length())
+		}
+	}
+	if covered != download.Size() {
+		_ = download.Close()
+		t.Fatalf("Extents cover %d bytes instead of the image size %d.", covered, download.Size())
+	}
+}
+
+func assertCanRunBackup(
+	t *testing.T,
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	diskID ovirtclient.DiskID,
+	params ovirtclient.OptionalBackupParameters,
+) ovirtclient.Backup {
+	t.Helper()
+	backup, err := client.StartBackup(vmID, []ovirtclient.DiskID{diskID}, params)
+	if err != nil {
+		t.Fatalf("Failed to start backup of VM %s (%v)", vmID, err)
+	}
+	backupID := backup.ID()
+	backup, err = backup.WaitForPhase(ovirtclient.BackupPhaseReady)
+	if err != nil {
+		t.Fatalf("Backup %s did not become ready (%v)", backupID, err)
+	}
+
+	download, err := backup.StartDownloadDisk(diskID)
+	if err != nil {
+		t.Fatalf("Failed to start downloading disk %s from backup %s (%v)", diskID, backupID, err)
+	}
+	<-download.Initialized()
+	if err := download.Err(); err != nil {
+		_ = download.Close()
+		t.Fatalf("Failed to initialize download of disk %s from backup %s (%v)", diskID, backupID, err)
+	}
+	assertCanReadAllocatedExtents(t, download)
+	if params != nil && params.FromCheckpointID() != nil {
+		if _, err := download.Extents(ovirtclient.ImageExtentContextDirty); err != nil {
+			_ = download.Close()
+			t.Fatalf("Failed to fetch dirty extents of disk %s from backup %s (%v)", diskID, backupID, err)
+		}
+	}
+	if err := download.Close(); err != nil {
+		t.Fatalf("Failed to close download of disk %s from backup %s (%v)", diskID, backupID, err)
+	}
+
+	if err := backup.Finalize(); err != nil {
+		t.Fatalf("Failed to finalize backup %s (%v)", backupID, err)
+	}
+	backup, err = client.WaitForBackupPhase(vmID, backupID, ovirtclient.BackupPhaseSucceeded)
+	if err != nil {
+		t.Fatalf("Backup %s did not succeed (%v)", backupID, err)
+	}
+	return backup
+}
@@ -0,0 +1,62 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForBackupPhase(
+	vmID VMID,
+	id BackupID,
+	phase BackupPhase,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("waiting for backup %s of VM %s to reach phase %s", id, vmID, phase),
+		reading(ResourceTypeBackup, string(id)).viaClient(),
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetBackup(vmID, id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkBackupPhase(result, phase)
+		})
+	return
+}
+
+func (m *mockClient) WaitForBackupPhase(
+	vmID VMID,
+	id BackupID,
+	phase BackupPhase,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	err = retry(
+		fmt.Sprintf("waiting for backup %s of VM %s to reach phase %s", id, vmID, phase),
+		reading(ResourceTypeBackup, string(id)),
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.GetBackup(vmID, id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkBackupPhase(result, phase)
+		})
+	return
+}
+
+// checkBackupPhase returns an EPending error if the backup is not yet in the desired phase, or a permanent error if
+// the backup has failed.
+func checkBackupPhase(b Backup, phase BackupPhase) error {
+	switch b.Phase() {
+	case phase:
+		return nil
+	case BackupPhaseFailed:
+		return newError(EUnidentified, "backup %s has failed while waiting for phase %s", b.ID(), phase)
+	default:
+		return newError(EPending, "backup %s is in phase %s, not %s", b.ID(), b.Phase(), phase)
+	}
+}
@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BulkError is returned by operations acting on multiple objects, such as CreateVMs, if the operation failed for
+// at least one of the objects. If all failures have the same error code, the BulkError carries that code,
+// otherwise it is EUnidentified.
+type BulkError interface {
+	EngineError
+
+	// Failures returns the errors that occurred, keyed by the name or ID of the object they occurred on.
+	Failures() map[string]error
+}
+
+type bulkError struct {
+	engineError
+
+	failures map[string]error
+}
+
+func (b *bulkError) Failures() map[string]error {
+	return b.failures
+}
+
+// newBulkError creates a BulkError from the failures of an operation. The action should be in the "ing" form, for
+// example "creating VMs".
+func newBulkError(action string, failures map[string]error) BulkError {
+	keys := make([]string, 0, len(failures))
+	for key := range failures {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	code := EUnidentified
+	messages := make([]string, len(keys))
+	for i, key := range keys {
+		err := failures[key]
+		messages[i] = fmt.Sprintf("%s: %v", key, err)
+		var e EngineError
+		errorCode := EUnidentified
+		if errors.As(err, &e) {
+			errorCode = e.Code()
+		}
+		switch {
+		case i == 0:
+			code = errorCode
+		case code != errorCode:
+			code = EUnidentified
+		}
+	}
+	result := &bulkError{
+		engineError: engineError{
+			message: fmt.Sprintf("%s failed for %d object(s) (%s)", action, len(failures), strings.Join(messages, "; ")),
+			code:    code,
+		},
+		failures: failures,
+	}
+	if len(keys) == 1 {
+		result.cause = failures[keys[0]]
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListCheckpoints(vmID VMID, retries ...RetryStrategy) (result []Checkpoint, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []Checkpoint{}
+	err = retry(
+		fmt.Sprintf("listing checkpoints of VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CheckpointsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Checkpoints()
+			if !ok {
+				return nil
+			}
+			result = make([]Checkpoint, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKCheckpoint(sdkObject, vmID)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert checkpoint of VM %s during listing item #%d", vmID, i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListCheckpoints(vmID VMID, _ ...RetryStrategy) ([]Checkpoint, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := make([]Checkpoint, len(m.checkpointsByVM[vmID]))
+	for i, item := range m.checkpointsByVM[vmID] {
+		result[i] = item
+	}
+	return result, nil
+}
@@ -10,19 +10,13 @@ import (
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
-// Client is a simplified client for the oVirt API.
+// Client is a simplified client for the oVirt API. It is composed of the subinterfaces, such as DiskClient, each of
+// which can be used on its own. Code that only needs a part of the API should accept the corresponding subinterface,
+// so it can be tested with a stub implementing only the methods it uses.
 //
 //goland:noinspection GoDeprecation
 type Client interface {
-	// GetURL returns the oVirt engine base URL.
-	GetURL() string
-	// Reconnect triggers the client to reauthenticate against the oVirt Engine.
-	Reconnect() (err error)
-	// WithContext creates a subclient with the specified context applied.
-	WithContext(ctx context.Context) Client
-	// GetContext returns the current context of the client. May be nil.
-	GetContext() context.Context
-
+	ConnectionClient
 	AffinityGroupClient
 	DiskClient
 	DiskAttachmentClient
@@ -36,11 +30,41 @@ type Client interface {
 	HostClient
 	TemplateClient
 	TemplateDiskClient
+	TemplateNICClient
 	TestConnectionClient
 	TagClient
 	FeatureClient
 	InstanceTypeClient
+	SchedulingPolicyClient
+	CPUProfileClient
+	DiskProfileClient
+	QoSClient
+	OperatingSystemClient
 	GraphicsConsoleClient
+	MutationListenerClient
+	ErrorIdentifierClient
+	BackupClient
+	CDROMClient
+	WatchdogClient
+	OpenStackImageClient
+	FenceClient
+	HostNetworkClient
+	MigrationClient
+	HostUpgradeClient
+	ErrataClient
+	ExternalVMImportClient
+}
+
+// ConnectionClient contains the methods dealing with the connection to the oVirt Engine.
+type ConnectionClient interface {
+	// GetURL returns the oVirt engine base URL.
+	GetURL() string
+	// Reconnect triggers the client to reauthenticate against the oVirt Engine.
+	Reconnect() (err error)
+	// WithContext creates a subclient with the specified context applied.
+	WithContext(ctx context.Context) Client
+	// GetContext returns the current context of the client. May be nil.
+	GetContext() context.Context
 }
 
 // ClientWithLegacySupport is an extension of Client that also offers the ability to retrieve the underlying
@@ -63,12 +87,17 @@ type oVirtClient struct {
 	httpClient      http.Client
 	logger          Logger
 	url             string
-	username        string
-	password        string
+	credentials     CredentialsProvider
 	tlsConfig       *tls.Config
 	extraSettings   ExtraSettings
 	nonSecureRandom *rand.Rand
 	verify          func(connection Client) error
+	// mutationListeners is shared between all subclients created using WithContext.
+	mutationListeners *mutationListeners
+	// errorIdentifiers is shared between all subclients created using WithContext.
+	errorIdentifiers *errorIdentifiers
+	// rateLimiter is shared between all subclients created using WithContext. It is nil if there is no rate limit.
+	rateLimiter *rateLimiter
 }
 
 func (o *oVirtClient) WithContext(ctx context.Context) Client {
@@ -79,15 +108,25 @@ func (o *oVirtClient) WithContext(ctx context.Context) Client {
 		o.httpClient,
 		o.logger.WithContext(ctx),
 		o.url,
-		o.username,
-		o.password,
+		o.credentials,
 		o.tlsConfig,
 		o.extraSettings,
 		o.nonSecureRandom,
 		o.verify,
+		o.mutationListeners,
+		o.errorIdentifiers,
+		o.rateLimiter,
 	}
 }
 
+// correlationID returns the client-level correlation ID from the extra settings, if any.
+func (o *oVirtClient) correlationID() string {
+	if v2, ok := o.extraSettings.(ExtraSettingsV2); ok {
+		return v2.CorrelationID()
+	}
+	return ""
+}
+
 func (o *oVirtClient) GetContext() context.Context {
 	return o.ctx
 }
@@ -95,10 +134,14 @@ func (o *oVirtClient) GetContext() context.Context {
 func (o *oVirtClient) Reconnect() error {
 	o.reconnectLock.Lock()
 	defer o.reconnectLock.Unlock()
+	username, password, err := getValidCredentials(o.credentials)
+	if err != nil {
+		return err
+	}
 	connBuilder := ovirtsdk4.NewConnectionBuilder().
 		URL(o.url).
-		Username(o.username).
-		Password(o.password).
+		Username(username).
+		Password(password).
 		TLSConfig(o.tlsConfig)
 	if err := processExtraSettings(o.extraSettings, connBuilder); err != nil {
 		return err
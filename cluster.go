@@ -16,6 +16,204 @@ type ClusterClient interface {
 	ListClusters(retries ...RetryStrategy) ([]Cluster, error)
 	// GetCluster returns a specific cluster based on the cluster ID. An error is returned if the cluster doesn't exist.
 	GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error)
+	// GetClusterByName returns a cluster by its name. An ENotFound error is returned if no cluster has the name, and
+	// an EMultipleResults error if more than one does.
+	GetClusterByName(name string, retries ...RetryStrategy) (Cluster, error)
+	// UpdateCluster updates the properties of a cluster.
+	UpdateCluster(id ClusterID, params UpdateClusterParameters, retries ...RetryStrategy) (Cluster, error)
+	// UpgradeClusterCompatibilityVersion raises the compatibility version of a cluster and returns the VMs that need
+	// a restart to pick up the new version. The engine does not restart the VMs by itself. Use
+	// ListClusterVMsPendingRestart to check which VMs are still left after restarting them.
+	UpgradeClusterCompatibilityVersion(
+		id ClusterID,
+		version Version,
+		retries ...RetryStrategy,
+	) (ClusterCompatibilityUpgrade, error)
+	// ListClusterVMsPendingRestart lists the VMs in a cluster that have configuration changes pending until the next
+	// restart.
+	ListClusterVMsPendingRestart(id ClusterID, retries ...RetryStrategy) ([]VM, error)
+	// GetClusterMaintenanceStatus returns which hosts in the cluster are being upgraded, are in maintenance or need
+	// a reinstallation.
+	GetClusterMaintenanceStatus(id ClusterID, retries ...RetryStrategy) (ClusterMaintenanceStatus, error)
+	// SelectCluster picks a cluster and host that can run a VM with the specified requirements, based on the
+	// capacity the engine reports for the hosts. Create the requirements using NewClusterSelectionRequirements.
+	//
+	// The memory check uses the scheduling memory the engine reserves for new VMs, but the CPU check only compares
+	// the number of logical CPUs of the host. The current CPU load and free memory from the host statistics are not
+	// taken into account, so a busy host may still be selected.
+	SelectCluster(requirements ClusterSelectionRequirements, retries ...RetryStrategy) (ClusterSelection, error)
+}
+
+// UpdateClusterParameters contains the changes to apply to a cluster.
+type UpdateClusterParameters interface {
+	// Name returns the new name of the cluster, or nil if the name should not be changed.
+	Name() *string
+	// CompatibilityVersion returns the new compatibility version, or nil if it should not be changed.
+	CompatibilityVersion() Version
+	// SchedulingPolicyID returns the ID of the new scheduling policy, or nil if it should not be changed.
+	SchedulingPolicyID() *SchedulingPolicyID
+	// SchedulingPolicyProperties returns the new values of the scheduling policy properties, or nil if they should
+	// not be changed.
+	SchedulingPolicyProperties() map[string]string
+}
+
+// BuildableUpdateClusterParameters is a buildable version of UpdateClusterParameters.
+type BuildableUpdateClusterParameters interface {
+	UpdateClusterParameters
+
+	// WithName sets the new name of the cluster.
+	WithName(name string) (BuildableUpdateClusterParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateClusterParameters
+
+	// WithCompatibilityVersion sets the new compatibility version. The compatibility version can only be raised.
+	WithCompatibilityVersion(version Version) (BuildableUpdateClusterParameters, error)
+	// MustWithCompatibilityVersion is identical to WithCompatibilityVersion, but panics instead of returning an error.
+	MustWithCompatibilityVersion(version Version) BuildableUpdateClusterParameters
+
+	// WithSchedulingPolicyID sets the scheduling policy of the cluster. Unless WithSchedulingPolicyProperties is also
+	// called, the cluster uses the default property values of the new policy.
+	WithSchedulingPolicyID(id SchedulingPolicyID) (BuildableUpdateClusterParameters, error)
+	// MustWithSchedulingPolicyID is identical to WithSchedulingPolicyID, but panics instead of returning an error.
+	MustWithSchedulingPolicyID(id SchedulingPolicyID) BuildableUpdateClusterParameters
+
+	// WithSchedulingPolicyProperties sets the values of the scheduling policy properties for the cluster, for example
+	// HighUtilization for the evenly_distributed policy. The properties must be accepted by the scheduling policy,
+	// see SchedulingPolicy.Properties.
+	WithSchedulingPolicyProperties(properties map[string]string) (BuildableUpdateClusterParameters, error)
+	// MustWithSchedulingPolicyProperties is identical to WithSchedulingPolicyProperties, but panics instead of
+	// returning an error.
+	MustWithSchedulingPolicyProperties(properties map[string]string) BuildableUpdateClusterParameters
+}
+
+// UpdateClusterParams creates a buildable set of parameters for updating a cluster.
+func UpdateClusterParams() BuildableUpdateClusterParameters {
+	return &updateClusterParams{}
+}
+
+type updateClusterParams struct {
+	name                       *string
+	compatibilityVersion       Version
+	schedulingPolicyID         *SchedulingPolicyID
+	schedulingPolicyProperties map[string]string
+}
+
+func (u *updateClusterParams) Name() *string {
+	return u.name
+}
+
+func (u *updateClusterParams) CompatibilityVersion() Version {
+	return u.compatibilityVersion
+}
+
+func (u *updateClusterParams) SchedulingPolicyID() *SchedulingPolicyID {
+	return u.schedulingPolicyID
+}
+
+func (u *updateClusterParams) SchedulingPolicyProperties() map[string]string {
+	return u.schedulingPolicyProperties
+}
+
+func (u *updateClusterParams) WithName(name string) (BuildableUpdateClusterParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the cluster name cannot be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithName(name string) BuildableUpdateClusterParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithCompatibilityVersion(version Version) (BuildableUpdateClusterParameters, error) {
+	if version == nil {
+		return nil, newError(EBadArgument, "the compatibility version cannot be nil")
+	}
+	u.compatibilityVersion = version
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithCompatibilityVersion(version Version) BuildableUpdateClusterParameters {
+	builder, err := u.WithCompatibilityVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithSchedulingPolicyID(id SchedulingPolicyID) (BuildableUpdateClusterParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the scheduling policy ID cannot be empty")
+	}
+	u.schedulingPolicyID = &id
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithSchedulingPolicyID(id SchedulingPolicyID) BuildableUpdateClusterParameters {
+	builder, err := u.WithSchedulingPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithSchedulingPolicyProperties(
+	properties map[string]string,
+) (BuildableUpdateClusterParameters, error) {
+	if properties == nil {
+		return nil, newError(EBadArgument, "the scheduling policy properties cannot be nil")
+	}
+	u.schedulingPolicyProperties = make(map[string]string, len(properties))
+	for name, value := range properties {
+		if name == "" {
+			return nil, newError(EBadArgument, "the scheduling policy property names cannot be empty")
+		}
+		u.schedulingPolicyProperties[name] = value
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithSchedulingPolicyProperties(
+	properties map[string]string,
+) BuildableUpdateClusterParameters {
+	builder, err := u.WithSchedulingPolicyProperties(properties)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// ClusterCompatibilityUpgrade is the result of raising the compatibility version of a cluster.
+type ClusterCompatibilityUpgrade interface {
+	// Cluster returns the cluster after the upgrade.
+	Cluster() Cluster
+	// PreviousVersion returns the compatibility version before the upgrade.
+	PreviousVersion() Version
+	// VMsPendingRestart returns the VMs that need to be restarted to run with the new compatibility version.
+	VMsPendingRestart() []VM
+}
+
+type clusterCompatibilityUpgrade struct {
+	cluster           Cluster
+	previousVersion   Version
+	vmsPendingRestart []VM
+}
+
+func (c *clusterCompatibilityUpgrade) Cluster() Cluster {
+	return c.cluster
+}
+
+func (c *clusterCompatibilityUpgrade) PreviousVersion() Version {
+	return c.previousVersion
+}
+
+func (c *clusterCompatibilityUpgrade) VMsPendingRestart() []VM {
+	return c.vmsPendingRestart
 }
 
 // ClusterID is an identifier for a cluster.
@@ -27,6 +225,14 @@ type Cluster interface {
 	ID() ClusterID
 	// Name returns the textual name of the cluster.
 	Name() string
+	// CompatibilityVersion returns the compatibility level of the cluster. It determines which VM features, such as
+	// a virtual TPM, are available.
+	CompatibilityVersion() Version
+	// SchedulingPolicyID returns the ID of the scheduling policy that decides the placement of VMs in the cluster.
+	SchedulingPolicyID() SchedulingPolicyID
+	// SchedulingPolicyProperties returns the scheduling policy property values set for the cluster. Properties not
+	// listed use the defaults of the scheduling policy.
+	SchedulingPolicyProperties() map[string]string
 }
 
 func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, error) {
@@ -39,18 +245,77 @@ func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, e
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch name for cluster %s", id)
 	}
+	sdkVersion, ok := sdkCluster.Version()
+	if !ok {
+		return nil, newError(EFieldMissing, "failed to fetch version for cluster %s", id)
+	}
+	compatibilityVersion, err := convertSDKVersion(sdkVersion)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to convert version of cluster %s", id)
+	}
+	schedulingPolicyID := SchedulingPolicyID("")
+	if sdkSchedulingPolicy, ok := sdkCluster.SchedulingPolicy(); ok {
+		if policyID, ok := sdkSchedulingPolicy.Id(); ok {
+			schedulingPolicyID = SchedulingPolicyID(policyID)
+		}
+	}
+	schedulingPolicyProperties := map[string]string{}
+	if sdkProperties, ok := sdkCluster.CustomSchedulingPolicyProperties(); ok {
+		schedulingPolicyProperties = convertSDKProperties(sdkProperties)
+	}
 	return &cluster{
-		client: client,
-		id:     ClusterID(id),
-		name:   name,
+		client:                     client,
+		id:                         ClusterID(id),
+		name:                       name,
+		compatibilityVersion:       compatibilityVersion,
+		schedulingPolicyID:         schedulingPolicyID,
+		schedulingPolicyProperties: schedulingPolicyProperties,
 	}, nil
 }
 
 type cluster struct {
 	client Client
 
-	id   ClusterID
-	name string
+	id                         ClusterID
+	name                       string
+	compatibilityVersion       Version
+	schedulingPolicyID         SchedulingPolicyID
+	schedulingPolicyProperties map[string]string
+}
+
+func (c cluster) withName(name string) *cluster {
+	c.name = name
+	return &c
+}
+
+func (c cluster) withCompatibilityVersion(version Version) *cluster {
+	c.compatibilityVersion = version
+	return &c
+}
+
+func (c cluster) withSchedulingPolicy(id SchedulingPolicyID, properties map[string]string) *cluster {
+	c.schedulingPolicyID = id
+	c.schedulingPolicyProperties = make(map[string]string, len(properties))
+	for name, value := range properties {
+		c.schedulingPolicyProperties[name] = value
+	}
+	return &c
+}
+
+func (c cluster) SchedulingPolicyID() SchedulingPolicyID {
+	return c.schedulingPolicyID
+}
+
+func (c cluster) SchedulingPolicyProperties() map[string]string {
+	result := make(map[string]string, len(c.schedulingPolicyProperties))
+	for name, value := range c.schedulingPolicyProperties {
+		result[name] = value
+	}
+	return result
+}
+
+func (c cluster) CompatibilityVersion() Version {
+	return c.compatibilityVersion
 }
 
 func (c cluster) ID() ClusterID {
@@ -10,10 +10,17 @@ func (o *oVirtClient) GetCluster(id ClusterID, retries ...RetryStrategy) (result
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting cluster %s", id),
+		reading(ResourceTypeCluster, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().ClustersService().ClusterService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				ClustersService().
+				ClusterService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
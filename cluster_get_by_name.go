@@ -0,0 +1,62 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetClusterByName(name string, retries ...RetryStrategy) (result Cluster, err error) {
+	quotedName, err := quoteSearchString(name)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting cluster by name %s", name),
+		reading(ResourceTypeCluster, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				ClustersService().
+				List().
+				Search("name="+quotedName).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			var results []Cluster
+			for _, sdkObject := range response.MustClusters().Slice() {
+				// The search may return other clusters too, so we check the name again.
+				if sdkName, ok := sdkObject.Name(); ok && sdkName == name {
+					item, err := convertSDKCluster(sdkObject, o)
+					if err != nil {
+						return wrap(err, EBug, "failed to convert cluster %s", name)
+					}
+					results = append(results, item)
+				}
+			}
+			if err := checkNameMatches(len(results), "cluster", name); err != nil {
+				return err
+			}
+			result = results[0]
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetClusterByName(name string, _ ...RetryStrategy) (Cluster, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var results []Cluster
+	for _, item := range m.clusters {
+		if item.name == name {
+			results = append(results, item)
+		}
+	}
+	if err := checkNameMatches(len(results), "cluster", name); err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
@@ -7,10 +7,16 @@ func (o *oVirtClient) ListClusters(retries ...RetryStrategy) (result []Cluster,
 	result = []Cluster{}
 	err = retry(
 		"listing clusters",
+		reading(ResourceTypeCluster, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().ClustersService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				ClustersService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
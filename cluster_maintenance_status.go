@@ -0,0 +1,106 @@
+package ovirtclient
+
+// ClusterMaintenanceStatus summarizes the maintenance activities in a cluster, so automation can hold back
+// operations, such as starting VMs, while hosts are being upgraded or reinstalled.
+//
+// The engine API version supported by the underlying SDK does not report the upgrade flag of the cluster itself, so
+// the status is derived from the hosts in the cluster.
+type ClusterMaintenanceStatus interface {
+	// ClusterID returns the ID of the cluster the status belongs to.
+	ClusterID() ClusterID
+	// UpgradeInProgress returns true if at least one host in the cluster is being upgraded, see
+	// HostUpgradeStatusInProgress.
+	UpgradeInProgress() bool
+	// MaintenanceInProgress returns true if an upgrade is in progress or at least one host is in maintenance or
+	// moving to maintenance.
+	MaintenanceInProgress() bool
+	// HostsUpgrading returns the IDs of the hosts being upgraded.
+	HostsUpgrading() []HostID
+	// HostsInMaintenance returns the IDs of the hosts in maintenance or moving to maintenance.
+	HostsInMaintenance() []HostID
+	// HostsRequiringReinstallation returns the IDs of the hosts the engine reports as needing a reinstallation, for
+	// example after a change to the cluster settings.
+	HostsRequiringReinstallation() []HostID
+}
+
+func (o *oVirtClient) GetClusterMaintenanceStatus(
+	id ClusterID,
+	retries ...RetryStrategy,
+) (ClusterMaintenanceStatus, error) {
+	return getClusterMaintenanceStatus(o, id, defaultRetries(retries, defaultReadTimeouts(o)))
+}
+
+func (m *mockClient) GetClusterMaintenanceStatus(
+	id ClusterID,
+	retries ...RetryStrategy,
+) (ClusterMaintenanceStatus, error) {
+	return getClusterMaintenanceStatus(m, id, retries)
+}
+
+// getClusterMaintenanceStatus contains the logic shared between the live and mock clients.
+func getClusterMaintenanceStatus(
+	client Client,
+	id ClusterID,
+	retries []RetryStrategy,
+) (ClusterMaintenanceStatus, error) {
+	// Fetching the cluster makes sure an ENotFound error is returned for non-existent clusters.
+	if _, err := client.GetCluster(id, retries...); err != nil {
+		return nil, err
+	}
+	hosts, err := client.ListHosts(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list hosts for the maintenance status of cluster %s", id)
+	}
+	result := &clusterMaintenanceStatus{
+		clusterID:                    id,
+		hostsUpgrading:               []HostID{},
+		hostsInMaintenance:           []HostID{},
+		hostsRequiringReinstallation: []HostID{},
+	}
+	for _, host := range hosts {
+		if host.ClusterID() != id {
+			continue
+		}
+		if host.UpgradeStatus() == HostUpgradeStatusInProgress {
+			result.hostsUpgrading = append(result.hostsUpgrading, host.ID())
+		}
+		if host.Status() == HostStatusMaintenance || host.Status() == HostStatusPreparingForMaintenance {
+			result.hostsInMaintenance = append(result.hostsInMaintenance, host.ID())
+		}
+		if host.ReinstallationRequired() {
+			result.hostsRequiringReinstallation = append(result.hostsRequiringReinstallation, host.ID())
+		}
+	}
+	return result, nil
+}
+
+type clusterMaintenanceStatus struct {
+	clusterID                    ClusterID
+	hostsUpgrading               []HostID
+	hostsInMaintenance           []HostID
+	hostsRequiringReinstallation []HostID
+}
+
+func (c *clusterMaintenanceStatus) ClusterID() ClusterID {
+	return c.clusterID
+}
+
+func (c *clusterMaintenanceStatus) UpgradeInProgress() bool {
+	return len(c.hostsUpgrading) > 0
+}
+
+func (c *clusterMaintenanceStatus) MaintenanceInProgress() bool {
+	return c.UpgradeInProgress() || len(c.hostsInMaintenance) > 0
+}
+
+func (c *clusterMaintenanceStatus) HostsUpgrading() []HostID {
+	return c.hostsUpgrading
+}
+
+func (c *clusterMaintenanceStatus) HostsInMaintenance() []HostID {
+	return c.hostsInMaintenance
+}
+
+func (c *clusterMaintenanceStatus) HostsRequiringReinstallation() []HostID {
+	return c.hostsRequiringReinstallation
+}
@@ -0,0 +1,62 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestClusterMaintenanceStatusDuringHostUpgrade(t *testing.T) {
+	t.Parallel()
+	// The upgrade is disruptive and changes the state of the host, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	host := hosts[0]
+
+	status, err := client.GetClusterMaintenanceStatus(host.ClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get the maintenance status of cluster %s (%v)", host.ClusterID(), err)
+	}
+	if status.MaintenanceInProgress() {
+		t.Fatalf("Maintenance reported in progress on an idle cluster (hosts: %v)", status.HostsInMaintenance())
+	}
+
+	if err := host.CheckUpgrade(); err != nil {
+		t.Fatalf("Failed to check host %s for updates (%v)", host.ID(), err)
+	}
+	host = assertHostUpgradeStatus(t, client, host.ID(), ovirtclient.HostUpgradeStatusUpdateAvailable)
+	if err := host.Upgrade(nil); err != nil {
+		t.Fatalf("Failed to upgrade host %s (%v)", host.ID(), err)
+	}
+	status, err = client.GetClusterMaintenanceStatus(host.ClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get the maintenance status of cluster %s (%v)", host.ClusterID(), err)
+	}
+	if !status.UpgradeInProgress() || len(status.HostsUpgrading()) != 1 || status.HostsUpgrading()[0] != host.ID() {
+		t.Fatalf("Upgrade of host %s not reported (upgrading: %v)", host.ID(), status.HostsUpgrading())
+	}
+
+	if _, err := client.WaitForHostUpgrade(host.ID()); err != nil {
+		t.Fatalf("Failed to wait for the upgrade of host %s (%v)", host.ID(), err)
+	}
+	status, err = client.GetClusterMaintenanceStatus(host.ClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get the maintenance status of cluster %s (%v)", host.ClusterID(), err)
+	}
+	if status.UpgradeInProgress() {
+		t.Fatalf("Upgrade still reported in progress after it finished (upgrading: %v)", status.HostsUpgrading())
+	}
+}
+
+func TestClusterMaintenanceStatusOfNonExistentCluster(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+
+	_, err := client.GetClusterMaintenanceStatus("does-not-exist")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting the maintenance status of a non-existent cluster did not fail with ENotFound (%v)", err)
+	}
+}
@@ -0,0 +1,216 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+// ClusterSelectionRequirements describes the resources a VM needs for SelectCluster.
+type ClusterSelectionRequirements interface {
+	// CPUs returns the number of virtual CPUs the VM needs.
+	CPUs() uint
+	// Memory returns the memory the VM needs in bytes.
+	Memory() uint64
+	// SingleNUMANode returns true if the VM must fit into a single NUMA node of the host, so it does not suffer from
+	// accessing memory attached to another node.
+	SingleNUMANode() bool
+	// ClusterIDs returns the clusters to choose from. If empty, all clusters are considered.
+	ClusterIDs() []ClusterID
+}
+
+// BuildableClusterSelectionRequirements is a buildable version of ClusterSelectionRequirements.
+type BuildableClusterSelectionRequirements interface {
+	ClusterSelectionRequirements
+
+	// WithSingleNUMANode sets whether the VM must fit into a single NUMA node of the host.
+	WithSingleNUMANode(singleNUMANode bool) BuildableClusterSelectionRequirements
+	// WithClusterIDs restricts the selection to the specified clusters.
+	WithClusterIDs(clusterIDs []ClusterID) BuildableClusterSelectionRequirements
+}
+
+// NewClusterSelectionRequirements creates the requirements for SelectCluster for a VM with the specified number of
+// CPUs and memory in bytes.
+func NewClusterSelectionRequirements(cpus uint, memory uint64) (BuildableClusterSelectionRequirements, error) {
+	if cpus == 0 {
+		return nil, newError(EBadArgument, "the number of CPUs must be positive")
+	}
+	if memory == 0 {
+		return nil, newError(EBadArgument, "the memory must be positive")
+	}
+	return &clusterSelectionRequirements{
+		cpus:   cpus,
+		memory: memory,
+	}, nil
+}
+
+// MustNewClusterSelectionRequirements is identical to NewClusterSelectionRequirements, but panics instead of
+// returning an error.
+func MustNewClusterSelectionRequirements(cpus uint, memory uint64) BuildableClusterSelectionRequirements {
+	requirements, err := NewClusterSelectionRequirements(cpus, memory)
+	if err != nil {
+		panic(err)
+	}
+	return requirements
+}
+
+type clusterSelectionRequirements struct {
+	cpus           uint
+	memory         uint64
+	singleNUMANode bool
+	clusterIDs     []ClusterID
+}
+
+func (c *clusterSelectionRequirements) CPUs() uint {
+	return c.cpus
+}
+
+func (c *clusterSelectionRequirements) Memory() uint64 {
+	return c.memory
+}
+
+func (c *clusterSelectionRequirements) SingleNUMANode() bool {
+	return c.singleNUMANode
+}
+
+func (c *clusterSelectionRequirements) ClusterIDs() []ClusterID {
+	return c.clusterIDs
+}
+
+func (c *clusterSelectionRequirements) WithSingleNUMANode(singleNUMANode bool) BuildableClusterSelectionRequirements {
+	c.singleNUMANode = singleNUMANode
+	return c
+}
+
+func (c *clusterSelectionRequirements) WithClusterIDs(clusterIDs []ClusterID) BuildableClusterSelectionRequirements {
+	c.clusterIDs = clusterIDs
+	return c
+}
+
+// ClusterSelection is the result of SelectCluster.
+type ClusterSelection interface {
+	// ClusterID returns the ID of the selected cluster.
+	ClusterID() ClusterID
+	// HostID returns the ID of the host in the cluster with the most free memory that can run the VM. The engine
+	// scheduler may still start the VM on a different host, pass the host in the placement policy to pin it.
+	HostID() HostID
+	// AvailableMemory returns the memory in bytes the engine can still assign to new VMs on the selected host.
+	AvailableMemory() uint64
+}
+
+func (o *oVirtClient) SelectCluster(
+	requirements ClusterSelectionRequirements,
+	retries ...RetryStrategy,
+) (ClusterSelection, error) {
+	return selectCluster(o, requirements, defaultRetries(retries, defaultReadTimeouts(o)))
+}
+
+func (m *mockClient) SelectCluster(
+	requirements ClusterSelectionRequirements,
+	retries ...RetryStrategy,
+) (ClusterSelection, error) {
+	return selectCluster(m, requirements, retries)
+}
+
+// selectCluster contains the logic shared between the live and mock clients.
+func selectCluster(
+	client Client,
+	requirements ClusterSelectionRequirements,
+	retries []RetryStrategy,
+) (ClusterSelection, error) {
+	if requirements == nil {
+		return nil, newError(EBadArgument, "the requirements for the cluster selection must be specified")
+	}
+	hosts, err := client.ListHosts(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list hosts for the cluster selection")
+	}
+	// Hosts with the most free memory come first, the ID makes the order stable.
+	sort.Slice(hosts, func(i, j int) bool {
+		if hosts[i].MaxSchedulingMemory() != hosts[j].MaxSchedulingMemory() {
+			return hosts[i].MaxSchedulingMemory() > hosts[j].MaxSchedulingMemory()
+		}
+		return hosts[i].ID() < hosts[j].ID()
+	})
+	for _, host := range hosts {
+		suitable, err := hostSuitableForSelection(host, requirements, retries)
+		if err != nil {
+			return nil, err
+		}
+		if suitable {
+			return &clusterSelection{
+				clusterID:       host.ClusterID(),
+				hostID:          host.ID(),
+				availableMemory: host.MaxSchedulingMemory(),
+			}, nil
+		}
+	}
+	return nil, newError(
+		ENotFound,
+		"no host can run a VM with %d CPUs and %d bytes of memory",
+		requirements.CPUs(),
+		requirements.Memory(),
+	)
+}
+
+// hostSuitableForSelection returns true if the host can run a VM with the specified requirements. It only checks the
+// static capacity of the host, not its statistics, see Client.SelectCluster.
+func hostSuitableForSelection(host Host, requirements ClusterSelectionRequirements, retries []RetryStrategy) (
+	bool,
+	error,
+) {
+	// Hosts that are not up, or need a reinstallation, will not be picked by the engine scheduler either.
+	if host.Status() != HostStatusUp || host.ReinstallationRequired() {
+		return false, nil
+	}
+	if clusterIDs := requirements.ClusterIDs(); len(clusterIDs) > 0 && !clusterIDInList(host.ClusterID(), clusterIDs) {
+		return false, nil
+	}
+	if host.MaxSchedulingMemory() < requirements.Memory() {
+		return false, nil
+	}
+	if host.CPUTopology().LogicalCPUs() < requirements.CPUs() {
+		return false, nil
+	}
+	if !requirements.SingleNUMANode() {
+		return true, nil
+	}
+	if !host.NUMASupported() {
+		return false, nil
+	}
+	numaNodes, err := host.ListNUMANodes(retries...)
+	if err != nil {
+		return false, wrap(err, EUnidentified, "failed to list NUMA nodes of host %s", host.ID())
+	}
+	for _, node := range numaNodes {
+		if node.Memory() >= requirements.Memory() && node.CPUs() >= requirements.CPUs() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func clusterIDInList(clusterID ClusterID, clusterIDs []ClusterID) bool {
+	for _, id := range clusterIDs {
+		if id == clusterID {
+			return true
+		}
+	}
+	return false
+}
+
+type clusterSelection struct {
+	clusterID       ClusterID
+	hostID          HostID
+	availableMemory uint64
+}
+
+func (c *clusterSelection) ClusterID() ClusterID {
+	return c.clusterID
+}
+
+func (c *clusterSelection) HostID() HostID {
+	return c.hostID
+}
+
+func (c *clusterSelection) AvailableMemory() uint64 {
+	return c.availableMemory
+}
@@ -0,0 +1,49 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestSelectCluster(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	selection, err := client.SelectCluster(ovirtclient.MustNewClusterSelectionRequirements(1, 128*1024*1024))
+	if err != nil {
+		t.Fatalf("Failed to select a cluster for a small VM (%v)", err)
+	}
+	host, err := client.GetHost(selection.HostID())
+	if err != nil {
+		t.Fatalf("Failed to get selected host %s (%v)", selection.HostID(), err)
+	}
+	if host.ClusterID() != selection.ClusterID() {
+		t.Fatalf("Selected host %s is not in selected cluster %s.", host.ID(), selection.ClusterID())
+	}
+	if host.Status() != ovirtclient.HostStatusUp {
+		t.Fatalf("Selected host %s is in status %s instead of %s.", host.ID(), host.Status(), ovirtclient.HostStatusUp)
+	}
+}
+
+func TestSelectClusterNUMARequirements(t *testing.T) {
+	t.Parallel()
+	// The mock hosts have two NUMA nodes with 16 GiB of memory each.
+	client := ovirtclient.NewMock()
+	memory := uint64(20 * 1024 * 1024 * 1024)
+
+	if _, err := client.SelectCluster(ovirtclient.MustNewClusterSelectionRequirements(4, memory)); err != nil {
+		t.Fatalf("Failed to select a cluster for a VM spanning NUMA nodes (%v)", err)
+	}
+	_, err := client.SelectCluster(
+		ovirtclient.MustNewClusterSelectionRequirements(4, memory).WithSingleNUMANode(true),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Selecting a cluster for a VM larger than a NUMA node did not fail with ENotFound (%v)", err)
+	}
+	_, err = client.SelectCluster(ovirtclient.MustNewClusterSelectionRequirements(64, memory))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Selecting a cluster for a VM with more CPUs than any host did not fail with ENotFound (%v)", err)
+	}
+}
@@ -0,0 +1,149 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// mockMaxClusterVersion is the newest compatibility version the mock engine supports.
+var mockMaxClusterVersion = NewVersion(4, 7)
+
+func (o *oVirtClient) UpdateCluster(
+	id ClusterID,
+	params UpdateClusterParameters,
+	retries ...RetryStrategy,
+) (result Cluster, err error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating cluster %s", id)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	builder := ovirtsdk.NewClusterBuilder()
+	if name := params.Name(); name != nil {
+		builder.Name(*name)
+	}
+	if version := params.CompatibilityVersion(); version != nil {
+		builder.Version(buildSDKVersion(version).MustBuild())
+	}
+	if schedulingPolicyID := params.SchedulingPolicyID(); schedulingPolicyID != nil {
+		builder.SchedulingPolicy(ovirtsdk.NewSchedulingPolicyBuilder().Id(string(*schedulingPolicyID)).MustBuild())
+	}
+	if properties := params.SchedulingPolicyProperties(); properties != nil {
+		builder.CustomSchedulingPolicyProperties(buildSDKProperties(properties))
+	}
+	sdkCluster, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build cluster update for %s", id)
+	}
+	err = retry(
+		fmt.Sprintf("updating cluster %s", id),
+		mutating(ResourceTypeCluster, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().ClustersService().ClusterService(string(id)).Update().Cluster(
+				sdkCluster,
+			).Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Cluster()
+			if !ok {
+				return newFieldNotFound("cluster update response", "cluster")
+			}
+			result, err = convertSDKCluster(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert cluster %s", id)
+			}
+			return nil
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeCluster, string(id), "", MutationTypeUpdated)
+	}
+	return result, err
+}
+
+func (m *mockClient) UpdateCluster(id ClusterID, params UpdateClusterParameters, _ ...RetryStrategy) (Cluster, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating cluster %s", id)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	c, ok := m.clusters[id]
+	if !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	if name := params.Name(); name != nil {
+		c = c.withName(*name)
+	}
+	if version := params.CompatibilityVersion(); version != nil {
+		if !version.AtLeast(c.compatibilityVersion.Major(), c.compatibilityVersion.Minor()) {
+			return nil, newError(
+				EBadArgument,
+				"the compatibility version of cluster %s cannot be lowered from %s to %s",
+				id,
+				c.compatibilityVersion,
+				version,
+			)
+		}
+		if !mockMaxClusterVersion.AtLeast(version.Major(), version.Minor()) {
+			return nil, newError(
+				EUnsupported,
+				"compatibility version %s is not supported, the newest version is %s",
+				version,
+				mockMaxClusterVersion,
+			)
+		}
+		if version.String() != c.compatibilityVersion.String() {
+			for _, item := range m.vms {
+				// Running VMs keep the old compatibility level until they are restarted.
+				if item.clusterID == id && item.status != VMStatusDown {
+					item.nextRunConfigurationExists = true
+				}
+			}
+		}
+		c = c.withCompatibilityVersion(version)
+	}
+	c, err := m.updateClusterSchedulingPolicy(c, params)
+	if err != nil {
+		return nil, err
+	}
+	m.clusters[id] = c
+	m.mutationListeners.notify(ResourceTypeCluster, string(id), "", MutationTypeUpdated)
+	return c, nil
+}
+
+// updateClusterSchedulingPolicy applies the scheduling policy changes from the parameters to the cluster. When only
+// the policy is changed, the properties are reset to the defaults of the new policy, like the engine does. It must be
+// called with the lock held.
+func (m *mockClient) updateClusterSchedulingPolicy(c *cluster, params UpdateClusterParameters) (*cluster, error) {
+	policyID := c.schedulingPolicyID
+	properties := c.schedulingPolicyProperties
+	if newPolicyID := params.SchedulingPolicyID(); newPolicyID != nil && *newPolicyID != policyID {
+		policyID = *newPolicyID
+		properties = map[string]string{}
+	}
+	if newProperties := params.SchedulingPolicyProperties(); newProperties != nil {
+		properties = newProperties
+	}
+	if policyID == c.schedulingPolicyID && params.SchedulingPolicyProperties() == nil {
+		return c, nil
+	}
+	policy, ok := m.schedulingPolicies[policyID]
+	if !ok {
+		return nil, newError(ENotFound, "scheduling policy with ID %s not found", policyID)
+	}
+	for name := range properties {
+		if _, ok := policy.properties[name]; !ok {
+			return nil, newError(
+				EBadArgument,
+				"scheduling policy %s does not accept the property %s",
+				policy.name,
+				name,
+			)
+		}
+	}
+	return c.withSchedulingPolicy(policyID, properties), nil
+}
@@ -0,0 +1,83 @@
+package ovirtclient
+
+func (o *oVirtClient) UpgradeClusterCompatibilityVersion(
+	id ClusterID,
+	version Version,
+	retries ...RetryStrategy,
+) (ClusterCompatibilityUpgrade, error) {
+	return upgradeClusterCompatibilityVersion(o, id, version, retries)
+}
+
+func (o *oVirtClient) ListClusterVMsPendingRestart(id ClusterID, retries ...RetryStrategy) ([]VM, error) {
+	return listClusterVMsPendingRestart(o, id, retries)
+}
+
+func (m *mockClient) UpgradeClusterCompatibilityVersion(
+	id ClusterID,
+	version Version,
+	retries ...RetryStrategy,
+) (ClusterCompatibilityUpgrade, error) {
+	return upgradeClusterCompatibilityVersion(m, id, version, retries)
+}
+
+func (m *mockClient) ListClusterVMsPendingRestart(id ClusterID, retries ...RetryStrategy) ([]VM, error) {
+	return listClusterVMsPendingRestart(m, id, retries)
+}
+
+// upgradeClusterCompatibilityVersion raises the compatibility version using the public API of the client, so the
+// live and the mock implementation behave the same.
+func upgradeClusterCompatibilityVersion(
+	client Client,
+	id ClusterID,
+	version Version,
+	retries []RetryStrategy,
+) (ClusterCompatibilityUpgrade, error) {
+	params, err := UpdateClusterParams().WithCompatibilityVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := client.GetCluster(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := client.UpdateCluster(id, params, retries...)
+	if err != nil {
+		return nil, wrap(
+			err,
+			EUnidentified,
+			"failed to raise the compatibility version of cluster %s from %s to %s",
+			id,
+			previous.CompatibilityVersion(),
+			version,
+		)
+	}
+	vms, err := listClusterVMsPendingRestart(client, id, retries)
+	if err != nil {
+		return nil, wrap(
+			err,
+			EUnidentified,
+			"the compatibility version of cluster %s was raised to %s, but listing the VMs pending restart failed",
+			id,
+			version,
+		)
+	}
+	return &clusterCompatibilityUpgrade{
+		cluster:           updated,
+		previousVersion:   previous.CompatibilityVersion(),
+		vmsPendingRestart: vms,
+	}, nil
+}
+
+func listClusterVMsPendingRestart(client Client, id ClusterID, retries []RetryStrategy) ([]VM, error) {
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return nil, err
+	}
+	result := []VM{}
+	for _, vm := range vms {
+		if vm.ClusterID() == id && vm.NextRunConfigurationExists() {
+			result = append(result, vm)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestUpgradeClusterCompatibilityVersion(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Raising the compatibility version of a live cluster cannot be undone, only running against the mock.")
+	}
+	vm := assertCanCreateBootableVM(t, helper)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+	if vm.NextRunConfigurationExists() {
+		t.Fatalf("Freshly started VM %s already has a next run configuration.", vm.ID())
+	}
+
+	cluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to fetch cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	previous := cluster.CompatibilityVersion()
+	newVersion := ovirtclient.NewVersion(previous.Major(), previous.Minor()+1)
+	upgrade, err := client.UpgradeClusterCompatibilityVersion(cluster.ID(), newVersion)
+	if err != nil {
+		t.Fatalf("Failed to upgrade cluster %s to %s (%v)", cluster.ID(), newVersion, err)
+	}
+	if upgrade.Cluster().CompatibilityVersion().String() != newVersion.String() {
+		t.Fatalf("Incorrect compatibility version after upgrade (%s)", upgrade.Cluster().CompatibilityVersion())
+	}
+	if upgrade.PreviousVersion().String() != previous.String() {
+		t.Fatalf("Incorrect previous compatibility version reported (%s)", upgrade.PreviousVersion())
+	}
+	if len(upgrade.VMsPendingRestart()) != 1 || upgrade.VMsPendingRestart()[0].ID() != vm.ID() {
+		t.Fatalf("VM %s not reported as pending restart after the upgrade.", vm.ID())
+	}
+
+	if _, err := client.UpdateCluster(
+		cluster.ID(),
+		ovirtclient.UpdateClusterParams().MustWithCompatibilityVersion(previous),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Lowering the compatibility version did not result in a bad argument error (%v)", err)
+	}
+
+	assertCanStopVM(t, vm)
+	assertVMWillStop(t, vm)
+	pending, err := client.ListClusterVMsPendingRestart(cluster.ID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs pending restart (%v)", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("VMs still pending restart after stopping them: %v", pending)
+	}
+}
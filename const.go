@@ -4,6 +4,13 @@ package ovirtclient
 // as the template may be deleted from the oVirt engine. Instead, use the API call to find the blank template.
 const DefaultBlankTemplateID TemplateID = "00000000-0000-0000-0000-000000000000"
 
+// IsBlankTemplateID returns true if the ID is the ID of the factory-default blank template. The engine does not allow
+// removing this template, and VMs cloned from a template refer to it after creation. Use GetBlankTemplate to find a
+// blank template to create VMs from.
+func IsBlankTemplateID(id TemplateID) bool {
+	return id == DefaultBlankTemplateID
+}
+
 // MinDiskSizeOVirt defines the minimum size of 1M for disks in oVirt. Smaller disks can be created, but they
 // lead to bugs in oVirt when creating disks from templates and changing the format.
 const MinDiskSizeOVirt uint64 = 1048576
@@ -1,7 +1,9 @@
 package ovirtclient
 
 const (
-	qcowHeaderSize    = 32
-	qcowMagicBytes    = "QFI\xfb"
-	qcowSizeStartByte = 24
+	qcowHeaderSize                 = 32
+	qcowMagicBytes                 = "QFI\xfb"
+	qcowVersionStartByte           = 4
+	qcowBackingFileOffsetStartByte = 8
+	qcowSizeStartByte              = 24
 )
@@ -0,0 +1,192 @@
+package ovirtclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// CorrelationIDStrategy carries a correlation ID for a single call. It implements the RetryStrategy interface, so it
+// can be passed to any call alongside the retry strategies without altering the retry behavior:
+//
+//	vm, err := client.GetVM(id, ovirtclient.CorrelationID("deploy-1234"))
+//
+// The correlation ID is sent to the engine as the correlation_id query parameter of each request the call makes. The
+// engine records the ID in its audit log and passes it on to VDSM, which makes it possible to trace a single call
+// through all log files. The ID is also included in the log messages and the returned error of the call. If the
+// client itself has a correlation ID configured via ExtraSettingsBuilder.WithCorrelationID, the per-call ID takes
+// precedence. Calls without either get a generated ID of the form call_xxxxxxxx, which is used for all attempts of the
+// call and reported in the same places.
+type CorrelationIDStrategy interface {
+	RetryStrategy
+
+	// CorrelationID returns the correlation ID for the call.
+	CorrelationID() string
+}
+
+// CorrelationID creates a CorrelationIDStrategy with the specified ID.
+func CorrelationID(id string) CorrelationIDStrategy {
+	return &correlationIDStrategy{
+		id: id,
+	}
+}
+
+// CorrelatedError is an EngineError that happened during a call with a correlation ID.
+type CorrelatedError interface {
+	EngineError
+
+	// CorrelationID returns the correlation ID of the call the error happened in.
+	CorrelationID() string
+}
+
+type correlationIDStrategy struct {
+	callOption
+
+	id string
+}
+
+func (c *correlationIDStrategy) CorrelationID() string {
+	return c.id
+}
+
+type correlatedError struct {
+	EngineError
+
+	correlationID string
+}
+
+func (c *correlatedError) CorrelationID() string {
+	return c.correlationID
+}
+
+func (c *correlatedError) Error() string {
+	return fmt.Sprintf("%s (correlation ID: %s)", c.EngineError.Error(), c.correlationID)
+}
+
+func (c *correlatedError) String() string {
+	return c.Error()
+}
+
+func (c *correlatedError) Unwrap() error {
+	return c.EngineError
+}
+
+// correlationIDRand is a random generator for selecting letters to put in the correlation ID. This does not need
+// to be cryptographically strong as it is short-lived.
+var correlationIDRand = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec,gochecknoglobals
+
+// correlationIDRandLock guards correlationIDRand, since correlation IDs are generated for concurrent requests.
+var correlationIDRandLock = &sync.Mutex{} //nolint:gochecknoglobals
+
+// generateCorrelationID generates a random ID usable for correlation.
+func generateCorrelationID(prefix string) string {
+	correlationIDRandLock.Lock()
+	defer correlationIDRandLock.Unlock()
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = letters[correlationIDRand.Intn(len(letters))]
+	}
+	return fmt.Sprintf("%s%s", prefix, string(b))
+}
+
+// requestCorrelationID returns the correlation ID to send to the engine with a request. defaultRetries adds a
+// generated ID to calls without one, so every attempt of a call sends the same ID. The fallback only applies to
+// retries that have not been defaulted, since the engine rejects an empty correlation ID.
+func requestCorrelationID(retries []RetryStrategy) string {
+	if id := findCorrelationID(retries); id != "" {
+		return id
+	}
+	return generateCorrelationID("call_")
+}
+
+// withGeneratedCorrelationID adds a generated correlation ID to the retries if they do not contain one yet.
+func withGeneratedCorrelationID(retries []RetryStrategy) []RetryStrategy {
+	if findCorrelationID(retries) != "" {
+		return retries
+	}
+	return append(retries, CorrelationID(generateCorrelationID("call_")))
+}
+
+// findCorrelationID returns the first correlation ID among the retry strategies, or an empty string if there is none.
+func findCorrelationID(retries []RetryStrategy) string {
+	for _, r := range retries {
+		if c, ok := r.(CorrelationIDStrategy); ok && c.CorrelationID() != "" {
+			return c.CorrelationID()
+		}
+	}
+	return ""
+}
+
+// jobCorrelationID returns the correlation ID for a call that waits for an engine job. The job is found by the
+// correlation ID of the call, so a random ID with the given prefix is only used if the call has none.
+func (o *oVirtClient) jobCorrelationID(prefix string, retries []RetryStrategy) string {
+	if correlationID := findCorrelationID(retries); correlationID != "" {
+		return correlationID
+	}
+	return fmt.Sprintf("%s_%s", prefix, generateRandomID(5, o.nonSecureRandom))
+}
+
+// withClientCorrelationID adds the correlation ID configured on the client to the default retry strategies.
+func withClientCorrelationID(client Client, retries []RetryStrategy) []RetryStrategy {
+	c, ok := client.(*oVirtClient)
+	if !ok {
+		return retries
+	}
+	if id := c.correlationID(); id != "" {
+		return append(retries, CorrelationID(id))
+	}
+	return retries
+}
+
+// correlateError attaches the correlation ID to the error returned from a call.
+func correlateError(err error, correlationID string) error {
+	if err == nil || correlationID == "" {
+		return err
+	}
+	var engineErr EngineError
+	if !errors.As(err, &engineErr) {
+		engineErr = wrap(err, EUnidentified, "call failed")
+	}
+	return &correlatedError{
+		EngineError:   engineErr,
+		correlationID: correlationID,
+	}
+}
+
+// correlatedLogger prefixes all log messages with the correlation ID of the call.
+type correlatedLogger struct {
+	backend       ovirtclientlog.Logger
+	correlationID string
+}
+
+func (c *correlatedLogger) prefix(format string) string {
+	return fmt.Sprintf("[correlation ID: %s] %s", c.correlationID, format)
+}
+
+func (c *correlatedLogger) WithContext(ctx context.Context) ovirtclientlog.Logger {
+	return &correlatedLogger{
+		backend:       c.backend.WithContext(ctx),
+		correlationID: c.correlationID,
+	}
+}
+
+func (c *correlatedLogger) Debugf(format string, args ...interface{}) {
+	c.backend.Debugf(c.prefix(format), args...)
+}
+
+func (c *correlatedLogger) Infof(format string, args ...interface{}) {
+	c.backend.Infof(c.prefix(format), args...)
+}
+
+func (c *correlatedLogger) Warningf(format string, args ...interface{}) {
+	c.backend.Warningf(c.prefix(format), args...)
+}
+
+func (c *correlatedLogger) Errorf(format string, args ...interface{}) {
+	c.backend.Errorf(c.prefix(format), args...)
+}
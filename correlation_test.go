@@ -0,0 +1,140 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestCorrelationIDIsSentToEngine(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	var correlationIDs []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/sso/oauth/token") {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"secret"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		if strings.HasSuffix(r.URL.Path, "/vms") {
+			lock.Lock()
+			correlationIDs = append(correlationIDs, r.URL.Query().Get("correlation_id"))
+			lock.Unlock()
+			_, _ = w.Write([]byte(`<vms></vms>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<api></api>`))
+	}))
+	defer server.Close()
+
+	client, err := ovirtclient.New(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtclient.NewExtraSettings().WithCorrelationID("client-id"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client (%v)", err)
+	}
+	if _, err := client.ListVMs(); err != nil {
+		t.Fatalf("Failed to list VMs (%v)", err)
+	}
+	if _, err := client.ListVMs(ovirtclient.CorrelationID("call-id")); err != nil {
+		t.Fatalf("Failed to list VMs (%v)", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if len(correlationIDs) != 2 {
+		t.Fatalf("Incorrect number of requests received: %d", len(correlationIDs))
+	}
+	if correlationIDs[0] != "client-id" {
+		t.Fatalf("Incorrect client-level correlation ID received: %s", correlationIDs[0])
+	}
+	if correlationIDs[1] != "call-id" {
+		t.Fatalf("Incorrect per-call correlation ID received: %s", correlationIDs[1])
+	}
+}
+
+func TestGeneratedCorrelationIDIsReported(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	var correlationIDs []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/sso/oauth/token") {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"secret"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		if strings.HasSuffix(r.URL.Path, "/vms") {
+			lock.Lock()
+			correlationIDs = append(correlationIDs, r.URL.Query().Get("correlation_id"))
+			lock.Unlock()
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`<fault><reason>Unavailable</reason></fault>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<api></api>`))
+	}))
+	defer server.Close()
+
+	var operationCorrelationID string
+	client, err := ovirtclient.New(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtclient.NewExtraSettings().WithOperationHook(correlationIDOperationHook(
+			func(operation ovirtclient.Operation) {
+				lock.Lock()
+				defer lock.Unlock()
+				operationCorrelationID = operation.CorrelationID()
+			},
+		)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client (%v)", err)
+	}
+	_, err = client.ListVMs(ovirtclient.MaxTries(3), ovirtclient.ExponentialBackoff(1), ovirtclient.AutoRetry())
+	var correlatedErr ovirtclient.CorrelatedError
+	if !errors.As(err, &correlatedErr) {
+		t.Fatalf("The returned error has no correlation ID (%v)", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if len(correlationIDs) == 0 {
+		t.Fatalf("No requests received.")
+	}
+	for _, id := range correlationIDs {
+		if id != correlatedErr.CorrelationID() {
+			t.Fatalf("Incorrect correlation ID received: %s instead of %s", id, correlatedErr.CorrelationID())
+		}
+	}
+	if operationCorrelationID != correlatedErr.CorrelationID() {
+		t.Fatalf(
+			"Incorrect correlation ID passed to the operation hook: %s instead of %s",
+			operationCorrelationID,
+			correlatedErr.CorrelationID(),
+		)
+	}
+}
+
+type correlationIDOperationHook func(operation ovirtclient.Operation)
+
+func (c correlationIDOperationHook) StartOperation(_ context.Context, operation ovirtclient.Operation) func(err error) {
+	c(operation)
+	return nil
+}
@@ -0,0 +1,169 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+// CPUProfileID is the identifier of a CPU profile.
+type CPUProfileID string
+
+// CPUProfileClient lists the methods for working with CPU profiles. A CPU profile belongs to a cluster and limits the
+// CPU usage of the VMs assigned to it through a CPU QoS entry. Every cluster has a default CPU profile with the same
+// name as the cluster. Use OptionalVMParameters.CPUProfileID to assign a profile on VM creation.
+type CPUProfileClient interface {
+	// ListCPUProfiles returns all CPU profiles in the oVirt Engine.
+	ListCPUProfiles(retries ...RetryStrategy) ([]CPUProfile, error)
+	// ListClusterCPUProfiles returns the CPU profiles that can be assigned to VMs in the specified cluster.
+	ListClusterCPUProfiles(clusterID ClusterID, retries ...RetryStrategy) ([]CPUProfile, error)
+	// GetCPUProfile returns a single CPU profile by its ID.
+	GetCPUProfile(id CPUProfileID, retries ...RetryStrategy) (CPUProfile, error)
+	// CreateCPUProfile creates a CPU profile in a cluster. Use NewCreateProfileParams to reference a CPU QoS entry.
+	CreateCPUProfile(
+		clusterID ClusterID,
+		name string,
+		params CreateProfileParameters,
+		retries ...RetryStrategy,
+	) (CPUProfile, error)
+	// RemoveCPUProfile removes a CPU profile. The engine refuses to remove profiles still assigned to VMs.
+	RemoveCPUProfile(id CPUProfileID, retries ...RetryStrategy) error
+}
+
+// CPUProfileData is the data segment of the CPUProfile type.
+type CPUProfileData interface {
+	// ID returns the unique identifier of the CPU profile.
+	ID() CPUProfileID
+	// Name returns the name of the CPU profile.
+	Name() string
+	// Description returns the user-readable description of the CPU profile.
+	Description() string
+	// ClusterID returns the ID of the cluster the profile belongs to.
+	ClusterID() ClusterID
+	// QoSID returns the ID of the CPU QoS entry limiting the VMs assigned to the profile, or nil if the profile does
+	// not limit them.
+	QoSID() *QoSID
+}
+
+// CPUProfile is a profile that limits the CPU usage of VMs in a cluster.
+type CPUProfile interface {
+	CPUProfileData
+
+	// Cluster fetches the cluster the profile belongs to.
+	Cluster(retries ...RetryStrategy) (Cluster, error)
+	// Remove removes the CPU profile.
+	Remove(retries ...RetryStrategy) error
+}
+
+func convertSDKCPUProfile(object *ovirtsdk.CpuProfile, client Client) (CPUProfile, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("CPU profile", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("CPU profile", "name")
+	}
+	sdkCluster, ok := object.Cluster()
+	if !ok {
+		return nil, newFieldNotFound("CPU profile", "cluster")
+	}
+	clusterID, ok := sdkCluster.Id()
+	if !ok {
+		return nil, newFieldNotFound("cluster on CPU profile", "ID")
+	}
+	description, _ := object.Description()
+	return &cpuProfile{
+		client:      client,
+		id:          CPUProfileID(id),
+		name:        name,
+		description: description,
+		clusterID:   ClusterID(clusterID),
+		qosID:       convertSDKProfileQoS(object.Qos),
+	}, nil
+}
+
+type cpuProfile struct {
+	client Client
+
+	id          CPUProfileID
+	name        string
+	description string
+	clusterID   ClusterID
+	qosID       *QoSID
+}
+
+func (c cpuProfile) ID() CPUProfileID {
+	return c.id
+}
+
+func (c cpuProfile) Name() string {
+	return c.name
+}
+
+func (c cpuProfile) Description() string {
+	return c.description
+}
+
+func (c cpuProfile) ClusterID() ClusterID {
+	return c.clusterID
+}
+
+func (c cpuProfile) QoSID() *QoSID {
+	return c.qosID
+}
+
+func (c cpuProfile) Cluster(retries ...RetryStrategy) (Cluster, error) {
+	return c.client.GetCluster(c.clusterID, retries...)
+}
+
+func (c cpuProfile) Remove(retries ...RetryStrategy) error {
+	return c.client.RemoveCPUProfile(c.id, retries...)
+}
+
+// addDefaultCPUProfile creates the CPU profile the engine adds to every new cluster. It must be called with the lock
+// held.
+func (m *mockClient) addDefaultCPUProfile(c *cluster) *cpuProfile {
+	profile := &cpuProfile{
+		client:    m,
+		id:        CPUProfileID(m.GenerateUUID()),
+		name:      c.name,
+		clusterID: c.id,
+	}
+	m.cpuProfiles[profile.id] = profile
+	return profile
+}
+
+// defaultCPUProfileID returns the ID of the CPU profile with the same name as the cluster, or an empty string if
+// there is none. It must be called with the lock held.
+func (m *mockClient) defaultCPUProfileID(clusterID ClusterID) CPUProfileID {
+	c, ok := m.clusters[clusterID]
+	if !ok {
+		return ""
+	}
+	for _, profile := range m.cpuProfiles {
+		if profile.clusterID == clusterID && profile.name == c.name {
+			return profile.id
+		}
+	}
+	return ""
+}
+
+// validateVMCPUProfile checks that the CPU profile set in the parameters exists and belongs to the cluster. It must
+// be called with the lock held.
+func (m *mockClient) validateVMCPUProfile(clusterID ClusterID, params OptionalVMParameters) error {
+	cpuProfileID := params.CPUProfileID()
+	if cpuProfileID == nil {
+		return nil
+	}
+	profile, ok := m.cpuProfiles[*cpuProfileID]
+	if !ok {
+		return newError(ENotFound, "CPU profile with ID %s not found", *cpuProfileID)
+	}
+	if profile.clusterID != clusterID {
+		return newVMParameterError(
+			"CPUProfileID",
+			"CPU profile %s belongs to cluster %s instead of %s",
+			profile.id,
+			profile.clusterID,
+			clusterID,
+		)
+	}
+	return nil
+}
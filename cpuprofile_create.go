@@ -0,0 +1,132 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateCPUProfile(
+	clusterID ClusterID,
+	name string,
+	params CreateProfileParameters,
+	retries ...RetryStrategy,
+) (result CPUProfile, err error) {
+	if params == nil {
+		params = NewCreateProfileParams()
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "the CPU profile name cannot be empty")
+	}
+	builder := ovirtsdk.NewCpuProfileBuilder().
+		Name(name).
+		Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild())
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if qosID := params.QoSID(); qosID != nil {
+		builder.Qos(ovirtsdk.NewQosBuilder().Id(string(*qosID)).MustBuild())
+	}
+	sdkProfile, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build CPU profile %s", name)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("creating CPU profile %s in cluster %s", name, clusterID),
+		mutating(ResourceTypeCPUProfile, "").
+			withParameters(map[string]string{
+				"cluster_id": string(clusterID),
+				"name":       name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				CpuProfilesService().
+				Add().
+				Profile(sdkProfile).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Profile()
+			if !ok {
+				return newFieldNotFound("CPU profile creation response", "profile")
+			}
+			result, e = convertSDKCPUProfile(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert CPU profile %s", name)
+			}
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeCPUProfile, string(result.ID()), string(clusterID), MutationTypeCreated)
+	}
+	return result, err
+}
+
+func (m *mockClient) CreateCPUProfile(
+	clusterID ClusterID,
+	name string,
+	params CreateProfileParameters,
+	_ ...RetryStrategy,
+) (CPUProfile, error) {
+	if params == nil {
+		params = NewCreateProfileParams()
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "the CPU profile name cannot be empty")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	for _, item := range m.cpuProfiles {
+		if item.clusterID == clusterID && item.name == name {
+			return nil, newError(ENameInUse, "a CPU profile named %s already exists in cluster %s", name, clusterID)
+		}
+	}
+	if err := m.validateMockProfileQoS(params.QoSID(), QoSTypeCPU); err != nil {
+		return nil, err
+	}
+	if qosID := params.QoSID(); qosID != nil && !m.clusterInDatacenter(clusterID, m.qos[*qosID].datacenterID) {
+		return nil, newError(
+			EBadArgument,
+			"QoS entry %s does not belong to the datacenter of cluster %s",
+			*qosID,
+			clusterID,
+		)
+	}
+	result := &cpuProfile{
+		client:    m,
+		id:        CPUProfileID(m.GenerateUUID()),
+		name:      name,
+		clusterID: clusterID,
+		qosID:     params.QoSID(),
+	}
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	m.cpuProfiles[result.id] = result
+	m.mutationListeners.notify(ResourceTypeCPUProfile, string(result.id), string(clusterID), MutationTypeCreated)
+	return result, nil
+}
+
+// clusterInDatacenter returns true if the cluster belongs to the datacenter. It must be called with the lock held.
+func (m *mockClient) clusterInDatacenter(clusterID ClusterID, datacenterID DatacenterID) bool {
+	dc, ok := m.dataCenters[datacenterID]
+	if !ok {
+		return false
+	}
+	for _, id := range dc.clusters {
+		if id == clusterID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetCPUProfile(id CPUProfileID, retries ...RetryStrategy) (result CPUProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting CPU profile %s", id),
+		reading(ResourceTypeCPUProfile, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				CpuProfilesService().
+				ProfileService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Profile()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no CPU profile returned when getting CPU profile ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKCPUProfile(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert CPU profile %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetCPUProfile(id CPUProfileID, _ ...RetryStrategy) (CPUProfile, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.cpuProfiles[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "CPU profile with ID %s not found", id)
+}
@@ -0,0 +1,102 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListCPUProfiles(retries ...RetryStrategy) (result []CPUProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []CPUProfile{}
+	err = retry(
+		"listing CPU profiles",
+		reading(ResourceTypeCPUProfile, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				CpuProfilesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Profile()
+			if !ok {
+				return nil
+			}
+			result, e = convertSDKCPUProfiles(sdkObjects, o)
+			return e
+		})
+	return
+}
+
+func (o *oVirtClient) ListClusterCPUProfiles(
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) (result []CPUProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []CPUProfile{}
+	err = retry(
+		fmt.Sprintf("listing CPU profiles of cluster %s", clusterID),
+		reading(ResourceTypeCluster, string(clusterID)),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().ClustersService().ClusterService(
+				string(clusterID),
+			).CpuProfilesService().List().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Profiles()
+			if !ok {
+				return nil
+			}
+			result, e = convertSDKCPUProfiles(sdkObjects, o)
+			return e
+		})
+	return
+}
+
+func convertSDKCPUProfiles(sdkObjects *ovirtsdk.CpuProfileSlice, client Client) ([]CPUProfile, error) {
+	result := make([]CPUProfile, len(sdkObjects.Slice()))
+	for i, sdkObject := range sdkObjects.Slice() {
+		var err error
+		result[i], err = convertSDKCPUProfile(sdkObject, client)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to convert CPU profile during listing item #%d", i)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListCPUProfiles(_ ...RetryStrategy) ([]CPUProfile, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]CPUProfile, len(m.cpuProfiles))
+	i := 0
+	for _, item := range m.cpuProfiles {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListClusterCPUProfiles(clusterID ClusterID, _ ...RetryStrategy) ([]CPUProfile, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	result := []CPUProfile{}
+	for _, item := range m.cpuProfiles {
+		if item.clusterID == clusterID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
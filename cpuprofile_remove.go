@@ -0,0 +1,46 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveCPUProfile(id CPUProfileID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err := retry(
+		fmt.Sprintf("removing CPU profile %s", id),
+		mutating(ResourceTypeCPUProfile, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				CpuProfilesService().
+				ProfileService(string(id)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeCPUProfile, string(id), "", MutationTypeRemoved)
+	}
+	return err
+}
+
+func (m *mockClient) RemoveCPUProfile(id CPUProfileID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	profile, ok := m.cpuProfiles[id]
+	if !ok {
+		return newError(ENotFound, "CPU profile with ID %s not found", id)
+	}
+	for _, item := range m.vms {
+		if item.cpuProfileID == id {
+			return newError(EConflict, "CPU profile %s is still assigned to VM %s", id, item.id)
+		}
+	}
+	delete(m.cpuProfiles, id)
+	m.mutationListeners.notify(ResourceTypeCPUProfile, string(id), string(profile.clusterID), MutationTypeRemoved)
+	return nil
+}
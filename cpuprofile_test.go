@@ -0,0 +1,51 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCreationWithCPUProfile(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	profiles, err := client.ListClusterCPUProfiles(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to list CPU profiles of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	if len(profiles) == 0 {
+		t.Fatalf("No CPU profiles found for cluster %s.", helper.GetClusterID())
+	}
+	profile := profiles[0]
+	if profile.ClusterID() != helper.GetClusterID() {
+		t.Fatalf("CPU profile %s belongs to incorrect cluster %s.", profile.ID(), profile.ClusterID())
+	}
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithCPUProfileID(profile.ID()),
+	)
+	if vm.CPUProfileID() != profile.ID() {
+		t.Fatalf("Incorrect CPU profile on VM %s: %s instead of %s", vm.ID(), vm.CPUProfileID(), profile.ID())
+	}
+}
+
+func TestVMCreationWithNonExistentCPUProfile(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_, err := client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithCPUProfileID("00000000-0000-0000-0000-000000000000"),
+	)
+	if err == nil {
+		t.Fatalf("Creating a VM with a non-existent CPU profile did not fail.")
+	}
+}
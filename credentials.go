@@ -0,0 +1,90 @@
+package ovirtclient
+
+import (
+	"strings"
+)
+
+// CredentialsProvider supplies the username and password for the oVirt Engine. It is called every time the client
+// connects or reauthenticates, for example after the SSO token expired, which makes it possible to use credentials
+// that are rotated while the client is running, such as those from Vault or a Kubernetes secret.
+//
+// There is no provider for SSO tokens or Kerberos tickets. The oVirt SDK requires a username and password to build a
+// connection and requests its own token with them, so these require support in the SDK first.
+type CredentialsProvider interface {
+	// GetCredentials returns the username and password to log in with. The username must contain the profile
+	// separated with an @ sign, for example admin@internal.
+	GetCredentials() (username string, password string, err error)
+}
+
+// StaticCredentials returns a CredentialsProvider that always returns the same username and password.
+func StaticCredentials(username string, password string) CredentialsProvider {
+	return &staticCredentials{
+		username: username,
+		password: password,
+	}
+}
+
+// ProfileCredentials returns a CredentialsProvider that logs in with the username on the specified authentication
+// profile, for example "internal" or the name of an LDAP profile. Use this instead of StaticCredentials if the profile
+// is configured separately from the username. An invalid profile results in an EBadArgument error when connecting,
+// and a profile the engine does not know of in an EInvalidProfile error.
+func ProfileCredentials(username string, profile string, password string) CredentialsProvider {
+	return &profileCredentials{
+		username: username,
+		profile:  profile,
+		password: password,
+	}
+}
+
+type profileCredentials struct {
+	username string
+	profile  string
+	password string
+}
+
+func (p *profileCredentials) GetCredentials() (string, string, error) {
+	username, err := joinUsernameProfile(p.username, p.profile)
+	if err != nil {
+		return "", "", err
+	}
+	return username, p.password, nil
+}
+
+// joinUsernameProfile creates the username the engine expects from a username and a separate authentication profile.
+func joinUsernameProfile(username string, profile string) (string, error) {
+	if username == "" {
+		return "", newError(EBadArgument, "the username must not be empty")
+	}
+	if profile == "" {
+		return "", newError(EBadArgument, "the authentication profile must not be empty")
+	}
+	if strings.Contains(profile, "@") {
+		return "", newError(EBadArgument, "the authentication profile must not contain an @ sign: %s", profile)
+	}
+	return username + "@" + profile, nil
+}
+
+type staticCredentials struct {
+	username string
+	password string
+}
+
+func (s *staticCredentials) GetCredentials() (string, string, error) {
+	return s.username, s.password, nil
+}
+
+// getValidCredentials fetches the credentials from the provider and validates them.
+func getValidCredentials(provider CredentialsProvider) (string, string, error) {
+	username, password, err := provider.GetCredentials()
+	if err != nil {
+		code := EAccessDenied
+		if HasErrorCode(err, EBadArgument) {
+			code = EBadArgument
+		}
+		return "", "", wrap(err, code, "failed to obtain credentials for the oVirt Engine")
+	}
+	if err := validateUsername(username); err != nil {
+		return "", "", wrap(err, EBadArgument, "invalid username: %s", username)
+	}
+	return username, password, nil
+}
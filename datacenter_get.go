@@ -10,10 +10,17 @@ func (o *oVirtClient) GetDatacenter(id DatacenterID, retries ...RetryStrategy) (
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting datacenter %s", id),
+		reading("", string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().DataCentersService().DataCenterService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				DataCentersService().
+				DataCenterService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
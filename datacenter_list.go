@@ -7,10 +7,16 @@ func (o *oVirtClient) ListDatacenters(retries ...RetryStrategy) (result []Datace
 	result = []Datacenter{}
 	err = retry(
 		"listing datacenters",
+		reading("", ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().DataCentersService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				DataCentersService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -9,6 +9,7 @@ func (o *oVirtClient) ListDatacenterClusters(id DatacenterID, retries ...RetrySt
 	result = []Cluster{}
 	err = retry(
 		fmt.Sprintf("listing datacenters %s clusters", id),
+		reading("", string(id)),
 		o.logger,
 		retries,
 		func() error {
@@ -18,6 +19,7 @@ func (o *oVirtClient) ListDatacenterClusters(id DatacenterID, retries ...RetrySt
 				DataCenterService(string(id)).
 				ClustersService().
 				List().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			if e != nil {
 				return e
@@ -214,6 +214,17 @@ type DiskClient interface {
 		retries ...RetryStrategy,
 	) (DiskCreation, error)
 
+	// CreateLUNDisk creates a direct LUN disk passing the specified logical unit of a FC or iSCSI storage through to
+	// the VMs it is attached to. The storage type must be StorageDomainTypeFCP or StorageDomainTypeISCSI. Optional
+	// parameters can be created using CreateLUNDiskParams(). Set the disk shareable to attach it to multiple VMs,
+	// for example for clustered filesystems.
+	CreateLUNDisk(
+		lunID string,
+		storageType StorageDomainType,
+		params CreateLUNDiskOptionalParameters,
+		retries ...RetryStrategy,
+	) (Disk, error)
+
 	// CreateDisk is a shorthand for calling StartCreateDisk, and then waiting for the disk creation to complete.
 	// Optional parameters can be created using CreateDiskParams().
 	//
@@ -245,16 +256,58 @@ type DiskClient interface {
 		retries ...RetryStrategy,
 	) (Disk, error)
 
+	// StartCopyDisk starts copying a disk to the specified storage domain and returns a DiskCreation object, which
+	// can be used to wait for the copy to complete. The copy is a new disk with its own ID. Optional parameters can be
+	// created using CopyDiskParams().
+	StartCopyDisk(
+		diskID DiskID,
+		storageDomainID StorageDomainID,
+		params CopyDiskOptionalParameters,
+		retries ...RetryStrategy,
+	) (DiskCreation, error)
+
+	// CopyDisk is a shorthand for calling StartCopyDisk, and then waiting for the copy to complete. It returns the
+	// newly created disk once it is unlocked. Optional parameters can be created using CopyDiskParams().
+	CopyDisk(
+		diskID DiskID,
+		storageDomainID StorageDomainID,
+		params CopyDiskOptionalParameters,
+		retries ...RetryStrategy,
+	) (Disk, error)
+
 	// ListDisks lists all disks.
 	ListDisks(retries ...RetryStrategy) ([]Disk, error)
 	// GetDisk fetches a disk with a specific ID from the oVirt Engine.
 	GetDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+	// GetDisks fetches multiple disks by their IDs, running at most concurrency requests at the same time. See GetVMs
+	// for details.
+	GetDisks(ids []DiskID, concurrency uint, retries ...RetryStrategy) ([]Disk, error)
 	// ListDisksByAlias fetches a disks with a specific name from the oVirt Engine.
 	ListDisksByAlias(alias string, retries ...RetryStrategy) ([]Disk, error)
+	// ListDisksInStorageDomain lists the disks stored on a storage domain.
+	ListDisksInStorageDomain(storageDomainID StorageDomainID, retries ...RetryStrategy) ([]Disk, error)
+	// ListVMDisks fetches the disks attached to a VM in a single request. Use ListDiskAttachments if the attachment
+	// details, such as the interface, are also needed.
+	ListVMDisks(vmID VMID, retries ...RetryStrategy) ([]Disk, error)
+	// SearchDisks lists the disks matching the criteria specified in params. The search is performed by the engine,
+	// so it is much faster than filtering the result of ListDisks in large environments. Use DiskSearchParams to
+	// create the parameters.
+	SearchDisks(params DiskSearchParameters, retries ...RetryStrategy) ([]Disk, error)
 	// RemoveDisk removes a disk with a specific ID.
 	RemoveDisk(diskID DiskID, retries ...RetryStrategy) error
-	// WaitForDiskOK waits for a disk to be in OK status
+	// WaitForDiskOK waits for a disk to be in OK status. It returns an EUnexpectedDiskStatus error without waiting
+	// further if the disk enters a status other than DiskStatusLocked, for example after a failed operation.
 	WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+	// WaitForDiskStatus waits for a disk to reach the specified status. This is useful after operations performed
+	// outside this client, for example in the administration portal.
+	WaitForDiskStatus(diskID DiskID, status DiskStatus, retries ...RetryStrategy) (Disk, error)
+	// WatchDisk reports the changes of a disk on the returned channel, instead of each caller implementing its own
+	// polling loop. See WatchVM for details. Use WatchParams to create the parameters, or pass nil for the defaults.
+	WatchDisk(id DiskID, params WatchParameters, retries ...RetryStrategy) (
+		events <-chan DiskWatchEvent,
+		stop func(),
+		err error,
+	)
 }
 
 // UpdateDiskParams creates a builder for the params for updating a disk.
@@ -342,6 +395,24 @@ type CreateDiskOptionalParameters interface {
 
 	// InitialSize is the initially reserved disk space when creating the disk.
 	InitialSize() *uint64
+
+	// ContentType is the type of content the disk will hold. If it returns nil, the disk will hold data.
+	ContentType() *DiskContentType
+
+	// WipeAfterDelete indicates that the disk should be wiped when it is removed. If it returns nil, the default
+	// will be used.
+	WipeAfterDelete() *bool
+
+	// Shareable indicates that the disk can be attached to multiple VMs at the same time. If it returns nil, the disk
+	// will not be shareable.
+	Shareable() *bool
+
+	// Backup is the backup mode of the disk. If it returns nil, the default will be used.
+	Backup() *DiskBackup
+
+	// DiskProfileID is the disk profile to assign to the disk. If it returns nil, the default profile of the
+	// storage domain will be used.
+	DiskProfileID() *DiskProfileID
 }
 
 // BuildableCreateDiskParameters is a buildable version of CreateDiskOptionalParameters.
@@ -362,6 +433,36 @@ type BuildableCreateDiskParameters interface {
 	WithInitialSize(size uint64) (BuildableCreateDiskParameters, error)
 	// MustWithInitialSize is the same as WithInitialSize, but panics instead of returning an error.
 	MustWithInitialSize(size uint64) BuildableCreateDiskParameters
+
+	// WithContentType sets the content type of the disk. Use DiskContentTypeISO for uploading ISO images that can be
+	// inserted into the CD-ROM drive of VMs.
+	WithContentType(contentType DiskContentType) (BuildableCreateDiskParameters, error)
+	// MustWithContentType is the same as WithContentType, but panics instead of returning an error.
+	MustWithContentType(contentType DiskContentType) BuildableCreateDiskParameters
+
+	// WithWipeAfterDelete sets if the disk should be wiped when it is removed.
+	WithWipeAfterDelete(wipeAfterDelete bool) (BuildableCreateDiskParameters, error)
+	// MustWithWipeAfterDelete is the same as WithWipeAfterDelete, but panics instead of returning an error.
+	MustWithWipeAfterDelete(wipeAfterDelete bool) BuildableCreateDiskParameters
+
+	// WithShareable sets if the disk can be attached to multiple VMs at the same time. Shareable disks must use the
+	// raw format.
+	WithShareable(shareable bool) (BuildableCreateDiskParameters, error)
+	// MustWithShareable is the same as WithShareable, but panics instead of returning an error.
+	MustWithShareable(shareable bool) BuildableCreateDiskParameters
+
+	// WithBackup sets the backup mode of the disk. Use DiskBackupIncremental to enable incremental backups, which
+	// requires the cow format.
+	WithBackup(backup DiskBackup) (BuildableCreateDiskParameters, error)
+	// MustWithBackup is the same as WithBackup, but panics instead of returning an error.
+	MustWithBackup(backup DiskBackup) BuildableCreateDiskParameters
+
+	// WithDiskProfileID sets the disk profile of the disk, which limits its throughput and IOPS through the QoS entry
+	// of the profile. The profile must belong to the storage domain the disk is created on, see
+	// ListStorageDomainDiskProfiles.
+	WithDiskProfileID(diskProfileID DiskProfileID) (BuildableCreateDiskParameters, error)
+	// MustWithDiskProfileID is the same as WithDiskProfileID, but panics instead of returning an error.
+	MustWithDiskProfileID(diskProfileID DiskProfileID) BuildableCreateDiskParameters
 }
 
 // CreateDiskParams creates a buildable set of CreateDiskOptionalParameters for use with
@@ -371,9 +472,14 @@ func CreateDiskParams() BuildableCreateDiskParameters {
 }
 
 type createDiskParams struct {
-	alias       string
-	sparse      *bool
-	initialSize *uint64
+	alias           string
+	sparse          *bool
+	initialSize     *uint64
+	contentType     *DiskContentType
+	wipeAfterDelete *bool
+	shareable       *bool
+	backup          *DiskBackup
+	diskProfileID   *DiskProfileID
 }
 
 func (c *createDiskParams) Alias() string {
@@ -427,6 +533,142 @@ func (c *createDiskParams) MustWithInitialSize(size uint64) BuildableCreateDiskP
 	return builder
 }
 
+func (c *createDiskParams) ContentType() *DiskContentType {
+	return c.contentType
+}
+
+func (c *createDiskParams) WithContentType(contentType DiskContentType) (BuildableCreateDiskParameters, error) {
+	if err := contentType.Validate(); err != nil {
+		return c, err
+	}
+	c.contentType = &contentType
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithContentType(contentType DiskContentType) BuildableCreateDiskParameters {
+	builder, err := c.WithContentType(contentType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) WipeAfterDelete() *bool {
+	return c.wipeAfterDelete
+}
+
+func (c *createDiskParams) WithWipeAfterDelete(wipeAfterDelete bool) (BuildableCreateDiskParameters, error) {
+	c.wipeAfterDelete = &wipeAfterDelete
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithWipeAfterDelete(wipeAfterDelete bool) BuildableCreateDiskParameters {
+	builder, err := c.WithWipeAfterDelete(wipeAfterDelete)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) Shareable() *bool {
+	return c.shareable
+}
+
+func (c *createDiskParams) WithShareable(shareable bool) (BuildableCreateDiskParameters, error) {
+	c.shareable = &shareable
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithShareable(shareable bool) BuildableCreateDiskParameters {
+	builder, err := c.WithShareable(shareable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) Backup() *DiskBackup {
+	return c.backup
+}
+
+func (c *createDiskParams) WithBackup(backup DiskBackup) (BuildableCreateDiskParameters, error) {
+	if err := backup.Validate(); err != nil {
+		return c, err
+	}
+	c.backup = &backup
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithBackup(backup DiskBackup) BuildableCreateDiskParameters {
+	builder, err := c.WithBackup(backup)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) DiskProfileID() *DiskProfileID {
+	return c.diskProfileID
+}
+
+func (c *createDiskParams) WithDiskProfileID(diskProfileID DiskProfileID) (BuildableCreateDiskParameters, error) {
+	if diskProfileID == "" {
+		return c, newError(EBadArgument, "the disk profile ID must not be empty")
+	}
+	c.diskProfileID = &diskProfileID
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithDiskProfileID(diskProfileID DiskProfileID) BuildableCreateDiskParameters {
+	builder, err := c.WithDiskProfileID(diskProfileID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// CopyDiskOptionalParameters holds the optional parameters for DiskClient.CopyDisk.
+type CopyDiskOptionalParameters interface {
+	// Alias is the alias the copied disk should have. If empty, the alias of the source disk is used.
+	Alias() string
+}
+
+// BuildableCopyDiskParameters is a buildable version of CopyDiskOptionalParameters.
+type BuildableCopyDiskParameters interface {
+	CopyDiskOptionalParameters
+
+	// WithAlias sets the alias of the copied disk.
+	WithAlias(alias string) (BuildableCopyDiskParameters, error)
+	// MustWithAlias is the same as WithAlias, but panics instead of returning an error.
+	MustWithAlias(alias string) BuildableCopyDiskParameters
+}
+
+// CopyDiskParams creates a buildable set of CopyDiskOptionalParameters for use with Client.CopyDisk.
+func CopyDiskParams() BuildableCopyDiskParameters {
+	return &copyDiskParams{}
+}
+
+type copyDiskParams struct {
+	alias string
+}
+
+func (c *copyDiskParams) Alias() string {
+	return c.alias
+}
+
+func (c *copyDiskParams) WithAlias(alias string) (BuildableCopyDiskParameters, error) {
+	c.alias = alias
+	return c, nil
+}
+
+func (c *copyDiskParams) MustWithAlias(alias string) BuildableCopyDiskParameters {
+	builder, err := c.WithAlias(alias)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // DiskCreation is a process object that lets you query the status of the disk creation.
 type DiskCreation interface {
 	// Disk returns the disk that has been created, even if it is not yet ready.
@@ -467,6 +709,7 @@ type ImageDownloadReader interface {
 // close the image download when it is finished otherwise the disk will not be unlocked.
 type ImageDownload interface {
 	ImageDownloadReader
+	ImageExtentReader
 
 	// Err returns the error that happened during initializing the download, or the last error reading from the
 	// image server.
@@ -498,12 +741,27 @@ type DiskData interface {
 	Format() ImageFormat
 	// StorageDomainIDs returns a list of storage domains this disk is present on. This will typically be a single
 	// disk, but may have multiple disk when the disk has been copied over to other storage domains. The disk is always
-	// present on at least one disk, so this list will never be empty.
+	// present on at least one disk, so this list will only be empty for direct LUN disks.
 	StorageDomainIDs() []StorageDomainID
 	// Status returns the status the disk is in.
 	Status() DiskStatus
 	// Sparse indicates sparse provisioning on the disk.
 	Sparse() bool
+	// ContentType returns the type of content stored on the disk, for example DiskContentTypeISO for ISO images.
+	ContentType() DiskContentType
+	// WipeAfterDelete indicates that the disk is wiped when it is removed.
+	WipeAfterDelete() bool
+	// Shareable indicates that the disk can be attached to multiple VMs at the same time.
+	Shareable() bool
+	// Backup returns the backup mode of the disk.
+	Backup() DiskBackup
+	// StorageType returns where the data of the disk is stored, for example DiskStorageTypeLUN for direct LUN disks.
+	StorageType() DiskStorageType
+	// LogicalUnit returns the logical unit backing a direct LUN disk. It returns nil for other disks.
+	LogicalUnit() DiskLogicalUnit
+	// DiskProfileID returns the ID of the disk profile limiting the storage usage of the disk. It returns nil for
+	// direct LUN disks, which have no profile.
+	DiskProfileID() *DiskProfileID
 }
 
 // Disk is a disk in oVirt.
@@ -557,6 +815,14 @@ type Disk interface {
 		retries ...RetryStrategy,
 	) (Disk, error)
 
+	// Copy copies the current disk to the specified storage domain and returns the new disk once the copy is
+	// complete. Use CopyDiskParams() to obtain a buildable structure for the optional parameters.
+	Copy(
+		storageDomainID StorageDomainID,
+		params CopyDiskOptionalParameters,
+		retries ...RetryStrategy,
+	) (Disk, error)
+
 	// StorageDomains will fetch and return the storage domains associated with this disk.
 	StorageDomains(retries ...RetryStrategy) ([]StorageDomain, error)
 
@@ -564,6 +830,100 @@ type Disk interface {
 	WaitForOK(retries ...RetryStrategy) (Disk, error)
 }
 
+// DiskSearchParameters declares the parameters that can be passed to a disk search. Each parameter is declared as a
+// pointer, where a nil value will mean that parameter will not be searched for. All parameters are used together as
+// an AND filter.
+type DiskSearchParameters interface {
+	// Alias will match the alias of the disk exactly.
+	Alias() *string
+	// VMName will match the disks attached to the VM with this name.
+	VMName() *string
+	// Statuses will return a list of acceptable statuses for this disk search.
+	Statuses() *DiskStatusList
+}
+
+// BuildableDiskSearchParameters is a buildable version of DiskSearchParameters.
+type BuildableDiskSearchParameters interface {
+	DiskSearchParameters
+
+	// WithAlias sets the alias to search for.
+	WithAlias(alias string) BuildableDiskSearchParameters
+	// WithVMName sets the name of the VM the disks are attached to.
+	WithVMName(name string) BuildableDiskSearchParameters
+	// WithStatus adds a single status to the filter.
+	WithStatus(status DiskStatus) BuildableDiskSearchParameters
+	// WithStatuses sets the statuses the returned disks should be in.
+	WithStatuses(list DiskStatusList) BuildableDiskSearchParameters
+}
+
+// DiskSearchParams creates a buildable set of search parameters for easier use.
+func DiskSearchParams() BuildableDiskSearchParameters {
+	return &diskSearchParams{
+		lock: &sync.Mutex{},
+	}
+}
+
+type diskSearchParams struct {
+	lock *sync.Mutex
+
+	alias    *string
+	vmName   *string
+	statuses *DiskStatusList
+}
+
+func (d *diskSearchParams) Alias() *string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.alias
+}
+
+func (d *diskSearchParams) VMName() *string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.vmName
+}
+
+func (d *diskSearchParams) Statuses() *DiskStatusList {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.statuses
+}
+
+func (d *diskSearchParams) WithAlias(alias string) BuildableDiskSearchParameters {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.alias = &alias
+	return d
+}
+
+func (d *diskSearchParams) WithVMName(name string) BuildableDiskSearchParameters {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.vmName = &name
+	return d
+}
+
+func (d *diskSearchParams) WithStatus(status DiskStatus) BuildableDiskSearchParameters {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var newStatuses DiskStatusList
+	if d.statuses != nil {
+		newStatuses = append(newStatuses, *d.statuses...)
+	}
+	newStatuses = append(newStatuses, status)
+	d.statuses = &newStatuses
+	return d
+}
+
+func (d *diskSearchParams) WithStatuses(list DiskStatusList) BuildableDiskSearchParameters {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	newStatuses := make(DiskStatusList, len(list))
+	copy(newStatuses, list)
+	d.statuses = &newStatuses
+	return d
+}
+
 // DiskStatus shows the status of a disk. Certain operations lock a disk, which is important because the disk can then
 // not be changed.
 type DiskStatus string
@@ -599,6 +959,135 @@ func (l DiskStatusList) Strings() []string {
 	return result
 }
 
+// Validate returns an error if the disk status doesn't have a valid value.
+func (d DiskStatus) Validate() error {
+	for _, status := range DiskStatusValues() {
+		if status == d {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk status: %s must be one of: %s",
+		d,
+		DiskStatusValues().Strings(),
+	)
+}
+
+// DiskContentType is the type of content a disk holds.
+type DiskContentType string
+
+const (
+	// DiskContentTypeData is a regular disk that can be attached to a VM.
+	DiskContentTypeData DiskContentType = "data"
+	// DiskContentTypeISO is an ISO image that can be inserted into the CD-ROM drive of a VM.
+	DiskContentTypeISO DiskContentType = "iso"
+	// DiskContentTypeOVFStore holds the OVF store of a storage domain.
+	DiskContentTypeOVFStore DiskContentType = "ovf_store"
+	// DiskContentTypeMemoryDumpVolume holds the memory of a VM snapshot.
+	DiskContentTypeMemoryDumpVolume DiskContentType = "memory_dump_volume"
+	// DiskContentTypeMemoryMetadataVolume holds the metadata of a VM memory snapshot.
+	DiskContentTypeMemoryMetadataVolume DiskContentType = "memory_metadata_volume"
+	// DiskContentTypeBackupScratch is a scratch disk used during a VM backup.
+	DiskContentTypeBackupScratch DiskContentType = "backup_scratch"
+	// DiskContentTypeHostedEngine is the disk of the hosted engine VM.
+	DiskContentTypeHostedEngine DiskContentType = "hosted_engine"
+	// DiskContentTypeHostedEngineSanlock is the sanlock disk of the hosted engine.
+	DiskContentTypeHostedEngineSanlock DiskContentType = "hosted_engine_sanlock"
+	// DiskContentTypeHostedEngineMetadata is the metadata disk of the hosted engine.
+	DiskContentTypeHostedEngineMetadata DiskContentType = "hosted_engine_metadata"
+	// DiskContentTypeHostedEngineConfiguration is the configuration disk of the hosted engine.
+	DiskContentTypeHostedEngineConfiguration DiskContentType = "hosted_engine_configuration"
+)
+
+// DiskContentTypeList is a list of DiskContentType values.
+type DiskContentTypeList []DiskContentType
+
+// DiskContentTypeValues returns all possible values for DiskContentType.
+func DiskContentTypeValues() DiskContentTypeList {
+	return []DiskContentType{
+		DiskContentTypeData,
+		DiskContentTypeISO,
+		DiskContentTypeOVFStore,
+		DiskContentTypeMemoryDumpVolume,
+		DiskContentTypeMemoryMetadataVolume,
+		DiskContentTypeBackupScratch,
+		DiskContentTypeHostedEngine,
+		DiskContentTypeHostedEngineSanlock,
+		DiskContentTypeHostedEngineMetadata,
+		DiskContentTypeHostedEngineConfiguration,
+	}
+}
+
+// Strings returns a list of strings.
+func (l DiskContentTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, contentType := range l {
+		result[i] = string(contentType)
+	}
+	return result
+}
+
+// Validate returns an error if the content type doesn't have a valid value.
+func (c DiskContentType) Validate() error {
+	for _, contentType := range DiskContentTypeValues() {
+		if contentType == c {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk content type: %s must be one of: %s",
+		c,
+		DiskContentTypeValues().Strings(),
+	)
+}
+
+// DiskBackup is the backup mode of a disk.
+type DiskBackup string
+
+const (
+	// DiskBackupNone means that the disk does not take part in incremental backups.
+	DiskBackupNone DiskBackup = "none"
+	// DiskBackupIncremental enables incremental backups for the disk. The disk must use the cow format.
+	DiskBackupIncremental DiskBackup = "incremental"
+)
+
+// DiskBackupList is a list of DiskBackup values.
+type DiskBackupList []DiskBackup
+
+// DiskBackupValues returns all possible values for DiskBackup.
+func DiskBackupValues() DiskBackupList {
+	return []DiskBackup{
+		DiskBackupNone,
+		DiskBackupIncremental,
+	}
+}
+
+// Strings returns a list of strings.
+func (l DiskBackupList) Strings() []string {
+	result := make([]string, len(l))
+	for i, backup := range l {
+		result[i] = string(backup)
+	}
+	return result
+}
+
+// Validate returns an error if the backup mode doesn't have a valid value.
+func (b DiskBackup) Validate() error {
+	for _, backup := range DiskBackupValues() {
+		if backup == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk backup mode: %s must be one of: %s",
+		b,
+		DiskBackupValues().Strings(),
+	)
+}
+
 // UploadImageProgress is a tracker for the upload progress happening in the background.
 type UploadImageProgress interface {
 	// Disk returns the disk created as part of the upload process once the upload is complete. Before the upload
@@ -674,6 +1163,9 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "disk does not contain an ID")
 	}
+	if storageType, ok := sdkDisk.StorageType(); ok && storageType == ovirtsdk4.DISKSTORAGETYPE_LUN {
+		return convertSDKLUNDisk(id, sdkDisk, client)
+	}
 	var storageDomainIDs []StorageDomainID
 	if sdkStorageDomain, ok := sdkDisk.StorageDomain(); ok {
 		storageDomainID, _ := sdkStorageDomain.Id()
@@ -685,6 +1177,10 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 			storageDomainIDs = append(storageDomainIDs, StorageDomainID(storageDomainID))
 		}
 	}
+	storageType := DiskStorageTypeImage
+	if sdkStorageType, ok := sdkDisk.StorageType(); ok {
+		storageType = DiskStorageType(sdkStorageType)
+	}
 	if len(storageDomainIDs) == 0 {
 		return nil, newError(EFieldMissing, "failed to find a valid storage domain for disk %s", id)
 	}
@@ -712,6 +1208,23 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "disk %s has no sparse field", id)
 	}
+	contentType := DiskContentTypeData
+	if sdkContentType, ok := sdkDisk.ContentType(); ok {
+		contentType = DiskContentType(sdkContentType)
+	}
+	wipeAfterDelete, _ := sdkDisk.WipeAfterDelete()
+	shareable, _ := sdkDisk.Shareable()
+	backup := DiskBackupNone
+	if sdkBackup, ok := sdkDisk.Backup(); ok {
+		backup = DiskBackup(sdkBackup)
+	}
+	var diskProfileID *DiskProfileID
+	if sdkDiskProfile, ok := sdkDisk.DiskProfile(); ok {
+		if sdkDiskProfileID, ok := sdkDiskProfile.Id(); ok {
+			profileID := DiskProfileID(sdkDiskProfileID)
+			diskProfileID = &profileID
+		}
+	}
 	return &disk{
 		client: client,
 
@@ -723,6 +1236,12 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 		storageDomainIDs: storageDomainIDs,
 		status:           DiskStatus(status),
 		sparse:           sparse,
+		contentType:      contentType,
+		wipeAfterDelete:  wipeAfterDelete,
+		shareable:        shareable,
+		backup:           backup,
+		storageType:      storageType,
+		diskProfileID:    diskProfileID,
 	}, nil
 }
 
@@ -737,6 +1256,13 @@ type disk struct {
 	status           DiskStatus
 	totalSize        uint64
 	sparse           bool
+	contentType      DiskContentType
+	wipeAfterDelete  bool
+	shareable        bool
+	backup           DiskBackup
+	storageType      DiskStorageType
+	logicalUnit      *diskLogicalUnit
+	diskProfileID    *DiskProfileID
 }
 
 func (d *disk) WaitForOK(retries ...RetryStrategy) (Disk, error) {
@@ -748,13 +1274,19 @@ func (d *disk) StorageDomainIDs() []StorageDomainID {
 }
 
 func (d *disk) StorageDomains(retries ...RetryStrategy) ([]StorageDomain, error) {
-	storageDomains := make([]StorageDomain, len(d.storageDomainIDs))
+	ids := make([]string, len(d.storageDomainIDs))
 	for i, id := range d.storageDomainIDs {
-		storageDomain, err := d.client.GetStorageDomain(id, retries...)
-		if err != nil {
-			return nil, err
-		}
-		storageDomains[i] = storageDomain
+		ids[i] = string(id)
+	}
+	objects, err := ResolveIDs(ids, defaultResolveConcurrency, func(id string) (interface{}, error) {
+		return d.client.GetStorageDomain(StorageDomainID(id), retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	storageDomains := make([]StorageDomain, len(objects))
+	for i, object := range objects {
+		storageDomains[i] = object.(StorageDomain)
 	}
 	return storageDomains, nil
 }
@@ -763,6 +1295,14 @@ func (d *disk) Update(params UpdateDiskParameters, retries ...RetryStrategy) (Di
 	return d.client.UpdateDisk(d.id, params, retries...)
 }
 
+func (d *disk) Copy(
+	storageDomainID StorageDomainID,
+	params CopyDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	return d.client.CopyDisk(d.id, storageDomainID, params, retries...)
+}
+
 func (d *disk) StartUpdate(params UpdateDiskParameters, retries ...RetryStrategy) (DiskUpdate, error) {
 	return d.client.StartUpdateDisk(d.id, params, retries...)
 }
@@ -771,6 +1311,37 @@ func (d *disk) Sparse() bool {
 	return d.sparse
 }
 
+func (d *disk) ContentType() DiskContentType {
+	return d.contentType
+}
+
+func (d *disk) WipeAfterDelete() bool {
+	return d.wipeAfterDelete
+}
+
+func (d *disk) Shareable() bool {
+	return d.shareable
+}
+
+func (d *disk) Backup() DiskBackup {
+	return d.backup
+}
+
+func (d *disk) StorageType() DiskStorageType {
+	return d.storageType
+}
+
+func (d *disk) DiskProfileID() *DiskProfileID {
+	return d.diskProfileID
+}
+
+func (d *disk) LogicalUnit() DiskLogicalUnit {
+	if d.logicalUnit == nil {
+		return nil
+	}
+	return d.logicalUnit
+}
+
 func (d *disk) AttachToVM(
 	vmID VMID,
 	diskInterface DiskInterface,
@@ -839,7 +1410,7 @@ func (d *diskWait) Wait(retries ...RetryStrategy) (Disk, error) {
 	diskID := d.disk.ID()
 	d.lock.Unlock()
 
-	disk, err := d.client.GetDisk(diskID, retries...)
+	disk, err := d.client.WaitForDiskOK(diskID, retries...)
 
 	d.lock.Lock()
 	defer d.lock.Unlock()
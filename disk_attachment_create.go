@@ -19,6 +19,13 @@ func (o *oVirtClient) CreateDiskAttachment(
 	}
 	err = retry(
 		fmt.Sprintf("attaching disk %s to vm %s", diskID, vmID),
+		mutating(ResourceTypeDiskAttachment, "").
+			withParameters(map[string]string{
+				"vm_id":     string(vmID),
+				"disk_id":   string(diskID),
+				"interface": string(diskInterface),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -38,7 +45,7 @@ func (o *oVirtClient) CreateDiskAttachment(
 
 			addRequest := o.conn.SystemService().VmsService().VmService(string(vmID)).DiskAttachmentsService().Add()
 			addRequest.Attachment(attachment)
-			response, err := addRequest.Send()
+			response, err := addRequest.Query("correlation_id", requestCorrelationID(retries)).Send()
 			if err != nil {
 				return wrap(
 					err,
@@ -61,6 +68,9 @@ func (o *oVirtClient) CreateDiskAttachment(
 			return nil
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeDiskAttachment, string(result.ID()), string(vmID), MutationTypeCreated)
+	}
 	return result, err
 }
 
@@ -109,18 +119,36 @@ func (m *mockClient) CreateDiskAttachment(
 		}
 	}
 
-	if diskAttachment, ok := m.vmDiskAttachmentsByDisk[disk.ID()]; ok {
+	if !disk.shareable && len(m.vmDiskAttachmentsByDisk[disk.ID()]) > 0 {
 		return nil, newError(
 			EConflict,
-			"cannot attach disk %s to VM %s, already attached to VM %s",
+			"cannot attach disk %s to VM %s, the disk is already attached to another VM and is not shareable",
 			diskID,
 			vmID,
-			diskAttachment.VMID(),
 		)
 	}
 
-	m.vmDiskAttachmentsByDisk[disk.ID()] = attachment
+	m.addDiskAttachmentByDisk(attachment)
 	m.vmDiskAttachmentsByVM[vm.ID()][attachment.ID()] = attachment
+	m.mutationListeners.notify(ResourceTypeDiskAttachment, string(attachment.id), string(vmID), MutationTypeCreated)
 
 	return attachment, nil
 }
+
+// addDiskAttachmentByDisk indexes the disk attachment by its disk. It must be called with the lock held.
+func (m *mockClient) addDiskAttachmentByDisk(attachment *diskAttachment) {
+	if _, ok := m.vmDiskAttachmentsByDisk[attachment.diskID]; !ok {
+		m.vmDiskAttachmentsByDisk[attachment.diskID] = map[DiskAttachmentID]*diskAttachment{}
+	}
+	m.vmDiskAttachmentsByDisk[attachment.diskID][attachment.id] = attachment
+}
+
+// isDiskAttachedToVM returns true if the disk is attached to the specified VM. It must be called with the lock held.
+func (m *mockClient) isDiskAttachedToVM(diskID DiskID, vmID VMID) bool {
+	for _, attachment := range m.vmDiskAttachmentsByDisk[diskID] {
+		if attachment.vmid == vmID {
+			return true
+		}
+	}
+	return false
+}
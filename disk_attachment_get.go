@@ -12,6 +12,7 @@ func (o *oVirtClient) GetDiskAttachment(
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting disk attachment %s on VM %s", id, vmid),
+		reading(ResourceTypeDiskAttachment, string(id)),
 		o.logger,
 		retries,
 		func() error {
@@ -22,6 +23,7 @@ func (o *oVirtClient) GetDiskAttachment(
 				DiskAttachmentsService().
 				AttachmentService(string(id)).
 				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			if err != nil {
 				return err
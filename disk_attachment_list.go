@@ -13,10 +13,18 @@ func (o *oVirtClient) ListDiskAttachments(
 	result = []DiskAttachment{}
 	err = retry(
 		fmt.Sprintf("listing disk attachments on VM %s", vmid),
+		reading(ResourceTypeVM, string(vmid)),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().VmService(string(vmid)).DiskAttachmentsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				DiskAttachmentsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
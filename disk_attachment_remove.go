@@ -6,8 +6,12 @@ import (
 
 func (o *oVirtClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttachmentID, retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	return retry(
+	err := retry(
 		fmt.Sprintf("removing disk attachment %s on VM %s", diskAttachmentID, vmID),
+		mutating(ResourceTypeDiskAttachment, string(diskAttachmentID)).
+			withParameters(map[string]string{
+				"vm_id": string(vmID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -18,10 +22,20 @@ func (o *oVirtClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttac
 				DiskAttachmentsService().
 				AttachmentService(string(diskAttachmentID)).
 				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			return err
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(
+			ResourceTypeDiskAttachment,
+			string(diskAttachmentID),
+			string(vmID),
+			MutationTypeRemoved,
+		)
+	}
+	return err
 }
 
 func (m *mockClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttachmentID, _ ...RetryStrategy) error {
@@ -38,8 +52,9 @@ func (m *mockClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttach
 		return newError(ENotFound, "Disk attachment %s not found on VM %s", diskAttachmentID, vmID)
 	}
 
-	delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+	delete(m.vmDiskAttachmentsByDisk[diskAttachment.DiskID()], diskAttachmentID)
 	delete(m.vmDiskAttachmentsByVM[vmID], diskAttachmentID)
+	m.mutationListeners.notify(ResourceTypeDiskAttachment, string(diskAttachmentID), string(vmID), MutationTypeRemoved)
 
 	return nil
 }
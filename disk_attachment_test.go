@@ -55,6 +55,41 @@ func TestDiskAttachmentCannotBeAttachedToSecondVM(t *testing.T) {
 	assertCannotAttachDisk(t, vm2, disk, ovirtclient.EConflict)
 }
 
+func TestShareableDiskCanBeAttachedToSecondVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm1 := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	vm2 := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+	if !disk.Shareable() {
+		t.Fatalf("Disk is not shareable after creation.")
+	}
+	attachment1 := assertCanAttachDisk(t, vm1, disk)
+	attachment2 := assertCanAttachDisk(t, vm2, disk)
+	assertDiskAttachmentMatches(t, attachment1, disk, vm1)
+	assertDiskAttachmentMatches(t, attachment2, disk, vm2)
+
+	assertCanDetachDisk(t, attachment1)
+	assertDiskAttachmentCount(t, vm1, 0)
+	assertDiskAttachmentCount(t, vm2, 1)
+}
+
 func assertCanCreateDisk(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.Disk {
 	return assertCanCreateDiskWithParameters(t, helper, ovirtclient.ImageFormatRaw, nil)
 }
@@ -0,0 +1,232 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	params CopyDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	progress, err := o.StartCopyDisk(diskID, storageDomainID, params, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (o *oVirtClient) StartCopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	params CopyDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (DiskCreation, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+
+	sourceDisk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateImageDiskOperation(sourceDisk, "copying"); err != nil {
+		return nil, err
+	}
+	alias := sourceDisk.Alias()
+	if params != nil && params.Alias() != "" {
+		alias = params.Alias()
+	}
+
+	// The copy action does not return the new disk, so we record the disks that already have the target alias in
+	// order to identify the copy once the job has finished.
+	existingDiskIDs, err := o.listDiskIDsByAlias(alias, retries)
+	if err != nil {
+		return nil, err
+	}
+
+	correlationID := o.jobCorrelationID("disk_copy", retries)
+	sdkStorageDomain := ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID))
+	sdkDisk := ovirtsdk.NewDiskBuilder().Alias(alias)
+
+	err = retry(
+		fmt.Sprintf("copying disk %s to storage domain %s", diskID, storageDomainID),
+		mutating(ResourceTypeDisk, string(diskID)).
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+				"alias":             alias,
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DisksService().
+				DiskService(string(diskID)).
+				Copy().
+				StorageDomain(sdkStorageDomain.MustBuild()).
+				Disk(sdkDisk.MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &diskCopyWait{
+		client:          o,
+		alias:           alias,
+		storageDomainID: storageDomainID,
+		existingDiskIDs: existingDiskIDs,
+		correlationID:   correlationID,
+		lock:            &sync.Mutex{},
+	}, nil
+}
+
+// diskCopyWait waits for a disk copy to finish. Since the oVirt Engine does not return the newly created disk in the
+// copy response the disk is looked up by its alias after the job has finished.
+type diskCopyWait struct {
+	client          *oVirtClient
+	disk            Disk
+	alias           string
+	storageDomainID StorageDomainID
+	existingDiskIDs map[DiskID]struct{}
+	correlationID   string
+	lock            *sync.Mutex
+}
+
+// Disk returns the copied disk. It returns nil until Wait has identified the new disk.
+func (d *diskCopyWait) Disk() Disk {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.disk
+}
+
+func (d *diskCopyWait) Wait(retries ...RetryStrategy) (Disk, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(d.client))
+	if err := d.client.waitForJobFinished(d.correlationID, retries); err != nil {
+		return nil, err
+	}
+
+	newDisk, err := d.client.findNewDiskByAlias(d.alias, d.storageDomainID, d.existingDiskIDs, retries)
+	if err != nil {
+		return nil, err
+	}
+
+	d.lock.Lock()
+	d.disk = newDisk
+	d.lock.Unlock()
+	d.client.mutationListeners.notify(ResourceTypeDisk, string(newDisk.ID()), "", MutationTypeCreated)
+
+	disk, err := d.client.WaitForDiskOK(newDisk.ID(), retries...)
+	if err != nil {
+		return newDisk, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.disk = disk
+	return disk, nil
+}
+
+func (m *mockClient) CopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	params CopyDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	progress, err := m.StartCopyDisk(diskID, storageDomainID, params, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (m *mockClient) StartCopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	params CopyDiskOptionalParameters,
+	_ ...RetryStrategy,
+) (DiskCreation, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	sourceDisk, ok := m.disks[diskID]
+	if !ok {
+		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
+	}
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if err := validateImageDiskOperation(sourceDisk, "copying"); err != nil {
+		return nil, err
+	}
+	if err := validateStorageDomainImageTransfer(storageDomain, "copying a disk"); err != nil {
+		return nil, err
+	}
+	if sourceDisk.Status() != DiskStatusOK {
+		return nil, newError(EDiskLocked, "disk %s is %s", diskID, sourceDisk.Status())
+	}
+	if err := m.ensureStorageAvailable(
+		map[StorageDomainID]uint64{storageDomainID: sourceDisk.provisionedSize},
+	); err != nil {
+		return nil, err
+	}
+
+	newDisk := sourceDisk.clone(nil)
+	newDisk.storageDomainIDs = []StorageDomainID{storageDomainID}
+	newDisk.diskProfileID = m.defaultDiskProfileID(storageDomainID)
+	newDisk.status = DiskStatusLocked
+	if params != nil && params.Alias() != "" {
+		newDisk.alias = params.Alias()
+	}
+	if sourceDisk.data != nil {
+		newDisk.data = make([]byte, len(sourceDisk.data))
+		copy(newDisk.data, sourceDisk.data)
+	}
+	m.disks[newDisk.ID()] = newDisk
+	m.mutationListeners.notify(ResourceTypeDisk, string(newDisk.ID()), "", MutationTypeCreated)
+
+	creation := &mockDiskCopyCreation{
+		client: m,
+		disk:   newDisk,
+		done:   make(chan struct{}),
+	}
+	go creation.do()
+	return creation, nil
+}
+
+type mockDiskCopyCreation struct {
+	client *mockClient
+	disk   *diskWithData
+	done   chan struct{}
+}
+
+func (c *mockDiskCopyCreation) Disk() Disk {
+	c.client.lock.Lock()
+	defer c.client.lock.Unlock()
+
+	return c.disk
+}
+
+func (c *mockDiskCopyCreation) Wait(_ ...RetryStrategy) (Disk, error) {
+	<-c.done
+
+	return c.disk, nil
+}
+
+func (c *mockDiskCopyCreation) do() {
+	// Sleep to trigger potential race conditions / improper status handling.
+	time.Sleep(time.Second)
+
+	c.client.lock.Lock()
+	c.disk.Unlock()
+	c.client.lock.Unlock()
+
+	close(c.done)
+}
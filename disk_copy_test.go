@@ -0,0 +1,47 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiskCopyToSecondaryStorageDomain(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	targetStorageDomainID := helper.GetSecondaryStorageDomainID(t)
+	disk := assertCanCreateDisk(t, helper)
+
+	alias := fmt.Sprintf("disk-copy-%s", helper.GenerateRandomID(5))
+	copiedDisk, err := disk.Copy(targetStorageDomainID, ovirtclient.CopyDiskParams().MustWithAlias(alias))
+	if copiedDisk != nil {
+		t.Cleanup(
+			func() {
+				if err := copiedDisk.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+					t.Fatalf("Failed to remove copied disk %s (%v)", copiedDisk.ID(), err)
+				}
+			},
+		)
+	}
+	if err != nil {
+		t.Fatalf("Failed to copy disk %s to storage domain %s (%v)", disk.ID(), targetStorageDomainID, err)
+	}
+	if copiedDisk.ID() == disk.ID() {
+		t.Fatalf("The copied disk has the same ID as the source disk (%s).", disk.ID())
+	}
+	if copiedDisk.Alias() != alias {
+		t.Fatalf("Incorrect alias on copied disk (expected: %s, got: %s)", alias, copiedDisk.Alias())
+	}
+	if copiedDisk.Status() != ovirtclient.DiskStatusOK {
+		t.Fatalf("Copied disk is not in the OK status (%s).", copiedDisk.Status())
+	}
+	storageDomainIDs := copiedDisk.StorageDomainIDs()
+	if len(storageDomainIDs) != 1 || storageDomainIDs[0] != targetStorageDomainID {
+		t.Fatalf(
+			"Copied disk is not on the target storage domain %s (storage domains: %v)",
+			targetStorageDomainID,
+			storageDomainIDs,
+		)
+	}
+}
@@ -16,19 +16,35 @@ func (o *oVirtClient) StartCreateDisk(
 ) (DiskCreation, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 
-	if err := validateDiskCreationParameters(format, size); err != nil {
+	if err := validateDiskCreationParameters(format, size, params); err != nil {
 		return nil, err
 	}
+	if format != ImageFormatRaw {
+		// Only fetch the storage domain if the format may be unsupported there.
+		storageDomain, err := o.GetStorageDomain(storageDomainID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateStorageDomainDiskFormat(storageDomain, format); err != nil {
+			return nil, err
+		}
+	}
 
 	var result *diskWait
 	processName := "creating disk"
-	correlationID := ""
 	if params != nil && params.Alias() != "" {
 		processName = fmt.Sprintf("creating disk %s", params.Alias())
 	}
-	correlationID = fmt.Sprintf("disk_create_%s", generateRandomID(5, o.nonSecureRandom))
+	correlationID := o.jobCorrelationID("disk_create", retries)
 	err := retry(
 		processName,
+		mutating(ResourceTypeDisk, "").
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+				"format":            string(format),
+				"size":              fmt.Sprintf("%d", size),
+			}).
+			withResultID(func() string { return string(result.disk.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -60,14 +76,27 @@ func (o *oVirtClient) StartCreateDisk(
 	if err != nil {
 		return nil, err
 	}
+	o.mutationListeners.notify(ResourceTypeDisk, string(result.disk.ID()), "", MutationTypeCreated)
 	return result, nil
 }
 
-func validateDiskCreationParameters(format ImageFormat, size uint64) error {
+func validateDiskCreationParameters(format ImageFormat, size uint64, params CreateDiskOptionalParameters) error {
 	if err := format.Validate(); err != nil {
 		return err
 	}
-	return validateDiskSize(size)
+	if err := validateDiskSize(size); err != nil {
+		return err
+	}
+	if params == nil {
+		return nil
+	}
+	if shareable := params.Shareable(); shareable != nil && *shareable && format != ImageFormatRaw {
+		return newError(EBadArgument, "shareable disks must use the %s format", ImageFormatRaw)
+	}
+	if backup := params.Backup(); backup != nil && *backup == DiskBackupIncremental && format != ImageFormatCow {
+		return newError(EBadArgument, "incremental backup requires the %s disk format", ImageFormatCow)
+	}
+	return nil
 }
 
 func validateDiskSize(size uint64) error {
@@ -130,6 +159,21 @@ func (o *oVirtClient) buildDiskObjectForCreation(
 		if initialSize := params.InitialSize(); initialSize != nil {
 			diskBuilder.InitialSize(int64(*initialSize))
 		}
+		if contentType := params.ContentType(); contentType != nil {
+			diskBuilder.ContentType(ovirtsdk4.DiskContentType(*contentType))
+		}
+		if wipeAfterDelete := params.WipeAfterDelete(); wipeAfterDelete != nil {
+			diskBuilder.WipeAfterDelete(*wipeAfterDelete)
+		}
+		if shareable := params.Shareable(); shareable != nil {
+			diskBuilder.Shareable(*shareable)
+		}
+		if backup := params.Backup(); backup != nil {
+			diskBuilder.Backup(ovirtsdk4.DiskBackup(*backup))
+		}
+		if diskProfileID := params.DiskProfileID(); diskProfileID != nil {
+			diskBuilder.DiskProfileBuilder(ovirtsdk4.NewDiskProfileBuilder().Id(string(*diskProfileID)))
+		}
 	}
 	return diskBuilder.Build()
 }
@@ -0,0 +1,147 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateLUNDisk(
+	lunID string,
+	storageType StorageDomainType,
+	params CreateLUNDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (result Disk, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateLUNDiskCreationParameters(lunID, storageType, params); err != nil {
+		return nil, err
+	}
+	sdkDisk, err := buildLUNDiskObjectForCreation(lunID, storageType, params)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to construct direct LUN disk object")
+	}
+	err = retry(
+		fmt.Sprintf("creating direct LUN disk for LUN %s", lunID),
+		mutating(ResourceTypeDisk, "").
+			withParameters(map[string]string{
+				"lun_id":       lunID,
+				"storage_type": string(storageType),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				DisksService().
+				Add().
+				Disk(sdkDisk).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to create direct LUN disk for LUN %s", lunID)
+			}
+			responseDisk, ok := response.Disk()
+			if !ok {
+				return newFieldNotFound("disk add response", "disk")
+			}
+			result, err = convertSDKDisk(responseDisk, o)
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to convert SDK disk object")
+			}
+			return nil
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeDisk, string(result.ID()), "", MutationTypeCreated)
+	}
+	return result, err
+}
+
+func buildLUNDiskObjectForCreation(
+	lunID string,
+	storageType StorageDomainType,
+	params CreateLUNDiskOptionalParameters,
+) (*ovirtsdk4.Disk, error) {
+	logicalUnitBuilder := ovirtsdk4.NewLogicalUnitBuilder().Id(lunID)
+	diskBuilder := ovirtsdk4.NewDiskBuilder()
+	if params != nil {
+		if target := params.ISCSITarget(); target != "" {
+			logicalUnitBuilder.
+				Address(params.ISCSIAddress()).
+				Port(int64(params.ISCSIPort())).
+				Target(target)
+		}
+		if alias := params.Alias(); alias != "" {
+			diskBuilder.Alias(alias)
+		}
+		if shareable := params.Shareable(); shareable != nil {
+			diskBuilder.Shareable(*shareable)
+		}
+	}
+	logicalUnit, err := logicalUnitBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build logical unit object for LUN %s", lunID)
+	}
+	lunStorage, err := ovirtsdk4.NewHostStorageBuilder().
+		Type(ovirtsdk4.StorageType(storageType)).
+		LogicalUnitsOfAny(logicalUnit).
+		Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build LUN storage object for LUN %s", lunID)
+	}
+	return diskBuilder.LunStorage(lunStorage).Build()
+}
+
+func (m *mockClient) CreateLUNDisk(
+	lunID string,
+	storageType StorageDomainType,
+	params CreateLUNDiskOptionalParameters,
+	_ ...RetryStrategy,
+) (Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := validateLUNDiskCreationParameters(lunID, storageType, params); err != nil {
+		return nil, err
+	}
+	for _, existingDisk := range m.disks {
+		if existingDisk.logicalUnit != nil && existingDisk.logicalUnit.id == lunID {
+			return nil, newError(EConflict, "LUN %s is already used by disk %s", lunID, existingDisk.id)
+		}
+	}
+
+	// The mock has no access to the storage, so the size of the logical unit is not known.
+	logicalUnit := &diskLogicalUnit{
+		id:          lunID,
+		storageType: storageType,
+	}
+	result := &diskWithData{
+		disk: disk{
+			client:           m,
+			id:               DiskID(m.GenerateUUID()),
+			format:           ImageFormatRaw,
+			storageDomainIDs: []StorageDomainID{},
+			status:           DiskStatusOK,
+			contentType:      DiskContentTypeData,
+			backup:           DiskBackupNone,
+			storageType:      DiskStorageTypeLUN,
+			logicalUnit:      logicalUnit,
+		},
+		lock: &sync.Mutex{},
+	}
+	if params != nil {
+		result.alias = params.Alias()
+		if shareable := params.Shareable(); shareable != nil {
+			result.shareable = *shareable
+		}
+		logicalUnit.address = params.ISCSIAddress()
+		logicalUnit.port = params.ISCSIPort()
+		logicalUnit.target = params.ISCSITarget()
+	}
+
+	m.disks[result.id] = result
+	m.mutationListeners.notify(ResourceTypeDisk, string(result.id), "", MutationTypeCreated)
+	return result, nil
+}
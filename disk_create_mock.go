@@ -35,13 +35,27 @@ func (m *mockClient) createDisk(
 	size uint64,
 	params CreateDiskOptionalParameters,
 ) (*diskWithData, error) {
-	if err := validateDiskCreationParameters(format, size); err != nil {
+	if err := validateDiskCreationParameters(format, size, params); err != nil {
 		return nil, err
 	}
 
-	if _, ok := m.storageDomains[storageDomainID]; !ok {
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
 		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
 	}
+	if err := validateStorageDomainDiskFormat(storageDomain, format); err != nil {
+		return nil, err
+	}
+	diskProfileID := m.defaultDiskProfileID(storageDomainID)
+	if params != nil && params.DiskProfileID() != nil {
+		if err := m.validateDiskProfile(storageDomainID, *params.DiskProfileID()); err != nil {
+			return nil, err
+		}
+		diskProfileID = params.DiskProfileID()
+	}
+	if err := m.ensureStorageAvailable(map[StorageDomainID]uint64{storageDomainID: size}); err != nil {
+		return nil, err
+	}
 
 	disk := &diskWithData{
 		disk: disk{
@@ -52,6 +66,10 @@ func (m *mockClient) createDisk(
 			totalSize:        size,
 			storageDomainIDs: []StorageDomainID{storageDomainID},
 			status:           DiskStatusLocked,
+			contentType:      DiskContentTypeData,
+			backup:           DiskBackupNone,
+			storageType:      diskStorageTypeForStorageDomain(storageDomain),
+			diskProfileID:    diskProfileID,
 		},
 		lock: &sync.Mutex{},
 		data: nil,
@@ -64,9 +82,22 @@ func (m *mockClient) createDisk(
 		if sparse := params.Sparse(); sparse != nil {
 			disk.disk.sparse = *sparse
 		}
+		if contentType := params.ContentType(); contentType != nil {
+			disk.disk.contentType = *contentType
+		}
+		if wipeAfterDelete := params.WipeAfterDelete(); wipeAfterDelete != nil {
+			disk.disk.wipeAfterDelete = *wipeAfterDelete
+		}
+		if shareable := params.Shareable(); shareable != nil {
+			disk.disk.shareable = *shareable
+		}
+		if backup := params.Backup(); backup != nil {
+			disk.disk.backup = *backup
+		}
 	}
 
 	m.disks[disk.id] = disk
+	m.mutationListeners.notify(ResourceTypeDisk, string(disk.id), "", MutationTypeCreated)
 
 	return disk, nil
 }
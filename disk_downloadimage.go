@@ -15,7 +15,7 @@ import (
 
 // Deprecated: use StartDownloadDisk instead.
 func (o *oVirtClient) StartImageDownload(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
-	o.logger.Warningf("Using StartImageDownload is deprecated, please use StartDownloadDisk instead.")
+	warn(o.logger, retries, WDeprecated, "Using StartImageDownload is deprecated, please use StartDownloadDisk instead.")
 	return o.StartDownloadDisk(diskID, format, retries...)
 }
 
@@ -27,6 +27,20 @@ func (o *oVirtClient) StartDownloadDisk(diskID DiskID, format ImageFormat, retri
 	if err != nil {
 		return nil, wrap(err, EUnidentified, "failed to fetch disk for image download")
 	}
+	if err := validateImageDiskOperation(disk, "downloading an image"); err != nil {
+		return nil, err
+	}
+	if disk.Format() != format {
+		warn(
+			o.logger,
+			retries,
+			WFormatConverted,
+			"disk %s is stored in %s format, the engine will convert it to %s for the download",
+			diskID,
+			disk.Format(),
+			format,
+		)
+	}
 
 	realCtx, cancel := context.WithCancel(context.Background())
 
@@ -58,7 +72,7 @@ func (o *oVirtClient) DownloadImage(diskID DiskID, format ImageFormat, retries .
 	ImageDownloadReader,
 	error,
 ) {
-	o.logger.Warningf("Using DownloadImage is deprecated, please use DownloadDisk instead.")
+	warn(o.logger, retries, WDeprecated, "Using DownloadImage is deprecated, please use DownloadDisk instead.")
 	return o.DownloadDisk(diskID, format, retries...)
 }
 
@@ -100,6 +114,9 @@ type imageDownload struct {
 	retries    []RetryStrategy
 	format     ImageFormat
 	disk       Disk
+	backupID   BackupID
+	// transferURL is the ImageIO URL of the transfer. It is set once the transfer is initialized.
+	transferURL string
 }
 
 // poll polls the oVirt API for the status of the transfer and initializes the HTTP request to
@@ -112,6 +129,7 @@ func (i *imageDownload) poll() {
 		i.cli,
 		i.logger,
 		i.disk.ID(),
+		i.backupID,
 		"",
 		i.retries,
 		ovirtsdk4.IMAGETRANSFERDIRECTION_DOWNLOAD,
@@ -124,6 +142,7 @@ func (i *imageDownload) poll() {
 		i.lastError = i.transfer.finalize(err)
 		return
 	}
+	i.transferURL = transferURL
 	var httpResponse *http.Response
 	httpResponse, err = i.transferImage(transferURL) //nolint:bodyclose
 	if err != nil {
@@ -144,6 +163,7 @@ func (i *imageDownload) updateDisk(disk Disk) {
 func (i *imageDownload) transferImage(transferURL string) (httpResponse *http.Response, err error) {
 	return httpResponse, retry(
 		fmt.Sprintf("transferring image from %s", transferURL),
+		reading(ResourceTypeDisk, string(i.disk.ID())),
 		i.logger,
 		i.retries,
 		func() error {
@@ -291,7 +311,7 @@ func (m *mockClient) StartImageDownload(diskID DiskID, format ImageFormat, retri
 	return m.StartDownloadDisk(diskID, format, retries...)
 }
 
-func (m *mockClient) StartDownloadDisk(diskID DiskID, format ImageFormat, _ ...RetryStrategy) (ImageDownload, error) {
+func (m *mockClient) StartDownloadDisk(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -299,9 +319,20 @@ func (m *mockClient) StartDownloadDisk(diskID DiskID, format ImageFormat, _ ...R
 	if !ok {
 		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
 	}
+	if err := validateImageDiskOperation(disk, "downloading an image"); err != nil {
+		return nil, err
+	}
 
 	if disk.format != format {
-		m.logger.Warningf("the image upload client requested a conversion from from %s to %s; the mock library does not support this and the source image data will be used unmodified which may lead to errors", disk.format, format)
+		warn(
+			m.logger,
+			retries,
+			WFormatConverted,
+			"the image download client requested a conversion from %s to %s; the mock library does not support this "+
+				"and the source image data will be used unmodified, which may lead to errors",
+			disk.format,
+			format,
+		)
 	}
 
 	dl := &mockImageDownload{
@@ -349,6 +380,8 @@ type mockImageDownload struct {
 	lastError error
 	lock      *sync.Mutex
 	reader    io.Reader
+	// dirtyExtents indicates that the download is part of an incremental backup and can return dirty extents.
+	dirtyExtents bool
 }
 
 func (m *mockImageDownload) Err() error {
@@ -52,6 +52,42 @@ func TestImageDownload(t *testing.T) {
 	}
 }
 
+func TestImageDownloadReportsFormatConversion(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk, err := client.CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		1024*1024,
+		ovirtclient.CreateDiskParams().MustWithAlias(helper.GenerateTestResourceName(t)),
+	)
+	if err != nil {
+		t.Fatalf("failed to create disk (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := client.RemoveDisk(disk.ID()); err != nil {
+			t.Fatalf("failed to remove disk %s (%v)", disk.ID(), err)
+		}
+	})
+
+	warnings := ovirtclient.CollectWarnings()
+	download, err := client.DownloadDisk(disk.ID(), ovirtclient.ImageFormatCow, warnings)
+	if err != nil {
+		t.Fatalf("failed to download disk %s (%v)", disk.ID(), err)
+	}
+	if err := download.Close(); err != nil {
+		t.Fatalf("failed to close download of disk %s (%v)", disk.ID(), err)
+	}
+	for _, w := range warnings.Warnings() {
+		if w.Code() == ovirtclient.WFormatConverted {
+			return
+		}
+	}
+	t.Fatalf("downloading a raw disk in cow format did not report a %s warning", ovirtclient.WFormatConverted)
+}
+
 //go:embed testimage/*
 var testImageFS embed.FS
 
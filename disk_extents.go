@@ -0,0 +1,253 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ImageExtentReader provides access to the allocation information of an image while it is being downloaded. This
+// lets callers skip the parts of a thin disk that only contain zeroes, or, in case of an incremental backup, only
+// download the blocks that have changed since the last checkpoint.
+//
+// Extents must be fetched before the download is closed. Since reading the full image via Read automatically closes
+// the download, callers using extents should use ReadRange instead and call Close when done.
+type ImageExtentReader interface {
+	// Extents returns the extents of the image in the specified context. ImageExtentContextDirty is only available
+	// for disks downloaded from an incremental backup.
+	Extents(extentContext ImageExtentContext, retries ...RetryStrategy) ([]ImageExtent, error)
+	// ReadRange reads a part of the image starting at offset. The caller must close the returned reader.
+	ReadRange(offset uint64, length uint64, retries ...RetryStrategy) (io.ReadCloser, error)
+}
+
+// ImageExtentContext selects the kind of information ImageExtentReader.Extents returns.
+type ImageExtentContext string
+
+const (
+	// ImageExtentContextZero returns extents that indicate if a range reads as zeroes.
+	ImageExtentContextZero ImageExtentContext = "zero"
+	// ImageExtentContextDirty returns extents that indicate if a range has changed since the checkpoint the
+	// backup started from.
+	ImageExtentContextDirty ImageExtentContext = "dirty"
+)
+
+// ImageExtent is a contiguous range of an image with the same allocation state.
+type ImageExtent interface {
+	// Start is the offset of the extent in bytes.
+	Start() uint64
+	// Length is the length of the extent in bytes.
+	Length() uint64
+	// Zero indicates that the extent reads as zeroes. Only set in the ImageExtentContextZero context.
+	Zero() bool
+	// Hole indicates that the extent is not allocated in the image. Only set in the ImageExtentContextZero context.
+	Hole() bool
+	// Dirty indicates that the extent has changed since the checkpoint. Only set in the ImageExtentContextDirty
+	// context.
+	Dirty() bool
+}
+
+type imageExtent struct {
+	ExtentStart  uint64 `json:"start"`
+	ExtentLength uint64 `json:"length"`
+	ExtentZero   bool   `json:"zero"`
+	ExtentHole   bool   `json:"hole"`
+	ExtentDirty  bool   `json:"dirty"`
+}
+
+func (i imageExtent) Start() uint64 {
+	return i.ExtentStart
+}
+
+func (i imageExtent) Length() uint64 {
+	return i.ExtentLength
+}
+
+func (i imageExtent) Zero() bool {
+	return i.ExtentZero
+}
+
+func (i imageExtent) Hole() bool {
+	return i.ExtentHole
+}
+
+func (i imageExtent) Dirty() bool {
+	return i.ExtentDirty
+}
+
+func (i *imageDownload) Extents(extentContext ImageExtentContext, retries ...RetryStrategy) (
+	result []ImageExtent,
+	err error,
+) {
+	<-i.done
+	if i.lastError != nil {
+		return nil, i.lastError
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(i.cli))
+	extentsURL := fmt.Sprintf("%s/extents?context=%s", i.transferURL, extentContext)
+	err = retry(
+		fmt.Sprintf("fetching %s extents for disk %s", extentContext, i.disk.ID()),
+		reading(ResourceTypeDisk, string(i.disk.ID())),
+		i.logger,
+		retries,
+		func() error {
+			req, err := http.NewRequest(http.MethodGet, extentsURL, nil)
+			if err != nil {
+				return wrap(err, EBug, "failed to create HTTP request to %s", extentsURL)
+			}
+			response, err := i.httpClient.Do(req)
+			if err != nil {
+				return wrap(err, EConnection, "HTTP request to %s failed", extentsURL)
+			}
+			defer func() {
+				_ = response.Body.Close()
+			}()
+			if err := i.transfer.checkStatusCode(response.StatusCode); err != nil {
+				return wrap(err, EUnidentified, "failed to fetch %s extents for disk %s", extentContext, i.disk.ID())
+			}
+			var extents []imageExtent
+			if err := json.NewDecoder(response.Body).Decode(&extents); err != nil {
+				return wrap(err, EUnidentified, "failed to decode extents response from %s", extentsURL)
+			}
+			result = make([]ImageExtent, len(extents))
+			for j, extent := range extents {
+				result[j] = extent
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (i *imageDownload) ReadRange(offset uint64, length uint64, retries ...RetryStrategy) (
+	result io.ReadCloser,
+	err error,
+) {
+	<-i.done
+	if i.lastError != nil {
+		return nil, i.lastError
+	}
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(i.cli))
+	err = retry(
+		fmt.Sprintf("reading %d bytes at offset %d from disk %s", length, offset, i.disk.ID()),
+		reading(ResourceTypeDisk, string(i.disk.ID())),
+		i.logger,
+		retries,
+		func() error {
+			req, err := http.NewRequest(http.MethodGet, i.transferURL, nil)
+			if err != nil {
+				return wrap(err, EBug, "failed to create HTTP request to %s", i.transferURL)
+			}
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+			response, err := i.httpClient.Do(req)
+			if err != nil {
+				return wrap(err, EConnection, "HTTP request to image transfer URL %s failed", i.transferURL)
+			}
+			if err := i.transfer.checkStatusCode(response.StatusCode); err != nil {
+				_ = response.Body.Close()
+				return wrap(err, EUnidentified, "failed to read range from disk %s", i.disk.ID())
+			}
+			result = response.Body
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockImageDownload) Extents(extentContext ImageExtentContext, _ ...RetryStrategy) ([]ImageExtent, error) {
+	<-m.done
+	if m.lastError != nil {
+		return nil, m.lastError
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	size := uint64(len(m.disk.data))
+	switch extentContext {
+	case ImageExtentContextZero:
+		return mockZeroExtents(m.disk.data), nil
+	case ImageExtentContextDirty:
+		if !m.dirtyExtents {
+			return nil, newError(
+				ENotFound,
+				"dirty extents are only available for downloads from incremental backups",
+			)
+		}
+		// The mock doesn't track changes, so the whole image is reported as changed.
+		if size == 0 {
+			return []ImageExtent{}, nil
+		}
+		return []ImageExtent{imageExtent{ExtentStart: 0, ExtentLength: size, ExtentDirty: true}}, nil
+	default:
+		return nil, newError(EBadArgument, "invalid extent context: %s", extentContext)
+	}
+}
+
+func (m *mockImageDownload) ReadRange(offset uint64, length uint64, _ ...RetryStrategy) (io.ReadCloser, error) {
+	<-m.done
+	if m.lastError != nil {
+		return nil, m.lastError
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	size := uint64(len(m.disk.data))
+	if offset+length > size {
+		return nil, newError(
+			EBadArgument,
+			"range %d-%d is outside of the image size %d",
+			offset,
+			offset+length,
+			size,
+		)
+	}
+	data := make([]byte, length)
+	copy(data, m.disk.data[offset:offset+length])
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// mockExtentBlockSize is the granularity at which the mock detects zero extents.
+const mockExtentBlockSize = 64 * 1024
+
+// mockZeroExtents splits the data into blocks and merges consecutive blocks with the same zero state into extents.
+func mockZeroExtents(data []byte) []ImageExtent {
+	result := []ImageExtent{}
+	var current *imageExtent
+	for start := 0; start < len(data); start += mockExtentBlockSize {
+		end := start + mockExtentBlockSize
+		if end > len(data) {
+			end = len(data)
+		}
+		zero := isAllZero(data[start:end])
+		if current != nil && current.ExtentZero == zero {
+			current.ExtentLength += uint64(end - start)
+			continue
+		}
+		if current != nil {
+			result = append(result, *current)
+		}
+		current = &imageExtent{
+			ExtentStart:  uint64(start),
+			ExtentLength: uint64(end - start),
+			ExtentZero:   zero,
+			ExtentHole:   zero,
+		}
+	}
+	if current != nil {
+		result = append(result, *current)
+	}
+	return result
+}
+
+func isAllZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
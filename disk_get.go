@@ -10,10 +10,17 @@ func (o *oVirtClient) GetDisk(id DiskID, retries ...RetryStrategy) (result Disk,
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting disk %s", id),
+		reading(ResourceTypeDisk, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().DisksService().DiskService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				DisksService().
+				DiskService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
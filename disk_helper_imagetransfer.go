@@ -3,34 +3,20 @@ package ovirtclient
 import (
 	"errors"
 	"fmt"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
-// correlationIDRand is a random generator for selecting letters to put in the correlation ID. This does not need
-// to be cryptographically strong as it is short-lived.
-var correlationIDRand = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec,gochecknoglobals
-
-// generateCorrelationID generates a random ID usable for correlation.
-func generateCorrelationID(prefix string) string {
-	b := make([]byte, 8)
-	for i := range b {
-		b[i] = letters[correlationIDRand.Intn(len(letters))]
-	}
-	return fmt.Sprintf("%s%s", prefix, string(b))
-}
-
 // newImageTransfer creates a new image transfer for both uploads and downloads of images. It must be passed the
 // following parameters:
 //
 //   - cli is the oVirt SDK client.
 //   - logger is a logger from the go-ovirt-client-logger library
 //   - diskID is the ID of the disk that is being transferred to/from.
+//   - backupID is the ID of the backup the disk is downloaded from. It is empty for regular transfers.
 //   - correlationID is an optional unique ID that can be used to check if the job completed. If no correlation ID is
 //     passed, this function generates a random one.
 //   - retries is a list of retry strategies to use for each API call.
@@ -42,6 +28,7 @@ func newImageTransfer(
 	cli *oVirtClient,
 	logger Logger,
 	diskID DiskID,
+	backupID BackupID,
 	correlationID string,
 	retries []RetryStrategy,
 	direction ovirtsdk4.ImageTransferDirection,
@@ -55,6 +42,7 @@ func newImageTransfer(
 	return &imageTransferImpl{
 		retries:         retries,
 		diskID:          diskID,
+		backupID:        backupID,
 		cli:             cli,
 		logger:          logger,
 		correlationID:   correlationID,
@@ -93,6 +81,9 @@ type imageTransferImpl struct {
 	retries []RetryStrategy
 	// diskID is the ID of the disk used for this transfer.
 	diskID DiskID
+	// backupID is the ID of the backup this transfer downloads from. It is empty if the transfer is not part of a
+	// backup.
+	backupID BackupID
 	// cli is the calling client library.
 	cli *oVirtClient
 	// logger is the go-ovirt-client-log logger
@@ -205,6 +196,11 @@ func (i *imageTransferImpl) finalize(err error) error {
 //
 // This function also calls the updateDisk hook to update the disk on the calling side.
 func (i *imageTransferImpl) waitForTransferOk() (err error) {
+	if i.backupID != "" {
+		// Disks that are part of a backup are not locked by the transfer, so we only need to wait for the job.
+		return i.cli.waitForJobFinished(i.correlationID, i.retries)
+	}
+
 	disk, err := i.cli.WaitForDiskOK(i.diskID, i.retries...)
 
 	if err != nil {
@@ -225,10 +221,16 @@ func (i *imageTransferImpl) buildImageTransferRequest() (
 	*ovirtsdk4.ImageTransfersService,
 ) {
 	imageTransfersService := i.conn.SystemService().ImageTransfersService()
-	image := ovirtsdk4.NewImageBuilder().Id(string(i.diskID)).MustBuild()
-	transfer := ovirtsdk4.
-		NewImageTransferBuilder().
-		Image(image).
+	transferBuilder := ovirtsdk4.NewImageTransferBuilder()
+	if i.backupID != "" {
+		// Backup transfers must reference the disk and the backup instead of the image.
+		transferBuilder.
+			Disk(ovirtsdk4.NewDiskBuilder().Id(string(i.diskID)).MustBuild()).
+			Backup(ovirtsdk4.NewBackupBuilder().Id(string(i.backupID)).MustBuild())
+	} else {
+		transferBuilder.Image(ovirtsdk4.NewImageBuilder().Id(string(i.diskID)).MustBuild())
+	}
+	transfer := transferBuilder.
 		Direction(i.direction).
 		Format(i.format).
 		MustBuild()
@@ -239,12 +241,21 @@ func (i *imageTransferImpl) buildImageTransferRequest() (
 	return transferReq, imageTransfersService
 }
 
+// operationTarget returns the target of the calls managing the transfer. Only uploads change the disk.
+func (i *imageTransferImpl) operationTarget() operationTarget {
+	if i.direction == ovirtsdk4.IMAGETRANSFERDIRECTION_UPLOAD {
+		return mutating(ResourceTypeDisk, string(i.diskID))
+	}
+	return reading(ResourceTypeDisk, string(i.diskID))
+}
+
 // createImageTransfer repeatedly tries to create an image transfer until it succeeds or it runs out of retries.
 // This function will set the i.transfer and i.transferService variables with the created image transfer and
 // the associated service.
 func (i *imageTransferImpl) createImageTransfer() (err error) {
 	return retry(
 		fmt.Sprintf("starting image transfer for disk %s", i.diskID),
+		i.operationTarget(),
 		i.logger,
 		i.retries,
 		i.attemptCreateImageTransfer,
@@ -289,6 +300,7 @@ func (i *imageTransferImpl) waitForImageTransferReady() (err error) {
 			"waiting for image transfer to become ready for disk ID %s",
 			i.diskID,
 		),
+		reading(ResourceTypeDisk, string(i.diskID)),
 		i.logger,
 		i.retries,
 		i.checkImageTransferReady,
@@ -298,7 +310,7 @@ func (i *imageTransferImpl) waitForImageTransferReady() (err error) {
 // checkImageTransferReady retrieves the image transfer once and checks if it is in the transferring phase.
 // waitForImageTransferReady can be used to call this function repeatedly.
 func (i *imageTransferImpl) checkImageTransferReady() error {
-	req, err := i.transferService.Get().Send()
+	req, err := i.transferService.Get().Query("correlation_id", i.correlationID).Send()
 	if err != nil {
 		return err
 	}
@@ -341,6 +353,7 @@ func (i *imageTransferImpl) checkImageTransferReady() error {
 func (i *imageTransferImpl) finalizeTransfer() error {
 	return retry(
 		fmt.Sprintf("finalizing image for disk %s", i.diskID),
+		i.operationTarget(),
 		i.logger,
 		i.retries,
 		i.attemptFinalizeTransfer,
@@ -359,6 +372,7 @@ func (i *imageTransferImpl) attemptFinalizeTransfer() error {
 func (i *imageTransferImpl) waitForTransferFinalize() error {
 	return retry(
 		fmt.Sprintf("waiting for finalizing image transfer for disk %s", i.diskID),
+		reading(ResourceTypeDisk, string(i.diskID)),
 		i.logger,
 		i.retries,
 		i.attemptWaitForTransferFinalize,
@@ -379,6 +393,7 @@ func (i *imageTransferImpl) attemptWaitForTransferFinalize() error {
 func (i *imageTransferImpl) waitForTransferAbort() error {
 	return retry(
 		fmt.Sprintf("waiting for aborting image transfer for disk %s", i.diskID),
+		reading(ResourceTypeDisk, string(i.diskID)),
 		i.logger,
 		i.retries,
 		i.attemptWaitForTransferAbort,
@@ -401,7 +416,7 @@ func (i *imageTransferImpl) checkImageTransferPhase(
 	disallowedPhases []ovirtsdk4.ImageTransferPhase,
 ) error {
 	var notFoundError *ovirtsdk4.NotFoundError
-	transferResponse, err := i.transferService.Get().Send()
+	transferResponse, err := i.transferService.Get().Query("correlation_id", i.correlationID).Send()
 	if err != nil {
 		if errors.As(err, &notFoundError) {
 			// The image transfer disappeared, which happens on oVirt <4.4.7. The calling
@@ -481,6 +496,7 @@ func (i *imageTransferImpl) verifyTransferURL(transferURL string) error {
 
 	return retry(
 		fmt.Sprintf("sending OPTIONS request to %s", transferURL),
+		reading(ResourceTypeDisk, string(i.diskID)),
 		i.logger,
 		append(i.retries, MaxTries(3)),
 		func() error {
@@ -537,6 +553,7 @@ func (i *imageTransferImpl) abortTransfer() {
 		errorHappened := false
 		if err := retry(
 			fmt.Sprintf("canceling transfer for disk %s", i.diskID),
+			i.operationTarget(),
 			i.logger,
 			i.retries,
 			i.attemptAbortTransfer,
@@ -572,6 +589,6 @@ func (i *imageTransferImpl) abortTransfer() {
 
 // attemptAbortTransfer attempts to cancel an image transfer with the oVirt Engine API.
 func (i *imageTransferImpl) attemptAbortTransfer() error {
-	_, err := i.transferService.Cancel().Send()
+	_, err := i.transferService.Cancel().Query("correlation_id", i.correlationID).Send()
 	return err
 }
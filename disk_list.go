@@ -7,10 +7,16 @@ func (o *oVirtClient) ListDisks(retries ...RetryStrategy) (result []Disk, err er
 	result = []Disk{}
 	err = retry(
 		"listing disks",
+		reading(ResourceTypeDisk, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().DisksService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				DisksService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -9,11 +9,18 @@ func (o *oVirtClient) ListDisksByAlias(alias string, retries ...RetryStrategy) (
 	result = []Disk{}
 	err = retry(
 		fmt.Sprintf("listing disk by alias %s", alias),
+		reading(ResourceTypeDisk, ""),
 		o.logger,
 		retries,
 		func() error {
 			searchString := fmt.Sprintf("name=%s", alias)
-			response, e := o.conn.SystemService().DisksService().List().Search(searchString).Send()
+			response, e := o.conn.
+				SystemService().
+				DisksService().
+				List().
+				Search(searchString).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -0,0 +1,62 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMDisks(vmID VMID, retries ...RetryStrategy) (result []Disk, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []Disk{}
+	err = retry(
+		fmt.Sprintf("listing disks of VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			// Following the disk link returns the disks with the attachments, saving a request per disk.
+			response, e := o.conn.SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				DiskAttachmentsService().
+				List().
+				Follow("disk").
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Attachments()
+			if !ok {
+				return nil
+			}
+			result = make([]Disk, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				sdkDisk, ok := sdkObject.Disk()
+				if !ok {
+					return newFieldNotFound("disk attachment", "disk")
+				}
+				result[i], e = convertSDKDisk(sdkDisk, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert disk during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListVMDisks(vmID VMID, _ ...RetryStrategy) ([]Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	diskAttachments, ok := m.vmDiskAttachmentsByVM[vmID]
+	if !ok {
+		return nil, newError(ENotFound, "VM %s doesn't exist", vmID)
+	}
+	result := make([]Disk, 0, len(diskAttachments))
+	for _, attachment := range diskAttachments {
+		if d, ok := m.disks[attachment.diskID]; ok {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
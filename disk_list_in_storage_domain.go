@@ -0,0 +1,64 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListDisksInStorageDomain(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []Disk, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []Disk{}
+	err = retry(
+		fmt.Sprintf("listing disks in storage domain %s", storageDomainID),
+		reading(ResourceTypeStorageDomain, string(storageDomainID)),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				DisksService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Disks()
+			if !ok {
+				return nil
+			}
+			result = make([]Disk, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKDisk(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert disk during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListDisksInStorageDomain(
+	storageDomainID StorageDomainID,
+	_ ...RetryStrategy,
+) ([]Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.storageDomains[storageDomainID]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	result := []Disk{}
+	for _, item := range m.disks {
+		for _, id := range item.storageDomainIDs {
+			if id == storageDomainID {
+				result = append(result, item)
+				break
+			}
+		}
+	}
+	return result, nil
+}
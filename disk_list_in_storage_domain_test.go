@@ -0,0 +1,22 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestListDisksInStorageDomain(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	disk := assertCanCreateDisk(t, helper)
+
+	disks, err := client.ListDisksInStorageDomain(helper.GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("Failed to list disks in storage domain %s (%v)", helper.GetStorageDomainID(), err)
+	}
+	for _, item := range disks {
+		if item.ID() == disk.ID() {
+			return
+		}
+	}
+	t.Fatalf("Disk %s not listed in storage domain %s.", disk.ID(), helper.GetStorageDomainID())
+}
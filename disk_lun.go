@@ -0,0 +1,348 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// DiskStorageType describes where the data of a disk is stored.
+type DiskStorageType string
+
+const (
+	// DiskStorageTypeImage is a disk stored as an image on a storage domain.
+	DiskStorageTypeImage DiskStorageType = "image"
+	// DiskStorageTypeLUN is a direct LUN disk passing a logical unit of a FC or iSCSI storage through to the VM.
+	DiskStorageTypeLUN DiskStorageType = "lun"
+	// DiskStorageTypeCinder is a disk stored on an OpenStack Cinder volume.
+	DiskStorageTypeCinder DiskStorageType = "cinder"
+	// DiskStorageTypeManagedBlockStorage is a disk stored on a managed block storage domain.
+	DiskStorageTypeManagedBlockStorage DiskStorageType = "managed_block_storage"
+)
+
+// DiskStorageTypeList is a list of DiskStorageType values.
+type DiskStorageTypeList []DiskStorageType
+
+// DiskStorageTypeValues returns all possible values for DiskStorageType.
+func DiskStorageTypeValues() DiskStorageTypeList {
+	return []DiskStorageType{
+		DiskStorageTypeImage,
+		DiskStorageTypeLUN,
+		DiskStorageTypeCinder,
+		DiskStorageTypeManagedBlockStorage,
+	}
+}
+
+// Strings returns a list of strings.
+func (l DiskStorageTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, storageType := range l {
+		result[i] = string(storageType)
+	}
+	return result
+}
+
+// Validate returns an error if the disk storage type doesn't have a valid value.
+func (d DiskStorageType) Validate() error {
+	for _, storageType := range DiskStorageTypeValues() {
+		if storageType == d {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk storage type: %s must be one of: %s",
+		d,
+		DiskStorageTypeValues().Strings(),
+	)
+}
+
+// LUNStorageTypeValues returns the storage types that can provide the logical unit for a direct LUN disk.
+func LUNStorageTypeValues() StorageDomainTypeList {
+	return []StorageDomainType{
+		StorageDomainTypeFCP,
+		StorageDomainTypeISCSI,
+	}
+}
+
+func validateLUNStorageType(storageType StorageDomainType) error {
+	for _, lunStorageType := range LUNStorageTypeValues() {
+		if lunStorageType == storageType {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid storage type for a direct LUN disk: %s must be one of: %v",
+		storageType,
+		LUNStorageTypeValues(),
+	)
+}
+
+// DiskLogicalUnit describes the logical unit backing a direct LUN disk.
+type DiskLogicalUnit interface {
+	// ID returns the ID of the logical unit, for example the WWID of a FC LUN.
+	ID() string
+	// StorageType returns the type of storage providing the logical unit, StorageDomainTypeFCP or
+	// StorageDomainTypeISCSI.
+	StorageType() StorageDomainType
+	// Address returns the address of the iSCSI portal. It is empty for FC logical units.
+	Address() string
+	// Port returns the port of the iSCSI portal. It is zero for FC logical units.
+	Port() uint16
+	// Target returns the IQN of the iSCSI target. It is empty for FC logical units.
+	Target() string
+	// Size returns the size of the logical unit in bytes, if reported.
+	Size() uint64
+}
+
+type diskLogicalUnit struct {
+	id          string
+	storageType StorageDomainType
+	address     string
+	port        uint16
+	target      string
+	size        uint64
+}
+
+func (d *diskLogicalUnit) ID() string {
+	return d.id
+}
+
+func (d *diskLogicalUnit) StorageType() StorageDomainType {
+	return d.storageType
+}
+
+func (d *diskLogicalUnit) Address() string {
+	return d.address
+}
+
+func (d *diskLogicalUnit) Port() uint16 {
+	return d.port
+}
+
+func (d *diskLogicalUnit) Target() string {
+	return d.target
+}
+
+func (d *diskLogicalUnit) Size() uint64 {
+	return d.size
+}
+
+// CreateLUNDiskOptionalParameters holds the optional parameters for DiskClient.CreateLUNDisk.
+type CreateLUNDiskOptionalParameters interface {
+	// Alias is a secondary name for the disk.
+	Alias() string
+
+	// Shareable indicates that the disk can be attached to multiple VMs at the same time. If it returns nil, the disk
+	// will not be shareable.
+	Shareable() *bool
+
+	// ISCSIAddress returns the address of the iSCSI portal providing the logical unit. If empty, the hosts must
+	// already be logged in to the target.
+	ISCSIAddress() string
+
+	// ISCSIPort returns the port of the iSCSI portal providing the logical unit.
+	ISCSIPort() uint16
+
+	// ISCSITarget returns the IQN of the iSCSI target providing the logical unit.
+	ISCSITarget() string
+}
+
+// BuildableCreateLUNDiskParameters is a buildable version of CreateLUNDiskOptionalParameters.
+type BuildableCreateLUNDiskParameters interface {
+	CreateLUNDiskOptionalParameters
+
+	// WithAlias sets the alias of the disk.
+	WithAlias(alias string) (BuildableCreateLUNDiskParameters, error)
+	// MustWithAlias is the same as WithAlias, but panics instead of returning an error.
+	MustWithAlias(alias string) BuildableCreateLUNDiskParameters
+
+	// WithShareable sets if the disk can be attached to multiple VMs at the same time.
+	WithShareable(shareable bool) (BuildableCreateLUNDiskParameters, error)
+	// MustWithShareable is the same as WithShareable, but panics instead of returning an error.
+	MustWithShareable(shareable bool) BuildableCreateLUNDiskParameters
+
+	// WithISCSITarget sets the iSCSI portal and target providing the logical unit. It can only be used with
+	// StorageDomainTypeISCSI.
+	WithISCSITarget(address string, port uint16, target string) (BuildableCreateLUNDiskParameters, error)
+	// MustWithISCSITarget is the same as WithISCSITarget, but panics instead of returning an error.
+	MustWithISCSITarget(address string, port uint16, target string) BuildableCreateLUNDiskParameters
+}
+
+// CreateLUNDiskParams creates a buildable set of CreateLUNDiskOptionalParameters for use with
+// Client.CreateLUNDisk.
+func CreateLUNDiskParams() BuildableCreateLUNDiskParameters {
+	return &createLUNDiskParams{}
+}
+
+type createLUNDiskParams struct {
+	alias        string
+	shareable    *bool
+	iscsiAddress string
+	iscsiPort    uint16
+	iscsiTarget  string
+}
+
+func (c *createLUNDiskParams) Alias() string {
+	return c.alias
+}
+
+func (c *createLUNDiskParams) WithAlias(alias string) (BuildableCreateLUNDiskParameters, error) {
+	c.alias = alias
+	return c, nil
+}
+
+func (c *createLUNDiskParams) MustWithAlias(alias string) BuildableCreateLUNDiskParameters {
+	builder, err := c.WithAlias(alias)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createLUNDiskParams) Shareable() *bool {
+	return c.shareable
+}
+
+func (c *createLUNDiskParams) WithShareable(shareable bool) (BuildableCreateLUNDiskParameters, error) {
+	c.shareable = &shareable
+	return c, nil
+}
+
+func (c *createLUNDiskParams) MustWithShareable(shareable bool) BuildableCreateLUNDiskParameters {
+	builder, err := c.WithShareable(shareable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createLUNDiskParams) ISCSIAddress() string {
+	return c.iscsiAddress
+}
+
+func (c *createLUNDiskParams) ISCSIPort() uint16 {
+	return c.iscsiPort
+}
+
+func (c *createLUNDiskParams) ISCSITarget() string {
+	return c.iscsiTarget
+}
+
+func (c *createLUNDiskParams) WithISCSITarget(
+	address string,
+	port uint16,
+	target string,
+) (BuildableCreateLUNDiskParameters, error) {
+	if address == "" {
+		return c, newError(EBadArgument, "the iSCSI portal address must not be empty")
+	}
+	if port == 0 {
+		return c, newError(EBadArgument, "the iSCSI portal port must not be zero")
+	}
+	if target == "" {
+		return c, newError(EBadArgument, "the iSCSI target must not be empty")
+	}
+	c.iscsiAddress = address
+	c.iscsiPort = port
+	c.iscsiTarget = target
+	return c, nil
+}
+
+func (c *createLUNDiskParams) MustWithISCSITarget(
+	address string,
+	port uint16,
+	target string,
+) BuildableCreateLUNDiskParameters {
+	builder, err := c.WithISCSITarget(address, port, target)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateLUNDiskCreationParameters(
+	lunID string,
+	storageType StorageDomainType,
+	params CreateLUNDiskOptionalParameters,
+) error {
+	if lunID == "" {
+		return newError(EBadArgument, "the LUN ID must not be empty")
+	}
+	if err := validateLUNStorageType(storageType); err != nil {
+		return err
+	}
+	if params != nil && params.ISCSITarget() != "" && storageType != StorageDomainTypeISCSI {
+		return newError(EBadArgument, "an iSCSI target can only be specified for %s LUNs", StorageDomainTypeISCSI)
+	}
+	return nil
+}
+
+// convertSDKLUNDisk converts a direct LUN disk. These disks have no image, so the storage domain, format and size
+// fields of image disks are not present.
+func convertSDKLUNDisk(id string, sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
+	sdkLUNStorage, ok := sdkDisk.LunStorage()
+	if !ok {
+		return nil, newError(EFieldMissing, "direct LUN disk %s has no LUN storage field", id)
+	}
+	logicalUnit, err := convertSDKDiskLogicalUnit(id, sdkLUNStorage)
+	if err != nil {
+		return nil, err
+	}
+	alias, _ := sdkDisk.Alias()
+	shareable, _ := sdkDisk.Shareable()
+	wipeAfterDelete, _ := sdkDisk.WipeAfterDelete()
+	status := DiskStatusOK
+	if sdkStatus, ok := sdkDisk.Status(); ok {
+		status = DiskStatus(sdkStatus)
+	}
+	return &disk{
+		client: client,
+
+		id:               DiskID(id),
+		alias:            alias,
+		provisionedSize:  logicalUnit.size,
+		totalSize:        logicalUnit.size,
+		format:           ImageFormatRaw,
+		storageDomainIDs: []StorageDomainID{},
+		status:           status,
+		contentType:      DiskContentTypeData,
+		wipeAfterDelete:  wipeAfterDelete,
+		shareable:        shareable,
+		backup:           DiskBackupNone,
+		storageType:      DiskStorageTypeLUN,
+		logicalUnit:      logicalUnit,
+	}, nil
+}
+
+func convertSDKDiskLogicalUnit(diskID string, sdkLUNStorage *ovirtsdk4.HostStorage) (*diskLogicalUnit, error) {
+	storageType, ok := sdkLUNStorage.Type()
+	if !ok {
+		return nil, newError(EFieldMissing, "the LUN storage of disk %s has no type field", diskID)
+	}
+	sdkLogicalUnits, ok := sdkLUNStorage.LogicalUnits()
+	if !ok || len(sdkLogicalUnits.Slice()) == 0 {
+		return nil, newError(EFieldMissing, "the LUN storage of disk %s has no logical units", diskID)
+	}
+	sdkLogicalUnit := sdkLogicalUnits.Slice()[0]
+	lunID, ok := sdkLogicalUnit.Id()
+	if !ok {
+		return nil, newError(EFieldMissing, "the logical unit of disk %s has no ID", diskID)
+	}
+	result := &diskLogicalUnit{
+		id:          lunID,
+		storageType: StorageDomainType(storageType),
+	}
+	if address, ok := sdkLogicalUnit.Address(); ok {
+		result.address = address
+	}
+	if port, ok := sdkLogicalUnit.Port(); ok {
+		result.port = uint16(port)
+	}
+	if target, ok := sdkLogicalUnit.Target(); ok {
+		result.target = target
+	}
+	if size, ok := sdkLogicalUnit.Size(); ok {
+		result.size = uint64(size)
+	}
+	return result, nil
+}
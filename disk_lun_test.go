@@ -0,0 +1,92 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// The direct LUN tests run against the mock only since they need a logical unit that is not used by any storage
+// domain.
+
+func TestCreateLUNDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelperMock(t)
+	client := helper.GetClient()
+
+	lunID := "3600a0980383146" + helper.GenerateRandomID(9)
+	disk, err := client.CreateLUNDisk(
+		lunID,
+		ovirtclient.StorageDomainTypeISCSI,
+		ovirtclient.CreateLUNDiskParams().
+			MustWithAlias("direct_lun_test").
+			MustWithShareable(true).
+			MustWithISCSITarget("192.0.2.1", 3260, "iqn.2003-01.org.example:target"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create direct LUN disk (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := disk.Remove(); err != nil {
+			t.Fatalf("Failed to remove direct LUN disk (%v)", err)
+		}
+	})
+
+	fetchedDisk, err := client.GetDisk(disk.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch direct LUN disk (%v)", err)
+	}
+	if fetchedDisk.StorageType() != ovirtclient.DiskStorageTypeLUN {
+		t.Fatalf("Incorrect storage type for direct LUN disk: %s", fetchedDisk.StorageType())
+	}
+	if !fetchedDisk.Shareable() {
+		t.Fatalf("Direct LUN disk is not shareable.")
+	}
+	if len(fetchedDisk.StorageDomainIDs()) != 0 {
+		t.Fatalf("Direct LUN disk has storage domains: %v", fetchedDisk.StorageDomainIDs())
+	}
+	logicalUnit := fetchedDisk.LogicalUnit()
+	if logicalUnit == nil {
+		t.Fatalf("Direct LUN disk has no logical unit.")
+	}
+	if logicalUnit.ID() != lunID {
+		t.Fatalf("Incorrect LUN ID: %s instead of %s", logicalUnit.ID(), lunID)
+	}
+	if logicalUnit.Port() != 3260 || logicalUnit.Target() != "iqn.2003-01.org.example:target" {
+		t.Fatalf("Incorrect iSCSI target: %s:%d %s", logicalUnit.Address(), logicalUnit.Port(), logicalUnit.Target())
+	}
+
+	if _, err := client.CreateLUNDisk(lunID, ovirtclient.StorageDomainTypeISCSI, nil); err == nil {
+		t.Fatalf("Creating a second disk for the same LUN did not fail.")
+	} else if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Creating a second disk for the same LUN did not return %s (%v)", ovirtclient.EConflict, err)
+	}
+}
+
+func TestCreateLUNDiskRejectsInvalidParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelperMock(t)
+	client := helper.GetClient()
+
+	testCases := map[string]struct {
+		lunID       string
+		storageType ovirtclient.StorageDomainType
+		params      ovirtclient.CreateLUNDiskOptionalParameters
+	}{
+		"empty-id": {"", ovirtclient.StorageDomainTypeFCP, nil},
+		"nfs":      {"36001405abcdef", ovirtclient.StorageDomainTypeNFS, nil},
+		"fcp-with-iscsi-target": {
+			"36001405abcdef",
+			ovirtclient.StorageDomainTypeFCP,
+			ovirtclient.CreateLUNDiskParams().MustWithISCSITarget("192.0.2.1", 3260, "iqn.2003-01.org.example:target"),
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CreateLUNDisk(testCase.lunID, testCase.storageType, testCase.params)
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+				t.Fatalf("Invalid direct LUN disk parameters did not return %s (%v)", ovirtclient.EBadArgument, err)
+			}
+		})
+	}
+}
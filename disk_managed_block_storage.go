@@ -0,0 +1,68 @@
+package ovirtclient
+
+// Disks on managed block storage domains, for example Ceph RBD volumes provisioned through cinderlib, are not stored
+// as images. The engine cannot transfer, copy or back up these disks, and they can only use the raw format. Direct
+// LUN disks have the same restrictions. The checks below let the client return EUnsupported before sending a request
+// the engine would reject with an unspecific error.
+
+// isBlockStorageDomainType returns true for storage domain types not storing disks as images.
+func isBlockStorageDomainType(storageType StorageDomainType) bool {
+	return storageType == StorageDomainTypeManagedBlockStorage || storageType == StorageDomainTypeCinder
+}
+
+// validateImageDiskOperation returns an EUnsupported error if the operation needs an image and the disk is not
+// stored as one.
+func validateImageDiskOperation(disk DiskData, operation string) error {
+	if disk.StorageType() == DiskStorageTypeImage {
+		return nil
+	}
+	return newError(
+		EUnsupported,
+		"%s is not supported for disk %s since it is stored on %s storage",
+		operation,
+		disk.ID(),
+		disk.StorageType(),
+	)
+}
+
+// validateStorageDomainDiskFormat returns an EUnsupported error if the storage domain cannot hold disks in the
+// specified format.
+func validateStorageDomainDiskFormat(storageDomain StorageDomainData, format ImageFormat) error {
+	if isBlockStorageDomainType(storageDomain.StorageType()) && format != ImageFormatRaw {
+		return newError(
+			EUnsupported,
+			"storage domain %s is a %s domain and only supports %s disks",
+			storageDomain.ID(),
+			storageDomain.StorageType(),
+			ImageFormatRaw,
+		)
+	}
+	return nil
+}
+
+// validateStorageDomainImageTransfer returns an EUnsupported error if images cannot be transferred to disks on the
+// storage domain.
+func validateStorageDomainImageTransfer(storageDomain StorageDomainData, operation string) error {
+	if isBlockStorageDomainType(storageDomain.StorageType()) {
+		return newError(
+			EUnsupported,
+			"%s is not supported on storage domain %s since it is a %s domain",
+			operation,
+			storageDomain.ID(),
+			storageDomain.StorageType(),
+		)
+	}
+	return nil
+}
+
+// diskStorageTypeForStorageDomain returns the storage type of disks created on the storage domain.
+func diskStorageTypeForStorageDomain(storageDomain StorageDomainData) DiskStorageType {
+	switch storageDomain.StorageType() {
+	case StorageDomainTypeManagedBlockStorage:
+		return DiskStorageTypeManagedBlockStorage
+	case StorageDomainTypeCinder:
+		return DiskStorageTypeCinder
+	default:
+		return DiskStorageTypeImage
+	}
+}
@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestManagedBlockStorageDisks(t *testing.T) {
+	t.Parallel()
+	client := NewMock()
+	mock := client.(*mockClient)
+	storageDomain := generateTestStorageDomain("Managed block storage domain")
+	storageDomain.storageType = StorageDomainTypeManagedBlockStorage
+	mock.lock.Lock()
+	mock.storageDomains[storageDomain.id] = storageDomain
+	mock.lock.Unlock()
+
+	if _, err := client.CreateDisk(storageDomain.id, ImageFormatCow, 1048576, nil); !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("Creating a cow disk on managed block storage did not return %s (%v)", EUnsupported, err)
+	}
+
+	disk, err := client.CreateDisk(storageDomain.id, ImageFormatRaw, 1048576, nil)
+	if err != nil {
+		t.Fatalf("Failed to create disk on managed block storage (%v)", err)
+	}
+	if disk.StorageType() != DiskStorageTypeManagedBlockStorage {
+		t.Fatalf("Incorrect storage type for disk on managed block storage: %s", disk.StorageType())
+	}
+
+	secondaryStorageDomainID := otherMockStorageDomainID(t, mock, storageDomain.id)
+	if _, err := client.CopyDisk(disk.ID(), secondaryStorageDomainID, nil); !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("Copying a disk on managed block storage did not return %s (%v)", EUnsupported, err)
+	}
+	if _, err := client.StartDownloadDisk(disk.ID(), ImageFormatRaw); !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("Downloading a disk on managed block storage did not return %s (%v)", EUnsupported, err)
+	}
+	image := bytes.Repeat([]byte{0}, 1048576)
+	if _, err := client.StartUploadToNewDisk(
+		storageDomain.id,
+		ImageFormatRaw,
+		uint64(len(image)),
+		CreateDiskParams(),
+		readSeekCloser{bytes.NewReader(image)},
+	); !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("Uploading to managed block storage did not return %s (%v)", EUnsupported, err)
+	}
+
+	if err := disk.Remove(); err != nil {
+		t.Fatalf("Failed to remove disk on managed block storage (%v)", err)
+	}
+}
+
+// otherMockStorageDomainID returns the ID of a mock storage domain other than the specified one.
+func otherMockStorageDomainID(t *testing.T, m *mockClient, excludeID StorageDomainID) StorageDomainID {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for id := range m.storageDomains {
+		if id != excludeID {
+			return id
+		}
+	}
+	t.Fatalf("No other storage domain found.")
+	return ""
+}
+
+type readSeekCloser struct {
+	*bytes.Reader
+}
+
+func (readSeekCloser) Close() error {
+	return nil
+}
@@ -42,6 +42,13 @@ func (d *diskWithData) WithAlias(alias *string) *diskWithData {
 			status:           d.status,
 			totalSize:        d.totalSize,
 			sparse:           d.sparse,
+			contentType:      d.contentType,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			shareable:        d.shareable,
+			backup:           d.backup,
+			storageType:      d.storageType,
+			logicalUnit:      d.logicalUnit,
+			diskProfileID:    d.diskProfileID,
 		},
 		d.lock,
 		d.data,
@@ -66,6 +73,13 @@ func (d *diskWithData) withProvisionedSize(ps uint64) (*diskWithData, error) {
 			status:           d.status,
 			totalSize:        ps,
 			sparse:           d.sparse,
+			contentType:      d.contentType,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			shareable:        d.shareable,
+			backup:           d.backup,
+			storageType:      d.storageType,
+			logicalUnit:      d.logicalUnit,
+			diskProfileID:    d.diskProfileID,
 		},
 		d.lock,
 		d.data,
@@ -88,6 +102,13 @@ func (d *diskWithData) clone(sparse *bool) *diskWithData {
 			d.status,
 			d.totalSize,
 			*sparse,
+			d.contentType,
+			d.wipeAfterDelete,
+			d.shareable,
+			d.backup,
+			d.storageType,
+			d.logicalUnit,
+			d.diskProfileID,
 		},
 		&sync.Mutex{},
 		d.data,
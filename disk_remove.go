@@ -6,13 +6,24 @@ import (
 
 func (o *oVirtClient) RemoveDisk(diskID DiskID, retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	return retry(
+	err := retry(
 		fmt.Sprintf("removing disk %s", diskID),
+		mutating(ResourceTypeDisk, string(diskID)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().DisksService().DiskService(string(diskID)).Remove().Send()
+			_, err := o.conn.
+				SystemService().
+				DisksService().
+				DiskService(string(diskID)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			return err
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeRemoved)
+	}
+	return err
 }
@@ -9,7 +9,7 @@ func (m *mockClient) RemoveDisk(diskID DiskID, _ ...RetryStrategy) error {
 	}
 
 	// Check if disk is attached to a running VM
-	if diskAttachment, ok := m.vmDiskAttachmentsByDisk[diskID]; ok {
+	for _, diskAttachment := range m.vmDiskAttachmentsByDisk[diskID] {
 		vm := m.vms[diskAttachment.vmid]
 		if vm.status != VMStatusDown {
 			return newError(
@@ -27,13 +27,20 @@ func (m *mockClient) RemoveDisk(diskID DiskID, _ ...RetryStrategy) error {
 		return newError(EUnidentified, "Cannot remove disk attached to a template. Please specify storage domain to remove from.")
 	}
 
-	if diskAttachment, ok := m.vmDiskAttachmentsByDisk[diskID]; ok {
+	for _, diskAttachment := range m.vmDiskAttachmentsByDisk[diskID] {
 		vm := m.vms[diskAttachment.vmid]
 		delete(m.vmDiskAttachmentsByVM[vm.id], diskAttachment.id)
+		m.mutationListeners.notify(
+			ResourceTypeDiskAttachment,
+			string(diskAttachment.id),
+			string(vm.id),
+			MutationTypeRemoved,
+		)
 	}
 
 	delete(m.vmDiskAttachmentsByDisk, diskID)
 	delete(m.disks, diskID)
+	m.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeRemoved)
 
 	return nil
 }
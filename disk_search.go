@@ -0,0 +1,119 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (o *oVirtClient) diskSearchCriteria(params DiskSearchParameters) (string, error) {
+	var criteria []string
+	if alias := params.Alias(); alias != nil {
+		quotedAlias, err := quoteSearchString(*alias)
+		if err != nil {
+			return "", newError(EBadArgument, "invalid alias search string: %s", *alias)
+		}
+		criteria = append(criteria, fmt.Sprintf("alias = %s", quotedAlias))
+	}
+	if vmName := params.VMName(); vmName != nil {
+		quotedVMName, err := quoteSearchString(*vmName)
+		if err != nil {
+			return "", newError(EBadArgument, "invalid VM name search string: %s", *vmName)
+		}
+		criteria = append(criteria, fmt.Sprintf("vm_names = %s", quotedVMName))
+	}
+	if statuses := params.Statuses(); statuses != nil {
+		items := make([]string, len(*statuses))
+		for i, status := range *statuses {
+			if err := status.Validate(); err != nil {
+				return "", wrap(err, EBadArgument, "invalid value for search field statuses")
+			}
+			items[i] = fmt.Sprintf("status = %s", status)
+		}
+		criteria = append(criteria, fmt.Sprintf("(%s)", strings.Join(items, " OR ")))
+	}
+	if len(criteria) == 0 {
+		return "", newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	return strings.Join(criteria, " AND "), nil
+}
+
+func (o *oVirtClient) SearchDisks(params DiskSearchParameters, retries ...RetryStrategy) (result []Disk, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []Disk{}
+	qs, err := o.diskSearchCriteria(params)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		"searching for disks",
+		reading(ResourceTypeDisk, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				DisksService().
+				List().
+				Search(qs).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Disks()
+			if !ok {
+				return nil
+			}
+			result = make([]Disk, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKDisk(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert disk during searching item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) SearchDisks(params DiskSearchParameters, _ ...RetryStrategy) ([]Disk, error) {
+	if params.Alias() == nil && params.VMName() == nil && params.Statuses() == nil {
+		return nil, newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Disk{}
+	for _, d := range m.disks {
+		if alias := params.Alias(); alias != nil && d.alias != *alias {
+			continue
+		}
+		if vmName := params.VMName(); vmName != nil && !m.diskAttachedToVMName(d.id, *vmName) {
+			continue
+		}
+		if statuses := params.Statuses(); statuses != nil && !diskStatusInList(d.status, *statuses) {
+			continue
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// diskAttachedToVMName returns true if the disk is attached to a VM with the specified name. It must be called with
+// the lock held.
+func (m *mockClient) diskAttachedToVMName(diskID DiskID, vmName string) bool {
+	for _, attachment := range m.vmDiskAttachmentsByDisk[diskID] {
+		if vm, ok := m.vms[attachment.vmid]; ok && vm.name == vmName {
+			return true
+		}
+	}
+	return false
+}
+
+func diskStatusInList(status DiskStatus, statuses DiskStatusList) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
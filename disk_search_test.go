@@ -0,0 +1,57 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiskSearchByAliasAndVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	alias := helper.GenerateTestResourceName(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithAlias(alias),
+	)
+	assertCanAttachDisk(t, vm, disk)
+
+	disks, err := client.ListVMDisks(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list disks of VM %s (%v)", vm.ID(), err)
+	}
+	if len(disks) != 1 || disks[0].ID() != disk.ID() {
+		t.Fatalf("Incorrect disks listed for VM %s (%d disks)", vm.ID(), len(disks))
+	}
+
+	disks, err = client.SearchDisks(ovirtclient.DiskSearchParams().WithVMName(vm.Name()).WithAlias(alias))
+	if err != nil {
+		t.Fatalf("Failed to search for disks (%v)", err)
+	}
+	if len(disks) != 1 || disks[0].ID() != disk.ID() {
+		t.Fatalf("Incorrect number of disks found for VM %s and alias %s: %d", vm.Name(), alias, len(disks))
+	}
+
+	disks, err = client.SearchDisks(ovirtclient.DiskSearchParams().WithAlias(alias).WithVMName("does-not-exist"))
+	if err != nil {
+		t.Fatalf("Failed to search for disks (%v)", err)
+	}
+	if len(disks) != 0 {
+		t.Fatalf("Disks found for a non-existent VM (%d disks)", len(disks))
+	}
+}
+
+func TestDiskSearchWithoutParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().SearchDisks(ovirtclient.DiskSearchParams())
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Searching for disks without parameters did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -113,3 +113,116 @@ func checkDiskAfterCreation(disk ovirtclient.Disk, t *testing.T, name string) {
 		t.Fatalf("Incorrect disk alias after creation (%s instead of %s)", disk.Alias(), name)
 	}
 }
+
+func TestDiskCreationWithFullParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	diskName := fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5))
+
+	disk, err := client.CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatCow,
+		1048576,
+		ovirtclient.CreateDiskParams().
+			MustWithAlias(diskName).
+			MustWithSparse(true).
+			MustWithWipeAfterDelete(true).
+			MustWithBackup(ovirtclient.DiskBackupIncremental),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := disk.Remove(); err != nil {
+			t.Fatalf("Failed to remove disk after disk creation test (%v)", err)
+		}
+	})
+
+	fetchedDisk, err := client.GetDisk(disk.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch disk after creation (%v)", err)
+	}
+	if fetchedDisk.Format() != ovirtclient.ImageFormatCow {
+		t.Fatalf("Incorrect disk format after creation: %s", fetchedDisk.Format())
+	}
+	if !fetchedDisk.Sparse() {
+		t.Fatalf("Disk is not sparse after creation.")
+	}
+	if !fetchedDisk.WipeAfterDelete() {
+		t.Fatalf("Wipe after delete is not set after creation.")
+	}
+	if fetchedDisk.Shareable() {
+		t.Fatalf("Disk is shareable after creation.")
+	}
+	if fetchedDisk.Backup() != ovirtclient.DiskBackupIncremental {
+		t.Fatalf("Incorrect disk backup mode after creation: %s", fetchedDisk.Backup())
+	}
+}
+
+func TestDiskCreationRejectsInvalidParameterCombinations(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	testCases := map[string]struct {
+		format ovirtclient.ImageFormat
+		params ovirtclient.BuildableCreateDiskParameters
+	}{
+		"shareable-cow": {
+			ovirtclient.ImageFormatCow,
+			ovirtclient.CreateDiskParams().MustWithShareable(true),
+		},
+		"incremental-raw": {
+			ovirtclient.ImageFormatRaw,
+			ovirtclient.CreateDiskParams().MustWithBackup(ovirtclient.DiskBackupIncremental),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CreateDisk(helper.GetStorageDomainID(), testCase.format, 1048576, testCase.params)
+			if err == nil {
+				t.Fatalf("Creating a disk with invalid parameters did not fail.")
+			}
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+				t.Fatalf("Creating a disk with invalid parameters did not return %s (%v)", ovirtclient.EBadArgument, err)
+			}
+		})
+	}
+}
+
+func TestWaitForDiskStatus(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	creation, err := client.StartCreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		1048576,
+		ovirtclient.CreateDiskParams().MustWithAlias(fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5))),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start disk creation (%v)", err)
+	}
+	diskID := creation.Disk().ID()
+	t.Cleanup(func() {
+		if err := client.RemoveDisk(diskID); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove disk after wait test (%v)", err)
+		}
+	})
+
+	disk, err := client.WaitForDiskStatus(diskID, ovirtclient.DiskStatusOK)
+	if err != nil {
+		t.Fatalf("Failed to wait for disk status %s (%v)", ovirtclient.DiskStatusOK, err)
+	}
+	if disk.Status() != ovirtclient.DiskStatusOK {
+		t.Fatalf("Disk is in status %s after waiting for %s.", disk.Status(), ovirtclient.DiskStatusOK)
+	}
+
+	if _, err := client.WaitForDiskStatus(diskID, "invalid"); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Waiting for an invalid disk status did not return %s (%v)", ovirtclient.EBadArgument, err)
+	}
+}
@@ -32,12 +32,13 @@ func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, re
 	if provisionedSize := params.ProvisionedSize(); provisionedSize != nil {
 		sdkDisk.ProvisionedSize(int64(*provisionedSize))
 	}
-	correlationID := fmt.Sprintf("disk_update_%s", generateRandomID(5, o.nonSecureRandom))
+	correlationID := o.jobCorrelationID("disk_update", retries)
 
 	var disk Disk
 
 	err := retry(
 		fmt.Sprintf("updating disk %s", id),
+		mutating(ResourceTypeDisk, string(id)),
 		o.logger,
 		retries,
 		func() error {
@@ -69,6 +70,7 @@ func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, re
 	if err != nil {
 		return nil, err
 	}
+	o.mutationListeners.notify(ResourceTypeDisk, string(id), "", MutationTypeUpdated)
 	return &diskWait{
 		client:        o,
 		disk:          disk,
@@ -80,7 +82,7 @@ func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, re
 func (m *mockClient) UpdateDisk(id DiskID, params UpdateDiskParameters, retries ...RetryStrategy) (Disk, error) {
 	progress, err := m.StartUpdateDisk(id, params, retries...)
 	if err != nil {
-		return progress.Disk(), err
+		return nil, err
 	}
 	return progress.Wait(retries...)
 }
@@ -97,6 +99,15 @@ func (m *mockClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, _ .
 	if !ok {
 		return nil, newError(ENotFound, "disk with ID %s not found", id)
 	}
+	if ps := params.ProvisionedSize(); ps != nil && *ps > disk.provisionedSize {
+		needed := map[StorageDomainID]uint64{}
+		for _, storageDomainID := range disk.storageDomainIDs {
+			needed[storageDomainID] = *ps - disk.provisionedSize
+		}
+		if err := m.ensureStorageAvailable(needed); err != nil {
+			return nil, err
+		}
+	}
 	if err := disk.Lock(); err != nil {
 		return nil, err
 	}
@@ -114,6 +125,7 @@ func (m *mockClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, _ .
 		disk:   disk,
 		done:   make(chan struct{}),
 	}
+	m.mutationListeners.notify(ResourceTypeDisk, string(id), "", MutationTypeUpdated)
 	defer update.do()
 	return update, nil
 }
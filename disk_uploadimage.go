@@ -23,7 +23,7 @@ func (o *oVirtClient) UploadImage(
 	reader io.ReadSeekCloser,
 	retries ...RetryStrategy,
 ) (UploadImageResult, error) {
-	o.logger.Warningf("Using UploadImage is deprecated. Please use UploadToNewDisk instead.")
+	warn(o.logger, retries, WDeprecated, "Using UploadImage is deprecated. Please use UploadToNewDisk instead.")
 	return o.UploadToNewDisk(
 		storageDomainID,
 		"",
@@ -63,7 +63,7 @@ func (o *oVirtClient) StartImageUpload(
 	reader io.ReadSeekCloser,
 	retries ...RetryStrategy,
 ) (UploadImageProgress, error) {
-	o.logger.Warningf("Using StartImageUpload is deprecated. Please use StartUploadToNewDisk instead.")
+	warn(o.logger, retries, WDeprecated, "Using StartImageUpload is deprecated. Please use StartUploadToNewDisk instead.")
 	return o.StartUploadToNewDisk(
 		storageDomainID,
 		"",
@@ -101,11 +101,17 @@ func (o *oVirtClient) StartUploadToDisk(
 	if err != nil {
 		return nil, err
 	}
+	if err := validateImageDiskOperation(disk, "uploading an image"); err != nil {
+		return nil, err
+	}
 
 	format, qcowSize, err := extractQCOWParameters(size, reader)
 	if err != nil {
 		return nil, err
 	}
+	if err := validateUploadFormat(format, disk.Format()); err != nil {
+		return nil, err
+	}
 
 	if qcowSize > disk.ProvisionedSize() {
 		return nil, newError(
@@ -123,7 +129,7 @@ func (o *oVirtClient) StartUploadToDisk(
 		done:          make(chan struct{}),
 		ctx:           ctx,
 		cancel:        cancel,
-		correlationID: fmt.Sprintf("image_upload_%s", generateRandomID(5, o.nonSecureRandom)),
+		correlationID: o.jobCorrelationID("image_upload", retries),
 		format:        format,
 		disk:          disk,
 		totalBytes:    size,
@@ -180,6 +186,7 @@ func (u *uploadToDiskProgress) transfer() error {
 		u.client,
 		u.client.logger,
 		u.disk.ID(),
+		"",
 		u.correlationID,
 		u.retries,
 		ovirtsdk4.IMAGETRANSFERDIRECTION_UPLOAD,
@@ -203,6 +210,7 @@ func (u *uploadToDiskProgress) transferImage(transfer imageTransfer, transferURL
 			u.disk.ID(),
 			transferURL,
 		),
+		mutating(ResourceTypeDisk, string(u.disk.ID())),
 		u.client.logger,
 		u.retries,
 		func() error {
@@ -306,6 +314,14 @@ func (o *oVirtClient) StartUploadToNewDisk(
 
 	o.logger.Infof("Starting disk image upload...")
 
+	storageDomain, err := o.GetStorageDomain(storageDomainID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStorageDomainImageTransfer(storageDomain, "uploading an image"); err != nil {
+		return nil, err
+	}
+
 	imageFormat, qcowSize, err := extractQCOWParameters(size, reader)
 	if err != nil {
 		return nil, err
@@ -315,6 +331,8 @@ func (o *oVirtClient) StartUploadToNewDisk(
 		format = imageFormat
 	} else if err := format.Validate(); err != nil {
 		return nil, err
+	} else if err := validateUploadFormat(imageFormat, format); err != nil {
+		return nil, err
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -325,6 +343,9 @@ func (o *oVirtClient) StartUploadToNewDisk(
 	if params.Sparse() != nil {
 		diskCreateParams.MustWithSparse(*params.Sparse())
 	}
+	if params.ContentType() != nil {
+		diskCreateParams.MustWithContentType(*params.ContentType())
+	}
 
 	progress := &uploadToNewDiskProgress{
 		uploadToDiskProgress: uploadToDiskProgress{
@@ -333,7 +354,7 @@ func (o *oVirtClient) StartUploadToNewDisk(
 			done:          make(chan struct{}),
 			ctx:           ctx,
 			cancel:        cancel,
-			correlationID: fmt.Sprintf("image_upload_%s", generateRandomID(5, o.nonSecureRandom)),
+			correlationID: o.jobCorrelationID("image_upload", retries),
 			format:        imageFormat,
 			disk:          nil,
 			totalBytes:    size,
@@ -394,7 +415,10 @@ func (u *uploadToNewDiskProgress) Do() {
 	if err != nil {
 		u.client.logger.Infof("Image upload to new disk failed, removing created disk (%v)", err)
 		if err := disk.Remove(u.retries...); err != nil && !HasErrorCode(err, ENotFound) {
-			u.client.logger.Warningf(
+			warn(
+				u.client.logger,
+				u.retries,
+				WCleanupFailed,
 				"Failed to remove newly created disk %s after failed image upload, please remove manually. (%v)",
 				disk.ID(),
 				err,
@@ -449,6 +473,9 @@ func (m *mockClient) StartUploadToDisk(
 	if err != nil {
 		return nil, err
 	}
+	if err := validateImageDiskOperation(disk, "uploading an image"); err != nil {
+		return nil, err
+	}
 
 	imageFormat, qcowSize, err := extractQCOWParameters(size, reader)
 	if err != nil {
@@ -514,9 +541,13 @@ func (m *mockClient) StartUploadToNewDisk(
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if _, ok := m.storageDomains[storageDomainID]; !ok {
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
 		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
 	}
+	if err := validateStorageDomainImageTransfer(storageDomain, "uploading an image"); err != nil {
+		return nil, err
+	}
 
 	imageFormat, qcowSize, err := extractQCOWParameters(size, reader)
 	if err != nil {
@@ -2,19 +2,40 @@ package ovirtclient
 
 import (
 	"fmt"
-	"time"
 )
 
 // WaitForDiskOK waits for a disk to be in the OK status, then additionally queries the job that was in progress with
 // the correlation ID. This is necessary because the disk returns OK status before the job has actually finished,
 // resulting in a "disk locked" error on subsequent operations. It uses checkDiskOk as an underlying function.
 func (o *oVirtClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (disk Disk, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
 	err = retry(
 		fmt.Sprintf("waiting for disk %s to become OK", diskID),
+		reading(ResourceTypeDisk, string(diskID)).viaClient(),
 		o.logger,
 		retries,
 		func() error {
-			disk, err = o.checkDiskOK(diskID)
+			disk, err = checkDiskOK(o, diskID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return disk, nil
+}
+
+// WaitForDiskOK waits for a disk to be in the OK status. Since the disks in the mock unlock by themselves after a
+// simulated operation, this only polls the disk status.
+func (m *mockClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (disk Disk, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	err = retry(
+		fmt.Sprintf("waiting for disk %s to become OK", diskID),
+		reading(ResourceTypeDisk, string(diskID)),
+		m.logger,
+		retries,
+		func() error {
+			disk, err = checkDiskOK(m, diskID)
 			return err
 		},
 	)
@@ -26,8 +47,8 @@ func (o *oVirtClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (di
 
 // checkDiskOK fetches the disk for the transfer and checks if it is in the OK status. It returns an EPending error if
 // it is not.
-func (o *oVirtClient) checkDiskOK(diskID DiskID) (Disk, error) {
-	disk, err := o.GetDisk(diskID)
+func checkDiskOK(client Client, diskID DiskID) (Disk, error) {
+	disk, err := client.GetDisk(diskID)
 	if err != nil {
 		return nil, err
 	}
@@ -40,20 +61,3 @@ func (o *oVirtClient) checkDiskOK(diskID DiskID) (Disk, error) {
 		return nil, newError(EUnexpectedDiskStatus, "disk status is %s, not %s", disk.Status(), DiskStatusOK)
 	}
 }
-
-// WaitForDiskOK waits for a disk to be in the OK status, then additionally queries the job that was in progress with
-// the correlation ID. This is necessary because the disk returns OK status before the job has actually finished,
-// resulting in a "disk locked" error on subsequent operations. It uses checkDiskOk as an underlying function.
-func (m *mockClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	disk, ok := m.disks[diskID]
-
-	if !ok {
-		return nil, newError(ENotFound, "Disk with ID %s not found", diskID)
-	}
-	time.Sleep(2 * time.Second)
-	disk.status = DiskStatusOK
-
-	return disk, nil
-}
@@ -0,0 +1,59 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForDiskStatus(
+	diskID DiskID,
+	status DiskStatus,
+	retries ...RetryStrategy,
+) (disk Disk, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for disk %s status %s", diskID, status),
+		reading(ResourceTypeDisk, string(diskID)).viaClient(),
+		o.logger,
+		retries,
+		func() error {
+			disk, err = o.GetDisk(diskID, retries...)
+			if err != nil {
+				return err
+			}
+			if disk.Status() != status {
+				return newError(EPending, "disk status is %s, not %s", disk.Status(), status)
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) WaitForDiskStatus(
+	diskID DiskID,
+	status DiskStatus,
+	retries ...RetryStrategy,
+) (disk Disk, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for disk %s status %s", diskID, status),
+		reading(ResourceTypeDisk, string(diskID)),
+		m.logger,
+		retries,
+		func() error {
+			disk, err = m.GetDisk(diskID, retries...)
+			if err != nil {
+				return err
+			}
+			if disk.Status() != status {
+				return newError(EPending, "disk status is %s, not %s", disk.Status(), status)
+			}
+			return nil
+		})
+	return
+}
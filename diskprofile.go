@@ -0,0 +1,169 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+// DiskProfileID is the identifier of a disk profile.
+type DiskProfileID string
+
+// DiskProfileClient lists the methods for working with disk profiles. A disk profile belongs to a storage domain and
+// limits the throughput and IOPS of the disks assigned to it through a storage QoS entry. Every storage domain has
+// a default disk profile with the same name as the storage domain. Use CreateDiskOptionalParameters.DiskProfileID to
+// assign a profile on disk creation.
+type DiskProfileClient interface {
+	// ListDiskProfiles returns all disk profiles in the oVirt Engine.
+	ListDiskProfiles(retries ...RetryStrategy) ([]DiskProfile, error)
+	// ListStorageDomainDiskProfiles returns the disk profiles that can be assigned to disks on the specified storage
+	// domain.
+	ListStorageDomainDiskProfiles(storageDomainID StorageDomainID, retries ...RetryStrategy) ([]DiskProfile, error)
+	// GetDiskProfile returns a single disk profile by its ID.
+	GetDiskProfile(id DiskProfileID, retries ...RetryStrategy) (DiskProfile, error)
+	// CreateDiskProfile creates a disk profile on a storage domain. Use NewCreateProfileParams to reference a storage
+	// QoS entry.
+	CreateDiskProfile(
+		storageDomainID StorageDomainID,
+		name string,
+		params CreateProfileParameters,
+		retries ...RetryStrategy,
+	) (DiskProfile, error)
+	// RemoveDiskProfile removes a disk profile. The engine refuses to remove profiles still assigned to disks.
+	RemoveDiskProfile(id DiskProfileID, retries ...RetryStrategy) error
+}
+
+// DiskProfileData is the data segment of the DiskProfile type.
+type DiskProfileData interface {
+	// ID returns the unique identifier of the disk profile.
+	ID() DiskProfileID
+	// Name returns the name of the disk profile.
+	Name() string
+	// Description returns the user-readable description of the disk profile.
+	Description() string
+	// StorageDomainID returns the ID of the storage domain the profile belongs to.
+	StorageDomainID() StorageDomainID
+	// QoSID returns the ID of the storage QoS entry limiting the disks assigned to the profile, or nil if the profile
+	// does not limit them.
+	QoSID() *QoSID
+}
+
+// DiskProfile is a profile that limits the storage usage of disks on a storage domain.
+type DiskProfile interface {
+	DiskProfileData
+
+	// StorageDomain fetches the storage domain the profile belongs to.
+	StorageDomain(retries ...RetryStrategy) (StorageDomain, error)
+	// Remove removes the disk profile.
+	Remove(retries ...RetryStrategy) error
+}
+
+func convertSDKDiskProfile(object *ovirtsdk.DiskProfile, client Client) (DiskProfile, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("disk profile", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("disk profile", "name")
+	}
+	sdkStorageDomain, ok := object.StorageDomain()
+	if !ok {
+		return nil, newFieldNotFound("disk profile", "storage domain")
+	}
+	storageDomainID, ok := sdkStorageDomain.Id()
+	if !ok {
+		return nil, newFieldNotFound("storage domain on disk profile", "ID")
+	}
+	description, _ := object.Description()
+	return &diskProfile{
+		client:          client,
+		id:              DiskProfileID(id),
+		name:            name,
+		description:     description,
+		storageDomainID: StorageDomainID(storageDomainID),
+		qosID:           convertSDKProfileQoS(object.Qos),
+	}, nil
+}
+
+type diskProfile struct {
+	client Client
+
+	id              DiskProfileID
+	name            string
+	description     string
+	storageDomainID StorageDomainID
+	qosID           *QoSID
+}
+
+func (d diskProfile) ID() DiskProfileID {
+	return d.id
+}
+
+func (d diskProfile) Name() string {
+	return d.name
+}
+
+func (d diskProfile) Description() string {
+	return d.description
+}
+
+func (d diskProfile) StorageDomainID() StorageDomainID {
+	return d.storageDomainID
+}
+
+func (d diskProfile) QoSID() *QoSID {
+	return d.qosID
+}
+
+func (d diskProfile) StorageDomain(retries ...RetryStrategy) (StorageDomain, error) {
+	return d.client.GetStorageDomain(d.storageDomainID, retries...)
+}
+
+func (d diskProfile) Remove(retries ...RetryStrategy) error {
+	return d.client.RemoveDiskProfile(d.id, retries...)
+}
+
+// addDefaultDiskProfile creates the disk profile the engine adds to every new storage domain. It must be called with
+// the lock held.
+func (m *mockClient) addDefaultDiskProfile(sd *storageDomain) *diskProfile {
+	profile := &diskProfile{
+		client:          m,
+		id:              DiskProfileID(m.GenerateUUID()),
+		name:            sd.name,
+		storageDomainID: sd.id,
+	}
+	m.diskProfiles[profile.id] = profile
+	return profile
+}
+
+// defaultDiskProfileID returns the ID of the disk profile with the same name as the storage domain, or nil if there
+// is none. It must be called with the lock held.
+func (m *mockClient) defaultDiskProfileID(storageDomainID StorageDomainID) *DiskProfileID {
+	sd, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil
+	}
+	for _, profile := range m.diskProfiles {
+		if profile.storageDomainID == storageDomainID && profile.name == sd.name {
+			id := profile.id
+			return &id
+		}
+	}
+	return nil
+}
+
+// validateDiskProfile checks that the disk profile exists and belongs to the storage domain. It must be called with
+// the lock held.
+func (m *mockClient) validateDiskProfile(storageDomainID StorageDomainID, diskProfileID DiskProfileID) error {
+	profile, ok := m.diskProfiles[diskProfileID]
+	if !ok {
+		return newError(ENotFound, "disk profile with ID %s not found", diskProfileID)
+	}
+	if profile.storageDomainID != storageDomainID {
+		return newError(
+			EBadArgument,
+			"disk profile %s belongs to storage domain %s instead of %s",
+			profile.id,
+			profile.storageDomainID,
+			storageDomainID,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateDiskProfile(
+	storageDomainID StorageDomainID,
+	name string,
+	params CreateProfileParameters,
+	retries ...RetryStrategy,
+) (result DiskProfile, err error) {
+	if params == nil {
+		params = NewCreateProfileParams()
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "the disk profile name cannot be empty")
+	}
+	builder := ovirtsdk.NewDiskProfileBuilder().
+		Name(name).
+		StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild())
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if qosID := params.QoSID(); qosID != nil {
+		builder.Qos(ovirtsdk.NewQosBuilder().Id(string(*qosID)).MustBuild())
+	}
+	sdkProfile, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build disk profile %s", name)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("creating disk profile %s on storage domain %s", name, storageDomainID),
+		mutating(ResourceTypeDiskProfile, "").
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+				"name":              name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				DiskProfilesService().
+				Add().
+				Profile(sdkProfile).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Profile()
+			if !ok {
+				return newFieldNotFound("disk profile creation response", "profile")
+			}
+			result, e = convertSDKDiskProfile(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert disk profile %s", name)
+			}
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(
+			ResourceTypeDiskProfile,
+			string(result.ID()),
+			string(storageDomainID),
+			MutationTypeCreated,
+		)
+	}
+	return result, err
+}
+
+func (m *mockClient) CreateDiskProfile(
+	storageDomainID StorageDomainID,
+	name string,
+	params CreateProfileParameters,
+	_ ...RetryStrategy,
+) (DiskProfile, error) {
+	if params == nil {
+		params = NewCreateProfileParams()
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "the disk profile name cannot be empty")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.storageDomains[storageDomainID]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	for _, item := range m.diskProfiles {
+		if item.storageDomainID == storageDomainID && item.name == name {
+			return nil, newError(
+				ENameInUse,
+				"a disk profile named %s already exists on storage domain %s",
+				name,
+				storageDomainID,
+			)
+		}
+	}
+	if err := m.validateMockProfileQoS(params.QoSID(), QoSTypeStorage); err != nil {
+		return nil, err
+	}
+	result := &diskProfile{
+		client:          m,
+		id:              DiskProfileID(m.GenerateUUID()),
+		name:            name,
+		storageDomainID: storageDomainID,
+		qosID:           params.QoSID(),
+	}
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	m.diskProfiles[result.id] = result
+	m.mutationListeners.notify(
+		ResourceTypeDiskProfile,
+		string(result.id),
+		string(storageDomainID),
+		MutationTypeCreated,
+	)
+	return result, nil
+}
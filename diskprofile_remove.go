@@ -0,0 +1,51 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveDiskProfile(id DiskProfileID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err := retry(
+		fmt.Sprintf("removing disk profile %s", id),
+		mutating(ResourceTypeDiskProfile, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DiskProfilesService().
+				DiskProfileService(string(id)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeDiskProfile, string(id), "", MutationTypeRemoved)
+	}
+	return err
+}
+
+func (m *mockClient) RemoveDiskProfile(id DiskProfileID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	profile, ok := m.diskProfiles[id]
+	if !ok {
+		return newError(ENotFound, "disk profile with ID %s not found", id)
+	}
+	for _, item := range m.disks {
+		if item.diskProfileID != nil && *item.diskProfileID == id {
+			return newError(EConflict, "disk profile %s is still assigned to disk %s", id, item.id)
+		}
+	}
+	delete(m.diskProfiles, id)
+	m.mutationListeners.notify(
+		ResourceTypeDiskProfile,
+		string(id),
+		string(profile.storageDomainID),
+		MutationTypeRemoved,
+	)
+	return nil
+}
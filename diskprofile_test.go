@@ -0,0 +1,43 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiskCreationWithDiskProfile(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	profiles, err := client.ListStorageDomainDiskProfiles(helper.GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("Failed to list disk profiles of storage domain %s (%v)", helper.GetStorageDomainID(), err)
+	}
+	if len(profiles) == 0 {
+		t.Fatalf("No disk profiles found for storage domain %s.", helper.GetStorageDomainID())
+	}
+	profile := profiles[0]
+	fetchedProfile, err := client.GetDiskProfile(profile.ID())
+	if err != nil {
+		t.Fatalf("Failed to get disk profile %s (%v)", profile.ID(), err)
+	}
+	if fetchedProfile.StorageDomainID() != helper.GetStorageDomainID() {
+		t.Fatalf(
+			"Disk profile %s belongs to incorrect storage domain %s.",
+			profile.ID(),
+			fetchedProfile.StorageDomainID(),
+		)
+	}
+
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithDiskProfileID(profile.ID()),
+	)
+	if diskProfileID := disk.DiskProfileID(); diskProfileID == nil || *diskProfileID != profile.ID() {
+		t.Fatalf("Incorrect disk profile on disk %s: %v instead of %s", disk.ID(), diskProfileID, profile.ID())
+	}
+}
@@ -0,0 +1,86 @@
+package ovirtclient
+
+import (
+	"context"
+	"sync"
+)
+
+// DryRunClient is a client that performs all calls against a mock snapshot of the inventory instead of the engine,
+// and records the changes they would make. It can be used to show a plan of the changes before applying them.
+//
+// The calls are validated by the mock client, so a call failing in the dry run will typically fail against the
+// engine as well. The opposite is not guaranteed, since the mock does not implement every check the engine does.
+type DryRunClient interface {
+	Client
+
+	// PlannedMutations returns the changes made so far, in the order they happened. Objects created in the dry run
+	// carry IDs generated by the mock, which will be different when the changes are applied to the engine.
+	PlannedMutations() []MutationEvent
+}
+
+// NewDryRunClient creates a DryRunClient with a snapshot of the inventory of the specified client, see
+// NewMockFromClient. Every change is logged on the info level.
+func NewDryRunClient(client Client, logger Logger, retries ...RetryStrategy) (DryRunClient, error) {
+	mock, err := NewMockFromClient(client, logger, retries...)
+	if err != nil {
+		return nil, err
+	}
+	journal := &dryRunJournal{
+		lock:   &sync.Mutex{},
+		logger: logger,
+	}
+	mock.(*mockClient).mutationListeners.addRecorder(journal.record)
+	return &dryRunClient{
+		Client:  mock,
+		journal: journal,
+	}, nil
+}
+
+type dryRunClient struct {
+	Client
+
+	journal *dryRunJournal
+}
+
+func (d *dryRunClient) WithContext(ctx context.Context) Client {
+	return &dryRunClient{
+		Client:  d.Client.WithContext(ctx),
+		journal: d.journal,
+	}
+}
+
+func (d *dryRunClient) PlannedMutations() []MutationEvent {
+	return d.journal.events()
+}
+
+// dryRunJournal holds the mutations recorded by a DryRunClient and its subclients.
+type dryRunJournal struct {
+	lock      *sync.Mutex
+	logger    Logger
+	mutations []MutationEvent
+}
+
+func (j *dryRunJournal) record(event MutationEvent) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if event.ParentID() == "" {
+		j.logger.Infof("Dry run: %s %s would be %s.", event.ResourceType(), event.ResourceID(), event.MutationType())
+	} else {
+		j.logger.Infof(
+			"Dry run: %s %s of %s would be %s.",
+			event.ResourceType(),
+			event.ResourceID(),
+			event.ParentID(),
+			event.MutationType(),
+		)
+	}
+	j.mutations = append(j.mutations, event)
+}
+
+func (j *dryRunJournal) events() []MutationEvent {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	result := make([]MutationEvent, len(j.mutations))
+	copy(result, j.mutations)
+	return result
+}
@@ -0,0 +1,92 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	existingVM := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	dryRun, err := ovirtclient.NewDryRunClient(helper.GetClient(), ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create dry run client (%v)", err)
+	}
+	if _, err := dryRun.GetVM(existingVM.ID()); err != nil {
+		t.Fatalf("The existing VM %s is not present in the dry run (%v)", existingVM.ID(), err)
+	}
+
+	name := helper.GenerateTestResourceName(t)
+	vm, err := dryRun.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM in dry run (%v)", err)
+	}
+	if err := dryRun.RemoveVM(existingVM.ID()); err != nil {
+		t.Fatalf("Failed to remove VM %s in dry run (%v)", existingVM.ID(), err)
+	}
+
+	mutations := dryRun.PlannedMutations()
+	if len(mutations) != 2 {
+		t.Fatalf("Incorrect number of planned mutations: %d instead of 2", len(mutations))
+	}
+	if mutations[0].ResourceID() != string(vm.ID()) || mutations[0].MutationType() != ovirtclient.MutationTypeCreated {
+		t.Fatalf("Incorrect first planned mutation: %s %s", mutations[0].MutationType(), mutations[0].ResourceID())
+	}
+	if mutations[1].ResourceID() != string(existingVM.ID()) ||
+		mutations[1].MutationType() != ovirtclient.MutationTypeRemoved {
+		t.Fatalf("Incorrect second planned mutation: %s %s", mutations[1].MutationType(), mutations[1].ResourceID())
+	}
+
+	if _, err := helper.GetClient().GetVMByName(name); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The VM created in the dry run exists in the source client (%v)", err)
+	}
+	if _, err := helper.GetClient().GetVM(existingVM.ID()); err != nil {
+		t.Fatalf("The VM removed in the dry run was removed from the source client (%v)", err)
+	}
+}
+
+func TestDryRunKeepsStorageDomainCapacity(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	if _, ok := client.(ovirtclient.MockClient); !ok {
+		t.Skipf("This test fills a storage domain and is only run with the mock.")
+	}
+	sd, err := client.GetStorageDomain(helper.GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("Failed to fetch storage domain %s (%v)", helper.GetStorageDomainID(), err)
+	}
+	if _, err := client.CreateDisk(sd.ID(), ovirtclient.ImageFormatRaw, sd.Available()/2, nil); err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+	sd, err = client.GetStorageDomain(sd.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch storage domain %s (%v)", sd.ID(), err)
+	}
+
+	dryRun, err := ovirtclient.NewDryRunClient(client, ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create dry run client (%v)", err)
+	}
+	dryRunSD, err := dryRun.GetStorageDomain(sd.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch storage domain %s in dry run (%v)", sd.ID(), err)
+	}
+	if dryRunSD.Available() != sd.Available() || dryRunSD.Used() != sd.Used() {
+		t.Fatalf(
+			"Incorrect space on storage domain in dry run (%d available and %d used instead of %d and %d).",
+			dryRunSD.Available(),
+			dryRunSD.Used(),
+			sd.Available(),
+			sd.Used(),
+		)
+	}
+	if _, err := dryRun.CreateDisk(sd.ID(), ovirtclient.ImageFormatRaw, sd.Available()-1, nil); err != nil {
+		t.Fatalf("Failed to fill storage domain to just under its capacity in dry run (%v)", err)
+	}
+}
@@ -0,0 +1,51 @@
+package ovirtclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+	"time"
+)
+
+// GetEngineCertificate connects to the engine without verifying its certificate and returns the certificate it
+// presents. It is meant for trust-on-first-use setups: show the CertificateFingerprint of the result to the user,
+// and once they confirmed it, pass it to BuildableTLSProvider.PinCertificateFingerprint. The url is the engine API
+// URL as passed to New, the port defaults to 443 if the URL does not contain one.
+//
+// The returned certificate must not be trusted without confirmation as anyone in the network path can present a
+// different one.
+func GetEngineCertificate(engineURL string, timeout time.Duration) (*x509.Certificate, error) {
+	u, err := url.Parse(engineURL)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "failed to parse engine URL: %s", engineURL)
+	}
+	if u.Scheme != "https" {
+		return nil, newError(EBadArgument, "the engine URL must use https, got %s", engineURL)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+	address := net.JoinHostPort(u.Hostname(), port)
+	dialer := &net.Dialer{
+		Timeout: timeout,
+	}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		// The certificate is fetched in order to be verified by the user, so it cannot be verified here.
+		InsecureSkipVerify: true, //nolint:gosec
+		ServerName:         u.Hostname(),
+		MinVersion:         tls.VersionTLS12,
+	})
+	if err != nil {
+		return nil, wrap(err, EConnection, "failed to connect to the engine at %s", address)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	certificates := conn.ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		return nil, newError(ETLSError, "the engine at %s did not present a certificate", address)
+	}
+	return certificates[0], nil
+}
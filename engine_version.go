@@ -0,0 +1,126 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EngineVersion is the full version of the oVirt Engine, such as 4.4.10.7.
+type EngineVersion interface {
+	Version
+
+	// Build returns the build number, the third component of the version.
+	Build() uint
+	// Revision returns the revision number, the fourth component of the version.
+	Revision() uint
+	// FullVersion returns the version string reported by the engine, for example 4.4.10.7-1.el8. It may be empty if
+	// the engine did not report it.
+	FullVersion() string
+	// AtLeastBuild returns true if this version is the same as or newer than major.minor.build.revision.
+	AtLeastBuild(major uint, minor uint, build uint, revision uint) bool
+}
+
+// NewEngineVersion creates a new EngineVersion from the specified numbers.
+func NewEngineVersion(major uint, minor uint, build uint, revision uint) EngineVersion {
+	return &engineVersion{
+		version: version{
+			major: major,
+			minor: minor,
+		},
+		build:    build,
+		revision: revision,
+	}
+}
+
+type engineVersion struct {
+	version
+
+	build       uint
+	revision    uint
+	fullVersion string
+}
+
+func (e *engineVersion) Build() uint {
+	return e.build
+}
+
+func (e *engineVersion) Revision() uint {
+	return e.revision
+}
+
+func (e *engineVersion) FullVersion() string {
+	return e.fullVersion
+}
+
+func (e *engineVersion) AtLeastBuild(major uint, minor uint, build uint, revision uint) bool {
+	switch {
+	case e.major != major || e.minor != minor:
+		return e.AtLeast(major, minor)
+	case e.build != build:
+		return e.build > build
+	default:
+		return e.revision >= revision
+	}
+}
+
+func (e *engineVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", e.major, e.minor, e.build, e.revision)
+}
+
+func convertSDKEngineVersion(sdkObject *ovirtsdk.Version) (EngineVersion, error) {
+	v, err := convertSDKVersion(sdkObject)
+	if err != nil {
+		return nil, err
+	}
+	result := &engineVersion{
+		version: version{
+			major: v.Major(),
+			minor: v.Minor(),
+		},
+	}
+	if build, ok := sdkObject.Build_(); ok {
+		result.build = uint(build)
+	}
+	if revision, ok := sdkObject.Revision(); ok {
+		result.revision = uint(revision)
+	}
+	if fullVersion, ok := sdkObject.FullVersion(); ok {
+		result.fullVersion = fullVersion
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) GetEngineVersion(retries ...RetryStrategy) (result EngineVersion, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		"fetching engine version",
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().Get().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to fetch the oVirt Engine API information")
+			}
+			result, err = o.parseEngineVersion(response)
+			return err
+		},
+	)
+	return result, err
+}
+
+// mockEngineVersion is the version the mock engine reports.
+var mockEngineVersion = &engineVersion{
+	version: version{
+		major: mockMaxClusterVersion.Major(),
+		minor: mockMaxClusterVersion.Minor(),
+	},
+	build:       0,
+	revision:    0,
+	fullVersion: fmt.Sprintf("%s.0-1.el8", mockMaxClusterVersion),
+}
+
+func (m *mockClient) GetEngineVersion(_ ...RetryStrategy) (EngineVersion, error) {
+	return mockEngineVersion, nil
+}
@@ -0,0 +1,177 @@
+package ovirtclient
+
+import (
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// ErratumID is the identifier of an erratum in the Katello (Foreman) provider.
+type ErratumID string
+
+// ErrataClient lists the errata applicable to hosts and VMs. Errata are only available if a Katello provider is
+// configured for the engine and the host or VM is registered with it. Otherwise, the lists are empty.
+type ErrataClient interface {
+	// ListHostErrata lists the errata available for a host.
+	ListHostErrata(hostID HostID, retries ...RetryStrategy) ([]Erratum, error)
+	// ListVMErrata lists the errata available for a VM. This requires the guest to be registered with Katello.
+	ListVMErrata(vmID VMID, retries ...RetryStrategy) ([]Erratum, error)
+}
+
+// Erratum is a package update published by the operating system vendor, such as a security fix.
+type Erratum interface {
+	// ID returns the identifier of the erratum.
+	ID() ErratumID
+	// Name returns the advisory name, for example RHSA-2022:1234.
+	Name() string
+	// Title returns the short description of the erratum.
+	Title() string
+	// Type returns the type of the erratum.
+	Type() ErratumType
+	// Severity returns the severity as reported by the vendor, for example "Important". Empty if not reported,
+	// which is typical for non-security errata.
+	Severity() string
+	// Summary returns the summary of the erratum.
+	Summary() string
+	// Solution returns the steps required to apply the erratum.
+	Solution() string
+	// Issued returns the date the erratum was issued, or nil if it is unknown.
+	Issued() *time.Time
+	// Packages returns the names of the packages fixed by the erratum.
+	Packages() []string
+}
+
+// ErratumType is the kind of change an erratum contains.
+type ErratumType string
+
+const (
+	// ErratumTypeSecurity is an erratum that fixes a security issue.
+	ErratumTypeSecurity ErratumType = "security"
+	// ErratumTypeBugfix is an erratum that fixes a bug.
+	ErratumTypeBugfix ErratumType = "bugfix"
+	// ErratumTypeEnhancement is an erratum that adds a feature.
+	ErratumTypeEnhancement ErratumType = "enhancement"
+)
+
+// ErratumTypeList is a list of ErratumType values.
+type ErratumTypeList []ErratumType
+
+// Strings creates a string list of the values.
+func (l ErratumTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, erratumType := range l {
+		result[i] = string(erratumType)
+	}
+	return result
+}
+
+// ErratumTypeValues returns all possible ErratumType values.
+func ErratumTypeValues() ErratumTypeList {
+	return []ErratumType{
+		ErratumTypeSecurity,
+		ErratumTypeBugfix,
+		ErratumTypeEnhancement,
+	}
+}
+
+func convertSDKErrata(sdkErrata *ovirtsdk.KatelloErratumSlice) ([]Erratum, error) {
+	result := make([]Erratum, len(sdkErrata.Slice()))
+	for i, sdkErratum := range sdkErrata.Slice() {
+		e, err := convertSDKErratum(sdkErratum)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = e
+	}
+	return result, nil
+}
+
+func convertSDKErratum(object *ovirtsdk.KatelloErratum) (Erratum, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("erratum", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("erratum", "name")
+	}
+	erratumType, ok := object.Type()
+	if !ok {
+		return nil, newFieldNotFound("erratum", "type")
+	}
+	title, _ := object.Title()
+	severity, _ := object.Severity()
+	summary, _ := object.Summary()
+	solution, _ := object.Solution()
+	var issued *time.Time
+	if sdkIssued, ok := object.Issued(); ok {
+		issued = &sdkIssued
+	}
+	var packages []string
+	if sdkPackages, ok := object.Packages(); ok {
+		for _, p := range sdkPackages.Slice() {
+			if packageName, ok := p.Name(); ok {
+				packages = append(packages, packageName)
+			}
+		}
+	}
+	return &erratum{
+		id:          ErratumID(id),
+		name:        name,
+		title:       title,
+		erratumType: ErratumType(erratumType),
+		severity:    severity,
+		summary:     summary,
+		solution:    solution,
+		issued:      issued,
+		packages:    packages,
+	}, nil
+}
+
+type erratum struct {
+	id          ErratumID
+	name        string
+	title       string
+	erratumType ErratumType
+	severity    string
+	summary     string
+	solution    string
+	issued      *time.Time
+	packages    []string
+}
+
+func (e *erratum) ID() ErratumID {
+	return e.id
+}
+
+func (e *erratum) Name() string {
+	return e.name
+}
+
+func (e *erratum) Title() string {
+	return e.title
+}
+
+func (e *erratum) Type() ErratumType {
+	return e.erratumType
+}
+
+func (e *erratum) Severity() string {
+	return e.severity
+}
+
+func (e *erratum) Summary() string {
+	return e.summary
+}
+
+func (e *erratum) Solution() string {
+	return e.solution
+}
+
+func (e *erratum) Issued() *time.Time {
+	return e.issued
+}
+
+func (e *erratum) Packages() []string {
+	return e.packages
+}
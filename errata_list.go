@@ -0,0 +1,88 @@
+package ovirtclient
+
+import "fmt"
+
+func (o *oVirtClient) ListHostErrata(hostID HostID, retries ...RetryStrategy) (result []Erratum, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing errata for host %s", hostID),
+		reading(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				KatelloErrataService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkErrata, ok := resp.Errata()
+			if !ok {
+				result = []Erratum{}
+				return nil
+			}
+			result, err = convertSDKErrata(sdkErrata)
+			return err
+		},
+	)
+	return result, err
+}
+
+func (o *oVirtClient) ListVMErrata(vmID VMID, retries ...RetryStrategy) (result []Erratum, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing errata for VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				KatelloErrataService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkErrata, ok := resp.Errata()
+			if !ok {
+				result = []Erratum{}
+				return nil
+			}
+			result, err = convertSDKErrata(sdkErrata)
+			return err
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListHostErrata(hostID HostID, _ ...RetryStrategy) ([]Erratum, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]Erratum, len(m.errataByHost[hostID]))
+	for i, e := range m.errataByHost[hostID] {
+		result[i] = e
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListVMErrata(vmID VMID, _ ...RetryStrategy) ([]Erratum, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	// Mock VMs are never registered with Katello.
+	return []Erratum{}, nil
+}
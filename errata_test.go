@@ -0,0 +1,51 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListHostErrata(t *testing.T) {
+	helper := getHelper(t)
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Fatalf("No hosts found.")
+	}
+	errata, err := hosts[0].ListErrata()
+	if err != nil {
+		t.Fatalf("Failed to list errata for host %s (%v)", hosts[0].ID(), err)
+	}
+	for _, erratum := range errata {
+		assertValidErratum(t, erratum)
+	}
+}
+
+func TestListVMErrata(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	errata, err := vm.ListErrata()
+	if err != nil {
+		t.Fatalf("Failed to list errata for VM %s (%v)", vm.ID(), err)
+	}
+	for _, erratum := range errata {
+		assertValidErratum(t, erratum)
+	}
+}
+
+func assertValidErratum(t *testing.T, erratum ovirtclient.Erratum) {
+	t.Helper()
+	if erratum.ID() == "" || erratum.Name() == "" {
+		t.Fatalf("Erratum without ID or name returned.")
+	}
+	for _, erratumType := range ovirtclient.ErratumTypeValues() {
+		if erratum.Type() == erratumType {
+			return
+		}
+	}
+	t.Fatalf("Erratum %s has an invalid type: %s", erratum.Name(), erratum.Type())
+}
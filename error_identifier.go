@@ -0,0 +1,131 @@
+package ovirtclient
+
+import (
+	"sync"
+)
+
+// ErrorIdentifierClient is the client interface part that lets downstream projects extend the error identification.
+// The client identifies errors by matching the English fault messages of the oVirt Engine, which does not work for
+// engines with a different locale, or for fault messages the client does not know about.
+type ErrorIdentifierClient interface {
+	// AddErrorIdentifier registers a function that is called with every error an API call returns. If the identifier
+	// returns an EngineError, it replaces the error identified by the client, so the code returned by the identifier
+	// also decides whether the call is retried. The returned function removes the identifier again.
+	//
+	// Identifiers are called in the order they were registered until one of them returns a non-nil value. They are
+	// shared with subclients created using WithContext.
+	AddErrorIdentifier(identifier ErrorIdentifier) (remove func())
+}
+
+// ErrorIdentifier inspects an error returned from an API call and returns an EngineError with the appropriate code
+// if it recognizes the error, or nil otherwise. Use WrapError to create the returned error, for example:
+//
+//	func(err error) ovirtclient.EngineError {
+//	    if strings.Contains(err.Error(), "Die VM ist gesperrt") {
+//	        return ovirtclient.WrapError(err, ovirtclient.EVMLocked, "the VM is locked")
+//	    }
+//	    return nil
+//	}
+type ErrorIdentifier func(err error) EngineError
+
+// WrapError wraps an error with the specified code and message. It is intended for ErrorIdentifier implementations.
+// If the code is EUnidentified, the client attempts to identify the error itself.
+func WrapError(err error, code ErrorCode, format string, args ...interface{}) EngineError {
+	return wrap(err, code, format, args...)
+}
+
+type errorIdentifierEntry struct {
+	id         uint64
+	identifier ErrorIdentifier
+}
+
+// errorIdentifiers holds the registered error identifiers. It is shared between a client and its subclients.
+type errorIdentifiers struct {
+	lock        *sync.Mutex
+	nextID      uint64
+	identifiers []errorIdentifierEntry
+}
+
+func newErrorIdentifiers() *errorIdentifiers {
+	return &errorIdentifiers{
+		lock: &sync.Mutex{},
+	}
+}
+
+func (e *errorIdentifiers) add(identifier ErrorIdentifier) func() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	id := e.nextID
+	e.nextID++
+	e.identifiers = append(e.identifiers, errorIdentifierEntry{id, identifier})
+	return func() {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		for i, entry := range e.identifiers {
+			if entry.id == id {
+				e.identifiers = append(e.identifiers[:i:i], e.identifiers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (e *errorIdentifiers) identify(err error) EngineError {
+	e.lock.Lock()
+	identifiers := make([]ErrorIdentifier, len(e.identifiers))
+	for i, entry := range e.identifiers {
+		identifiers[i] = entry.identifier
+	}
+	e.lock.Unlock()
+	// The identifiers are called without holding the lock so they can register or remove identifiers themselves.
+	for _, identifier := range identifiers {
+		if identified := identifier(err); identified != nil {
+			return identified
+		}
+	}
+	return nil
+}
+
+// errorIdentifierStrategy carries the error identifiers of the client to the retry function. It does not take part in
+// the retry decisions itself.
+type errorIdentifierStrategy struct {
+	callOption
+
+	identifiers *errorIdentifiers
+}
+
+// withClientErrorIdentifiers adds the error identifiers registered on the client to the default retry strategies.
+func withClientErrorIdentifiers(client Client, retries []RetryStrategy) []RetryStrategy {
+	var identifiers *errorIdentifiers
+	switch c := client.(type) {
+	case *oVirtClient:
+		identifiers = c.errorIdentifiers
+	case *mockClient:
+		identifiers = c.errorIdentifiers
+	}
+	if identifiers == nil {
+		return retries
+	}
+	return append(retries, &errorIdentifierStrategy{identifiers: identifiers})
+}
+
+// identifyCustomError passes the error to the error identifiers among the retry strategies and returns the identified
+// error, or the original error if no identifier recognized it.
+func identifyCustomError(retries []RetryStrategy, err error) error {
+	for _, r := range retries {
+		if s, ok := r.(*errorIdentifierStrategy); ok {
+			if identified := s.identifiers.identify(err); identified != nil {
+				return identified
+			}
+		}
+	}
+	return err
+}
+
+func (o *oVirtClient) AddErrorIdentifier(identifier ErrorIdentifier) func() {
+	return o.errorIdentifiers.add(identifier)
+}
+
+func (m *mockClient) AddErrorIdentifier(identifier ErrorIdentifier) func() {
+	return m.errorIdentifiers.add(identifier)
+}
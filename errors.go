@@ -3,6 +3,7 @@ package ovirtclient
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
@@ -18,6 +19,10 @@ const EAccessDenied ErrorCode = "access_denied"
 // EUserAccountLocked signals that the provided user account for the oVirt engine has been locked.
 const EUserAccountLocked ErrorCode = "user_locked"
 
+// EInvalidProfile signals that the authentication profile in the username, the part after the last @ sign, does not
+// exist on the oVirt engine.
+const EInvalidProfile ErrorCode = "invalid_profile"
+
 // ENotAnOVirtEngine signals that the server did not respond with a proper oVirt response.
 const ENotAnOVirtEngine ErrorCode = "not_ovirt_engine"
 
@@ -97,6 +102,36 @@ const EInvalidGrant ErrorCode = "invalid_grant"
 // ECannotRunVM indicates an error with the VM configuration which prevents it from being run.
 const ECannotRunVM ErrorCode = "cannot_run_vm"
 
+// EUnexpectedHostStatus indicates that a host ended up in a status it will not leave on its own, for example because
+// the installation failed.
+const EUnexpectedHostStatus ErrorCode = "unexpected_host_status"
+
+// EObjectChanged indicates that an update was refused because the object was changed by someone else since the caller
+// fetched it. The caller should fetch the object again and decide whether the update is still needed.
+const EObjectChanged ErrorCode = "object_changed"
+
+// ENameInUse indicates that a resource could not be created or renamed because another resource of the same type
+// already uses the name.
+const ENameInUse ErrorCode = "name_in_use"
+
+// EInsufficientStorage indicates that the storage domain does not have enough free space for the operation.
+const EInsufficientStorage ErrorCode = "insufficient_storage"
+
+// EQuotaExceeded indicates that the operation would exceed the quota assigned to the resource or the user.
+const EQuotaExceeded ErrorCode = "quota_exceeded"
+
+// EHostedEngine indicates that a destructive operation was refused because it would affect the Hosted Engine VM. Pass
+// AllowHostedEngine to the call to override the check.
+const EHostedEngine ErrorCode = "hosted_engine"
+
+// EDeleteProtected indicates that a VM could not be removed because delete protection is enabled on it. Pass
+// RemoveVMDisableDeleteProtection to RemoveVM to disable the protection before the removal.
+const EDeleteProtected ErrorCode = "delete_protected"
+
+// EImportFailed indicates that the engine reported an import, for example of an external VM, as failed or aborted.
+// The engine log and the events of the VM contain the reason.
+const EImportFailed ErrorCode = "import_failed"
+
 // CanRecover returns true if there is a way to automatically recoverFailure from this error. For the actual recovery an
 // appropriate recovery strategy must be passed to the retry function.
 func (e ErrorCode) CanRecover() bool {
@@ -117,6 +152,8 @@ func (e ErrorCode) CanAutoRetry() bool {
 		return false
 	case EUserAccountLocked:
 		return false
+	case EInvalidProfile:
+		return false
 	case ENotAnOVirtEngine:
 		return false
 	case ETLSError:
@@ -137,6 +174,22 @@ func (e ErrorCode) CanAutoRetry() bool {
 		return false
 	case ECannotRunVM:
 		return false
+	case EUnexpectedHostStatus:
+		return false
+	case EObjectChanged:
+		return false
+	case ENameInUse:
+		return false
+	case EInsufficientStorage:
+		return false
+	case EQuotaExceeded:
+		return false
+	case EHostedEngine:
+		return false
+	case EDeleteProtected:
+		return false
+	case EImportFailed:
+		return false
 	default:
 		return true
 	}
@@ -172,6 +225,13 @@ type EngineError interface {
 	CanRecover() bool
 	// CanAutoRetry returns false if an automatic retry should not be attempted.
 	CanAutoRetry() bool
+	// FaultReason returns the reason from the fault the engine responded with, for example "Operation Failed". It is
+	// empty if the error did not originate from an engine fault.
+	FaultReason() string
+	// FaultDetail returns the detail from the fault the engine responded with, for example
+	// "[Cannot add VM. Disk is locked.]". It is empty if the error did not originate from an engine fault, or the
+	// engine did not send any details.
+	FaultDetail() string
 }
 
 // HasErrorCode returns true if the specified error has the specified error code.
@@ -180,14 +240,19 @@ func HasErrorCode(err error, code ErrorCode) bool {
 	if errors.As(err, &e) {
 		return e.HasCode(code)
 	}
+	if err == nil {
+		return false
+	}
 	e = realIdentify(err)
-	return e.HasCode(code)
+	return e != nil && e.HasCode(code)
 }
 
 type engineError struct {
-	message string
-	code    ErrorCode
-	cause   error
+	message     string
+	code        ErrorCode
+	cause       error
+	faultReason string
+	faultDetail string
 }
 
 func (e *engineError) HasCode(code ErrorCode) bool {
@@ -231,6 +296,45 @@ func (e *engineError) CanAutoRetry() bool {
 	return e.code.CanAutoRetry()
 }
 
+func (e *engineError) FaultReason() string {
+	if e.faultReason != "" {
+		return e.faultReason
+	}
+	var causeE EngineError
+	if e.cause != nil && errors.As(e.cause, &causeE) {
+		return causeE.FaultReason()
+	}
+	return ""
+}
+
+func (e *engineError) FaultDetail() string {
+	if e.faultDetail != "" {
+		return e.faultDetail
+	}
+	var causeE EngineError
+	if e.cause != nil && errors.As(e.cause, &causeE) {
+		return causeE.FaultDetail()
+	}
+	return ""
+}
+
+// faultReasonRegexp and faultDetailRegexp extract the fault fields from the error message the SDK builds in
+// ovirtsdk.BuildError, since the SDK does not expose the fault object itself.
+var faultReasonRegexp = regexp.MustCompile(`Fault reason is "(.*?)"\.(?: Fault detail is| HTTP response code is|$)`)
+var faultDetailRegexp = regexp.MustCompile(`Fault detail is "(.*?)"\.(?: HTTP response code is|$)`)
+
+// parseFault returns the fault reason and detail contained in an SDK error, if any.
+func parseFault(err error) (reason string, detail string) {
+	message := err.Error()
+	if match := faultReasonRegexp.FindStringSubmatch(message); match != nil {
+		reason = match[1]
+	}
+	if match := faultDetailRegexp.FindStringSubmatch(message); match != nil {
+		detail = match[1]
+	}
+	return reason, detail
+}
+
 func newFieldNotFound(object string, field string) error {
 	return newError(EFieldMissing, "no %s field found on %s object", field, object)
 }
@@ -259,11 +363,16 @@ func wrap(err error, code ErrorCode, format string, args ...interface{}) EngineE
 			realMessage = e.Message()
 		}
 	}
-	return &engineError{
+	result := &engineError{
 		message: realMessage,
 		code:    code,
 		cause:   err,
 	}
+	var causeE EngineError
+	if !errors.As(err, &causeE) {
+		result.faultReason, result.faultDetail = parseFault(err)
+	}
+	return result
 }
 
 //nolint:funlen
@@ -319,8 +428,24 @@ func realIdentify(err error) EngineError {
 		return wrap(err, ERelatedOperationInProgress, "a related operation is in progress")
 	case strings.Contains(err.Error(), "Disk configuration") && strings.Contains(err.Error(), " is incompatible with the storage domain type."):
 		return wrap(err, EBadArgument, "disk configuration is incompatible with the storage domain type")
+	case strings.Contains(err.Error(), "name is already in use") ||
+		strings.Contains(err.Error(), "name is already used"):
+		return wrap(err, ENameInUse, "the name is already in use")
+	case strings.Contains(err.Error(), "Low disk space on Storage Domain") ||
+		strings.Contains(err.Error(), "Storage Domain doesn't have enough free space") ||
+		strings.Contains(err.Error(), "not enough space"):
+		return wrap(err, EInsufficientStorage, "not enough free space on the storage domain")
+	case strings.Contains(err.Error(), "Quota") &&
+		(strings.Contains(err.Error(), "exceeded") || strings.Contains(err.Error(), "insufficient")):
+		return wrap(err, EQuotaExceeded, "the quota has been exceeded")
+	case strings.Contains(err.Error(), "Delete protection is enabled"):
+		return wrap(err, EDeleteProtected, "the VM is delete protected")
 	case strings.Contains(err.Error(), "409 Conflict"):
 		return wrap(err, EConflict, "conflicting operations")
+	case errors.As(err, &authErr) && strings.Contains(err.Error(), "HTTP response code is \"401\""):
+		// The credentials were accepted when the connection was established, so a 401 on an API call means that the
+		// SSO token has expired or was revoked on the engine side.
+		return wrap(err, EInvalidGrant, "the access token is no longer valid, please reauthenticate")
 	case errors.As(err, &authErr):
 		fallthrough
 	case strings.Contains(err.Error(), "access_denied"):
@@ -328,6 +453,13 @@ func realIdentify(err error) EngineError {
 		if strings.Contains(err.Error(), "user account is disabled or locked") {
 			wrappedErr = wrap(wrappedErr, EUserAccountLocked, "access denied, user account has been locked")
 		}
+		if strings.Contains(err.Error(), "No valid profile found") {
+			wrappedErr = wrap(
+				wrappedErr,
+				EInvalidProfile,
+				"access denied, the authentication profile does not exist, check the part of the username after the @ sign",
+			)
+		}
 		return wrappedErr
 	default:
 		return nil
@@ -0,0 +1,81 @@
+// This file contains tests for the internal error identification. It is therefore excluded from the testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"net/http"
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func TestFaultDetailsAreParsed(t *testing.T) {
+	fault := ovirtsdk.NewFaultBuilder().
+		Reason("Operation Failed").
+		Detail("[Cannot add VM. Disk is locked.]").
+		MustBuild()
+	sdkErr := ovirtsdk.BuildError(&http.Response{StatusCode: 409, Status: "409 Conflict"}, fault)
+
+	err := wrap(sdkErr, EUnidentified, "failed to create VM")
+	if err.FaultReason() != "Operation Failed" {
+		t.Fatalf("Incorrect fault reason: %s", err.FaultReason())
+	}
+	if err.FaultDetail() != "[Cannot add VM. Disk is locked.]" {
+		t.Fatalf("Incorrect fault detail: %s", err.FaultDetail())
+	}
+
+	outer := wrap(err, EUnidentified, "failed to create VM from template")
+	if outer.FaultDetail() != err.FaultDetail() {
+		t.Fatalf("Fault detail not passed through wrapped errors: %s", outer.FaultDetail())
+	}
+}
+
+func TestFaultDetailsAreEmptyWithoutFault(t *testing.T) {
+	err := newError(EBadArgument, "invalid name")
+	if err.FaultReason() != "" || err.FaultDetail() != "" {
+		t.Fatalf("Fault fields filled for an error that did not come from the engine.")
+	}
+}
+
+func TestEngineFaultsAreIdentified(t *testing.T) {
+	testCases := map[string]ErrorCode{
+		"[Cannot add VM. The VM name is already in use, please choose a unique name and try again.]": ENameInUse,
+		"[Cannot add Virtual Disk. Low disk space on Storage Domain data.]":                          EInsufficientStorage,
+		"[Cannot add VM. Quota has insufficient storage resources.]":                                 EQuotaExceeded,
+		"[Cannot remove VM. Disk is locked.]":                                                        EDiskLocked,
+		"[Cannot stop VM. VM is locked.]":                                                            EVMLocked,
+		"[Cannot remove VM. Delete protection is enabled.]":                                          EDeleteProtected,
+	}
+	for detail, code := range testCases {
+		detail := detail
+		code := code
+		t.Run(string(code), func(t *testing.T) {
+			fault := ovirtsdk.NewFaultBuilder().Reason("Operation Failed").Detail(detail).MustBuild()
+			sdkErr := ovirtsdk.BuildError(&http.Response{StatusCode: 409, Status: "409 Conflict"}, fault)
+			err := wrap(sdkErr, EUnidentified, "operation failed")
+			if !err.HasCode(code) {
+				t.Fatalf("Fault %s was not identified as %s (%v)", detail, code, err)
+			}
+			if err.FaultDetail() != detail {
+				t.Fatalf("Incorrect fault detail: %s", err.FaultDetail())
+			}
+		})
+	}
+}
+
+func TestExpiredTokenIsIdentified(t *testing.T) {
+	sdkErr := ovirtsdk.BuildError(&http.Response{StatusCode: 401, Status: "401 Unauthorized"}, nil)
+	err := wrap(sdkErr, EUnidentified, "failed to list VMs")
+	if !err.HasCode(EInvalidGrant) {
+		t.Fatalf("A 401 response on an API call was not identified as an expired token (%v)", err)
+	}
+	if !err.CanRecover() {
+		t.Fatalf("An expired token is not marked as recoverable.")
+	}
+
+	sdkErr = ovirtsdk.BuildError(&http.Response{StatusCode: 403, Status: "403 Forbidden"}, nil)
+	err = wrap(sdkErr, EUnidentified, "failed to list VMs")
+	if !err.HasCode(EAccessDenied) {
+		t.Fatalf("A 403 response on an API call was not identified as access denied (%v)", err)
+	}
+}
@@ -0,0 +1,70 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"io"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// This example demonstrates taking a full backup of a VM disk followed by an incremental backup that only contains
+// the changes since the first one. It runs against the mock client.
+func ExampleBackupClient_incremental() {
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewNOOPLogger())
+	if err != nil {
+		panic(fmt.Errorf("failed to create mock test helper (%w)", err))
+	}
+	client := helper.GetClient()
+
+	vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), "example-backup", nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to create VM (%w)", err))
+	}
+	disk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatCow, 1048576, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to create disk (%w)", err))
+	}
+	if _, err := vm.AttachDisk(disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		panic(fmt.Errorf("failed to attach disk (%w)", err))
+	}
+
+	// backupDisk runs a single backup and downloads the disk from it.
+	backupDisk := func(params ovirtclient.OptionalBackupParameters) ovirtclient.Backup {
+		backup, err := client.StartBackup(vm.ID(), []ovirtclient.DiskID{disk.ID()}, params)
+		if err != nil {
+			panic(fmt.Errorf("failed to start backup (%w)", err))
+		}
+		backup, err = backup.WaitForPhase(ovirtclient.BackupPhaseReady)
+		if err != nil {
+			panic(fmt.Errorf("backup did not become ready (%w)", err))
+		}
+		download, err := backup.StartDownloadDisk(disk.ID())
+		if err != nil {
+			panic(fmt.Errorf("failed to start disk download (%w)", err))
+		}
+		// Write the data to a file or object storage here.
+		if _, err := io.Copy(io.Discard, download); err != nil {
+			panic(fmt.Errorf("failed to download disk (%w)", err))
+		}
+		_ = download.Close()
+		if err := backup.Finalize(); err != nil {
+			panic(fmt.Errorf("failed to finalize backup (%w)", err))
+		}
+		// A new backup can only be started once the previous one has finished.
+		if _, err := backup.WaitForPhase(ovirtclient.BackupPhaseSucceeded); err != nil {
+			panic(fmt.Errorf("backup did not succeed (%w)", err))
+		}
+		return backup
+	}
+
+	fullBackup := backupDisk(nil)
+	fmt.Println("Full backup complete.")
+
+	// The checkpoint created by the full backup is the starting point of the incremental backup.
+	backupDisk(ovirtclient.BackupParams().MustWithFromCheckpointID(*fullBackup.ToCheckpointID()))
+	fmt.Println("Incremental backup complete.")
+
+	// Output: Full backup complete.
+	// Incremental backup complete.
+}
@@ -0,0 +1,49 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// countDisks only needs the disk functions, so it accepts a DiskClient instead of the full Client.
+func countDisks(client ovirtclient.DiskClient) (int, error) {
+	disks, err := client.ListDisks()
+	if err != nil {
+		return 0, err
+	}
+	return len(disks), nil
+}
+
+// diskClientStub implements DiskClient for a test. Embedding the interface satisfies the methods the test does not
+// use; calling them panics.
+type diskClientStub struct {
+	ovirtclient.DiskClient
+
+	disks []ovirtclient.Disk
+}
+
+func (d *diskClientStub) ListDisks(_ ...ovirtclient.RetryStrategy) ([]ovirtclient.Disk, error) {
+	return d.disks, nil
+}
+
+// The following example demonstrates how to depend on a single subinterface of the client. The function can be
+// called with a full client, as well as with a stub implementing only the disk functions.
+func ExampleDiskClient_subinterface() {
+	// Any Client can be passed where a DiskClient is expected.
+	count, err := countDisks(ovirtclient.NewMock())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("The mock has %d disks.\n", count)
+
+	// In tests, a stub only needs to implement the functions that are called.
+	count, err = countDisks(&diskClientStub{})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("The stub has %d disks.\n", count)
+
+	// Output: The mock has 0 disks.
+	// The stub has 0 disks.
+}
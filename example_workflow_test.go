@@ -0,0 +1,117 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"os"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// This example demonstrates the complete lifecycle of a VM: uploading an image, turning it into a template, creating
+// a VM from the template, starting it, waiting for an IP address and then tearing everything down. It runs against
+// the mock client, but works the same way with a client created using ovirtclient.New().
+func Example_vmLifecycle() {
+	// Create a test helper backed by the in-memory mock. Use NewLiveTestHelperFromEnv() to run against an engine.
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewNOOPLogger())
+	if err != nil {
+		panic(fmt.Errorf("failed to create mock test helper (%w)", err))
+	}
+	client := helper.GetClient()
+
+	// Upload the test image from this repository into a new disk.
+	fh, err := os.Open("testimage/image")
+	if err != nil {
+		panic(fmt.Errorf("failed to open image file (%w)", err))
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+	stat, err := fh.Stat()
+	if err != nil {
+		panic(fmt.Errorf("failed to stat image file (%w)", err))
+	}
+	upload, err := client.UploadToNewDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		uint64(stat.Size()),
+		ovirtclient.CreateDiskParams().MustWithAlias("example-image"),
+		fh,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to upload image (%w)", err))
+	}
+	disk := upload.Disk()
+	fmt.Println("Image uploaded.")
+
+	// Create a VM that holds the disk, then turn it into a template.
+	baseVM, err := client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		"example-base",
+		nil,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to create base VM (%w)", err))
+	}
+	if _, err := baseVM.AttachDisk(disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		panic(fmt.Errorf("failed to attach disk (%w)", err))
+	}
+	tpl, err := client.CreateTemplate(baseVM.ID(), "example-template", nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to create template (%w)", err))
+	}
+	if _, err := tpl.WaitForStatus(ovirtclient.TemplateStatusOK); err != nil {
+		panic(fmt.Errorf("template did not become ready (%w)", err))
+	}
+	fmt.Println("Template created.")
+
+	// Create the VM from the template and give it a network interface.
+	vm, err := client.CreateVM(helper.GetClusterID(), tpl.ID(), "example-vm", nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to create VM (%w)", err))
+	}
+	if _, err := vm.CreateNIC("eth0", helper.GetVNICProfileID(), nil); err != nil {
+		panic(fmt.Errorf("failed to create NIC (%w)", err))
+	}
+
+	// Start the VM and wait for the guest agent to report an address.
+	if err := vm.Start(); err != nil {
+		panic(fmt.Errorf("failed to start VM (%w)", err))
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusUp); err != nil {
+		panic(fmt.Errorf("VM did not start (%w)", err))
+	}
+	fmt.Println("VM is up.")
+	ips, err := vm.WaitForNonLocalIPAddress()
+	if err != nil {
+		panic(fmt.Errorf("VM did not report an IP address (%w)", err))
+	}
+	if len(ips) > 0 {
+		fmt.Println("VM reported an IP address.")
+	}
+
+	// Tear everything down in reverse order.
+	if err := vm.Stop(true); err != nil {
+		panic(fmt.Errorf("failed to stop VM (%w)", err))
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusDown); err != nil {
+		panic(fmt.Errorf("VM did not stop (%w)", err))
+	}
+	if err := vm.Remove(); err != nil {
+		panic(fmt.Errorf("failed to remove VM (%w)", err))
+	}
+	if err := tpl.Remove(); err != nil {
+		panic(fmt.Errorf("failed to remove template (%w)", err))
+	}
+	if err := baseVM.Remove(); err != nil {
+		panic(fmt.Errorf("failed to remove base VM (%w)", err))
+	}
+	fmt.Println("Cleanup complete.")
+
+	// Output: Image uploaded.
+	// Template created.
+	// VM is up.
+	// VM reported an IP address.
+	// Cleanup complete.
+}
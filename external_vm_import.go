@@ -0,0 +1,383 @@
+package ovirtclient
+
+import (
+	"strings"
+)
+
+// ExternalVMImportClient contains the methods to import VMs from external hypervisors, such as VMware vCenter,
+// libvirt/KVM or Xen hosts. The engine converts the VMs using virt-v2v on a host of the target cluster, which may take
+// a long time, so the import runs in the background and its progress can be queried using the correlation ID of the
+// import.
+type ExternalVMImportClient interface {
+	// ImportExternalVM starts importing the VM with the specified name from an external hypervisor into the cluster
+	// and storage domain. The url identifies the source hypervisor in the libvirt URI format, for example:
+	//
+	//	vpx://administrator%40vsphere.local@vcenter.example.com/Datacenter/Cluster/esxi1.example.com?no_verify=1
+	//	qemu+ssh://root@kvm1.example.com/system
+	//	xen+ssh://root@xen1.example.com
+	//
+	// The import is assigned a correlation ID, either the one passed via CorrelationID, or a generated one. Use the
+	// returned ExternalVMImport to track the progress of the import. Use ExternalVMImportParams to obtain a builder
+	// for the optional params.
+	ImportExternalVM(
+		provider ExternalVMProviderType,
+		url string,
+		sourceVMName string,
+		clusterID ClusterID,
+		storageDomainID StorageDomainID,
+		params OptionalExternalVMImportParameters,
+		retries ...RetryStrategy,
+	) (ExternalVMImport, error)
+	// GetExternalVMImportProgress returns the progress of an import started with ImportExternalVM, based on the job
+	// the engine created for it. It returns an ENotFound error if the engine has no job for the correlation ID, for
+	// example because the import has only just been started, or the engine cleared the job some time after it
+	// finished.
+	GetExternalVMImportProgress(correlationID string, retries ...RetryStrategy) (ExternalVMImportProgress, error)
+	// WaitForExternalVMImport waits for an import started with ImportExternalVM to finish. It returns an
+	// EImportFailed error if the engine reports the import as failed or aborted, or has lost track of it. Conversions
+	// of large VMs may take longer than the default timeout, pass a suitable timeout strategy for these.
+	WaitForExternalVMImport(correlationID string, retries ...RetryStrategy) (ExternalVMImportProgress, error)
+}
+
+// ExternalVMProviderType is the type of hypervisor an external VM is imported from.
+type ExternalVMProviderType string
+
+const (
+	// ExternalVMProviderTypeVMware imports a VM from VMware vCenter or ESXi. A username and password are required.
+	ExternalVMProviderTypeVMware ExternalVMProviderType = "vmware"
+	// ExternalVMProviderTypeKVM imports a VM from a libvirt/KVM host.
+	ExternalVMProviderTypeKVM ExternalVMProviderType = "kvm"
+	// ExternalVMProviderTypeXen imports a VM from a Xen host. The engine connects via SSH as the vdsm user, so the
+	// SSH key of the vdsm user on the conversion host must be authorized on the Xen host.
+	ExternalVMProviderTypeXen ExternalVMProviderType = "xen"
+)
+
+// ExternalVMProviderTypeValues returns all possible ExternalVMProviderType values.
+func ExternalVMProviderTypeValues() []ExternalVMProviderType {
+	return []ExternalVMProviderType{
+		ExternalVMProviderTypeVMware,
+		ExternalVMProviderTypeKVM,
+		ExternalVMProviderTypeXen,
+	}
+}
+
+// Validate returns an error if the provider type is not valid.
+func (e ExternalVMProviderType) Validate() error {
+	for _, value := range ExternalVMProviderTypeValues() {
+		if value == e {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid external VM provider type: %s", e)
+}
+
+// ExternalVMImportStatus is the status of an import started with ImportExternalVM. The values match the statuses of
+// the engine job running the import.
+type ExternalVMImportStatus string
+
+const (
+	// ExternalVMImportStatusStarted indicates that the import is still running.
+	ExternalVMImportStatusStarted ExternalVMImportStatus = "started"
+	// ExternalVMImportStatusFinished indicates that the VM has been imported successfully.
+	ExternalVMImportStatusFinished ExternalVMImportStatus = "finished"
+	// ExternalVMImportStatusFailed indicates that the import failed.
+	ExternalVMImportStatusFailed ExternalVMImportStatus = "failed"
+	// ExternalVMImportStatusAborted indicates that the import has been aborted, for example because the engine has
+	// been restarted.
+	ExternalVMImportStatusAborted ExternalVMImportStatus = "aborted"
+	// ExternalVMImportStatusUnknown indicates that the engine lost track of the import.
+	ExternalVMImportStatusUnknown ExternalVMImportStatus = "unknown"
+)
+
+// ExternalVMImportStatusValues returns all possible ExternalVMImportStatus values.
+func ExternalVMImportStatusValues() []ExternalVMImportStatus {
+	return []ExternalVMImportStatus{
+		ExternalVMImportStatusStarted,
+		ExternalVMImportStatusFinished,
+		ExternalVMImportStatusFailed,
+		ExternalVMImportStatusAborted,
+		ExternalVMImportStatusUnknown,
+	}
+}
+
+// Validate returns an error if the import status is not valid.
+func (e ExternalVMImportStatus) Validate() error {
+	for _, value := range ExternalVMImportStatusValues() {
+		if value == e {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid external VM import status: %s", e)
+}
+
+// ExternalVMImport is an import started with ImportExternalVM.
+type ExternalVMImport interface {
+	// CorrelationID returns the correlation ID the import has been started with.
+	CorrelationID() string
+	// VMName returns the name of the VM the import creates.
+	VMName() string
+
+	// Progress returns the current progress of the import. See
+	// ExternalVMImportClient.GetExternalVMImportProgress for details.
+	Progress(retries ...RetryStrategy) (ExternalVMImportProgress, error)
+	// Wait waits for the import to finish and returns the imported VM. See
+	// ExternalVMImportClient.WaitForExternalVMImport for details.
+	Wait(retries ...RetryStrategy) (VM, error)
+}
+
+// ExternalVMImportProgress is the progress of an import started with ImportExternalVM.
+type ExternalVMImportProgress interface {
+	// CorrelationID returns the correlation ID of the import.
+	CorrelationID() string
+	// Status returns the status of the import.
+	Status() ExternalVMImportStatus
+	// Description returns the description of the import the engine reports, for example "Importing VM web1 from
+	// VMware to Cluster Default".
+	Description() string
+	// Percent returns the progress of the import between 0 and 100. The engine only reports progress for some steps
+	// of the import, such as the disk conversion, so the value may stay at 0 for some time.
+	Percent() uint
+}
+
+// OptionalExternalVMImportParameters contains the optional parameters for ImportExternalVM.
+type OptionalExternalVMImportParameters interface {
+	// VMName returns the name of the VM to create. If empty, the name of the source VM is used.
+	VMName() string
+	// Username returns the username to log in to the source hypervisor with.
+	Username() string
+	// Password returns the password to log in to the source hypervisor with.
+	Password() string
+	// HostID returns the host that should run the conversion. If nil, the engine selects a host of the cluster.
+	HostID() *HostID
+	// Sparse returns if the imported disks should be thin provisioned. If nil, the engine default is used.
+	Sparse() *bool
+	// DriversISO returns the name of the ISO file with the VirtIO drivers to install in Windows VMs during the
+	// conversion. The file must be present in the ISO domain or a data domain of the data center.
+	DriversISO() string
+}
+
+// BuildableExternalVMImportParameters is a buildable version of OptionalExternalVMImportParameters.
+type BuildableExternalVMImportParameters interface {
+	OptionalExternalVMImportParameters
+
+	// WithVMName sets the name of the VM to create.
+	WithVMName(name string) (BuildableExternalVMImportParameters, error)
+	// MustWithVMName is identical to WithVMName, but panics instead of returning an error.
+	MustWithVMName(name string) BuildableExternalVMImportParameters
+
+	// WithCredentials sets the username and password to log in to the source hypervisor with.
+	WithCredentials(username string, password string) (BuildableExternalVMImportParameters, error)
+	// MustWithCredentials is identical to WithCredentials, but panics instead of returning an error.
+	MustWithCredentials(username string, password string) BuildableExternalVMImportParameters
+
+	// WithHostID sets the host that should run the conversion.
+	WithHostID(hostID HostID) (BuildableExternalVMImportParameters, error)
+	// MustWithHostID is identical to WithHostID, but panics instead of returning an error.
+	MustWithHostID(hostID HostID) BuildableExternalVMImportParameters
+
+	// WithSparse sets if the imported disks should be thin provisioned.
+	WithSparse(sparse bool) (BuildableExternalVMImportParameters, error)
+	// MustWithSparse is identical to WithSparse, but panics instead of returning an error.
+	MustWithSparse(sparse bool) BuildableExternalVMImportParameters
+
+	// WithDriversISO sets the name of the ISO file with the VirtIO drivers for Windows VMs.
+	WithDriversISO(fileName string) (BuildableExternalVMImportParameters, error)
+	// MustWithDriversISO is identical to WithDriversISO, but panics instead of returning an error.
+	MustWithDriversISO(fileName string) BuildableExternalVMImportParameters
+}
+
+// ExternalVMImportParams creates a new set of optional parameters for ImportExternalVM.
+func ExternalVMImportParams() BuildableExternalVMImportParameters {
+	return &externalVMImportParams{}
+}
+
+type externalVMImportParams struct {
+	vmName     string
+	username   string
+	password   string
+	hostID     *HostID
+	sparse     *bool
+	driversISO string
+}
+
+func (e *externalVMImportParams) VMName() string {
+	return e.vmName
+}
+
+func (e *externalVMImportParams) Username() string {
+	return e.username
+}
+
+func (e *externalVMImportParams) Password() string {
+	return e.password
+}
+
+func (e *externalVMImportParams) HostID() *HostID {
+	return e.hostID
+}
+
+func (e *externalVMImportParams) Sparse() *bool {
+	return e.sparse
+}
+
+func (e *externalVMImportParams) DriversISO() string {
+	return e.driversISO
+}
+
+func (e *externalVMImportParams) WithVMName(name string) (BuildableExternalVMImportParameters, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, newError(EBadArgument, "the VM name must not be empty")
+	}
+	e.vmName = name
+	return e, nil
+}
+
+func (e *externalVMImportParams) MustWithVMName(name string) BuildableExternalVMImportParameters {
+	builder, err := e.WithVMName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *externalVMImportParams) WithCredentials(
+	username string,
+	password string,
+) (BuildableExternalVMImportParameters, error) {
+	if username == "" {
+		return nil, newError(EBadArgument, "the username must not be empty")
+	}
+	e.username = username
+	e.password = password
+	return e, nil
+}
+
+func (e *externalVMImportParams) MustWithCredentials(
+	username string,
+	password string,
+) BuildableExternalVMImportParameters {
+	builder, err := e.WithCredentials(username, password)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *externalVMImportParams) WithHostID(hostID HostID) (BuildableExternalVMImportParameters, error) {
+	if hostID == "" {
+		return nil, newError(EBadArgument, "the host ID must not be empty")
+	}
+	e.hostID = &hostID
+	return e, nil
+}
+
+func (e *externalVMImportParams) MustWithHostID(hostID HostID) BuildableExternalVMImportParameters {
+	builder, err := e.WithHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *externalVMImportParams) WithSparse(sparse bool) (BuildableExternalVMImportParameters, error) {
+	e.sparse = &sparse
+	return e, nil
+}
+
+func (e *externalVMImportParams) MustWithSparse(sparse bool) BuildableExternalVMImportParameters {
+	builder, err := e.WithSparse(sparse)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *externalVMImportParams) WithDriversISO(fileName string) (BuildableExternalVMImportParameters, error) {
+	if fileName == "" {
+		return nil, newError(EBadArgument, "the drivers ISO file name must not be empty")
+	}
+	e.driversISO = fileName
+	return e, nil
+}
+
+func (e *externalVMImportParams) MustWithDriversISO(fileName string) BuildableExternalVMImportParameters {
+	builder, err := e.WithDriversISO(fileName)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateExternalVMImport checks the parameters of ImportExternalVM and returns the name of the VM to create.
+func validateExternalVMImport(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	params OptionalExternalVMImportParameters,
+) (string, error) {
+	if err := provider.Validate(); err != nil {
+		return "", err
+	}
+	if url == "" {
+		return "", newError(EBadArgument, "the URL of the source hypervisor must not be empty")
+	}
+	if sourceVMName == "" {
+		return "", newError(EBadArgument, "the name of the source VM must not be empty")
+	}
+	if provider == ExternalVMProviderTypeVMware && params.Username() == "" {
+		return "", newError(EBadArgument, "a username and password are required for importing from VMware")
+	}
+	if name := params.VMName(); name != "" {
+		return name, nil
+	}
+	return sourceVMName, nil
+}
+
+type externalVMImport struct {
+	client Client
+
+	correlationID string
+	vmName        string
+}
+
+func (e *externalVMImport) CorrelationID() string {
+	return e.correlationID
+}
+
+func (e *externalVMImport) VMName() string {
+	return e.vmName
+}
+
+func (e *externalVMImport) Progress(retries ...RetryStrategy) (ExternalVMImportProgress, error) {
+	return e.client.GetExternalVMImportProgress(e.correlationID, retries...)
+}
+
+func (e *externalVMImport) Wait(retries ...RetryStrategy) (VM, error) {
+	if _, err := e.client.WaitForExternalVMImport(e.correlationID, retries...); err != nil {
+		return nil, err
+	}
+	return e.client.GetVMByName(e.vmName, retries...)
+}
+
+type externalVMImportProgress struct {
+	correlationID string
+	status        ExternalVMImportStatus
+	description   string
+	percent       uint
+}
+
+func (e *externalVMImportProgress) CorrelationID() string {
+	return e.correlationID
+}
+
+func (e *externalVMImportProgress) Status() ExternalVMImportStatus {
+	return e.status
+}
+
+func (e *externalVMImportProgress) Description() string {
+	return e.description
+}
+
+func (e *externalVMImportProgress) Percent() uint {
+	return e.percent
+}
@@ -0,0 +1,151 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) GetExternalVMImportProgress(
+	correlationID string,
+	retries ...RetryStrategy,
+) (result ExternalVMImportProgress, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting progress of external VM import %s", correlationID),
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				JobsService().
+				List().
+				Search(fmt.Sprintf("correlation_id=%s", correlationID)).
+				Follow("steps").
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			jobs, ok := response.Jobs()
+			if !ok || len(jobs.Slice()) == 0 {
+				return newError(ENotFound, "no job found for external VM import %s", correlationID)
+			}
+			result, err = convertSDKExternalVMImportJob(correlationID, jobs.Slice()[0])
+			return err
+		})
+	return result, err
+}
+
+func (m *mockClient) GetExternalVMImportProgress(
+	correlationID string,
+	_ ...RetryStrategy,
+) (ExternalVMImportProgress, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	progress, ok := m.externalVMImports[correlationID]
+	if !ok {
+		return nil, newError(ENotFound, "no job found for external VM import %s", correlationID)
+	}
+	result := *progress
+	return &result, nil
+}
+
+func (o *oVirtClient) WaitForExternalVMImport(
+	correlationID string,
+	retries ...RetryStrategy,
+) (ExternalVMImportProgress, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	return waitForExternalVMImport(o, o.logger, correlationID, retries)
+}
+
+func (m *mockClient) WaitForExternalVMImport(
+	correlationID string,
+	retries ...RetryStrategy,
+) (ExternalVMImportProgress, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	return waitForExternalVMImport(m, m.logger, correlationID, retries)
+}
+
+// waitForExternalVMImport polls the progress of an import until it finished. A missing job is treated as pending,
+// since the engine may create it with a delay after the import has been started.
+func waitForExternalVMImport(
+	client Client,
+	logger Logger,
+	correlationID string,
+	retries []RetryStrategy,
+) (result ExternalVMImportProgress, err error) {
+	err = retry(
+		fmt.Sprintf("waiting for external VM import %s to finish", correlationID),
+		reading("", ""),
+		logger,
+		retries,
+		func() error {
+			result, err = client.GetExternalVMImportProgress(correlationID, retries...)
+			if err != nil {
+				if HasErrorCode(err, ENotFound) {
+					return wrap(err, EPending, "the job of external VM import %s does not exist yet", correlationID)
+				}
+				return err
+			}
+			switch result.Status() {
+			case ExternalVMImportStatusFinished:
+				return nil
+			case ExternalVMImportStatusFailed, ExternalVMImportStatusAborted, ExternalVMImportStatusUnknown:
+				return newError(
+					EImportFailed,
+					"external VM import %s is in status %s (%s)",
+					correlationID,
+					result.Status(),
+					result.Description(),
+				)
+			default:
+				return newError(
+					EPending,
+					"external VM import %s is in status %s at %d%%",
+					correlationID,
+					result.Status(),
+					result.Percent(),
+				)
+			}
+		})
+	return result, err
+}
+
+// convertSDKExternalVMImportJob converts the engine job of an import. The progress is the average of the steps that
+// report one, or 100 once the job finished.
+func convertSDKExternalVMImportJob(correlationID string, job *ovirtsdk.Job) (ExternalVMImportProgress, error) {
+	status, ok := job.Status()
+	if !ok {
+		return nil, newFieldNotFound("job", "status")
+	}
+	result := &externalVMImportProgress{
+		correlationID: correlationID,
+		status:        ExternalVMImportStatus(status),
+	}
+	if err := result.status.Validate(); err != nil {
+		return nil, wrap(err, EBug, "unexpected status for job of external VM import %s", correlationID)
+	}
+	if description, ok := job.Description(); ok {
+		result.description = description
+	}
+	if result.status == ExternalVMImportStatusFinished {
+		result.percent = 100
+		return result, nil
+	}
+	if steps, ok := job.Steps(); ok {
+		var total int64
+		var count int64
+		for _, step := range steps.Slice() {
+			if progress, ok := step.Progress(); ok {
+				total += progress
+				count++
+			}
+		}
+		if count > 0 {
+			result.percent = uint(total / count)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,185 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	neturl "net/url"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// mockExternalVMImportStepDelay is the time the mock takes for each quarter of an external VM import.
+const mockExternalVMImportStepDelay = 500 * time.Millisecond
+
+func (o *oVirtClient) ImportExternalVM(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID StorageDomainID,
+	params OptionalExternalVMImportParameters,
+	retries ...RetryStrategy,
+) (result ExternalVMImport, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = ExternalVMImportParams()
+	}
+	vmName, err := validateExternalVMImport(provider, url, sourceVMName, params)
+	if err != nil {
+		return nil, err
+	}
+	correlationID := findCorrelationID(retries)
+	if correlationID == "" {
+		correlationID = generateCorrelationID("vm_import_")
+	}
+
+	importBuilder := ovirtsdk.NewExternalVmImportBuilder().
+		Provider(ovirtsdk.ExternalVmProviderType(provider)).
+		Url(url).
+		Name(sourceVMName).
+		Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()).
+		StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild()).
+		Vm(ovirtsdk.NewVmBuilder().Name(vmName).MustBuild())
+	if username := params.Username(); username != "" {
+		importBuilder.Username(username)
+		importBuilder.Password(params.Password())
+	}
+	if hostID := params.HostID(); hostID != nil {
+		importBuilder.Host(ovirtsdk.NewHostBuilder().Id(string(*hostID)).MustBuild())
+	}
+	if sparse := params.Sparse(); sparse != nil {
+		importBuilder.Sparse(*sparse)
+	}
+	if driversISO := params.DriversISO(); driversISO != "" {
+		importBuilder.DriversIso(ovirtsdk.NewFileBuilder().Id(driversISO).MustBuild())
+	}
+	sdkImport, err := importBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build external VM import")
+	}
+
+	err = retry(
+		fmt.Sprintf("importing %s VM %s from %s as %s", provider, sourceVMName, redactExternalVMURL(url), vmName),
+		mutating(ResourceTypeVM, "").
+			withParameters(map[string]string{
+				"provider":          string(provider),
+				"url":               redactExternalVMURL(url),
+				"source_vm_name":    sourceVMName,
+				"cluster_id":        string(clusterID),
+				"storage_domain_id": string(storageDomainID),
+				"vm_name":           vmName,
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				ExternalVmImportsService().
+				Add().
+				Import(sdkImport).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &externalVMImport{
+		client:        o,
+		correlationID: correlationID,
+		vmName:        vmName,
+	}, nil
+}
+
+func (m *mockClient) ImportExternalVM(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID StorageDomainID,
+	params OptionalExternalVMImportParameters,
+	retries ...RetryStrategy,
+) (ExternalVMImport, error) {
+	if params == nil {
+		params = ExternalVMImportParams()
+	}
+	vmName, err := validateExternalVMImport(provider, url, sourceVMName, params)
+	if err != nil {
+		return nil, err
+	}
+	correlationID := findCorrelationID(retries)
+	if correlationID == "" {
+		correlationID = generateCorrelationID("vm_import_")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	if _, ok := m.storageDomains[storageDomainID]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if hostID := params.HostID(); hostID != nil {
+		if _, ok := m.hosts[*hostID]; !ok {
+			return nil, newError(ENotFound, "host with ID %s not found", *hostID)
+		}
+	}
+	for _, vm := range m.vms {
+		if vm.name == vmName {
+			return nil, newError(ENameInUse, "A VM with the name \"%s\" already exists.", vmName)
+		}
+	}
+	if _, ok := m.externalVMImports[correlationID]; ok {
+		return nil, newError(EConflict, "an import with the correlation ID %s is already running", correlationID)
+	}
+
+	vmParams := CreateVMParams()
+	cpu := m.createVMCPU(vmParams, m.templates[DefaultBlankTemplateID])
+	vm := m.createVM(vmName, vmParams, clusterID, DefaultBlankTemplateID, cpu)
+	vm.status = VMStatusImageLocked
+	m.vmIPs[vm.id] = map[string][]net.IP{}
+	m.addGraphicsConsoles(vm)
+	progress := &externalVMImportProgress{
+		correlationID: correlationID,
+		status:        ExternalVMImportStatusStarted,
+		description:   fmt.Sprintf("Importing VM %s from %s", sourceVMName, provider),
+	}
+	m.externalVMImports[correlationID] = progress
+	m.mutationListeners.notify(ResourceTypeVM, string(vm.id), "", MutationTypeCreated)
+
+	go m.runExternalVMImport(vm, progress)
+	return &externalVMImport{
+		client:        m,
+		correlationID: correlationID,
+		vmName:        vmName,
+	}, nil
+}
+
+// runExternalVMImport advances the progress of an import in four steps, then unlocks the VM like the engine does once
+// virt-v2v finished the conversion.
+func (m *mockClient) runExternalVMImport(vm *vm, progress *externalVMImportProgress) {
+	for step := uint(1); step <= 4; step++ {
+		time.Sleep(mockExternalVMImportStepDelay)
+		m.lock.Lock()
+		progress.percent = step * 25
+		m.lock.Unlock()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	progress.status = ExternalVMImportStatusFinished
+	if vm.status == VMStatusImageLocked {
+		vm.status = VMStatusDown
+	}
+}
+
+// redactExternalVMURL removes the password from the URL of a source hypervisor, so it does not end up in the logs. A
+// URL that cannot be parsed is replaced entirely, since the password cannot be located in it.
+func redactExternalVMURL(rawURL string) string {
+	parsed, err := neturl.Parse(rawURL)
+	if err != nil {
+		return redactedValue
+	}
+	return redactURL(parsed)
+}
@@ -0,0 +1,78 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestExternalVMImport(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Importing a VM requires an external hypervisor, only running this test against the mock.")
+	}
+	name := fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5))
+
+	vmImport, err := client.ImportExternalVM(
+		ovirtclient.ExternalVMProviderTypeKVM,
+		"qemu+ssh://root@kvm1.example.com/system",
+		"web1",
+		helper.GetClusterID(),
+		helper.GetStorageDomainID(),
+		ovirtclient.ExternalVMImportParams().MustWithVMName(name),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start external VM import (%v)", err)
+	}
+	progress, err := vmImport.Progress()
+	if err != nil {
+		t.Fatalf("Failed to get external VM import progress (%v)", err)
+	}
+	if progress.Status() != ovirtclient.ExternalVMImportStatusStarted {
+		t.Fatalf(
+			"Incorrect import status (expected: %s, got: %s)",
+			ovirtclient.ExternalVMImportStatusStarted,
+			progress.Status(),
+		)
+	}
+
+	vm, err := vmImport.Wait()
+	if err != nil {
+		t.Fatalf("Failed to wait for external VM import (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove imported VM %s (%v)", vm.ID(), err)
+		}
+	})
+	if vm.Name() != name {
+		t.Fatalf("Incorrect name of imported VM (expected: %s, got: %s)", name, vm.Name())
+	}
+	if vm.Status() != ovirtclient.VMStatusDown {
+		t.Fatalf("Incorrect status of imported VM (expected: %s, got: %s)", ovirtclient.VMStatusDown, vm.Status())
+	}
+	progress, err = vmImport.Progress()
+	if err != nil {
+		t.Fatalf("Failed to get external VM import progress (%v)", err)
+	}
+	if progress.Percent() != 100 {
+		t.Fatalf("Incorrect progress of finished import (%d%%)", progress.Percent())
+	}
+}
+
+func TestExternalVMImportFromVMwareRequiresCredentials(t *testing.T) {
+	helper := getHelper(t)
+	_, err := helper.GetClient().ImportExternalVM(
+		ovirtclient.ExternalVMProviderTypeVMware,
+		"vpx://vcenter.example.com/Datacenter/Cluster/esxi1.example.com?no_verify=1",
+		"web1",
+		helper.GetClusterID(),
+		helper.GetStorageDomainID(),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Importing from VMware without credentials did not fail with EBadArgument (%v)", err)
+	}
+}
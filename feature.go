@@ -1,9 +1,5 @@
 package ovirtclient
 
-import (
-	ovirtsdk "github.com/ovirt/go-ovirt"
-)
-
 // Feature is a specialized type for feature flags. These can be checked for support by using SupportsFeature in
 // FeatureClient.
 type Feature string
@@ -14,67 +10,52 @@ const (
 
 	// FeaturePlacementPolicy is a feature flag to indicate placement policy support in the oVirt Engine.
 	FeaturePlacementPolicy Feature = "placement_policy"
+
+	// FeatureIncrementalBackup is a feature flag for incremental VM backups using checkpoints, supported since 4.4.0.
+	FeatureIncrementalBackup Feature = "incremental_backup"
+
+	// FeatureQ35 is a feature flag for the Q35 chipset BIOS types, supported since 4.4.0.
+	FeatureQ35 Feature = "q35"
 )
 
+// featureMinimumVersions contains the first engine version that supports each feature.
+var featureMinimumVersions = map[Feature]EngineVersion{
+	FeatureAutoPinning:       NewEngineVersion(4, 4, 5, 0),
+	FeaturePlacementPolicy:   NewEngineVersion(4, 4, 5, 0),
+	FeatureIncrementalBackup: NewEngineVersion(4, 4, 0, 0),
+	FeatureQ35:               NewEngineVersion(4, 4, 0, 0),
+}
+
 // FeatureClient provides the functions to determine the capabilities of the oVirt Engine.
 type FeatureClient interface {
 	// SupportsFeature checks the features supported by the oVirt Engine.
 	SupportsFeature(feature Feature, retries ...RetryStrategy) (bool, error)
+	// GetEngineVersion returns the version of the oVirt Engine. This can be used to gate functionality that is not
+	// covered by a Feature flag.
+	GetEngineVersion(retries ...RetryStrategy) (EngineVersion, error)
 }
 
-func (o *oVirtClient) SupportsFeature(feature Feature, retries ...RetryStrategy) (result bool, err error) {
-	var minimumVersion *ovirtsdk.Version
-	switch feature {
-	case FeatureAutoPinning:
-		minimumVersion = ovirtsdk.NewVersionBuilder().
-			Major(4).
-			Minor(4).
-			Build_(5).
-			Revision(0).
-			MustBuild()
-	case FeaturePlacementPolicy:
-		minimumVersion = ovirtsdk.NewVersionBuilder().
-			Major(4).
-			Minor(4).
-			Build_(5).
-			Revision(0).
-			MustBuild()
-	default:
-		return false, newError(EBug, "unknown feature: %s", feature)
-	}
+func (o *oVirtClient) SupportsFeature(feature Feature, retries ...RetryStrategy) (bool, error) {
+	return supportsFeature(o, feature, retries)
+}
 
-	retries = defaultRetries(retries, defaultReadTimeouts(o))
-	err = retry(
-		"fetching engine version",
-		o.logger,
-		retries,
-		func() error {
-			systemGetResponse, err := o.conn.SystemService().Get().Send()
-			if err != nil {
-				return err
-			}
-			engineVer := systemGetResponse.MustApi().MustProductInfo().MustVersion()
-			versionCompareResult := versionCompare(engineVer, minimumVersion)
-			result = versionCompareResult >= 0
-			return nil
-		})
-	return result, err
+func (m *mockClient) SupportsFeature(feature Feature, retries ...RetryStrategy) (bool, error) {
+	return supportsFeature(m, feature, retries)
 }
 
-func versionCompare(v *ovirtsdk.Version, other *ovirtsdk.Version) int64 {
-	if result := v.MustMajor() - other.MustMajor(); result != 0 {
-		return result
-	}
-	if result := v.MustMinor() - other.MustMinor(); result != 0 {
-		return result
+func supportsFeature(client Client, feature Feature, retries []RetryStrategy) (bool, error) {
+	minimumVersion, ok := featureMinimumVersions[feature]
+	if !ok {
+		return false, newError(EBadArgument, "unknown feature: %s", feature)
 	}
-
-	if result := v.MustBuild() - other.MustBuild(); result != 0 {
-		return result
+	engineVersion, err := client.GetEngineVersion(retries...)
+	if err != nil {
+		return false, err
 	}
-	return v.MustRevision() - other.MustRevision()
-}
-
-func (m *mockClient) SupportsFeature(_ Feature, _ ...RetryStrategy) (bool, error) {
-	return true, nil
+	return engineVersion.AtLeastBuild(
+		minimumVersion.Major(),
+		minimumVersion.Minor(),
+		minimumVersion.Build(),
+		minimumVersion.Revision(),
+	), nil
 }
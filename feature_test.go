@@ -17,7 +17,13 @@ func TestFeatureFlags(t *testing.T) {
 			input: ovirtclient.FeatureAutoPinning,
 		},
 		"Feature Placement Policy": {
-			input: ovirtclient.FeatureAutoPinning,
+			input: ovirtclient.FeaturePlacementPolicy,
+		},
+		"Feature Incremental Backup": {
+			input: ovirtclient.FeatureIncrementalBackup,
+		},
+		"Feature Q35": {
+			input: ovirtclient.FeatureQ35,
 		},
 	}
 
@@ -35,3 +41,32 @@ func TestFeatureFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEngineVersion(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	engineVersion, err := helper.GetClient().GetEngineVersion()
+	if err != nil {
+		t.Fatalf("Failed to fetch engine version (%v)", err)
+	}
+	if !engineVersion.AtLeast(4, 0) {
+		t.Fatalf("Implausible engine version: %s", engineVersion)
+	}
+	if !engineVersion.AtLeastBuild(engineVersion.Major(), engineVersion.Minor(), engineVersion.Build(), 0) {
+		t.Fatalf("Engine version %s is not at least its own build.", engineVersion)
+	}
+	if engineVersion.AtLeastBuild(engineVersion.Major(), engineVersion.Minor(), engineVersion.Build()+1, 0) {
+		t.Fatalf("Engine version %s is at least a newer build.", engineVersion)
+	}
+}
+
+func TestUnknownFeature(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().SupportsFeature("nonexistent")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Checking an unknown feature did not result in an EBadArgument error (%v)", err)
+	}
+}
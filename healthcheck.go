@@ -0,0 +1,102 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net/http"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// minimumEngineVersion is the oldest oVirt Engine version Healthcheck accepts.
+var minimumEngineVersion = NewVersion(4, 4)
+
+func (o *oVirtClient) Healthcheck(retries ...RetryStrategy) (result EngineVersion, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	if err := o.checkEngineReachable(retries); err != nil {
+		return nil, err
+	}
+	err = retry(
+		"checking oVirt Engine credentials and API version",
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().Get().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to fetch the oVirt Engine API information")
+			}
+			result, err = o.parseEngineVersion(response)
+			if err != nil {
+				return err
+			}
+			return checkEngineVersion(result)
+		},
+	)
+	return result, err
+}
+
+// parseEngineVersion extracts the engine version from the API information. It is shared with GetEngineVersion.
+func (o *oVirtClient) parseEngineVersion(response *ovirtsdk.SystemServiceGetResponse) (EngineVersion, error) {
+	api, ok := response.Api()
+	if !ok {
+		return nil, newError(ENotAnOVirtEngine, "the response from %s does not contain the API information", o.url)
+	}
+	productInfo, ok := api.ProductInfo()
+	if !ok {
+		return nil, newError(ENotAnOVirtEngine, "the response from %s does not contain the product information", o.url)
+	}
+	sdkVersion, ok := productInfo.Version()
+	if !ok {
+		return nil, newFieldNotFound("product info", "version")
+	}
+	return convertSDKEngineVersion(sdkVersion)
+}
+
+// checkEngineReachable sends an unauthenticated request to the engine URL to separate network and TLS problems from
+// authentication failures.
+func (o *oVirtClient) checkEngineReachable(retries []RetryStrategy) error {
+	return retry(
+		fmt.Sprintf("checking if %s is reachable", o.url),
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			req, err := http.NewRequest(http.MethodGet, o.url, nil)
+			if err != nil {
+				return wrap(err, EBadArgument, "failed to create HTTP request to %s", o.url)
+			}
+			if o.ctx != nil {
+				req = req.WithContext(o.ctx)
+			}
+			response, err := o.httpClient.Do(req)
+			if err != nil {
+				identifiedErr := wrap(err, EUnidentified, "failed to reach %s", o.url)
+				if identifiedErr.HasCode(EUnidentified) {
+					return wrap(err, EConnection, "failed to reach %s", o.url)
+				}
+				return identifiedErr
+			}
+			_ = response.Body.Close()
+			if response.StatusCode == http.StatusNotFound {
+				return newError(ENotAnOVirtEngine, "no oVirt Engine API found at %s, check if your URL is correct", o.url)
+			}
+			return nil
+		},
+	)
+}
+
+func checkEngineVersion(engineVersion Version) error {
+	if !engineVersion.AtLeast(minimumEngineVersion.Major(), minimumEngineVersion.Minor()) {
+		return newError(
+			EUnsupported,
+			"oVirt Engine version %s is not supported, at least version %s is required",
+			engineVersion,
+			minimumEngineVersion,
+		)
+	}
+	return nil
+}
+
+func (m *mockClient) Healthcheck(_ ...RetryStrategy) (EngineVersion, error) {
+	return mockEngineVersion, nil
+}
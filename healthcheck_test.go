@@ -0,0 +1,94 @@
+package ovirtclient_test
+
+import (
+	"crypto/x509"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHealthcheck(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	engineVersion, err := client.Healthcheck()
+	if err != nil {
+		t.Fatalf("Healthcheck failed on a working connection (%v)", err)
+	}
+	if !engineVersion.AtLeast(4, 4) {
+		t.Fatalf("Healthcheck returned an unsupported engine version: %s", engineVersion)
+	}
+}
+
+func TestHealthcheckStages(t *testing.T) {
+	t.Parallel()
+
+	unauthorized := http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusUnauthorized)
+	})
+	notFound := http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	})
+	stopped := httptest.NewServer(unauthorized)
+	stopped.Close()
+	untrusted := httptest.NewUnstartedServer(unauthorized)
+	// The rejected handshake is expected, don't log it.
+	untrusted.Config.ErrorLog = log.New(io.Discard, "", 0)
+	untrusted.StartTLS()
+
+	testCases := map[string]struct {
+		server   *httptest.Server
+		tls      ovirtclient.TLSProvider
+		expected ovirtclient.ErrorCode
+	}{
+		"unreachable": {
+			stopped,
+			ovirtclient.TLS().Insecure(),
+			ovirtclient.EConnection,
+		},
+		"tls": {
+			untrusted,
+			ovirtclient.TLS().CACertsFromCertPool(x509.NewCertPool()),
+			ovirtclient.ETLSError,
+		},
+		"not an engine": {
+			httptest.NewServer(notFound),
+			ovirtclient.TLS().Insecure(),
+			ovirtclient.ENotAnOVirtEngine,
+		},
+		"credentials": {
+			httptest.NewServer(unauthorized),
+			ovirtclient.TLS().Insecure(),
+			ovirtclient.EAccessDenied,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			t.Cleanup(testCase.server.Close)
+
+			client, err := ovirtclient.NewWithVerify(
+				testCase.server.URL+"/ovirt-engine/api",
+				"admin@internal",
+				"asdf",
+				testCase.tls,
+				ovirtclientlog.NewTestLogger(t),
+				nil,
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("failed to create client (%v)", err)
+			}
+			_, err = client.Healthcheck(ovirtclient.MaxTries(1))
+			if !ovirtclient.HasErrorCode(err, testCase.expected) {
+				t.Fatalf("Healthcheck did not return an error with the %s code (%v)", testCase.expected, err)
+			}
+		})
+	}
+}
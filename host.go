@@ -12,17 +12,139 @@ type HostID string
 // HostClient contains the API portion that deals with hosts.
 type HostClient interface {
 	ListHosts(retries ...RetryStrategy) ([]Host, error)
+	// ListHostsInCluster lists the hosts of a cluster. The filtering is done by the engine, so it is faster than
+	// ListHosts in large environments.
+	ListHostsInCluster(clusterID ClusterID, retries ...RetryStrategy) ([]Host, error)
 	GetHost(id HostID, retries ...RetryStrategy) (Host, error)
+	// GetHosts fetches multiple hosts by their IDs, running at most concurrency requests at the same time. See GetVMs
+	// for details.
+	GetHosts(ids []HostID, concurrency uint, retries ...RetryStrategy) ([]Host, error)
+	// WatchHost reports the changes of a host, such as status changes during maintenance, on the returned channel.
+	// See WatchVM for details. Use WatchParams to create the parameters, or pass nil for the defaults.
+	WatchHost(id HostID, params WatchParameters, retries ...RetryStrategy) (
+		events <-chan HostWatchEvent,
+		stop func(),
+		err error,
+	)
+	// GetHostByName returns a host by its name. An ENotFound error is returned if no host has the name, and an
+	// EMultipleResults error if more than one does.
+	GetHostByName(name string, retries ...RetryStrategy) (Host, error)
+	// AddHost adds a new host to a cluster. The engine connects to the host via SSH using the specified
+	// authentication and installs the necessary packages. The returned host will typically be in the
+	// HostStatusInstalling status, use WaitForHostUp to wait for the installation to finish.
+	AddHost(
+		clusterID ClusterID,
+		name string,
+		address string,
+		authentication HostAuthentication,
+		params OptionalAddHostParameters,
+		retries ...RetryStrategy,
+	) (Host, error)
+	// WaitForHostUp waits for a host to reach the HostStatusUp status. It returns an error with the code
+	// EUnexpectedHostStatus if the host ends up in a status it will not leave without intervention, such as
+	// HostStatusInstallFailed.
+	WaitForHostUp(id HostID, retries ...RetryStrategy) (Host, error)
+	// ListHostedEngineHosts lists the hosts deployed as Hosted Engine hosts, including the HA score and maintenance
+	// state of their Hosted Engine agents. The hosts returned by the other calls do not contain these details.
+	ListHostedEngineHosts(retries ...RetryStrategy) ([]Host, error)
+	// ListHostNUMANodes lists the NUMA nodes of a host with their memory and CPU count.
+	ListHostNUMANodes(id HostID, retries ...RetryStrategy) ([]HostNUMANode, error)
+	// DiscoverISCSITargets lists the IQNs of the targets the iSCSI portal offers to the host. Pass DefaultISCSIPort
+	// as the port unless the portal listens on a different one.
+	DiscoverISCSITargets(
+		hostID HostID,
+		address string,
+		port uint,
+		params OptionalISCSIParameters,
+		retries ...RetryStrategy,
+	) ([]string, error)
+	// LoginISCSITarget logs the host in to an iSCSI target, so its LUNs become visible to the host and can be used
+	// for block storage domains, see ListHostLogicalUnits.
+	LoginISCSITarget(
+		hostID HostID,
+		address string,
+		port uint,
+		target string,
+		params OptionalISCSIParameters,
+		retries ...RetryStrategy,
+	) error
+	// ListHostLogicalUnits lists the iSCSI and FC LUNs visible to the host.
+	ListHostLogicalUnits(hostID HostID, retries ...RetryStrategy) ([]HostLogicalUnit, error)
+	// GetHostSSHKey returns the SSH host key the engine recorded for the host when it was added. It can be
+	// compared against the key offered by the host before trusting it, for example when connecting to the host
+	// with an SSH client.
+	GetHostSSHKey(id HostID, retries ...RetryStrategy) (HostSSHKey, error)
+}
+
+// HostSSHKey is the SSH host key of a host as recorded by the engine.
+type HostSSHKey interface {
+	// PublicKey returns the public key in the OpenSSH authorized_keys format, for example "ssh-ed25519 AAAA...". It
+	// is empty if the engine only recorded the fingerprint.
+	PublicKey() string
+	// Fingerprint returns the SHA-256 fingerprint of the key as printed by ssh-keygen -l, for example
+	// "SHA256:...".
+	Fingerprint() string
+	// Port returns the SSH port the engine connects to the host on.
+	Port() uint16
 }
 
 // HostData is the core of Host, providing only data access functions.
 type HostData interface {
 	// ID returns the identifier of the host in question.
 	ID() HostID
+	// Name returns the name of the host as it appears in the engine.
+	Name() string
+	// Address returns the address (FQDN or IP) the engine uses to reach the host.
+	Address() string
 	// ClusterID returns the ID of the cluster this host belongs to.
 	ClusterID() ClusterID
 	// Status returns the status of this host.
 	Status() HostStatus
+	// PowerManagementEnabled returns true if the engine can fence this host using its fence agents.
+	PowerManagementEnabled() bool
+	// UpdateAvailable returns true if the last upgrade check found package updates for the host.
+	UpdateAvailable() bool
+	// UpgradeStatus returns the upgrade status of the host derived from its status and available updates.
+	UpgradeStatus() HostUpgradeStatus
+	// ReinstallationRequired returns true if the engine reports that the host has to be reinstalled to pick up
+	// changed settings, for example after changing the firewall type of the cluster.
+	ReinstallationRequired() bool
+	// HostedEngine returns the state of the Hosted Engine agent on the host. It is nil if the host is not a Hosted
+	// Engine host or if the engine did not return the details, see ListHostedEngineHosts.
+	HostedEngine() HostedEngineStatus
+	// Memory returns the physical memory of the host in bytes.
+	Memory() uint64
+	// MaxSchedulingMemory returns the memory in bytes the engine can still assign to new VMs on the host. Unlike
+	// Memory, it is calculated by the engine from the VMs running on the host and the memory overcommit settings of
+	// the cluster.
+	MaxSchedulingMemory() uint64
+	// CPUTopology returns the physical CPU layout of the host. All values are 0 if the engine does not report it.
+	CPUTopology() HostCPUTopology
+	// NUMASupported returns true if the engine reports NUMA support on the host, see ListHostNUMANodes.
+	NUMASupported() bool
+}
+
+// HostCPUTopology describes the physical CPU layout of a host.
+type HostCPUTopology interface {
+	// Sockets returns the number of CPU sockets.
+	Sockets() uint
+	// Cores returns the number of cores per socket.
+	Cores() uint
+	// Threads returns the number of threads per core.
+	Threads() uint
+	// LogicalCPUs returns the total number of threads the host can run VM CPUs on.
+	LogicalCPUs() uint
+}
+
+// HostNUMANode is a NUMA node of a host. VMs that fit a single node avoid the penalty of accessing memory attached
+// to another node.
+type HostNUMANode interface {
+	// Index returns the index of the NUMA node on the host.
+	Index() uint
+	// Memory returns the memory attached to the NUMA node in bytes.
+	Memory() uint64
+	// CPUs returns the number of logical CPUs in the NUMA node.
+	CPUs() uint
 }
 
 // Host is the representation of a host returned from the oVirt Engine API. Hosts, also known as hypervisors, are the
@@ -32,6 +154,30 @@ type HostData interface {
 // See https://www.ovirt.org/documentation/administration_guide/#chap-Hosts for details.
 type Host interface {
 	HostData
+
+	// ListErrata lists the errata available for this host. See ErrataClient.ListHostErrata for details.
+	ListErrata(retries ...RetryStrategy) ([]Erratum, error)
+	// ListFenceAgents lists the fence agents configured on this host.
+	ListFenceAgents(retries ...RetryStrategy) ([]HostFenceAgent, error)
+	// Fence issues a fence action on this host. See FenceClient.FenceHost for details.
+	Fence(fenceType FenceType, retries ...RetryStrategy) (PowerManagementStatus, error)
+	// CheckUpgrade asks the engine to check for updates. See HostUpgradeClient.CheckHostUpgrade for details.
+	CheckUpgrade(retries ...RetryStrategy) error
+	// Upgrade upgrades the host. See HostUpgradeClient.UpgradeHost for details.
+	Upgrade(params OptionalHostUpgradeParameters, retries ...RetryStrategy) error
+	// WaitForUp waits for the host to reach the HostStatusUp status. See HostClient.WaitForHostUp for details.
+	WaitForUp(retries ...RetryStrategy) (Host, error)
+	// ListNUMANodes lists the NUMA nodes of the host. See HostClient.ListHostNUMANodes for details.
+	ListNUMANodes(retries ...RetryStrategy) ([]HostNUMANode, error)
+	// ListNICs lists the network interfaces of the host. See HostNetworkClient.ListHostNICs for details.
+	ListNICs(retries ...RetryStrategy) ([]HostNIC, error)
+	// ListNetworkAttachments lists the networks attached to the host. See
+	// HostNetworkClient.ListHostNetworkAttachments for details.
+	ListNetworkAttachments(retries ...RetryStrategy) ([]HostNetworkAttachment, error)
+	// SetupNetworks changes the networking of the host. See HostNetworkClient.SetupHostNetworks for details.
+	SetupNetworks(params HostNetworkSetupParameters, retries ...RetryStrategy) error
+	// SSHKey returns the SSH host key of the host. See HostClient.GetHostSSHKey for details.
+	SSHKey(retries ...RetryStrategy) (HostSSHKey, error)
 }
 
 // HostStatus represents the complex states an oVirt host can be in.
@@ -113,11 +259,138 @@ func (l HostStatusList) Strings() []string {
 	return result
 }
 
+// HostAuthenticationMethod is the way the engine authenticates to a host over SSH when installing it.
+type HostAuthenticationMethod string
+
+const (
+	// HostAuthenticationMethodPassword uses the root password of the host.
+	HostAuthenticationMethodPassword HostAuthenticationMethod = "password"
+	// HostAuthenticationMethodPublicKey uses the SSH key of the engine. The public key of the engine must be added to
+	// the authorized_keys file of the root user on the host beforehand.
+	HostAuthenticationMethodPublicKey HostAuthenticationMethod = "publickey"
+)
+
+// HostAuthentication describes how the engine logs in to a host to install it.
+type HostAuthentication interface {
+	// Method returns the SSH authentication method.
+	Method() HostAuthenticationMethod
+	// RootPassword returns the root password of the host. Only used with HostAuthenticationMethodPassword.
+	RootPassword() string
+}
+
+// HostRootPasswordAuthentication creates a HostAuthentication that logs in with the root password of the host.
+func HostRootPasswordAuthentication(rootPassword string) HostAuthentication {
+	return &hostAuthentication{
+		method:       HostAuthenticationMethodPassword,
+		rootPassword: rootPassword,
+	}
+}
+
+// HostPublicKeyAuthentication creates a HostAuthentication that logs in with the SSH key of the engine.
+func HostPublicKeyAuthentication() HostAuthentication {
+	return &hostAuthentication{
+		method: HostAuthenticationMethodPublicKey,
+	}
+}
+
+type hostAuthentication struct {
+	method       HostAuthenticationMethod
+	rootPassword string
+}
+
+func (h *hostAuthentication) Method() HostAuthenticationMethod {
+	return h.method
+}
+
+func (h *hostAuthentication) RootPassword() string {
+	return h.rootPassword
+}
+
+// OptionalAddHostParameters contains the optional parameters for adding a host.
+type OptionalAddHostParameters interface {
+	// SSHPort returns the SSH port of the host, or nil to use port 22.
+	SSHPort() *uint16
+	// DeployHostedEngine returns true if the host should also be deployed as a hosted engine host.
+	DeployHostedEngine() *bool
+	// Activate returns false if the host should be left in maintenance after the installation. Defaults to true.
+	Activate() *bool
+}
+
+// BuildableAddHostParameters is a buildable version of OptionalAddHostParameters.
+type BuildableAddHostParameters interface {
+	OptionalAddHostParameters
+
+	// WithSSHPort sets the SSH port of the host.
+	WithSSHPort(port uint16) (BuildableAddHostParameters, error)
+	// MustWithSSHPort is identical to WithSSHPort, but panics instead of returning an error.
+	MustWithSSHPort(port uint16) BuildableAddHostParameters
+
+	// WithDeployHostedEngine sets if the host should also be deployed as a hosted engine host.
+	WithDeployHostedEngine(deploy bool) BuildableAddHostParameters
+
+	// WithActivate sets if the host should be activated after the installation.
+	WithActivate(activate bool) BuildableAddHostParameters
+}
+
+// AddHostParams creates a buildable set of parameters for adding a host.
+func AddHostParams() BuildableAddHostParameters {
+	return &addHostParams{}
+}
+
+type addHostParams struct {
+	sshPort            *uint16
+	deployHostedEngine *bool
+	activate           *bool
+}
+
+func (a *addHostParams) SSHPort() *uint16 {
+	return a.sshPort
+}
+
+func (a *addHostParams) DeployHostedEngine() *bool {
+	return a.deployHostedEngine
+}
+
+func (a *addHostParams) Activate() *bool {
+	return a.activate
+}
+
+func (a *addHostParams) WithSSHPort(port uint16) (BuildableAddHostParameters, error) {
+	if port == 0 {
+		return nil, newError(EBadArgument, "the SSH port cannot be 0")
+	}
+	a.sshPort = &port
+	return a, nil
+}
+
+func (a *addHostParams) MustWithSSHPort(port uint16) BuildableAddHostParameters {
+	builder, err := a.WithSSHPort(port)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (a *addHostParams) WithDeployHostedEngine(deploy bool) BuildableAddHostParameters {
+	a.deployHostedEngine = &deploy
+	return a
+}
+
+func (a *addHostParams) WithActivate(activate bool) BuildableAddHostParameters {
+	a.activate = &activate
+	return a
+}
+
 func convertSDKHost(sdkHost *ovirtsdk4.Host, client Client) (Host, error) {
 	id, ok := sdkHost.Id()
 	if !ok {
 		return nil, newError(EFieldMissing, "returned host did not contain an ID")
 	}
+	name, ok := sdkHost.Name()
+	if !ok {
+		return nil, newError(EFieldMissing, "returned host %s did not contain a name", id)
+	}
+	address, _ := sdkHost.Address()
 	status, ok := sdkHost.Status()
 	if !ok {
 		return nil, newError(EFieldMissing, "returned host did not contain a status")
@@ -130,26 +403,138 @@ func convertSDKHost(sdkHost *ovirtsdk4.Host, client Client) (Host, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch cluster ID from host %s", id)
 	}
-	return &host{
-		client:    client,
-		id:        HostID(id),
-		status:    HostStatus(status),
-		clusterID: ClusterID(clusterID),
-	}, nil
+	updateAvailable, _ := sdkHost.UpdateAvailable()
+	reinstallationRequired, _ := sdkHost.ReinstallationRequired()
+	powerManagementEnabled := false
+	if powerManagement, ok := sdkHost.PowerManagement(); ok {
+		powerManagementEnabled, _ = powerManagement.Enabled()
+	}
+	result := &host{
+		client:                 client,
+		id:                     HostID(id),
+		name:                   name,
+		address:                address,
+		status:                 HostStatus(status),
+		clusterID:              ClusterID(clusterID),
+		powerManagementEnabled: powerManagementEnabled,
+		updateAvailable:        updateAvailable,
+		reinstallationRequired: reinstallationRequired,
+	}
+	if hostedEngine, ok := sdkHost.HostedEngine(); ok {
+		result.hostedEngine = convertSDKHostedEngine(hostedEngine)
+	}
+	if memory, ok := sdkHost.Memory(); ok && memory > 0 {
+		result.memory = uint64(memory)
+	}
+	if maxSchedulingMemory, ok := sdkHost.MaxSchedulingMemory(); ok && maxSchedulingMemory > 0 {
+		result.maxSchedulingMemory = uint64(maxSchedulingMemory)
+	}
+	if cpu, ok := sdkHost.Cpu(); ok {
+		result.cpuTopology = convertSDKHostCPUTopology(cpu)
+	}
+	result.numaSupported, _ = sdkHost.NumaSupported()
+	return result, nil
+}
+
+// convertSDKHostCPUTopology converts the CPU topology of a host. Values missing from a reported topology are treated
+// as 1, otherwise a missing value would make the host appear to have no logical CPUs at all.
+func convertSDKHostCPUTopology(cpu *ovirtsdk4.Cpu) hostCPUTopology {
+	topology, ok := cpu.Topology()
+	if !ok {
+		return hostCPUTopology{}
+	}
+	result := hostCPUTopology{sockets: 1, cores: 1, threads: 1}
+	if sockets, ok := topology.Sockets(); ok && sockets > 0 {
+		result.sockets = uint(sockets)
+	}
+	if cores, ok := topology.Cores(); ok && cores > 0 {
+		result.cores = uint(cores)
+	}
+	if threads, ok := topology.Threads(); ok && threads > 0 {
+		result.threads = uint(threads)
+	}
+	return result
+}
+
+type hostCPUTopology struct {
+	sockets uint
+	cores   uint
+	threads uint
+}
+
+func (h hostCPUTopology) Sockets() uint {
+	return h.sockets
+}
+
+func (h hostCPUTopology) Cores() uint {
+	return h.cores
+}
+
+func (h hostCPUTopology) Threads() uint {
+	return h.threads
+}
+
+func (h hostCPUTopology) LogicalCPUs() uint {
+	return h.sockets * h.cores * h.threads
 }
 
 type host struct {
 	client Client
 
-	id        HostID
-	clusterID ClusterID
-	status    HostStatus
+	id                     HostID
+	name                   string
+	address                string
+	clusterID              ClusterID
+	status                 HostStatus
+	powerManagementEnabled bool
+	updateAvailable        bool
+	reinstallationRequired bool
+	hostedEngine           *hostedEngineStatus
+	memory                 uint64
+	maxSchedulingMemory    uint64
+	cpuTopology            hostCPUTopology
+	numaSupported          bool
+}
+
+func (h host) Memory() uint64 {
+	return h.memory
+}
+
+func (h host) MaxSchedulingMemory() uint64 {
+	return h.maxSchedulingMemory
+}
+
+func (h host) CPUTopology() HostCPUTopology {
+	return h.cpuTopology
+}
+
+func (h host) NUMASupported() bool {
+	return h.numaSupported
+}
+
+func (h host) ReinstallationRequired() bool {
+	return h.reinstallationRequired
+}
+
+func (h host) HostedEngine() HostedEngineStatus {
+	if h.hostedEngine == nil {
+		return nil
+	}
+	return h.hostedEngine
 }
 
 func (h host) ID() HostID {
 	return h.id
 }
 
+func (h host) Name() string {
+	return h.name
+}
+
+func (h host) Address() string {
+	return h.address
+}
+
 func (h host) ClusterID() ClusterID {
 	return h.clusterID
 }
@@ -157,3 +542,84 @@ func (h host) ClusterID() ClusterID {
 func (h host) Status() HostStatus {
 	return h.status
 }
+
+func (h host) PowerManagementEnabled() bool {
+	return h.powerManagementEnabled
+}
+
+func (h host) UpdateAvailable() bool {
+	return h.updateAvailable
+}
+
+func (h host) UpgradeStatus() HostUpgradeStatus {
+	return hostUpgradeStatus(h.status, h.updateAvailable)
+}
+
+func (h host) ListErrata(retries ...RetryStrategy) ([]Erratum, error) {
+	return h.client.ListHostErrata(h.id, retries...)
+}
+
+func (h host) ListFenceAgents(retries ...RetryStrategy) ([]HostFenceAgent, error) {
+	return h.client.ListHostFenceAgents(h.id, retries...)
+}
+
+func (h host) Fence(fenceType FenceType, retries ...RetryStrategy) (PowerManagementStatus, error) {
+	return h.client.FenceHost(h.id, fenceType, retries...)
+}
+
+func (h host) CheckUpgrade(retries ...RetryStrategy) error {
+	return h.client.CheckHostUpgrade(h.id, retries...)
+}
+
+func (h host) Upgrade(params OptionalHostUpgradeParameters, retries ...RetryStrategy) error {
+	return h.client.UpgradeHost(h.id, params, retries...)
+}
+
+func (h host) WaitForUp(retries ...RetryStrategy) (Host, error) {
+	return h.client.WaitForHostUp(h.id, retries...)
+}
+
+func (h host) ListNICs(retries ...RetryStrategy) ([]HostNIC, error) {
+	return h.client.ListHostNICs(h.id, retries...)
+}
+
+func (h host) ListNetworkAttachments(retries ...RetryStrategy) ([]HostNetworkAttachment, error) {
+	return h.client.ListHostNetworkAttachments(h.id, retries...)
+}
+
+func (h host) SetupNetworks(params HostNetworkSetupParameters, retries ...RetryStrategy) error {
+	return h.client.SetupHostNetworks(h.id, params, retries...)
+}
+
+func (h host) ListNUMANodes(retries ...RetryStrategy) ([]HostNUMANode, error) {
+	return h.client.ListHostNUMANodes(h.id, retries...)
+}
+
+func (h host) SSHKey(retries ...RetryStrategy) (HostSSHKey, error) {
+	return h.client.GetHostSSHKey(h.id, retries...)
+}
+
+func (h host) withStatus(status HostStatus) *host {
+	h.status = status
+	return &h
+}
+
+func (h host) withPowerManagementEnabled(enabled bool) *host {
+	h.powerManagementEnabled = enabled
+	return &h
+}
+
+func (h host) withUpdateAvailable(updateAvailable bool) *host {
+	h.updateAvailable = updateAvailable
+	return &h
+}
+
+// withMockCapacity sets the memory and CPU layout of hosts in the mock: two sockets with 4 cores and 2 threads each,
+// and 32 GiB of memory.
+func (h host) withMockCapacity() *host {
+	h.memory = 32 * 1024 * 1024 * 1024
+	h.maxSchedulingMemory = h.memory
+	h.cpuTopology = hostCPUTopology{sockets: 2, cores: 4, threads: 2}
+	h.numaSupported = true
+	return &h
+}
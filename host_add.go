@@ -0,0 +1,176 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddHost(
+	clusterID ClusterID,
+	name string,
+	address string,
+	authentication HostAuthentication,
+	params OptionalAddHostParameters,
+	retries ...RetryStrategy,
+) (result Host, err error) {
+	if params == nil {
+		params = AddHostParams()
+	}
+	if err := validateAddHost(name, address, authentication); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+
+	sshBuilder := ovirtsdk.NewSshBuilder().AuthenticationMethod(ovirtsdk.SshAuthenticationMethod(authentication.Method()))
+	if port := params.SSHPort(); port != nil {
+		sshBuilder.Port(int64(*port))
+	}
+	hostBuilder := ovirtsdk.NewHostBuilder().
+		Name(name).
+		Address(address).
+		Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()).
+		Ssh(sshBuilder.MustBuild())
+	if authentication.Method() == HostAuthenticationMethodPassword {
+		hostBuilder.RootPassword(authentication.RootPassword())
+	}
+	sdkHost, err := hostBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build host %s", name)
+	}
+
+	err = retry(
+		fmt.Sprintf("adding host %s (%s) to cluster %s", name, address, clusterID),
+		mutating(ResourceTypeHost, "").
+			withParameters(map[string]string{
+				"cluster_id": string(clusterID),
+				"name":       name,
+				"address":    address,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.SystemService().HostsService().Add().Host(sdkHost)
+			if activate := params.Activate(); activate != nil {
+				req.Activate(*activate)
+			}
+			if deployHostedEngine := params.DeployHostedEngine(); deployHostedEngine != nil {
+				req.DeployHostedEngine(*deployHostedEngine)
+			}
+			response, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Host()
+			if !ok {
+				return newFieldNotFound("host add response", "host")
+			}
+			result, err = convertSDKHost(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert host %s", name)
+			}
+			return nil
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeHost, string(result.ID()), "", MutationTypeCreated)
+	}
+	return result, err
+}
+
+func (m *mockClient) AddHost(
+	clusterID ClusterID,
+	name string,
+	address string,
+	authentication HostAuthentication,
+	params OptionalAddHostParameters,
+	_ ...RetryStrategy,
+) (Host, error) {
+	if params == nil {
+		params = AddHostParams()
+	}
+	if err := validateAddHost(name, address, authentication); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	for _, existing := range m.hosts {
+		if existing.name == name {
+			return nil, newError(ENameInUse, "a host with the name %s already exists", name)
+		}
+		if existing.address == address {
+			return nil, newError(EConflict, "a host with the address %s already exists", address)
+		}
+	}
+	h := &host{
+		client:    m,
+		id:        HostID(m.GenerateUUID()),
+		name:      name,
+		address:   address,
+		clusterID: clusterID,
+		status:    HostStatusInstalling,
+	}
+	h = h.withMockCapacity()
+	m.hosts[h.id] = h
+	m.fenceAgentsByHost[h.id] = []*hostFenceAgent{}
+	m.numaNodesByHost[h.id] = generateMockHostNUMANodes(h)
+	m.hostNICsByHost[h.id] = generateMockHostNICs(h)
+	m.networkAttachmentsByHost[h.id] = []*hostNetworkAttachment{}
+	activate := params.Activate() == nil || *params.Activate()
+	go m.finishHostInstall(h.id, activate)
+
+	m.mutationListeners.notify(ResourceTypeHost, string(h.id), "", MutationTypeCreated)
+	return h, nil
+}
+
+// finishHostInstall simulates the installation of a new host, which ends up either active or in maintenance.
+func (m *mockClient) finishHostInstall(id HostID, activate bool) {
+	// Sleep to trigger potential race conditions / improper status handling.
+	time.Sleep(2 * time.Second)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	h, ok := m.hosts[id]
+	if !ok || h.status != HostStatusInstalling {
+		return
+	}
+	if activate {
+		m.hosts[id] = h.withStatus(HostStatusUp)
+	} else {
+		m.hosts[id] = h.withStatus(HostStatusMaintenance)
+	}
+	m.mutationListeners.notify(ResourceTypeHost, string(id), "", MutationTypeUpdated)
+}
+
+func validateAddHost(name string, address string, authentication HostAuthentication) error {
+	if name == "" {
+		return newError(EBadArgument, "the host name cannot be empty")
+	}
+	if address == "" {
+		return newError(EBadArgument, "the address of host %s cannot be empty", name)
+	}
+	if authentication == nil {
+		return newError(EBadArgument, "no authentication specified for host %s", name)
+	}
+	switch authentication.Method() {
+	case HostAuthenticationMethodPassword:
+		if authentication.RootPassword() == "" {
+			return newError(EBadArgument, "the root password for host %s cannot be empty", name)
+		}
+	case HostAuthenticationMethodPublicKey:
+	default:
+		return newError(
+			EBadArgument,
+			"invalid authentication method for host %s: %s",
+			name,
+			authentication.Method(),
+		)
+	}
+	return nil
+}
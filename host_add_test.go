@@ -0,0 +1,79 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestAddHost(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Adding a host requires a spare machine, only running this test against the mock.")
+	}
+	name := helper.GenerateTestResourceName(t)
+
+	if _, err := client.AddHost(
+		helper.GetClusterID(),
+		name,
+		"192.0.2.20",
+		ovirtclient.HostRootPasswordAuthentication(""),
+		nil,
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Adding a host with an empty root password did not result in a bad argument error (%v)", err)
+	}
+
+	host, err := client.AddHost(
+		helper.GetClusterID(),
+		name,
+		"192.0.2.20",
+		ovirtclient.HostRootPasswordAuthentication("secret"),
+		ovirtclient.AddHostParams().MustWithSSHPort(22),
+	)
+	if err != nil {
+		t.Fatalf("Failed to add host %s (%v)", name, err)
+	}
+	if host.Name() != name || host.Address() != "192.0.2.20" || host.ClusterID() != helper.GetClusterID() {
+		t.Fatalf("Incorrect host returned (name: %s, address: %s)", host.Name(), host.Address())
+	}
+	host, err = host.WaitForUp()
+	if err != nil {
+		t.Fatalf("Host %s did not come up (%v)", name, err)
+	}
+	if host.Status() != ovirtclient.HostStatusUp {
+		t.Fatalf("Incorrect host status after waiting (%s)", host.Status())
+	}
+
+	if _, err := client.AddHost(
+		helper.GetClusterID(),
+		name,
+		"192.0.2.21",
+		ovirtclient.HostPublicKeyAuthentication(),
+		nil,
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENameInUse) {
+		t.Fatalf("Adding a host with a duplicate name did not result in a name in use error (%v)", err)
+	}
+}
+
+func TestAddHostWithoutActivation(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Adding a host requires a spare machine, only running this test against the mock.")
+	}
+
+	host, err := client.AddHost(
+		helper.GetClusterID(),
+		helper.GenerateTestResourceName(t),
+		"192.0.2.22",
+		ovirtclient.HostPublicKeyAuthentication(),
+		ovirtclient.AddHostParams().WithActivate(false),
+	)
+	if err != nil {
+		t.Fatalf("Failed to add host (%v)", err)
+	}
+	if _, err := client.WaitForHostUp(host.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.EUnexpectedHostStatus) {
+		t.Fatalf("Waiting for a host left in maintenance did not result in an unexpected host status error (%v)", err)
+	}
+}
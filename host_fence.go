@@ -0,0 +1,404 @@
+package ovirtclient
+
+import (
+	"sort"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// HostFenceAgentID is the identifier for fence agents configured on a host.
+type HostFenceAgentID string
+
+// FenceClient contains the methods to configure power management on hosts and to fence them through the engine. The
+// engine uses the fence agents of a host to talk to its out-of-band management interface (IPMI, iLO, DRAC, etc.), or
+// a proxy host when the management interface is not reachable from the engine.
+type FenceClient interface {
+	// ListHostFenceAgents lists the fence agents configured on a host.
+	ListHostFenceAgents(hostID HostID, retries ...RetryStrategy) ([]HostFenceAgent, error)
+	// AddHostFenceAgent adds a fence agent to a host. Power management has to be enabled separately using
+	// SetHostPowerManagement.
+	AddHostFenceAgent(
+		hostID HostID,
+		params HostFenceAgentParameters,
+		retries ...RetryStrategy,
+	) (HostFenceAgent, error)
+	// RemoveHostFenceAgent removes a fence agent from a host.
+	RemoveHostFenceAgent(hostID HostID, agentID HostFenceAgentID, retries ...RetryStrategy) error
+	// SetHostPowerManagement enables or disables power management on a host. Enabling power management requires at
+	// least one fence agent.
+	SetHostPowerManagement(hostID HostID, enabled bool, retries ...RetryStrategy) error
+	// FenceHost issues a fence action on a host and returns the power status reported by the fence agent. The power
+	// status is PowerManagementStatusUnknown if the engine did not report it, which is typical for actions other than
+	// FenceTypeStatus.
+	FenceHost(hostID HostID, fenceType FenceType, retries ...RetryStrategy) (PowerManagementStatus, error)
+}
+
+// HostFenceAgentData contains the data of a fence agent. The password is never returned by the engine.
+type HostFenceAgentData interface {
+	// ID returns the identifier of the fence agent.
+	ID() HostFenceAgentID
+	// HostID returns the ID of the host the fence agent belongs to.
+	HostID() HostID
+	// Type returns the fence agent type, for example "ipmilan", "ilo4" or "drac7".
+	Type() string
+	// Address returns the address of the out-of-band management interface.
+	Address() string
+	// Username returns the username used to log in to the management interface.
+	Username() string
+	// Port returns the port of the management interface if it is not the default.
+	Port() *uint16
+	// Order returns the order in which the engine tries the fence agents. Lower numbers are tried first.
+	Order() uint
+	// Options returns the agent specific options, for example lanplus=1 for IPMI.
+	Options() map[string]string
+}
+
+// HostFenceAgent is a fence agent configured on a host.
+type HostFenceAgent interface {
+	HostFenceAgentData
+
+	// Remove removes the fence agent from the host.
+	Remove(retries ...RetryStrategy) error
+}
+
+// HostFenceAgentParameters contains the parameters for adding a fence agent.
+type HostFenceAgentParameters interface {
+	// Type returns the fence agent type, for example "ipmilan".
+	Type() string
+	// Address returns the address of the out-of-band management interface.
+	Address() string
+	// Username returns the username used to log in to the management interface.
+	Username() string
+	// Password returns the password used to log in to the management interface.
+	Password() string
+	// Port returns the port of the management interface, or nil to use the default port for the agent type.
+	Port() *uint16
+	// Order returns the order of the agent, or nil to add it after the existing agents.
+	Order() *uint
+	// Options returns the agent specific options.
+	Options() map[string]string
+}
+
+// BuildableHostFenceAgentParameters is a buildable version of HostFenceAgentParameters.
+type BuildableHostFenceAgentParameters interface {
+	HostFenceAgentParameters
+
+	// WithPort sets the port of the management interface.
+	WithPort(port uint16) (BuildableHostFenceAgentParameters, error)
+	// MustWithPort is identical to WithPort, but panics instead of returning an error.
+	MustWithPort(port uint16) BuildableHostFenceAgentParameters
+
+	// WithOrder sets the order of the agent. Lower numbers are tried first.
+	WithOrder(order uint) (BuildableHostFenceAgentParameters, error)
+	// MustWithOrder is identical to WithOrder, but panics instead of returning an error.
+	MustWithOrder(order uint) BuildableHostFenceAgentParameters
+
+	// WithOption sets an agent specific option.
+	WithOption(name string, value string) (BuildableHostFenceAgentParameters, error)
+	// MustWithOption is identical to WithOption, but panics instead of returning an error.
+	MustWithOption(name string, value string) BuildableHostFenceAgentParameters
+}
+
+// HostFenceAgentParams creates a buildable set of parameters for adding a fence agent with the required parameters.
+func HostFenceAgentParams(
+	agentType string,
+	address string,
+	username string,
+	password string,
+) (BuildableHostFenceAgentParameters, error) {
+	if agentType == "" {
+		return nil, newError(EBadArgument, "the fence agent type cannot be empty")
+	}
+	if address == "" {
+		return nil, newError(EBadArgument, "the fence agent address cannot be empty")
+	}
+	return &hostFenceAgentParams{
+		agentType: agentType,
+		address:   address,
+		username:  username,
+		password:  password,
+		options:   map[string]string{},
+	}, nil
+}
+
+// MustHostFenceAgentParams is identical to HostFenceAgentParams, but panics instead of returning an error.
+func MustHostFenceAgentParams(
+	agentType string,
+	address string,
+	username string,
+	password string,
+) BuildableHostFenceAgentParameters {
+	params, err := HostFenceAgentParams(agentType, address, username, password)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+type hostFenceAgentParams struct {
+	agentType string
+	address   string
+	username  string
+	password  string
+	port      *uint16
+	order     *uint
+	options   map[string]string
+}
+
+func (h *hostFenceAgentParams) Type() string {
+	return h.agentType
+}
+
+func (h *hostFenceAgentParams) Address() string {
+	return h.address
+}
+
+func (h *hostFenceAgentParams) Username() string {
+	return h.username
+}
+
+func (h *hostFenceAgentParams) Password() string {
+	return h.password
+}
+
+func (h *hostFenceAgentParams) Port() *uint16 {
+	return h.port
+}
+
+func (h *hostFenceAgentParams) Order() *uint {
+	return h.order
+}
+
+func (h *hostFenceAgentParams) Options() map[string]string {
+	return h.options
+}
+
+func (h *hostFenceAgentParams) WithPort(port uint16) (BuildableHostFenceAgentParameters, error) {
+	if port == 0 {
+		return nil, newError(EBadArgument, "the fence agent port cannot be 0")
+	}
+	h.port = &port
+	return h, nil
+}
+
+func (h *hostFenceAgentParams) MustWithPort(port uint16) BuildableHostFenceAgentParameters {
+	builder, err := h.WithPort(port)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostFenceAgentParams) WithOrder(order uint) (BuildableHostFenceAgentParameters, error) {
+	if order == 0 {
+		return nil, newError(EBadArgument, "the fence agent order starts at 1")
+	}
+	h.order = &order
+	return h, nil
+}
+
+func (h *hostFenceAgentParams) MustWithOrder(order uint) BuildableHostFenceAgentParameters {
+	builder, err := h.WithOrder(order)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostFenceAgentParams) WithOption(name string, value string) (BuildableHostFenceAgentParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the fence agent option name cannot be empty")
+	}
+	h.options[name] = value
+	return h, nil
+}
+
+func (h *hostFenceAgentParams) MustWithOption(name string, value string) BuildableHostFenceAgentParameters {
+	builder, err := h.WithOption(name, value)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// FenceType is the fence action to perform on a host.
+type FenceType string
+
+const (
+	// FenceTypeStart powers on the host.
+	FenceTypeStart FenceType = "start"
+	// FenceTypeStop powers off the host.
+	FenceTypeStop FenceType = "stop"
+	// FenceTypeRestart powers the host off and on again.
+	FenceTypeRestart FenceType = "restart"
+	// FenceTypeStatus queries the power status of the host without changing it.
+	FenceTypeStatus FenceType = "status"
+)
+
+// Validate validates the fence type.
+func (f FenceType) Validate() error {
+	for _, fenceType := range FenceTypeValues() {
+		if fenceType == f {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid fence type: %s must be one of: %v", f, FenceTypeValues())
+}
+
+// FenceTypeList is a list of FenceType values.
+type FenceTypeList []FenceType
+
+// Strings creates a string list of the values.
+func (l FenceTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, fenceType := range l {
+		result[i] = string(fenceType)
+	}
+	return result
+}
+
+// FenceTypeValues returns all possible FenceType values.
+func FenceTypeValues() FenceTypeList {
+	return []FenceType{
+		FenceTypeStart,
+		FenceTypeStop,
+		FenceTypeRestart,
+		FenceTypeStatus,
+	}
+}
+
+// PowerManagementStatus is the power status of a host as reported by its fence agent.
+type PowerManagementStatus string
+
+const (
+	// PowerManagementStatusOn indicates that the host is powered on.
+	PowerManagementStatusOn PowerManagementStatus = "on"
+	// PowerManagementStatusOff indicates that the host is powered off.
+	PowerManagementStatusOff PowerManagementStatus = "off"
+	// PowerManagementStatusUnknown indicates that the power status could not be determined.
+	PowerManagementStatusUnknown PowerManagementStatus = "unknown"
+)
+
+// PowerManagementStatusList is a list of PowerManagementStatus values.
+type PowerManagementStatusList []PowerManagementStatus
+
+// Strings creates a string list of the values.
+func (l PowerManagementStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
+}
+
+// PowerManagementStatusValues returns all possible PowerManagementStatus values.
+func PowerManagementStatusValues() PowerManagementStatusList {
+	return []PowerManagementStatus{
+		PowerManagementStatusOn,
+		PowerManagementStatusOff,
+		PowerManagementStatusUnknown,
+	}
+}
+
+func convertSDKFenceAgent(object *ovirtsdk.Agent, hostID HostID, client Client) (HostFenceAgent, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "ID")
+	}
+	agentType, ok := object.Type()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "type")
+	}
+	address, ok := object.Address()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "address")
+	}
+	username, _ := object.Username()
+	order, ok := object.Order()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "order")
+	}
+	var port *uint16
+	if sdkPort, ok := object.Port(); ok && sdkPort > 0 {
+		p := uint16(sdkPort)
+		port = &p
+	}
+	options := map[string]string{}
+	if sdkOptions, ok := object.Options(); ok {
+		for _, option := range sdkOptions.Slice() {
+			name, ok := option.Name()
+			if !ok {
+				continue
+			}
+			value, _ := option.Value()
+			options[name] = value
+		}
+	}
+	return &hostFenceAgent{
+		client:    client,
+		id:        HostFenceAgentID(id),
+		hostID:    hostID,
+		agentType: agentType,
+		address:   address,
+		username:  username,
+		port:      port,
+		order:     uint(order),
+		options:   options,
+	}, nil
+}
+
+type hostFenceAgent struct {
+	client Client
+
+	id        HostFenceAgentID
+	hostID    HostID
+	agentType string
+	address   string
+	username  string
+	port      *uint16
+	order     uint
+	options   map[string]string
+}
+
+func (h *hostFenceAgent) ID() HostFenceAgentID {
+	return h.id
+}
+
+func (h *hostFenceAgent) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostFenceAgent) Type() string {
+	return h.agentType
+}
+
+func (h *hostFenceAgent) Address() string {
+	return h.address
+}
+
+func (h *hostFenceAgent) Username() string {
+	return h.username
+}
+
+func (h *hostFenceAgent) Port() *uint16 {
+	return h.port
+}
+
+func (h *hostFenceAgent) Order() uint {
+	return h.order
+}
+
+func (h *hostFenceAgent) Options() map[string]string {
+	return h.options
+}
+
+func (h *hostFenceAgent) Remove(retries ...RetryStrategy) error {
+	return h.client.RemoveHostFenceAgent(h.hostID, h.id, retries...)
+}
+
+// sortFenceAgents sorts the fence agents in the order the engine tries them.
+func sortFenceAgents(agents []HostFenceAgent) {
+	sort.SliceStable(agents, func(i, j int) bool {
+		return agents[i].Order() < agents[j].Order()
+	})
+}
@@ -0,0 +1,123 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+func (o *oVirtClient) FenceHost(
+	hostID HostID,
+	fenceType FenceType,
+	retries ...RetryStrategy,
+) (result PowerManagementStatus, err error) {
+	if err := fenceType.Validate(); err != nil {
+		return "", err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("fencing host %s (%s)", hostID, fenceType),
+		mutating(ResourceTypeHost, string(hostID)).
+			withParameters(map[string]string{
+				"fence_type": string(fenceType),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				Fence().
+				FenceType(string(fenceType)).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			result = PowerManagementStatusUnknown
+			if powerManagement, ok := resp.PowerManagement(); ok {
+				if status, ok := powerManagement.Status(); ok {
+					result = PowerManagementStatus(status)
+				}
+			}
+			return nil
+		},
+	)
+	if err == nil && fenceType != FenceTypeStatus {
+		o.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+	}
+	return result, err
+}
+
+func (m *mockClient) FenceHost(hostID HostID, fenceType FenceType, _ ...RetryStrategy) (PowerManagementStatus, error) {
+	if err := fenceType.Validate(); err != nil {
+		return "", err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	h, ok := m.hosts[hostID]
+	if !ok {
+		return "", newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if !h.powerManagementEnabled {
+		return "", newError(EConflict, "power management is not enabled on host %s", hostID)
+	}
+	if len(m.fenceAgentsByHost[hostID]) == 0 {
+		return "", newError(EConflict, "host %s has no fence agents", hostID)
+	}
+
+	switch fenceType {
+	case FenceTypeStatus:
+		if h.status == HostStatusDown {
+			return PowerManagementStatusOff, nil
+		}
+		return PowerManagementStatusOn, nil
+	case FenceTypeStart:
+		m.hosts[hostID] = h.withStatus(HostStatusUp)
+		m.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+		return PowerManagementStatusOn, nil
+	case FenceTypeStop:
+		m.stopVMsOnFencedHost(hostID)
+		m.hosts[hostID] = h.withStatus(HostStatusDown)
+		m.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+		return PowerManagementStatusOff, nil
+	default:
+		m.stopVMsOnFencedHost(hostID)
+		m.hosts[hostID] = h.withStatus(HostStatusReboot)
+		go m.finishHostReboot(hostID)
+		m.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+		return PowerManagementStatusOn, nil
+	}
+}
+
+// stopVMsOnFencedHost marks all VMs running on a host as down since a fenced host loses its VMs. It must be called
+// with the lock held.
+func (m *mockClient) stopVMsOnFencedHost(hostID HostID) {
+	for _, item := range m.vms {
+		if item.hostID == nil || *item.hostID != hostID {
+			continue
+		}
+		item.status = VMStatusDown
+		item.nextRunConfigurationExists = false
+		item.hostID = nil
+		m.vmIPs[item.id] = map[string][]net.IP{}
+		delete(m.reportedDevicesByVM, item.id)
+		m.mutationListeners.notify(ResourceTypeVM, string(item.id), "", MutationTypeUpdated)
+	}
+}
+
+// finishHostReboot brings a restarted host back up after a delay.
+func (m *mockClient) finishHostReboot(hostID HostID) {
+	time.Sleep(2 * time.Second)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	h, ok := m.hosts[hostID]
+	if !ok || h.status != HostStatusReboot {
+		return
+	}
+	m.hosts[hostID] = h.withStatus(HostStatusUp)
+	m.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+}
@@ -0,0 +1,120 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddHostFenceAgent(
+	hostID HostID,
+	params HostFenceAgentParameters,
+	retries ...RetryStrategy,
+) (result HostFenceAgent, err error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "fence agent parameters are required for adding a fence agent")
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	builder := ovirtsdk.NewAgentBuilder().
+		Type(params.Type()).
+		Address(params.Address()).
+		Username(params.Username()).
+		Password(params.Password())
+	if port := params.Port(); port != nil {
+		builder.Port(int64(*port))
+	}
+	if order := params.Order(); order != nil {
+		builder.Order(int64(*order))
+	}
+	if len(params.Options()) > 0 {
+		sdkOptions := make([]*ovirtsdk.Option, 0, len(params.Options()))
+		for name, value := range params.Options() {
+			sdkOptions = append(sdkOptions, ovirtsdk.NewOptionBuilder().Name(name).Value(value).MustBuild())
+		}
+		builder.OptionsOfAny(sdkOptions...)
+	}
+	sdkAgent, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build fence agent for host %s", hostID)
+	}
+	err = retry(
+		fmt.Sprintf("adding %s fence agent to host %s", params.Type(), hostID),
+		mutating(ResourceTypeFenceAgent, "").
+			withParameters(map[string]string{
+				"host_id": string(hostID),
+				"type":    params.Type(),
+				"address": params.Address(),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				FenceAgentsService().
+				Add().
+				Agent(sdkAgent).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			agent, ok := resp.Agent()
+			if !ok {
+				return newFieldNotFound("fence agent add response", "agent")
+			}
+			result, err = convertSDKFenceAgent(agent, hostID, o)
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeFenceAgent, string(result.ID()), string(hostID), MutationTypeCreated)
+	}
+	return result, err
+}
+
+func (m *mockClient) AddHostFenceAgent(
+	hostID HostID,
+	params HostFenceAgentParameters,
+	_ ...RetryStrategy,
+) (HostFenceAgent, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "fence agent parameters are required for adding a fence agent")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	var order uint = 1
+	if params.Order() != nil {
+		order = *params.Order()
+	} else {
+		for _, existing := range m.fenceAgentsByHost[hostID] {
+			if existing.order >= order {
+				order = existing.order + 1
+			}
+		}
+	}
+	options := make(map[string]string, len(params.Options()))
+	for name, value := range params.Options() {
+		options[name] = value
+	}
+	agent := &hostFenceAgent{
+		client:    m,
+		id:        HostFenceAgentID(m.GenerateUUID()),
+		hostID:    hostID,
+		agentType: params.Type(),
+		address:   params.Address(),
+		username:  params.Username(),
+		port:      params.Port(),
+		order:     order,
+		options:   options,
+	}
+	m.fenceAgentsByHost[hostID] = append(m.fenceAgentsByHost[hostID], agent)
+	m.mutationListeners.notify(ResourceTypeFenceAgent, string(agent.id), string(hostID), MutationTypeCreated)
+	return agent, nil
+}
@@ -0,0 +1,54 @@
+package ovirtclient
+
+import "fmt"
+
+func (o *oVirtClient) ListHostFenceAgents(hostID HostID, retries ...RetryStrategy) (result []HostFenceAgent, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing fence agents for host %s", hostID),
+		reading(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				FenceAgentsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			agentList, ok := resp.Agents()
+			if !ok {
+				return newFieldNotFound("fence agent list response", "agents")
+			}
+			result = make([]HostFenceAgent, len(agentList.Slice()))
+			for i, agent := range agentList.Slice() {
+				result[i], err = convertSDKFenceAgent(agent, hostID, o)
+				if err != nil {
+					return err
+				}
+			}
+			sortFenceAgents(result)
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListHostFenceAgents(hostID HostID, _ ...RetryStrategy) ([]HostFenceAgent, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostFenceAgent, len(m.fenceAgentsByHost[hostID]))
+	for i, agent := range m.fenceAgentsByHost[hostID] {
+		result[i] = agent
+	}
+	sortFenceAgents(result)
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package ovirtclient
+
+import "fmt"
+
+func (o *oVirtClient) RemoveHostFenceAgent(hostID HostID, agentID HostFenceAgentID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing fence agent %s from host %s", agentID, hostID),
+		mutating(ResourceTypeFenceAgent, string(agentID)).
+			withParameters(map[string]string{
+				"host_id": string(hostID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err = o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				FenceAgentsService().
+				AgentService(string(agentID)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeFenceAgent, string(agentID), string(hostID), MutationTypeRemoved)
+	}
+	return err
+}
+
+func (m *mockClient) RemoveHostFenceAgent(hostID HostID, agentID HostFenceAgentID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	h, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	agents := m.fenceAgentsByHost[hostID]
+	for i, agent := range agents {
+		if agent.id == agentID {
+			if h.powerManagementEnabled && len(agents) == 1 {
+				return newError(
+					EConflict,
+					"cannot remove the last fence agent %s from host %s while power management is enabled",
+					agentID,
+					hostID,
+				)
+			}
+			m.fenceAgentsByHost[hostID] = append(agents[:i:i], agents[i+1:]...)
+			m.mutationListeners.notify(ResourceTypeFenceAgent, string(agentID), string(hostID), MutationTypeRemoved)
+			return nil
+		}
+	}
+	return newError(ENotFound, "fence agent with ID %s not found on host %s", agentID, hostID)
+}
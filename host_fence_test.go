@@ -0,0 +1,87 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHostFencing(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Fencing a live host is disruptive, only running this test against the mock.")
+	}
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	host := hosts[0]
+
+	if _, err := host.Fence(ovirtclient.FenceTypeStatus); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Fencing a host without power management did not result in a conflict (%v)", err)
+	}
+
+	agent, err := client.AddHostFenceAgent(
+		host.ID(),
+		ovirtclient.MustHostFenceAgentParams("ipmilan", "192.0.2.10", "admin", "secret").
+			MustWithPort(623).
+			MustWithOption("lanplus", "1"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to add fence agent to host %s (%v)", host.ID(), err)
+	}
+	if agent.Type() != "ipmilan" || agent.Address() != "192.0.2.10" || agent.Options()["lanplus"] != "1" {
+		t.Fatalf("Incorrect fence agent settings returned (type: %s, address: %s)", agent.Type(), agent.Address())
+	}
+	if err := client.SetHostPowerManagement(host.ID(), true); err != nil {
+		t.Fatalf("Failed to enable power management on host %s (%v)", host.ID(), err)
+	}
+
+	status, err := host.Fence(ovirtclient.FenceTypeStatus)
+	if err != nil {
+		t.Fatalf("Failed to query power status of host %s (%v)", host.ID(), err)
+	}
+	if status != ovirtclient.PowerManagementStatusOn {
+		t.Fatalf("Incorrect power status before stopping host (%s)", status)
+	}
+	assertHostFenceResult(t, client, host.ID(), ovirtclient.FenceTypeStop, ovirtclient.HostStatusDown)
+	assertHostFenceResult(t, client, host.ID(), ovirtclient.FenceTypeStart, ovirtclient.HostStatusUp)
+
+	if err := agent.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing the last fence agent with power management enabled did not result in a conflict (%v)", err)
+	}
+	if err := client.SetHostPowerManagement(host.ID(), false); err != nil {
+		t.Fatalf("Failed to disable power management on host %s (%v)", host.ID(), err)
+	}
+	if err := agent.Remove(); err != nil {
+		t.Fatalf("Failed to remove fence agent %s (%v)", agent.ID(), err)
+	}
+	agents, err := host.ListFenceAgents()
+	if err != nil {
+		t.Fatalf("Failed to list fence agents on host %s (%v)", host.ID(), err)
+	}
+	if len(agents) != 0 {
+		t.Fatalf("Fence agents still present on host %s after removal.", host.ID())
+	}
+}
+
+func assertHostFenceResult(
+	t *testing.T,
+	client ovirtclient.Client,
+	hostID ovirtclient.HostID,
+	fenceType ovirtclient.FenceType,
+	expectedStatus ovirtclient.HostStatus,
+) {
+	t.Helper()
+	if _, err := client.FenceHost(hostID, fenceType); err != nil {
+		t.Fatalf("Failed to fence host %s (%s, %v)", hostID, fenceType, err)
+	}
+	host, err := client.GetHost(hostID)
+	if err != nil {
+		t.Fatalf("Failed to fetch host %s after fencing (%v)", hostID, err)
+	}
+	if host.Status() != expectedStatus {
+		t.Fatalf("Incorrect host status after %s (expected: %s, got: %s)", fenceType, expectedStatus, host.Status())
+	}
+}
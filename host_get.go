@@ -10,10 +10,17 @@ func (o *oVirtClient) GetHost(id HostID, retries ...RetryStrategy) (result Host,
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting host %s", id),
+		reading(ResourceTypeHost, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().HostsService().HostService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
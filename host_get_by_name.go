@@ -0,0 +1,62 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetHostByName(name string, retries ...RetryStrategy) (result Host, err error) {
+	quotedName, err := quoteSearchString(name)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting host by name %s", name),
+		reading(ResourceTypeHost, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				HostsService().
+				List().
+				Search("name="+quotedName).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			var results []Host
+			for _, sdkObject := range response.MustHosts().Slice() {
+				// The search may return other hosts too, so we check the name again.
+				if sdkName, ok := sdkObject.Name(); ok && sdkName == name {
+					item, err := convertSDKHost(sdkObject, o)
+					if err != nil {
+						return wrap(err, EBug, "failed to convert host %s", name)
+					}
+					results = append(results, item)
+				}
+			}
+			if err := checkNameMatches(len(results), "host", name); err != nil {
+				return err
+			}
+			result = results[0]
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetHostByName(name string, _ ...RetryStrategy) (Host, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var results []Host
+	for _, item := range m.hosts {
+		if item.name == name {
+			results = append(results, item)
+		}
+	}
+	if err := checkNameMatches(len(results), "host", name); err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
@@ -0,0 +1,24 @@
+// This file contains tests for the internal conversion of hosts. It is therefore excluded from the testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"testing"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func TestConvertSDKHostCPUTopologyWithoutThreads(t *testing.T) {
+	t.Parallel()
+
+	cpu := ovirtsdk4.NewCpuBuilder().
+		TopologyBuilder(ovirtsdk4.NewCpuTopologyBuilder().Sockets(2).Cores(8)).
+		MustBuild()
+	topology := convertSDKHostCPUTopology(cpu)
+	if topology.Threads() != 1 {
+		t.Fatalf("incorrect number of threads for a topology without threads: %d", topology.Threads())
+	}
+	if topology.LogicalCPUs() != 16 {
+		t.Fatalf("incorrect number of logical CPUs: %d", topology.LogicalCPUs())
+	}
+}
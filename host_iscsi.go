@@ -0,0 +1,398 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// DefaultISCSIPort is the standard port of iSCSI portals.
+const DefaultISCSIPort uint = 3260
+
+// OptionalISCSIParameters are the optional parameters of the iSCSI discovery and login on a host.
+type OptionalISCSIParameters interface {
+	// Username returns the CHAP username to authenticate to the portal with, or an empty string if the portal does
+	// not require authentication.
+	Username() string
+	// Password returns the CHAP password to authenticate to the portal with.
+	Password() string
+}
+
+// BuildableISCSIParameters is a buildable version of OptionalISCSIParameters.
+type BuildableISCSIParameters interface {
+	OptionalISCSIParameters
+
+	// WithCHAP sets the CHAP credentials to authenticate to the portal with.
+	WithCHAP(username string, password string) (BuildableISCSIParameters, error)
+	// MustWithCHAP is identical to WithCHAP, but panics instead of returning an error.
+	MustWithCHAP(username string, password string) BuildableISCSIParameters
+}
+
+// ISCSIParams creates a buildable set of optional parameters for the iSCSI discovery and login.
+func ISCSIParams() BuildableISCSIParameters {
+	return &iscsiParams{}
+}
+
+type iscsiParams struct {
+	username string
+	password string
+}
+
+func (i *iscsiParams) Username() string {
+	return i.username
+}
+
+func (i *iscsiParams) Password() string {
+	return i.password
+}
+
+func (i *iscsiParams) WithCHAP(username string, password string) (BuildableISCSIParameters, error) {
+	if username == "" || password == "" {
+		return nil, newError(EBadArgument, "both the CHAP username and password must be specified")
+	}
+	i.username = username
+	i.password = password
+	return i, nil
+}
+
+func (i *iscsiParams) MustWithCHAP(username string, password string) BuildableISCSIParameters {
+	builder, err := i.WithCHAP(username, password)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// HostLogicalUnit is a LUN visible to a host, for example after logging in to an iSCSI target. The IDs can be passed
+// to NewISCSIStorageDomainConnection or NewFCPStorageDomainConnection to import a storage domain residing on them.
+type HostLogicalUnit interface {
+	// ID returns the ID of the LUN.
+	ID() string
+	// StorageType returns the type of storage the LUN is reachable over, StorageDomainTypeISCSI or
+	// StorageDomainTypeFCP.
+	StorageType() StorageDomainType
+	// Size returns the size of the LUN in bytes.
+	Size() uint64
+	// Address returns the address of the iSCSI portal the LUN is reachable over. It is empty for FC.
+	Address() string
+	// Port returns the port of the iSCSI portal. It is 0 for FC.
+	Port() uint
+	// Target returns the IQN of the iSCSI target the LUN belongs to. It is empty for FC.
+	Target() string
+	// StorageDomainID returns the ID of the storage domain residing on the LUN, or nil if the LUN is not used by a
+	// storage domain.
+	StorageDomainID() *StorageDomainID
+}
+
+type hostLogicalUnit struct {
+	id              string
+	storageType     StorageDomainType
+	size            uint64
+	address         string
+	port            uint
+	target          string
+	storageDomainID *StorageDomainID
+}
+
+func (h *hostLogicalUnit) ID() string {
+	return h.id
+}
+
+func (h *hostLogicalUnit) StorageType() StorageDomainType {
+	return h.storageType
+}
+
+func (h *hostLogicalUnit) Size() uint64 {
+	return h.size
+}
+
+func (h *hostLogicalUnit) Address() string {
+	return h.address
+}
+
+func (h *hostLogicalUnit) Port() uint {
+	return h.port
+}
+
+func (h *hostLogicalUnit) Target() string {
+	return h.target
+}
+
+func (h *hostLogicalUnit) StorageDomainID() *StorageDomainID {
+	return h.storageDomainID
+}
+
+func validateISCSIPortal(address string, port uint) error {
+	if address == "" {
+		return newError(EBadArgument, "the iSCSI portal address must be specified")
+	}
+	if port == 0 || port > 65535 {
+		return newError(EBadArgument, "invalid iSCSI port: %d", port)
+	}
+	return nil
+}
+
+func buildSDKISCSIDetails(
+	address string,
+	port uint,
+	target string,
+	params OptionalISCSIParameters,
+) (*ovirtsdk4.IscsiDetails, error) {
+	builder := ovirtsdk4.NewIscsiDetailsBuilder().Address(address).Port(int64(port))
+	if target != "" {
+		builder.Target(target)
+	}
+	if params != nil && params.Username() != "" {
+		builder.Username(params.Username()).Password(params.Password())
+	}
+	details, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build iSCSI details")
+	}
+	return details, nil
+}
+
+func (o *oVirtClient) DiscoverISCSITargets(
+	hostID HostID,
+	address string,
+	port uint,
+	params OptionalISCSIParameters,
+	retries ...RetryStrategy,
+) (result []string, err error) {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	details, err := buildSDKISCSIDetails(address, port, "", params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("discovering iSCSI targets on %s:%d from host %s", address, port, hostID),
+		reading(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).
+				IscsiDiscover().Iscsi(details).Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			targets, ok := response.IscsiTargets()
+			if !ok {
+				targets = []string{}
+			}
+			result = targets
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) LoginISCSITarget(
+	hostID HostID,
+	address string,
+	port uint,
+	target string,
+	params OptionalISCSIParameters,
+	retries ...RetryStrategy,
+) error {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return err
+	}
+	if target == "" {
+		return newError(EBadArgument, "the iSCSI target must be specified")
+	}
+	details, err := buildSDKISCSIDetails(address, port, target, params)
+	if err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	return retry(
+		fmt.Sprintf("logging in to iSCSI target %s on %s:%d from host %s", target, address, port, hostID),
+		mutating(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().HostsService().HostService(string(hostID)).
+				IscsiLogin().Iscsi(details).Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+}
+
+func (o *oVirtClient) ListHostLogicalUnits(
+	hostID HostID,
+	retries ...RetryStrategy,
+) (result []HostLogicalUnit, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []HostLogicalUnit{}
+	err = retry(
+		fmt.Sprintf("listing logical units of host %s", hostID),
+		reading(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).
+				StorageService().List().ReportStatus(false).Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Storages()
+			if !ok {
+				return nil
+			}
+			result = []HostLogicalUnit{}
+			for _, sdkObject := range sdkObjects.Slice() {
+				storageType, _ := sdkObject.Type()
+				logicalUnits, ok := sdkObject.LogicalUnits()
+				if !ok {
+					continue
+				}
+				for _, lun := range logicalUnits.Slice() {
+					item, err := convertSDKHostLogicalUnit(lun, StorageDomainType(storageType))
+					if err != nil {
+						return err
+					}
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func convertSDKHostLogicalUnit(
+	sdkObject *ovirtsdk4.LogicalUnit,
+	storageType StorageDomainType,
+) (*hostLogicalUnit, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("logical unit", "ID")
+	}
+	result := &hostLogicalUnit{
+		id:          id,
+		storageType: storageType,
+	}
+	if size, ok := sdkObject.Size(); ok && size > 0 {
+		result.size = uint64(size)
+	}
+	result.address, _ = sdkObject.Address()
+	if port, ok := sdkObject.Port(); ok && port > 0 {
+		result.port = uint(port)
+	}
+	result.target, _ = sdkObject.Target()
+	if storageDomainID, ok := sdkObject.StorageDomainId(); ok && storageDomainID != "" {
+		id := StorageDomainID(storageDomainID)
+		result.storageDomainID = &id
+	}
+	return result, nil
+}
+
+// The mock reports a single target on every portal and a single LUN in every target, so tests can go through the
+// discovery, login and import flow without configuring storage first.
+
+// mockISCSILUNSize is the size of the LUN the mock reports for each iSCSI target.
+const mockISCSILUNSize uint64 = 10 * 1024 * 1024 * 1024
+
+func mockISCSITarget(address string) string {
+	return fmt.Sprintf("iqn.2022-05.org.ovirt.mock:%s", strings.ReplaceAll(address, ":", "-"))
+}
+
+// mockISCSILUNID returns a stable LUN ID for the target, formatted like the WWIDs reported for real LUNs.
+func mockISCSILUNID(target string) string {
+	return "36" + strings.ReplaceAll(uuid.NewSHA1(uuid.NameSpaceURL, []byte(target)).String(), "-", "")
+}
+
+func (m *mockClient) DiscoverISCSITargets(
+	hostID HostID,
+	address string,
+	port uint,
+	_ OptionalISCSIParameters,
+	_ ...RetryStrategy,
+) ([]string, error) {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	return []string{mockISCSITarget(address)}, nil
+}
+
+func (m *mockClient) LoginISCSITarget(
+	hostID HostID,
+	address string,
+	port uint,
+	target string,
+	_ OptionalISCSIParameters,
+	_ ...RetryStrategy,
+) error {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return err
+	}
+	if target == "" {
+		return newError(EBadArgument, "the iSCSI target must be specified")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if target != mockISCSITarget(address) {
+		return newError(ENotFound, "iSCSI target %s not found on %s:%d", target, address, port)
+	}
+	for _, lun := range m.iscsiLogicalUnitsByHost[hostID] {
+		if lun.target == target {
+			return nil
+		}
+	}
+	m.iscsiLogicalUnitsByHost[hostID] = append(m.iscsiLogicalUnitsByHost[hostID], &hostLogicalUnit{
+		id:          mockISCSILUNID(target),
+		storageType: StorageDomainTypeISCSI,
+		size:        mockISCSILUNSize,
+		address:     address,
+		port:        port,
+		target:      target,
+	})
+	return nil
+}
+
+func (m *mockClient) ListHostLogicalUnits(hostID HostID, _ ...RetryStrategy) ([]HostLogicalUnit, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostLogicalUnit, len(m.iscsiLogicalUnitsByHost[hostID]))
+	for i, lun := range m.iscsiLogicalUnitsByHost[hostID] {
+		result[i] = lun
+	}
+	return result, nil
+}
+
+// validateMockISCSIConnection checks that the host is logged in to the target of the connection and sees all of its
+// LUNs. It must be called with the lock held.
+func (m *mockClient) validateMockISCSIConnection(hostID HostID, connection StorageDomainConnection) error {
+	for _, id := range connection.LogicalUnitIDs() {
+		found := false
+		for _, lun := range m.iscsiLogicalUnitsByHost[hostID] {
+			if lun.id == id && lun.target == connection.Target() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return newError(
+				EBadArgument,
+				"LUN %s of target %s is not visible on host %s, log in to the target first",
+				id,
+				connection.Target(),
+				hostID,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestISCSIDiscoveryLoginAndImport(t *testing.T) {
+	t.Parallel()
+	// Logging in and importing changes the host and storage inventory, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	hostID := hosts[0].ID()
+	address := "iscsi.example.com"
+
+	targets, err := client.DiscoverISCSITargets(hostID, address, ovirtclient.DefaultISCSIPort, nil)
+	if err != nil {
+		t.Fatalf("Failed to discover iSCSI targets on %s (%v)", address, err)
+	}
+	if len(targets) == 0 {
+		t.Fatalf("No iSCSI targets discovered on %s.", address)
+	}
+	connection := ovirtclient.MustNewISCSIStorageDomainConnection(
+		address,
+		ovirtclient.DefaultISCSIPort,
+		targets[0],
+		[]string{"does-not-exist"},
+	)
+	if _, err := client.ImportStorageDomain("dr-iscsi", hostID, connection, nil); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Importing a storage domain on LUNs not visible to the host did not fail with EBadArgument (%v)", err)
+	}
+
+	if err := client.LoginISCSITarget(hostID, address, ovirtclient.DefaultISCSIPort, targets[0], nil); err != nil {
+		t.Fatalf("Failed to log in to iSCSI target %s (%v)", targets[0], err)
+	}
+	luns, err := client.ListHostLogicalUnits(hostID)
+	if err != nil {
+		t.Fatalf("Failed to list logical units of host %s (%v)", hostID, err)
+	}
+	if len(luns) != 1 || luns[0].Target() != targets[0] {
+		t.Fatalf("Incorrect logical units after logging in to iSCSI target %s: %v", targets[0], luns)
+	}
+
+	connection = ovirtclient.MustNewISCSIStorageDomainConnection(
+		address,
+		ovirtclient.DefaultISCSIPort,
+		targets[0],
+		[]string{luns[0].ID()},
+	)
+	storageDomain, err := client.ImportStorageDomain("dr-iscsi", hostID, connection, nil)
+	if err != nil {
+		t.Fatalf("Failed to import iSCSI storage domain (%v)", err)
+	}
+	if storageDomain.StorageType() != ovirtclient.StorageDomainTypeISCSI {
+		t.Fatalf("Incorrect storage type of imported storage domain: %s", storageDomain.StorageType())
+	}
+}
+
+func TestISCSIParamsCHAPValidation(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.ISCSIParams().WithCHAP("user", ""); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting CHAP credentials without a password did not fail with EBadArgument (%v)", err)
+	}
+}
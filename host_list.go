@@ -7,10 +7,16 @@ func (o *oVirtClient) ListHosts(retries ...RetryStrategy) (result []Host, err er
 	result = []Host{}
 	err = retry(
 		"listing hosts",
+		reading(ResourceTypeHost, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().HostsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				HostsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
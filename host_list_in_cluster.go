@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostsInCluster(clusterID ClusterID, retries ...RetryStrategy) (result []Host, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	// The engine search works on cluster names only, so the cluster is fetched first. This also results in an
+	// ENotFound error for non-existent clusters.
+	cluster, err := o.GetCluster(clusterID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	quotedName, err := quoteSearchString(cluster.Name())
+	if err != nil {
+		return nil, wrap(err, EUnsupported, "cannot search for hosts in cluster %s", clusterID)
+	}
+	result = []Host{}
+	err = retry(
+		fmt.Sprintf("listing hosts in cluster %s", clusterID),
+		reading(ResourceTypeCluster, string(clusterID)),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().
+				HostsService().
+				List().
+				Search(fmt.Sprintf("cluster = %s", quotedName)).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Hosts()
+			if !ok {
+				return nil
+			}
+			result = []Host{}
+			for i, sdkObject := range sdkObjects.Slice() {
+				item, e := convertSDKHost(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host during listing item #%d", i)
+				}
+				if item.ClusterID() == clusterID {
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListHostsInCluster(clusterID ClusterID, _ ...RetryStrategy) ([]Host, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	result := []Host{}
+	for _, item := range m.hosts {
+		if item.clusterID == clusterID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestListHostsInCluster(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHostsInCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to list hosts in cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	if len(hosts) == 0 {
+		t.Fatalf("No hosts listed in cluster %s.", helper.GetClusterID())
+	}
+	for _, host := range hosts {
+		if host.ClusterID() != helper.GetClusterID() {
+			t.Fatalf("Host %s from cluster %s listed in cluster %s.", host.ID(), host.ClusterID(), helper.GetClusterID())
+		}
+	}
+}
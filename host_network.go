@@ -0,0 +1,966 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostNICID is the identifier for network interfaces of hosts.
+type HostNICID string
+
+// HostNetworkAttachmentID is the identifier for the attachments of logical networks to host network interfaces.
+type HostNetworkAttachmentID string
+
+// HostNetworkClient contains the methods to configure the networking of hosts. Logical networks are attached to the
+// network interfaces of hosts using network attachments, which also carry the IP configuration of the host on that
+// network. Several interfaces can be combined into a bond, which can carry network attachments like any other
+// interface. The changes are applied using the setupnetworks action of the engine, which rolls them back if it loses
+// connectivity to the host.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#Host_Network_Interfaces for details.
+type HostNetworkClient interface {
+	// ListHostNICs lists the network interfaces of a host, including bonds and VLAN interfaces.
+	ListHostNICs(hostID HostID, retries ...RetryStrategy) ([]HostNIC, error)
+	// ListHostNetworkAttachments lists the logical networks attached to the network interfaces of a host.
+	ListHostNetworkAttachments(hostID HostID, retries ...RetryStrategy) ([]HostNetworkAttachment, error)
+	// SetupHostNetworks changes the network attachments and bonds of a host in a single transaction. Attaching a
+	// network that is already attached to the host modifies the existing attachment, for example to move it to
+	// another interface or to change its IP configuration. Create the parameters using HostNetworkSetupParams.
+	SetupHostNetworks(hostID HostID, params HostNetworkSetupParameters, retries ...RetryStrategy) error
+}
+
+// HostNIC is a network interface of a host. Besides physical interfaces this is also used for bonds and for the VLAN
+// interfaces the engine creates when attaching a VLAN network.
+type HostNIC interface {
+	// ID returns the identifier of the network interface.
+	ID() HostNICID
+	// HostID returns the ID of the host the network interface belongs to.
+	HostID() HostID
+	// Name returns the name of the interface on the host, for example eth0 or bond0.
+	Name() string
+	// MAC returns the MAC address of the interface.
+	MAC() string
+	// IsBond returns true if the interface is a bond of other interfaces.
+	IsBond() bool
+	// BondSlaves returns the names of the interfaces in the bond. It is empty if the interface is not a bond.
+	BondSlaves() []string
+	// BondOptions returns the bonding options, such as mode and miimon. It is empty if the interface is not a bond.
+	BondOptions() map[string]string
+	// VLANID returns the VLAN tag of the interface if it is a VLAN interface, nil otherwise.
+	VLANID() *uint
+	// BaseInterface returns the name of the interface a VLAN interface is created on. It is empty if the interface
+	// is not a VLAN interface.
+	BaseInterface() string
+}
+
+// HostNetworkAttachment is a logical network attached to a network interface of a host.
+type HostNetworkAttachment interface {
+	// ID returns the identifier of the network attachment.
+	ID() HostNetworkAttachmentID
+	// HostID returns the ID of the host the network is attached to.
+	HostID() HostID
+	// NetworkID returns the ID of the attached logical network.
+	NetworkID() NetworkID
+	// HostNICName returns the name of the interface the network is attached to. For VLAN networks this is the base
+	// interface, not the VLAN interface.
+	HostNICName() string
+	// IPv4Config returns the IPv4 configuration of the host on the network.
+	IPv4Config() HostNetworkIPConfig
+	// NameServers returns the DNS servers the host uses, if they are configured on this attachment.
+	NameServers() []string
+}
+
+// HostNetworkBootProtocol describes how a host obtains its address on a network.
+type HostNetworkBootProtocol string
+
+const (
+	// HostNetworkBootProtocolNone means that the host has no address on the network.
+	HostNetworkBootProtocolNone HostNetworkBootProtocol = "none"
+	// HostNetworkBootProtocolDHCP means that the host obtains its address using DHCP.
+	HostNetworkBootProtocolDHCP HostNetworkBootProtocol = "dhcp"
+	// HostNetworkBootProtocolStatic means that the host uses the configured static address.
+	HostNetworkBootProtocolStatic HostNetworkBootProtocol = "static"
+)
+
+// Validate returns an error if the boot protocol is not a valid value.
+func (h HostNetworkBootProtocol) Validate() error {
+	for _, protocol := range HostNetworkBootProtocolValues() {
+		if protocol == h {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid host network boot protocol: %s must be one of: %v",
+		h,
+		HostNetworkBootProtocolValues(),
+	)
+}
+
+// HostNetworkBootProtocolList is a list of HostNetworkBootProtocol values.
+type HostNetworkBootProtocolList []HostNetworkBootProtocol
+
+// Strings creates a string list of the values.
+func (l HostNetworkBootProtocolList) Strings() []string {
+	result := make([]string, len(l))
+	for i, protocol := range l {
+		result[i] = string(protocol)
+	}
+	return result
+}
+
+// HostNetworkBootProtocolValues returns all possible HostNetworkBootProtocol values.
+func HostNetworkBootProtocolValues() HostNetworkBootProtocolList {
+	return []HostNetworkBootProtocol{
+		HostNetworkBootProtocolNone,
+		HostNetworkBootProtocolDHCP,
+		HostNetworkBootProtocolStatic,
+	}
+}
+
+// HostNetworkIPConfig is the IP configuration of a host on a logical network.
+type HostNetworkIPConfig interface {
+	// BootProtocol returns how the host obtains its address.
+	BootProtocol() HostNetworkBootProtocol
+	// Address returns the static address of the host. It is empty unless the boot protocol is
+	// HostNetworkBootProtocolStatic.
+	Address() string
+	// Netmask returns the netmask of the static address in dotted notation.
+	Netmask() string
+	// Gateway returns the gateway of the network, or an empty string if it has none. The host sets up source routing
+	// for each network with a gateway, so the traffic from its address on the network is routed through the gateway
+	// of the network. The gateway of the network with the default route role of the cluster is also the default
+	// gateway of the host. The engine does not support configuring additional static routes.
+	Gateway() string
+}
+
+// NewHostNetworkDHCPConfig creates an IP configuration that obtains the address of the host using DHCP.
+func NewHostNetworkDHCPConfig() HostNetworkIPConfig {
+	return &hostNetworkIPConfig{
+		bootProtocol: HostNetworkBootProtocolDHCP,
+	}
+}
+
+// NewHostNetworkStaticIPConfig creates an IPv4 configuration with a static address. The gateway may be empty.
+func NewHostNetworkStaticIPConfig(address string, netmask string, gateway string) (HostNetworkIPConfig, error) {
+	if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+		return nil, newError(EBadArgument, "invalid IPv4 address: %s", address)
+	}
+	mask := net.ParseIP(netmask)
+	if mask == nil || mask.To4() == nil {
+		return nil, newError(EBadArgument, "invalid IPv4 netmask: %s", netmask)
+	}
+	if ones, bits := net.IPMask(mask.To4()).Size(); ones == 0 && bits == 0 {
+		return nil, newError(EBadArgument, "invalid IPv4 netmask: %s", netmask)
+	}
+	if gateway != "" {
+		if ip := net.ParseIP(gateway); ip == nil || ip.To4() == nil {
+			return nil, newError(EBadArgument, "invalid IPv4 gateway: %s", gateway)
+		}
+	}
+	return &hostNetworkIPConfig{
+		bootProtocol: HostNetworkBootProtocolStatic,
+		address:      address,
+		netmask:      netmask,
+		gateway:      gateway,
+	}, nil
+}
+
+// MustNewHostNetworkStaticIPConfig is identical to NewHostNetworkStaticIPConfig, but panics instead of returning an
+// error.
+func MustNewHostNetworkStaticIPConfig(address string, netmask string, gateway string) HostNetworkIPConfig {
+	config, err := NewHostNetworkStaticIPConfig(address, netmask, gateway)
+	if err != nil {
+		panic(err)
+	}
+	return config
+}
+
+type hostNetworkIPConfig struct {
+	bootProtocol HostNetworkBootProtocol
+	address      string
+	netmask      string
+	gateway      string
+}
+
+func (h *hostNetworkIPConfig) BootProtocol() HostNetworkBootProtocol {
+	return h.bootProtocol
+}
+
+func (h *hostNetworkIPConfig) Address() string {
+	return h.address
+}
+
+func (h *hostNetworkIPConfig) Netmask() string {
+	return h.netmask
+}
+
+func (h *hostNetworkIPConfig) Gateway() string {
+	return h.gateway
+}
+
+// HostNetworkAttachmentParameters describes a logical network to attach to a network interface of a host.
+type HostNetworkAttachmentParameters interface {
+	// NetworkID returns the ID of the logical network to attach.
+	NetworkID() NetworkID
+	// HostNICName returns the name of the interface or bond to attach the network to.
+	HostNICName() string
+	// IPv4Config returns the IPv4 configuration of the host on the network, or nil to leave the host without an
+	// address on the network.
+	IPv4Config() HostNetworkIPConfig
+	// NameServers returns the DNS servers to configure on the host, or nil to leave them unchanged.
+	NameServers() []string
+}
+
+// BuildableHostNetworkAttachmentParameters is a buildable version of HostNetworkAttachmentParameters.
+type BuildableHostNetworkAttachmentParameters interface {
+	HostNetworkAttachmentParameters
+
+	// WithIPv4Config sets the IPv4 configuration of the host on the network.
+	WithIPv4Config(config HostNetworkIPConfig) (BuildableHostNetworkAttachmentParameters, error)
+	// MustWithIPv4Config is identical to WithIPv4Config, but panics instead of returning an error.
+	MustWithIPv4Config(config HostNetworkIPConfig) BuildableHostNetworkAttachmentParameters
+
+	// WithNameServers sets the DNS servers of the host, in the order they should be queried. The engine only accepts
+	// them on the attachment of the network with the default route role of the cluster, typically the management
+	// network. At most three servers can be set since the resolver of the host ignores the rest.
+	WithNameServers(nameServers ...string) (BuildableHostNetworkAttachmentParameters, error)
+	// MustWithNameServers is identical to WithNameServers, but panics instead of returning an error.
+	MustWithNameServers(nameServers ...string) BuildableHostNetworkAttachmentParameters
+}
+
+// HostNetworkAttachmentParams creates a buildable set of parameters for attaching a network to the host interface
+// with the specified name.
+func HostNetworkAttachmentParams(
+	networkID NetworkID,
+	hostNICName string,
+) (BuildableHostNetworkAttachmentParameters, error) {
+	if networkID == "" {
+		return nil, newError(EBadArgument, "the network ID cannot be empty")
+	}
+	if hostNICName == "" {
+		return nil, newError(EBadArgument, "the host network interface name cannot be empty")
+	}
+	return &hostNetworkAttachmentParams{
+		networkID:   networkID,
+		hostNICName: hostNICName,
+	}, nil
+}
+
+// MustHostNetworkAttachmentParams is identical to HostNetworkAttachmentParams, but panics instead of returning an
+// error.
+func MustHostNetworkAttachmentParams(
+	networkID NetworkID,
+	hostNICName string,
+) BuildableHostNetworkAttachmentParameters {
+	params, err := HostNetworkAttachmentParams(networkID, hostNICName)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+type hostNetworkAttachmentParams struct {
+	networkID   NetworkID
+	hostNICName string
+	ipv4Config  HostNetworkIPConfig
+	nameServers []string
+}
+
+func (h *hostNetworkAttachmentParams) NetworkID() NetworkID {
+	return h.networkID
+}
+
+func (h *hostNetworkAttachmentParams) HostNICName() string {
+	return h.hostNICName
+}
+
+func (h *hostNetworkAttachmentParams) IPv4Config() HostNetworkIPConfig {
+	return h.ipv4Config
+}
+
+func (h *hostNetworkAttachmentParams) NameServers() []string {
+	return h.nameServers
+}
+
+func (h *hostNetworkAttachmentParams) WithIPv4Config(
+	config HostNetworkIPConfig,
+) (BuildableHostNetworkAttachmentParameters, error) {
+	if config == nil {
+		return nil, newError(EBadArgument, "the IPv4 configuration cannot be nil")
+	}
+	if err := config.BootProtocol().Validate(); err != nil {
+		return nil, err
+	}
+	h.ipv4Config = config
+	return h, nil
+}
+
+func (h *hostNetworkAttachmentParams) MustWithIPv4Config(
+	config HostNetworkIPConfig,
+) BuildableHostNetworkAttachmentParameters {
+	builder, err := h.WithIPv4Config(config)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// maxHostNameServers is the number of DNS servers the resolver of the host uses.
+const maxHostNameServers = 3
+
+func (h *hostNetworkAttachmentParams) WithNameServers(
+	nameServers ...string,
+) (BuildableHostNetworkAttachmentParameters, error) {
+	if len(nameServers) == 0 {
+		return nil, newError(EBadArgument, "at least one DNS server must be specified")
+	}
+	if len(nameServers) > maxHostNameServers {
+		return nil, newError(
+			EBadArgument,
+			"at most %d DNS servers can be specified, %d given",
+			maxHostNameServers,
+			len(nameServers),
+		)
+	}
+	seen := map[string]bool{}
+	for _, nameServer := range nameServers {
+		if net.ParseIP(nameServer) == nil {
+			return nil, newError(EBadArgument, "invalid DNS server address: %s", nameServer)
+		}
+		if seen[nameServer] {
+			return nil, newError(EBadArgument, "DNS server %s is specified more than once", nameServer)
+		}
+		seen[nameServer] = true
+	}
+	h.nameServers = append([]string{}, nameServers...)
+	return h, nil
+}
+
+func (h *hostNetworkAttachmentParams) MustWithNameServers(
+	nameServers ...string,
+) BuildableHostNetworkAttachmentParameters {
+	builder, err := h.WithNameServers(nameServers...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// hostBondNameRegexp matches the bond names the engine accepts.
+var hostBondNameRegexp = regexp.MustCompile(`^bond\w+$`) //nolint:gochecknoglobals
+
+// HostBondParameters describes a bond to create or modify on a host.
+type HostBondParameters interface {
+	// Name returns the name of the bond, for example bond0.
+	Name() string
+	// Slaves returns the names of the interfaces to combine into the bond.
+	Slaves() []string
+	// Options returns the bonding options, for example mode=4 and miimon=100.
+	Options() map[string]string
+}
+
+// BuildableHostBondParameters is a buildable version of HostBondParameters.
+type BuildableHostBondParameters interface {
+	HostBondParameters
+
+	// WithOption sets a bonding option. Prefer the typed helpers below for the common options.
+	WithOption(name string, value string) (BuildableHostBondParameters, error)
+	// MustWithOption is identical to WithOption, but panics instead of returning an error.
+	MustWithOption(name string, value string) BuildableHostBondParameters
+
+	// WithMode sets the bond mode.
+	WithMode(mode HostBondMode) (BuildableHostBondParameters, error)
+	// MustWithMode is identical to WithMode, but panics instead of returning an error.
+	MustWithMode(mode HostBondMode) BuildableHostBondParameters
+
+	// WithMIIMon sets the interval of the link monitoring, which is required for HostBondModeLACP. The interval is
+	// rounded down to milliseconds.
+	WithMIIMon(interval time.Duration) (BuildableHostBondParameters, error)
+	// MustWithMIIMon is identical to WithMIIMon, but panics instead of returning an error.
+	MustWithMIIMon(interval time.Duration) BuildableHostBondParameters
+
+	// WithLACPRate sets the LACP rate of a HostBondModeLACP bond.
+	WithLACPRate(rate HostBondLACPRate) (BuildableHostBondParameters, error)
+	// MustWithLACPRate is identical to WithLACPRate, but panics instead of returning an error.
+	MustWithLACPRate(rate HostBondLACPRate) BuildableHostBondParameters
+
+	// WithXmitHashPolicy sets the transmit hash policy of a HostBondModeBalanceXOR or HostBondModeLACP bond.
+	WithXmitHashPolicy(policy HostBondXmitHashPolicy) (BuildableHostBondParameters, error)
+	// MustWithXmitHashPolicy is identical to WithXmitHashPolicy, but panics instead of returning an error.
+	MustWithXmitHashPolicy(policy HostBondXmitHashPolicy) BuildableHostBondParameters
+}
+
+// HostBondParams creates a buildable set of parameters for a bond with the specified name combining the specified
+// interfaces. The engine requires bond names starting with "bond" and at least two interfaces in a bond. The
+// combination of the options is validated when the bond is added to HostNetworkSetupParams, for example a
+// HostBondModeLACP bond must also have WithMIIMon set:
+//
+//	bond := ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+//	    MustWithMode(ovirtclient.HostBondModeLACP).
+//	    MustWithMIIMon(100 * time.Millisecond)
+func HostBondParams(name string, slaves []string) (BuildableHostBondParameters, error) {
+	if !hostBondNameRegexp.MatchString(name) {
+		return nil, newError(EBadArgument, "invalid bond name: %s, bond names must start with \"bond\"", name)
+	}
+	if len(slaves) < 2 {
+		return nil, newError(EBadArgument, "bond %s must have at least two slaves", name)
+	}
+	seen := map[string]bool{}
+	for _, slave := range slaves {
+		if slave == "" {
+			return nil, newError(EBadArgument, "the slave names of bond %s cannot be empty", name)
+		}
+		if seen[slave] {
+			return nil, newError(EBadArgument, "%s is listed as a slave of bond %s more than once", slave, name)
+		}
+		seen[slave] = true
+	}
+	return &hostBondParams{
+		name:    name,
+		slaves:  append([]string{}, slaves...),
+		options: map[string]string{},
+	}, nil
+}
+
+// MustHostBondParams is identical to HostBondParams, but panics instead of returning an error.
+func MustHostBondParams(name string, slaves []string) BuildableHostBondParameters {
+	params, err := HostBondParams(name, slaves)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+type hostBondParams struct {
+	name    string
+	slaves  []string
+	options map[string]string
+}
+
+func (h *hostBondParams) Name() string {
+	return h.name
+}
+
+func (h *hostBondParams) Slaves() []string {
+	return h.slaves
+}
+
+func (h *hostBondParams) Options() map[string]string {
+	return h.options
+}
+
+func (h *hostBondParams) WithOption(name string, value string) (BuildableHostBondParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the bonding option name cannot be empty")
+	}
+	h.options[name] = value
+	return h, nil
+}
+
+func (h *hostBondParams) MustWithOption(name string, value string) BuildableHostBondParameters {
+	builder, err := h.WithOption(name, value)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// HostNetworkSetupParameters contains the changes to apply to the networking of a host using SetupHostNetworks.
+type HostNetworkSetupParameters interface {
+	// ModifiedAttachments returns the networks to attach or to update on the host.
+	ModifiedAttachments() []HostNetworkAttachmentParameters
+	// RemovedAttachments returns the IDs of the network attachments to remove from the host.
+	RemovedAttachments() []HostNetworkAttachmentID
+	// ModifiedBonds returns the bonds to create or to update on the host.
+	ModifiedBonds() []HostBondParameters
+	// RemovedBonds returns the names of the bonds to remove from the host.
+	RemovedBonds() []string
+	// CheckConnectivity returns true if the engine should roll back the changes when it loses connectivity to the
+	// host after applying them.
+	CheckConnectivity() bool
+	// ConnectivityTimeout returns how long the engine waits for the host to become reachable again when checking
+	// connectivity, or nil to use the engine default.
+	ConnectivityTimeout() *time.Duration
+	// CommitOnSuccess returns true if the changes should be persisted on the host, so they survive a reboot of the
+	// host. It defaults to true.
+	CommitOnSuccess() bool
+}
+
+// BuildableHostNetworkSetupParameters is a buildable version of HostNetworkSetupParameters.
+type BuildableHostNetworkSetupParameters interface {
+	HostNetworkSetupParameters
+
+	// WithModifiedAttachment adds a network to attach, or to update if it is already attached to the host.
+	WithModifiedAttachment(
+		attachment HostNetworkAttachmentParameters,
+	) (BuildableHostNetworkSetupParameters, error)
+	// MustWithModifiedAttachment is identical to WithModifiedAttachment, but panics instead of returning an error.
+	MustWithModifiedAttachment(attachment HostNetworkAttachmentParameters) BuildableHostNetworkSetupParameters
+
+	// WithRemovedAttachment adds a network attachment to remove.
+	WithRemovedAttachment(id HostNetworkAttachmentID) (BuildableHostNetworkSetupParameters, error)
+	// MustWithRemovedAttachment is identical to WithRemovedAttachment, but panics instead of returning an error.
+	MustWithRemovedAttachment(id HostNetworkAttachmentID) BuildableHostNetworkSetupParameters
+
+	// WithModifiedBond adds a bond to create, or to update if a bond with the same name exists. It returns an
+	// EBadArgument error if the bonding options do not work together.
+	WithModifiedBond(bond HostBondParameters) (BuildableHostNetworkSetupParameters, error)
+	// MustWithModifiedBond is identical to WithModifiedBond, but panics instead of returning an error.
+	MustWithModifiedBond(bond HostBondParameters) BuildableHostNetworkSetupParameters
+
+	// WithBondedNetworks adds a bond like WithModifiedBond and attaches the specified networks to it without an IP
+	// configuration, as is typical for VM networks. Use WithModifiedAttachment with the name of the bond to attach
+	// networks with an IP configuration.
+	WithBondedNetworks(bond HostBondParameters, networkIDs ...NetworkID) (BuildableHostNetworkSetupParameters, error)
+	// MustWithBondedNetworks is identical to WithBondedNetworks, but panics instead of returning an error.
+	MustWithBondedNetworks(bond HostBondParameters, networkIDs ...NetworkID) BuildableHostNetworkSetupParameters
+
+	// WithRemovedBond adds a bond to remove. The networks attached to the bond must be removed or moved to another
+	// interface in the same call.
+	WithRemovedBond(name string) (BuildableHostNetworkSetupParameters, error)
+	// MustWithRemovedBond is identical to WithRemovedBond, but panics instead of returning an error.
+	MustWithRemovedBond(name string) BuildableHostNetworkSetupParameters
+
+	// WithCheckConnectivity enables rolling back the changes if the engine loses connectivity to the host.
+	WithCheckConnectivity(checkConnectivity bool) BuildableHostNetworkSetupParameters
+
+	// WithConnectivityTimeout sets how long the engine waits for the host when checking connectivity.
+	WithConnectivityTimeout(timeout time.Duration) (BuildableHostNetworkSetupParameters, error)
+	// MustWithConnectivityTimeout is identical to WithConnectivityTimeout, but panics instead of returning an error.
+	MustWithConnectivityTimeout(timeout time.Duration) BuildableHostNetworkSetupParameters
+
+	// WithCommitOnSuccess sets if the changes should be persisted on the host.
+	WithCommitOnSuccess(commitOnSuccess bool) BuildableHostNetworkSetupParameters
+}
+
+// HostNetworkSetupParams creates a buildable set of changes for SetupHostNetworks.
+func HostNetworkSetupParams() BuildableHostNetworkSetupParameters {
+	return &hostNetworkSetupParams{
+		modifiedAttachments: []HostNetworkAttachmentParameters{},
+		removedAttachments:  []HostNetworkAttachmentID{},
+		modifiedBonds:       []HostBondParameters{},
+		removedBonds:        []string{},
+		commitOnSuccess:     true,
+	}
+}
+
+type hostNetworkSetupParams struct {
+	modifiedAttachments []HostNetworkAttachmentParameters
+	removedAttachments  []HostNetworkAttachmentID
+	modifiedBonds       []HostBondParameters
+	removedBonds        []string
+	checkConnectivity   bool
+	connectivityTimeout *time.Duration
+	commitOnSuccess     bool
+}
+
+func (h *hostNetworkSetupParams) ModifiedAttachments() []HostNetworkAttachmentParameters {
+	return h.modifiedAttachments
+}
+
+func (h *hostNetworkSetupParams) RemovedAttachments() []HostNetworkAttachmentID {
+	return h.removedAttachments
+}
+
+func (h *hostNetworkSetupParams) ModifiedBonds() []HostBondParameters {
+	return h.modifiedBonds
+}
+
+func (h *hostNetworkSetupParams) RemovedBonds() []string {
+	return h.removedBonds
+}
+
+func (h *hostNetworkSetupParams) CheckConnectivity() bool {
+	return h.checkConnectivity
+}
+
+func (h *hostNetworkSetupParams) ConnectivityTimeout() *time.Duration {
+	return h.connectivityTimeout
+}
+
+func (h *hostNetworkSetupParams) CommitOnSuccess() bool {
+	return h.commitOnSuccess
+}
+
+func (h *hostNetworkSetupParams) WithModifiedAttachment(
+	attachment HostNetworkAttachmentParameters,
+) (BuildableHostNetworkSetupParameters, error) {
+	if attachment == nil {
+		return nil, newError(EBadArgument, "the network attachment parameters cannot be nil")
+	}
+	for _, existing := range h.modifiedAttachments {
+		if existing.NetworkID() == attachment.NetworkID() {
+			return nil, newError(EBadArgument, "network %s is attached more than once", attachment.NetworkID())
+		}
+	}
+	h.modifiedAttachments = append(h.modifiedAttachments, attachment)
+	return h, nil
+}
+
+func (h *hostNetworkSetupParams) MustWithModifiedAttachment(
+	attachment HostNetworkAttachmentParameters,
+) BuildableHostNetworkSetupParameters {
+	builder, err := h.WithModifiedAttachment(attachment)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostNetworkSetupParams) WithRemovedAttachment(
+	id HostNetworkAttachmentID,
+) (BuildableHostNetworkSetupParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the network attachment ID cannot be empty")
+	}
+	h.removedAttachments = append(h.removedAttachments, id)
+	return h, nil
+}
+
+func (h *hostNetworkSetupParams) MustWithRemovedAttachment(
+	id HostNetworkAttachmentID,
+) BuildableHostNetworkSetupParameters {
+	builder, err := h.WithRemovedAttachment(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostNetworkSetupParams) WithModifiedBond(
+	bond HostBondParameters,
+) (BuildableHostNetworkSetupParameters, error) {
+	if bond == nil {
+		return nil, newError(EBadArgument, "the bond parameters cannot be nil")
+	}
+	if err := validateHostBond(bond); err != nil {
+		return nil, err
+	}
+	for _, existing := range h.modifiedBonds {
+		if existing.Name() == bond.Name() {
+			return nil, newError(EBadArgument, "bond %s is modified more than once", bond.Name())
+		}
+	}
+	h.modifiedBonds = append(h.modifiedBonds, bond)
+	return h, nil
+}
+
+func (h *hostNetworkSetupParams) MustWithModifiedBond(bond HostBondParameters) BuildableHostNetworkSetupParameters {
+	builder, err := h.WithModifiedBond(bond)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostNetworkSetupParams) WithBondedNetworks(
+	bond HostBondParameters,
+	networkIDs ...NetworkID,
+) (BuildableHostNetworkSetupParameters, error) {
+	if bond == nil {
+		return nil, newError(EBadArgument, "the bond parameters cannot be nil")
+	}
+	// The attachments are built first, so a failure does not leave the bond added without its networks.
+	attached := map[NetworkID]bool{}
+	for _, existing := range h.modifiedAttachments {
+		attached[existing.NetworkID()] = true
+	}
+	attachments := make([]HostNetworkAttachmentParameters, len(networkIDs))
+	for i, networkID := range networkIDs {
+		attachment, err := HostNetworkAttachmentParams(networkID, bond.Name())
+		if err != nil {
+			return nil, err
+		}
+		if attached[networkID] {
+			return nil, newError(EBadArgument, "network %s is attached more than once", networkID)
+		}
+		attached[networkID] = true
+		attachments[i] = attachment
+	}
+	if _, err := h.WithModifiedBond(bond); err != nil {
+		return nil, err
+	}
+	h.modifiedAttachments = append(h.modifiedAttachments, attachments...)
+	return h, nil
+}
+
+func (h *hostNetworkSetupParams) MustWithBondedNetworks(
+	bond HostBondParameters,
+	networkIDs ...NetworkID,
+) BuildableHostNetworkSetupParameters {
+	builder, err := h.WithBondedNetworks(bond, networkIDs...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostNetworkSetupParams) WithRemovedBond(name string) (BuildableHostNetworkSetupParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the bond name cannot be empty")
+	}
+	h.removedBonds = append(h.removedBonds, name)
+	return h, nil
+}
+
+func (h *hostNetworkSetupParams) MustWithRemovedBond(name string) BuildableHostNetworkSetupParameters {
+	builder, err := h.WithRemovedBond(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostNetworkSetupParams) WithCheckConnectivity(checkConnectivity bool) BuildableHostNetworkSetupParameters {
+	h.checkConnectivity = checkConnectivity
+	return h
+}
+
+func (h *hostNetworkSetupParams) WithConnectivityTimeout(
+	timeout time.Duration,
+) (BuildableHostNetworkSetupParameters, error) {
+	if timeout < time.Second {
+		return nil, newError(EBadArgument, "the connectivity timeout must be at least one second, %s given", timeout)
+	}
+	h.connectivityTimeout = &timeout
+	return h, nil
+}
+
+func (h *hostNetworkSetupParams) MustWithConnectivityTimeout(
+	timeout time.Duration,
+) BuildableHostNetworkSetupParameters {
+	builder, err := h.WithConnectivityTimeout(timeout)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostNetworkSetupParams) WithCommitOnSuccess(commitOnSuccess bool) BuildableHostNetworkSetupParameters {
+	h.commitOnSuccess = commitOnSuccess
+	return h
+}
+
+type hostNIC struct {
+	id            HostNICID
+	hostID        HostID
+	name          string
+	mac           string
+	bond          bool
+	bondSlaves    []string
+	bondOptions   map[string]string
+	vlanID        *uint
+	baseInterface string
+}
+
+func (h *hostNIC) ID() HostNICID {
+	return h.id
+}
+
+func (h *hostNIC) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostNIC) Name() string {
+	return h.name
+}
+
+func (h *hostNIC) MAC() string {
+	return h.mac
+}
+
+func (h *hostNIC) IsBond() bool {
+	return h.bond
+}
+
+func (h *hostNIC) BondSlaves() []string {
+	return h.bondSlaves
+}
+
+func (h *hostNIC) BondOptions() map[string]string {
+	return h.bondOptions
+}
+
+func (h *hostNIC) VLANID() *uint {
+	return h.vlanID
+}
+
+func (h *hostNIC) BaseInterface() string {
+	return h.baseInterface
+}
+
+type hostNetworkAttachment struct {
+	id          HostNetworkAttachmentID
+	hostID      HostID
+	networkID   NetworkID
+	hostNICName string
+	ipv4Config  *hostNetworkIPConfig
+	nameServers []string
+}
+
+func (h *hostNetworkAttachment) ID() HostNetworkAttachmentID {
+	return h.id
+}
+
+func (h *hostNetworkAttachment) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostNetworkAttachment) NetworkID() NetworkID {
+	return h.networkID
+}
+
+func (h *hostNetworkAttachment) HostNICName() string {
+	return h.hostNICName
+}
+
+func (h *hostNetworkAttachment) IPv4Config() HostNetworkIPConfig {
+	return h.ipv4Config
+}
+
+func (h *hostNetworkAttachment) NameServers() []string {
+	return h.nameServers
+}
+
+// convertSDKHostNIC converts a host NIC. The bond slaves are returned as IDs, since the engine only references them,
+// and have to be resolved to names by the caller.
+func convertSDKHostNIC(sdkObject *ovirtsdk4.HostNic, hostID HostID) (*hostNIC, []HostNICID, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, nil, newFieldNotFound("host NIC", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, nil, newFieldNotFound("host NIC", "name")
+	}
+	result := &hostNIC{
+		id:          HostNICID(id),
+		hostID:      hostID,
+		name:        name,
+		bondSlaves:  []string{},
+		bondOptions: map[string]string{},
+	}
+	if mac, ok := sdkObject.Mac(); ok {
+		result.mac, _ = mac.Address()
+	}
+	if vlan, ok := sdkObject.Vlan(); ok {
+		if vlanID, ok := vlan.Id(); ok {
+			v := uint(vlanID)
+			result.vlanID = &v
+		}
+	}
+	result.baseInterface, _ = sdkObject.BaseInterface()
+	var slaveIDs []HostNICID
+	if bonding, ok := sdkObject.Bonding(); ok {
+		result.bond = true
+		if options, ok := bonding.Options(); ok {
+			for _, option := range options.Slice() {
+				optionName, ok := option.Name()
+				if !ok {
+					continue
+				}
+				result.bondOptions[optionName], _ = option.Value()
+			}
+		}
+		if slaves, ok := bonding.Slaves(); ok {
+			for _, slave := range slaves.Slice() {
+				if slaveID, ok := slave.Id(); ok {
+					slaveIDs = append(slaveIDs, HostNICID(slaveID))
+				}
+			}
+		}
+	}
+	return result, slaveIDs, nil
+}
+
+func convertSDKHostNetworkAttachment(
+	sdkObject *ovirtsdk4.NetworkAttachment,
+	hostID HostID,
+	nicNames map[HostNICID]string,
+) (*hostNetworkAttachment, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("network attachment", "ID")
+	}
+	sdkNetwork, ok := sdkObject.Network()
+	if !ok {
+		return nil, newFieldNotFound("network attachment", "network")
+	}
+	networkID, ok := sdkNetwork.Id()
+	if !ok {
+		return nil, newFieldNotFound("network on network attachment", "ID")
+	}
+	sdkNIC, ok := sdkObject.HostNic()
+	if !ok {
+		return nil, newFieldNotFound("network attachment", "host NIC")
+	}
+	nicName, ok := sdkNIC.Name()
+	if !ok {
+		nicID, ok := sdkNIC.Id()
+		if !ok {
+			return nil, newFieldNotFound("host NIC on network attachment", "ID")
+		}
+		if nicName, ok = nicNames[HostNICID(nicID)]; !ok {
+			return nil, newError(ENotFound, "network attachment %s refers to non-existent host NIC %s", id, nicID)
+		}
+	}
+	result := &hostNetworkAttachment{
+		id:          HostNetworkAttachmentID(id),
+		hostID:      hostID,
+		networkID:   NetworkID(networkID),
+		hostNICName: nicName,
+		ipv4Config:  &hostNetworkIPConfig{bootProtocol: HostNetworkBootProtocolNone},
+		nameServers: []string{},
+	}
+	if dns, ok := sdkObject.DnsResolverConfiguration(); ok {
+		if nameServers, ok := dns.NameServers(); ok {
+			result.nameServers = nameServers
+		}
+	}
+	if assignments, ok := sdkObject.IpAddressAssignments(); ok {
+		for _, assignment := range assignments.Slice() {
+			ip, ok := assignment.Ip()
+			if !ok {
+				continue
+			}
+			if version, ok := ip.Version(); ok && version != ovirtsdk4.IPVERSION_V4 {
+				continue
+			}
+			if method, ok := assignment.AssignmentMethod(); ok {
+				result.ipv4Config.bootProtocol = HostNetworkBootProtocol(method)
+			}
+			result.ipv4Config.address, _ = ip.Address()
+			result.ipv4Config.netmask, _ = ip.Netmask()
+			result.ipv4Config.gateway, _ = ip.Gateway()
+		}
+	}
+	return result, nil
+}
+
+// mockHostNICCount is the number of physical network interfaces the mock creates for each host.
+const mockHostNICCount = 4
+
+// generateMockHostNICs creates the physical network interfaces of a mock host. The MAC addresses are derived from the
+// host ID, so they are stable across loading a saved state.
+func generateMockHostNICs(h *host) []*hostNIC {
+	result := make([]*hostNIC, mockHostNICCount)
+	for i := 0; i < mockHostNICCount; i++ {
+		name := fmt.Sprintf("eth%d", i)
+		id := uuid.NewSHA1(uuid.NameSpaceURL, []byte(string(h.id)+"/"+name))
+		result[i] = &hostNIC{
+			id:          HostNICID(id.String()),
+			hostID:      h.id,
+			name:        name,
+			mac:         fmt.Sprintf("00:1a:4a:%02x:%02x:%02x", id[13], id[14], id[15]),
+			bondSlaves:  []string{},
+			bondOptions: map[string]string{},
+		}
+	}
+	return result
+}
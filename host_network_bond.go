@@ -0,0 +1,347 @@
+package ovirtclient
+
+import (
+	"strconv"
+	"time"
+)
+
+// Names of the bonding options the typed helpers of BuildableHostBondParameters set. The engine passes the options
+// to the bonding driver of the host kernel.
+const (
+	hostBondOptionMode           = "mode"
+	hostBondOptionMIIMon         = "miimon"
+	hostBondOptionARPInterval    = "arp_interval"
+	hostBondOptionLACPRate       = "lacp_rate"
+	hostBondOptionXmitHashPolicy = "xmit_hash_policy"
+)
+
+// HostBondMode is the mode of a bond, which determines how the traffic is distributed across its slaves.
+type HostBondMode string
+
+const (
+	// HostBondModeBalanceRR sends packets on the slaves in turn. The connected switch ports must be grouped as a
+	// static link aggregation. This is the default of the kernel if no mode is set.
+	HostBondModeBalanceRR HostBondMode = "0"
+	// HostBondModeActiveBackup uses a single slave and fails over to another one if it goes down. It works without
+	// any switch configuration.
+	HostBondModeActiveBackup HostBondMode = "1"
+	// HostBondModeBalanceXOR selects the slave based on the hash of the packet, see HostBondXmitHashPolicy. The
+	// connected switch ports must be grouped as a static link aggregation.
+	HostBondModeBalanceXOR HostBondMode = "2"
+	// HostBondModeBroadcast sends every packet on all slaves.
+	HostBondModeBroadcast HostBondMode = "3"
+	// HostBondModeLACP is IEEE 802.3ad dynamic link aggregation. The connected switch ports must be configured for
+	// LACP, and link monitoring must be enabled using miimon.
+	HostBondModeLACP HostBondMode = "4"
+	// HostBondModeBalanceTLB balances outgoing traffic based on the load of the slaves. It works without any switch
+	// configuration.
+	HostBondModeBalanceTLB HostBondMode = "5"
+	// HostBondModeBalanceALB balances incoming and outgoing traffic based on the load of the slaves. It works without
+	// any switch configuration.
+	HostBondModeBalanceALB HostBondMode = "6"
+)
+
+// hostBondModeNames maps the names the kernel accepts for the bond modes to their numbers.
+var hostBondModeNames = map[string]HostBondMode{ //nolint:gochecknoglobals
+	"balance-rr":    HostBondModeBalanceRR,
+	"active-backup": HostBondModeActiveBackup,
+	"balance-xor":   HostBondModeBalanceXOR,
+	"broadcast":     HostBondModeBroadcast,
+	"802.3ad":       HostBondModeLACP,
+	"balance-tlb":   HostBondModeBalanceTLB,
+	"balance-alb":   HostBondModeBalanceALB,
+}
+
+// Validate returns an error if the bond mode is not a valid value.
+func (h HostBondMode) Validate() error {
+	for _, mode := range HostBondModeValues() {
+		if mode == h {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid bond mode: %s must be one of: %v", h, HostBondModeValues())
+}
+
+// HostBondModeList is a list of HostBondMode values.
+type HostBondModeList []HostBondMode
+
+// Strings creates a string list of the values.
+func (l HostBondModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
+}
+
+// HostBondModeValues returns all possible HostBondMode values.
+func HostBondModeValues() HostBondModeList {
+	return []HostBondMode{
+		HostBondModeBalanceRR,
+		HostBondModeActiveBackup,
+		HostBondModeBalanceXOR,
+		HostBondModeBroadcast,
+		HostBondModeLACP,
+		HostBondModeBalanceTLB,
+		HostBondModeBalanceALB,
+	}
+}
+
+// parseHostBondMode parses the mode option of a bond, which the kernel accepts both as a number and as a name.
+func parseHostBondMode(value string) (HostBondMode, error) {
+	if mode, ok := hostBondModeNames[value]; ok {
+		return mode, nil
+	}
+	mode := HostBondMode(value)
+	return mode, mode.Validate()
+}
+
+// HostBondLACPRate is the rate at which the link partner of a HostBondModeLACP bond is asked to send LACP packets.
+type HostBondLACPRate string
+
+const (
+	// HostBondLACPRateSlow requests LACP packets every 30 seconds. This is the default.
+	HostBondLACPRateSlow HostBondLACPRate = "slow"
+	// HostBondLACPRateFast requests LACP packets every second, so failed links are detected faster.
+	HostBondLACPRateFast HostBondLACPRate = "fast"
+)
+
+// Validate returns an error if the LACP rate is not a valid value.
+func (h HostBondLACPRate) Validate() error {
+	for _, rate := range HostBondLACPRateValues() {
+		if rate == h {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid LACP rate: %s must be one of: %v", h, HostBondLACPRateValues())
+}
+
+// HostBondLACPRateList is a list of HostBondLACPRate values.
+type HostBondLACPRateList []HostBondLACPRate
+
+// Strings creates a string list of the values.
+func (l HostBondLACPRateList) Strings() []string {
+	result := make([]string, len(l))
+	for i, rate := range l {
+		result[i] = string(rate)
+	}
+	return result
+}
+
+// HostBondLACPRateValues returns all possible HostBondLACPRate values.
+func HostBondLACPRateValues() HostBondLACPRateList {
+	return []HostBondLACPRate{
+		HostBondLACPRateSlow,
+		HostBondLACPRateFast,
+	}
+}
+
+// HostBondXmitHashPolicy selects the fields of the packets used to pick the slave in the HostBondModeBalanceXOR and
+// HostBondModeLACP modes.
+type HostBondXmitHashPolicy string
+
+const (
+	// HostBondXmitHashPolicyLayer2 uses the MAC addresses. This is the default.
+	HostBondXmitHashPolicyLayer2 HostBondXmitHashPolicy = "layer2"
+	// HostBondXmitHashPolicyLayer23 uses the MAC and IP addresses.
+	HostBondXmitHashPolicyLayer23 HostBondXmitHashPolicy = "layer2+3"
+	// HostBondXmitHashPolicyLayer34 uses the IP addresses and ports, so the connections between two hosts are
+	// spread across the slaves.
+	HostBondXmitHashPolicyLayer34 HostBondXmitHashPolicy = "layer3+4"
+	// HostBondXmitHashPolicyEncap23 is like HostBondXmitHashPolicyLayer23, but uses the inner headers of
+	// encapsulated packets.
+	HostBondXmitHashPolicyEncap23 HostBondXmitHashPolicy = "encap2+3"
+	// HostBondXmitHashPolicyEncap34 is like HostBondXmitHashPolicyLayer34, but uses the inner headers of
+	// encapsulated packets.
+	HostBondXmitHashPolicyEncap34 HostBondXmitHashPolicy = "encap3+4"
+)
+
+// Validate returns an error if the transmit hash policy is not a valid value.
+func (h HostBondXmitHashPolicy) Validate() error {
+	for _, policy := range HostBondXmitHashPolicyValues() {
+		if policy == h {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid transmit hash policy: %s must be one of: %v",
+		h,
+		HostBondXmitHashPolicyValues(),
+	)
+}
+
+// HostBondXmitHashPolicyList is a list of HostBondXmitHashPolicy values.
+type HostBondXmitHashPolicyList []HostBondXmitHashPolicy
+
+// Strings creates a string list of the values.
+func (l HostBondXmitHashPolicyList) Strings() []string {
+	result := make([]string, len(l))
+	for i, policy := range l {
+		result[i] = string(policy)
+	}
+	return result
+}
+
+// HostBondXmitHashPolicyValues returns all possible HostBondXmitHashPolicy values.
+func HostBondXmitHashPolicyValues() HostBondXmitHashPolicyList {
+	return []HostBondXmitHashPolicy{
+		HostBondXmitHashPolicyLayer2,
+		HostBondXmitHashPolicyLayer23,
+		HostBondXmitHashPolicyLayer34,
+		HostBondXmitHashPolicyEncap23,
+		HostBondXmitHashPolicyEncap34,
+	}
+}
+
+func (h *hostBondParams) WithMode(mode HostBondMode) (BuildableHostBondParameters, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	h.options[hostBondOptionMode] = string(mode)
+	return h, nil
+}
+
+func (h *hostBondParams) MustWithMode(mode HostBondMode) BuildableHostBondParameters {
+	builder, err := h.WithMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostBondParams) WithMIIMon(interval time.Duration) (BuildableHostBondParameters, error) {
+	if interval < time.Millisecond {
+		return nil, newError(EBadArgument, "the link monitoring interval must be at least 1ms, %s given", interval)
+	}
+	h.options[hostBondOptionMIIMon] = strconv.FormatInt(interval.Milliseconds(), 10)
+	return h, nil
+}
+
+func (h *hostBondParams) MustWithMIIMon(interval time.Duration) BuildableHostBondParameters {
+	builder, err := h.WithMIIMon(interval)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostBondParams) WithLACPRate(rate HostBondLACPRate) (BuildableHostBondParameters, error) {
+	if err := rate.Validate(); err != nil {
+		return nil, err
+	}
+	h.options[hostBondOptionLACPRate] = string(rate)
+	return h, nil
+}
+
+func (h *hostBondParams) MustWithLACPRate(rate HostBondLACPRate) BuildableHostBondParameters {
+	builder, err := h.WithLACPRate(rate)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostBondParams) WithXmitHashPolicy(policy HostBondXmitHashPolicy) (BuildableHostBondParameters, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	h.options[hostBondOptionXmitHashPolicy] = string(policy)
+	return h, nil
+}
+
+func (h *hostBondParams) MustWithXmitHashPolicy(policy HostBondXmitHashPolicy) BuildableHostBondParameters {
+	builder, err := h.WithXmitHashPolicy(policy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateHostBond checks the bonding options for combinations the bonding driver rejects or that leave the bond
+// without failure detection. The engine only reports these after trying to apply them on the host.
+func validateHostBond(bond HostBondParameters) error {
+	options := bond.Options()
+	mode := HostBondModeBalanceRR
+	if value, ok := options[hostBondOptionMode]; ok {
+		var err error
+		if mode, err = parseHostBondMode(value); err != nil {
+			return wrap(err, EBadArgument, "invalid mode for bond %s", bond.Name())
+		}
+	}
+	miimon, err := parseHostBondIntervalOption(bond, hostBondOptionMIIMon)
+	if err != nil {
+		return err
+	}
+	arpInterval, err := parseHostBondIntervalOption(bond, hostBondOptionARPInterval)
+	if err != nil {
+		return err
+	}
+	if miimon > 0 && arpInterval > 0 {
+		return newError(EBadArgument, "bond %s cannot use both miimon and arp_interval link monitoring", bond.Name())
+	}
+	switch mode {
+	case HostBondModeLACP:
+		if miimon == 0 {
+			return newError(
+				EBadArgument,
+				"bond %s uses LACP (mode 4) without miimon, the bond cannot detect failed links or LACP peers "+
+					"without link monitoring",
+				bond.Name(),
+			)
+		}
+	case HostBondModeBalanceTLB, HostBondModeBalanceALB:
+		if arpInterval > 0 {
+			return newError(EBadArgument, "bond %s cannot use arp_interval in mode %s, use miimon", bond.Name(), mode)
+		}
+	}
+	if value, ok := options[hostBondOptionLACPRate]; ok {
+		if mode != HostBondModeLACP {
+			return newError(EBadArgument, "bond %s sets lacp_rate, which only applies to LACP (mode 4)", bond.Name())
+		}
+		// The kernel also accepts the numeric values.
+		if value != "0" && value != "1" {
+			if err := HostBondLACPRate(value).Validate(); err != nil {
+				return wrap(err, EBadArgument, "invalid lacp_rate for bond %s", bond.Name())
+			}
+		}
+	}
+	if value, ok := options[hostBondOptionXmitHashPolicy]; ok {
+		if mode != HostBondModeBalanceXOR && mode != HostBondModeLACP {
+			return newError(
+				EBadArgument,
+				"bond %s sets xmit_hash_policy, which only applies to balance-xor (mode 2) and LACP (mode 4)",
+				bond.Name(),
+			)
+		}
+		if err := HostBondXmitHashPolicy(value).Validate(); err != nil {
+			return wrap(err, EBadArgument, "invalid xmit_hash_policy for bond %s", bond.Name())
+		}
+	}
+	return nil
+}
+
+func parseHostBondIntervalOption(bond HostBondParameters, option string) (uint64, error) {
+	value, ok := bond.Options()[option]
+	if !ok {
+		return 0, nil
+	}
+	interval, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, wrap(err, EBadArgument, "invalid %s for bond %s: %s", option, bond.Name(), value)
+	}
+	return interval, nil
+}
+
+// validateHostNetworkSetup validates the bonds in the changes passed to SetupHostNetworks.
+func validateHostNetworkSetup(params HostNetworkSetupParameters) error {
+	if params == nil {
+		return newError(EBadArgument, "the host network setup parameters cannot be nil")
+	}
+	for _, bond := range params.ModifiedBonds() {
+		if err := validateHostBond(bond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestLACPBondWithNetworks(t *testing.T) {
+	t.Parallel()
+	// Setting up networks changes the host networking, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	host := hosts[0]
+	networks, err := client.ListNetworks()
+	if err != nil {
+		t.Fatalf("Failed to list networks (%v)", err)
+	}
+	bond := ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+		MustWithMode(ovirtclient.HostBondModeLACP).
+		MustWithMIIMon(100 * time.Millisecond).
+		MustWithLACPRate(ovirtclient.HostBondLACPRateFast).
+		MustWithXmitHashPolicy(ovirtclient.HostBondXmitHashPolicyLayer34)
+
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().MustWithBondedNetworks(bond, networks[0].ID()),
+	); err != nil {
+		t.Fatalf("Failed to set up LACP bond (%v)", err)
+	}
+	nics, err := host.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of host %s (%v)", host.ID(), err)
+	}
+	found := false
+	for _, nic := range nics {
+		if nic.Name() == "bond0" {
+			found = true
+			if !nic.IsBond() || nic.BondOptions()["mode"] != string(ovirtclient.HostBondModeLACP) ||
+				nic.BondOptions()["miimon"] != "100" {
+				t.Fatalf("Incorrect bond0 after setting up the LACP bond: %v", nic.BondOptions())
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("bond0 not found on host %s.", host.ID())
+	}
+	attachments, err := host.ListNetworkAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list network attachments of host %s (%v)", host.ID(), err)
+	}
+	if len(attachments) != 1 || attachments[0].HostNICName() != "bond0" {
+		t.Fatalf("The network was not attached to bond0: %v", attachments)
+	}
+}
+
+func TestHostBondOptionValidation(t *testing.T) {
+	t.Parallel()
+	for name, bond := range map[string]ovirtclient.HostBondParameters{
+		"LACP without miimon": ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+			MustWithMode(ovirtclient.HostBondModeLACP),
+		"lacp_rate outside of LACP": ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+			MustWithMode(ovirtclient.HostBondModeActiveBackup).
+			MustWithLACPRate(ovirtclient.HostBondLACPRateFast),
+		"xmit_hash_policy in active-backup": ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+			MustWithMode(ovirtclient.HostBondModeActiveBackup).
+			MustWithXmitHashPolicy(ovirtclient.HostBondXmitHashPolicyLayer23),
+		"miimon and arp_interval": ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+			MustWithMIIMon(100*time.Millisecond).
+			MustWithOption("arp_interval", "100"),
+		"unknown mode name": ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+			MustWithOption("mode", "balance-nope"),
+	} {
+		if _, err := ovirtclient.HostNetworkSetupParams().WithModifiedBond(bond); !ovirtclient.HasErrorCode(
+			err,
+			ovirtclient.EBadArgument,
+		) {
+			t.Fatalf("Bond with %s did not fail with EBadArgument (%v)", name, err)
+		}
+	}
+	if _, err := ovirtclient.HostNetworkSetupParams().WithModifiedBond(
+		ovirtclient.MustHostBondParams("bond0", []string{"eth0", "eth1"}).
+			MustWithOption("mode", "802.3ad").
+			MustWithOption("miimon", "100"),
+	); err != nil {
+		t.Fatalf("LACP bond with the mode set by name failed validation (%v)", err)
+	}
+}
@@ -0,0 +1,141 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListHostNICs(hostID HostID, retries ...RetryStrategy) (result []HostNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []HostNIC{}
+	err = retry(
+		fmt.Sprintf("listing network interfaces of host %s", hostID),
+		reading(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			nics, err := o.listSDKHostNICs(hostID, retries)
+			if err != nil {
+				return err
+			}
+			result = make([]HostNIC, len(nics))
+			for i, nic := range nics {
+				result[i] = nic
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) ListHostNetworkAttachments(
+	hostID HostID,
+	retries ...RetryStrategy,
+) (result []HostNetworkAttachment, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []HostNetworkAttachment{}
+	err = retry(
+		fmt.Sprintf("listing network attachments of host %s", hostID),
+		reading(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			// The attachments only reference the NICs by ID, so the NICs are needed to resolve their names.
+			nics, err := o.listSDKHostNICs(hostID, retries)
+			if err != nil {
+				return err
+			}
+			nicNames := make(map[HostNICID]string, len(nics))
+			for _, nic := range nics {
+				nicNames[nic.id] = nic.name
+			}
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).
+				NetworkAttachmentsService().List().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Attachments()
+			if !ok {
+				return nil
+			}
+			result = make([]HostNetworkAttachment, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKHostNetworkAttachment(sdkObject, hostID, nicNames)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+// listSDKHostNICs fetches and converts the NICs of a host, resolving the bond slave IDs to names.
+func (o *oVirtClient) listSDKHostNICs(hostID HostID, retries []RetryStrategy) ([]*hostNIC, error) {
+	response, err := o.conn.SystemService().
+		HostsService().
+		HostService(string(hostID)).
+		NicsService().
+		List().
+		Query("correlation_id", requestCorrelationID(retries)).
+		Send()
+	if err != nil {
+		return nil, err
+	}
+	sdkObjects, ok := response.Nics()
+	if !ok {
+		return []*hostNIC{}, nil
+	}
+	return convertSDKHostNICs(sdkObjects.Slice(), hostID)
+}
+
+func convertSDKHostNICs(sdkObjects []*ovirtsdk4.HostNic, hostID HostID) ([]*hostNIC, error) {
+	result := make([]*hostNIC, len(sdkObjects))
+	slaveIDs := make([][]HostNICID, len(sdkObjects))
+	names := make(map[HostNICID]string, len(sdkObjects))
+	for i, sdkObject := range sdkObjects {
+		nic, ids, err := convertSDKHostNIC(sdkObject, hostID)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to convert host NIC #%d", i)
+		}
+		result[i] = nic
+		slaveIDs[i] = ids
+		names[nic.id] = nic.name
+	}
+	for i, nic := range result {
+		for _, id := range slaveIDs[i] {
+			name, ok := names[id]
+			if !ok {
+				return nil, newError(ENotFound, "bond %s refers to non-existent host NIC %s", nic.name, id)
+			}
+			nic.bondSlaves = append(nic.bondSlaves, name)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListHostNICs(hostID HostID, _ ...RetryStrategy) ([]HostNIC, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostNIC, len(m.hostNICsByHost[hostID]))
+	for i, nic := range m.hostNICsByHost[hostID] {
+		result[i] = nic
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListHostNetworkAttachments(hostID HostID, _ ...RetryStrategy) ([]HostNetworkAttachment, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostNetworkAttachment, len(m.networkAttachmentsByHost[hostID]))
+	for i, attachment := range m.networkAttachmentsByHost[hostID] {
+		result[i] = attachment
+	}
+	return result, nil
+}
@@ -0,0 +1,394 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) SetupHostNetworks(
+	hostID HostID,
+	params HostNetworkSetupParameters,
+	retries ...RetryStrategy,
+) error {
+	if err := validateHostNetworkSetup(params); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	// The engine rejects attaching a network that is already attached, so the existing attachments are looked up
+	// to modify them instead.
+	existing, err := o.ListHostNetworkAttachments(hostID, retries...)
+	if err != nil {
+		return err
+	}
+	existingIDs := make(map[NetworkID]HostNetworkAttachmentID, len(existing))
+	for _, attachment := range existing {
+		existingIDs[attachment.NetworkID()] = attachment.ID()
+	}
+	modifiedAttachments, err := buildSDKModifiedNetworkAttachments(params.ModifiedAttachments(), existingIDs)
+	if err != nil {
+		return err
+	}
+	modifiedBonds, err := buildSDKModifiedBonds(params.ModifiedBonds())
+	if err != nil {
+		return err
+	}
+	removedAttachments := &ovirtsdk4.NetworkAttachmentSlice{}
+	for _, id := range params.RemovedAttachments() {
+		removedAttachments.SetSlice(append(removedAttachments.Slice(), ovirtsdk4.NewNetworkAttachmentBuilder().
+			Id(string(id)).MustBuild()))
+	}
+	removedBonds := &ovirtsdk4.HostNicSlice{}
+	for _, name := range params.RemovedBonds() {
+		removedBonds.SetSlice(append(removedBonds.Slice(), ovirtsdk4.NewHostNicBuilder().Name(name).MustBuild()))
+	}
+
+	err = retry(
+		fmt.Sprintf("setting up networks on host %s", hostID),
+		mutating(ResourceTypeHost, string(hostID)),
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().HostsService().HostService(string(hostID)).SetupNetworks().
+				ModifiedNetworkAttachments(modifiedAttachments).
+				RemovedNetworkAttachments(removedAttachments).
+				ModifiedBonds(modifiedBonds).
+				RemovedBonds(removedBonds).
+				CheckConnectivity(params.CheckConnectivity()).
+				CommitOnSuccess(params.CommitOnSuccess())
+			if timeout := params.ConnectivityTimeout(); timeout != nil {
+				request.ConnectivityTimeout(int64(timeout.Seconds()))
+			}
+			_, err := request.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	o.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+	return nil
+}
+
+func buildSDKModifiedNetworkAttachments(
+	attachments []HostNetworkAttachmentParameters,
+	existingIDs map[NetworkID]HostNetworkAttachmentID,
+) (*ovirtsdk4.NetworkAttachmentSlice, error) {
+	result := &ovirtsdk4.NetworkAttachmentSlice{}
+	for _, attachment := range attachments {
+		builder := ovirtsdk4.NewNetworkAttachmentBuilder().
+			Network(ovirtsdk4.NewNetworkBuilder().Id(string(attachment.NetworkID())).MustBuild()).
+			HostNic(ovirtsdk4.NewHostNicBuilder().Name(attachment.HostNICName()).MustBuild())
+		if id, ok := existingIDs[attachment.NetworkID()]; ok {
+			builder.Id(string(id))
+		}
+		if config := attachment.IPv4Config(); config != nil {
+			assignment, err := buildSDKIPv4AddressAssignment(config)
+			if err != nil {
+				return nil, err
+			}
+			builder.IpAddressAssignmentsOfAny(assignment)
+		}
+		if nameServers := attachment.NameServers(); len(nameServers) > 0 {
+			dns, err := ovirtsdk4.NewDnsResolverConfigurationBuilder().NameServers(nameServers).Build()
+			if err != nil {
+				return nil, wrap(err, EBug, "failed to build DNS configuration for network %s", attachment.NetworkID())
+			}
+			builder.DnsResolverConfiguration(dns)
+		}
+		sdkAttachment, err := builder.Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build network attachment for network %s", attachment.NetworkID())
+		}
+		result.SetSlice(append(result.Slice(), sdkAttachment))
+	}
+	return result, nil
+}
+
+func buildSDKIPv4AddressAssignment(config HostNetworkIPConfig) (*ovirtsdk4.IpAddressAssignment, error) {
+	ipBuilder := ovirtsdk4.NewIpBuilder().Version(ovirtsdk4.IPVERSION_V4)
+	if config.BootProtocol() == HostNetworkBootProtocolStatic {
+		ipBuilder.Address(config.Address()).Netmask(config.Netmask())
+		if config.Gateway() != "" {
+			ipBuilder.Gateway(config.Gateway())
+		}
+	}
+	ip, err := ipBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build IP configuration")
+	}
+	assignment, err := ovirtsdk4.NewIpAddressAssignmentBuilder().
+		AssignmentMethod(ovirtsdk4.BootProtocol(config.BootProtocol())).
+		Ip(ip).
+		Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build IP address assignment")
+	}
+	return assignment, nil
+}
+
+func buildSDKModifiedBonds(bonds []HostBondParameters) (*ovirtsdk4.HostNicSlice, error) {
+	result := &ovirtsdk4.HostNicSlice{}
+	for _, bond := range bonds {
+		slaves := &ovirtsdk4.HostNicSlice{}
+		for _, slave := range bond.Slaves() {
+			slaves.SetSlice(append(slaves.Slice(), ovirtsdk4.NewHostNicBuilder().Name(slave).MustBuild()))
+		}
+		options := &ovirtsdk4.OptionSlice{}
+		for name, value := range bond.Options() {
+			options.SetSlice(append(options.Slice(), ovirtsdk4.NewOptionBuilder().Name(name).Value(value).MustBuild()))
+		}
+		bonding, err := ovirtsdk4.NewBondingBuilder().Slaves(slaves).Options(options).Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build bonding for bond %s", bond.Name())
+		}
+		sdkBond, err := ovirtsdk4.NewHostNicBuilder().Name(bond.Name()).Bonding(bonding).Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build bond %s", bond.Name())
+		}
+		result.SetSlice(append(result.Slice(), sdkBond))
+	}
+	return result, nil
+}
+
+func (m *mockClient) SetupHostNetworks(hostID HostID, params HostNetworkSetupParameters, _ ...RetryStrategy) error {
+	if err := validateHostNetworkSetup(params); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	h, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	nics, attachments, err := m.applyMockHostNetworkSetup(h, params)
+	if err != nil {
+		return err
+	}
+	m.hostNICsByHost[hostID] = nics
+	m.networkAttachmentsByHost[hostID] = attachments
+	m.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+	return nil
+}
+
+// applyMockHostNetworkSetup applies the changes to copies of the NICs and network attachments of the host, so nothing
+// is changed if the changes are invalid, like the engine does. It must be called with the lock held.
+func (m *mockClient) applyMockHostNetworkSetup(
+	h *host,
+	params HostNetworkSetupParameters,
+) ([]*hostNIC, []*hostNetworkAttachment, error) {
+	nics := make([]*hostNIC, len(m.hostNICsByHost[h.id]))
+	for i, nic := range m.hostNICsByHost[h.id] {
+		c := *nic
+		nics[i] = &c
+	}
+	attachments := make([]*hostNetworkAttachment, len(m.networkAttachmentsByHost[h.id]))
+	for i, attachment := range m.networkAttachmentsByHost[h.id] {
+		c := *attachment
+		attachments[i] = &c
+	}
+
+	for _, id := range params.RemovedAttachments() {
+		i := findMockHostNetworkAttachment(attachments, id)
+		if i < 0 {
+			return nil, nil, newError(ENotFound, "network attachment %s not found on host %s", id, h.id)
+		}
+		attachments = append(attachments[:i:i], attachments[i+1:]...)
+	}
+	for _, name := range params.RemovedBonds() {
+		i := findMockHostNIC(nics, name)
+		if i < 0 || !nics[i].bond {
+			return nil, nil, newError(ENotFound, "bond %s not found on host %s", name, h.id)
+		}
+		nics = append(nics[:i:i], nics[i+1:]...)
+	}
+	for _, bond := range params.ModifiedBonds() {
+		var err error
+		if nics, err = m.applyMockHostBond(h, nics, bond); err != nil {
+			return nil, nil, err
+		}
+	}
+	slaveOf := map[string]string{}
+	for _, nic := range nics {
+		for _, slave := range nic.bondSlaves {
+			if other, ok := slaveOf[slave]; ok {
+				return nil, nil, newError(
+					EConflict,
+					"interface %s cannot be a slave of both %s and %s",
+					slave,
+					other,
+					nic.name,
+				)
+			}
+			slaveOf[slave] = nic.name
+		}
+	}
+	for _, attachmentParams := range params.ModifiedAttachments() {
+		attachment, err := m.applyMockHostNetworkAttachment(h, nics, attachments, attachmentParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		if findMockHostNetworkAttachment(attachments, attachment.id) < 0 {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	usedBy := map[string]NetworkID{}
+	var dnsNetwork NetworkID
+	for _, attachment := range attachments {
+		// The mock has no network roles, but like the engine it only accepts DNS servers on a single network.
+		if len(attachment.nameServers) > 0 {
+			if dnsNetwork != "" {
+				return nil, nil, newError(
+					EConflict,
+					"DNS servers are configured on both network %s and %s, they can only be set on the network with "+
+						"the default route role",
+					dnsNetwork,
+					attachment.networkID,
+				)
+			}
+			dnsNetwork = attachment.networkID
+		}
+		if findMockHostNIC(nics, attachment.hostNICName) < 0 {
+			return nil, nil, newError(
+				EConflict,
+				"network %s is still attached to %s, remove or move the attachment before removing the bond",
+				attachment.networkID,
+				attachment.hostNICName,
+			)
+		}
+		if bond, ok := slaveOf[attachment.hostNICName]; ok {
+			return nil, nil, newError(
+				EConflict,
+				"network %s cannot be attached to %s since it is a slave of bond %s",
+				attachment.networkID,
+				attachment.hostNICName,
+				bond,
+			)
+		}
+		// The mock networks are untagged and a NIC can carry only one untagged network.
+		if other, ok := usedBy[attachment.hostNICName]; ok {
+			return nil, nil, newError(
+				EConflict,
+				"interface %s cannot carry both network %s and %s",
+				attachment.hostNICName,
+				other,
+				attachment.networkID,
+			)
+		}
+		usedBy[attachment.hostNICName] = attachment.networkID
+	}
+	return nics, attachments, nil
+}
+
+// applyMockHostBond creates or updates a bond in the NICs of the host. It must be called with the lock held.
+func (m *mockClient) applyMockHostBond(h *host, nics []*hostNIC, params HostBondParameters) ([]*hostNIC, error) {
+	for _, slave := range params.Slaves() {
+		i := findMockHostNIC(nics, slave)
+		if i < 0 {
+			return nil, newError(ENotFound, "interface %s of bond %s not found on host %s", slave, params.Name(), h.id)
+		}
+		if nics[i].bond || nics[i].vlanID != nil {
+			return nil, newError(
+				EBadArgument,
+				"%s cannot be a slave of bond %s, only physical interfaces can be bonded",
+				slave,
+				params.Name(),
+			)
+		}
+	}
+	var bond *hostNIC
+	if i := findMockHostNIC(nics, params.Name()); i >= 0 {
+		if !nics[i].bond {
+			return nil, newError(EConflict, "interface %s on host %s is not a bond", params.Name(), h.id)
+		}
+		bond = nics[i]
+	} else {
+		bond = &hostNIC{
+			id:     HostNICID(m.GenerateUUID()),
+			hostID: h.id,
+			name:   params.Name(),
+			mac:    nics[findMockHostNIC(nics, params.Slaves()[0])].mac,
+			bond:   true,
+		}
+		nics = append(nics, bond)
+	}
+	bond.bondSlaves = append([]string{}, params.Slaves()...)
+	bond.bondOptions = make(map[string]string, len(params.Options()))
+	for name, value := range params.Options() {
+		bond.bondOptions[name] = value
+	}
+	return nics, nil
+}
+
+// applyMockHostNetworkAttachment returns the updated attachment of the network, or a new attachment if the network
+// is not attached to the host yet. It must be called with the lock held.
+func (m *mockClient) applyMockHostNetworkAttachment(
+	h *host,
+	nics []*hostNIC,
+	attachments []*hostNetworkAttachment,
+	params HostNetworkAttachmentParameters,
+) (*hostNetworkAttachment, error) {
+	n, ok := m.networks[params.NetworkID()]
+	if !ok {
+		return nil, newError(ENotFound, "network with ID %s not found", params.NetworkID())
+	}
+	if !m.clusterInDatacenter(h.clusterID, n.dcID) {
+		return nil, newError(
+			EBadArgument,
+			"network %s is not in the datacenter of cluster %s of host %s",
+			n.id,
+			h.clusterID,
+			h.id,
+		)
+	}
+	if findMockHostNIC(nics, params.HostNICName()) < 0 {
+		return nil, newError(ENotFound, "interface %s not found on host %s", params.HostNICName(), h.id)
+	}
+	var attachment *hostNetworkAttachment
+	for _, existing := range attachments {
+		if existing.networkID == n.id {
+			attachment = existing
+			break
+		}
+	}
+	if attachment == nil {
+		attachment = &hostNetworkAttachment{
+			id:          HostNetworkAttachmentID(m.GenerateUUID()),
+			hostID:      h.id,
+			networkID:   n.id,
+			nameServers: []string{},
+		}
+	}
+	if nameServers := params.NameServers(); len(nameServers) > 0 {
+		attachment.nameServers = append([]string{}, nameServers...)
+	}
+	attachment.hostNICName = params.HostNICName()
+	attachment.ipv4Config = &hostNetworkIPConfig{bootProtocol: HostNetworkBootProtocolNone}
+	if config := params.IPv4Config(); config != nil {
+		attachment.ipv4Config = &hostNetworkIPConfig{
+			bootProtocol: config.BootProtocol(),
+			address:      config.Address(),
+			netmask:      config.Netmask(),
+			gateway:      config.Gateway(),
+		}
+	}
+	return attachment, nil
+}
+
+func findMockHostNIC(nics []*hostNIC, name string) int {
+	for i, nic := range nics {
+		if nic.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func findMockHostNetworkAttachment(attachments []*hostNetworkAttachment, id HostNetworkAttachmentID) int {
+	for i, attachment := range attachments {
+		if attachment.id == id {
+			return i
+		}
+	}
+	return -1
+}
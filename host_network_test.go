@@ -0,0 +1,166 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestSetupHostNetworks(t *testing.T) {
+	t.Parallel()
+	// Setting up networks changes the host networking, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	host := hosts[0]
+	networks, err := client.ListNetworks()
+	if err != nil {
+		t.Fatalf("Failed to list networks (%v)", err)
+	}
+	if len(networks) < 2 {
+		t.Fatalf("The mock has less than two networks.")
+	}
+	nics, err := host.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of host %s (%v)", host.ID(), err)
+	}
+	if len(nics) < 3 {
+		t.Fatalf("The mock host has less than three NICs.")
+	}
+
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().
+			MustWithModifiedBond(
+				ovirtclient.MustHostBondParams("bond0", []string{nics[0].Name(), nics[1].Name()}).
+					MustWithMode(ovirtclient.HostBondModeActiveBackup),
+			).
+			MustWithModifiedAttachment(
+				ovirtclient.MustHostNetworkAttachmentParams(networks[0].ID(), "bond0").
+					MustWithIPv4Config(ovirtclient.MustNewHostNetworkStaticIPConfig(
+						"192.0.2.10",
+						"255.255.255.0",
+						"192.0.2.1",
+					)),
+			),
+	); err != nil {
+		t.Fatalf("Failed to set up bond0 on host %s (%v)", host.ID(), err)
+	}
+	attachments, err := host.ListNetworkAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list network attachments of host %s (%v)", host.ID(), err)
+	}
+	if len(attachments) != 1 || attachments[0].HostNICName() != "bond0" {
+		t.Fatalf("Incorrect network attachments after setting up bond0: %v", attachments)
+	}
+	if config := attachments[0].IPv4Config(); config.BootProtocol() != ovirtclient.HostNetworkBootProtocolStatic ||
+		config.Address() != "192.0.2.10" {
+		t.Fatalf("Incorrect IPv4 configuration on the network attachment: %v", config)
+	}
+
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().MustWithModifiedAttachment(
+			ovirtclient.MustHostNetworkAttachmentParams(networks[1].ID(), nics[0].Name()),
+		),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Attaching a network to a bond slave did not fail with EConflict (%v)", err)
+	}
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().MustWithRemovedBond("bond0"),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing a bond with an attached network did not fail with EConflict (%v)", err)
+	}
+
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().
+			MustWithRemovedBond("bond0").
+			MustWithModifiedAttachment(
+				ovirtclient.MustHostNetworkAttachmentParams(networks[0].ID(), nics[2].Name()).
+					MustWithIPv4Config(ovirtclient.NewHostNetworkDHCPConfig()),
+			),
+	); err != nil {
+		t.Fatalf("Failed to move the network off bond0 while removing it (%v)", err)
+	}
+	newAttachments, err := host.ListNetworkAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list network attachments of host %s (%v)", host.ID(), err)
+	}
+	if len(newAttachments) != 1 || newAttachments[0].ID() != attachments[0].ID() ||
+		newAttachments[0].HostNICName() != nics[2].Name() {
+		t.Fatalf("The network attachment was not moved to %s: %v", nics[2].Name(), newAttachments)
+	}
+	newNICs, err := host.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of host %s (%v)", host.ID(), err)
+	}
+	if len(newNICs) != len(nics) {
+		t.Fatalf("bond0 was not removed from host %s.", host.ID())
+	}
+}
+
+func TestHostBondParamsValidation(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.HostBondParams("eth0", []string{"eth1", "eth2"}); err == nil {
+		t.Fatalf("A bond name not starting with bond was accepted.")
+	}
+	if _, err := ovirtclient.HostBondParams("bond0", []string{"eth1"}); err == nil {
+		t.Fatalf("A bond with a single slave was accepted.")
+	}
+	if _, err := ovirtclient.NewHostNetworkStaticIPConfig("192.0.2.10", "255.0.255.0", ""); err == nil {
+		t.Fatalf("A non-contiguous netmask was accepted.")
+	}
+}
+
+func TestHostNetworkAttachmentNameServers(t *testing.T) {
+	t.Parallel()
+	// Setting up networks changes the host networking, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	host := hosts[0]
+	networks, err := client.ListNetworks()
+	if err != nil {
+		t.Fatalf("Failed to list networks (%v)", err)
+	}
+	if len(networks) < 2 {
+		t.Fatalf("The mock has less than two networks.")
+	}
+	if _, err := ovirtclient.MustHostNetworkAttachmentParams(networks[0].ID(), "eth0").
+		WithNameServers("dns.example.com"); err == nil {
+		t.Fatalf("A DNS server hostname was accepted instead of an address.")
+	}
+
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().MustWithModifiedAttachment(
+			ovirtclient.MustHostNetworkAttachmentParams(networks[0].ID(), "eth0").
+				MustWithIPv4Config(ovirtclient.MustNewHostNetworkStaticIPConfig(
+					"192.0.2.10",
+					"255.255.255.0",
+					"192.0.2.1",
+				)).
+				MustWithNameServers("192.0.2.53", "2001:db8::53"),
+		),
+	); err != nil {
+		t.Fatalf("Failed to attach network with DNS servers (%v)", err)
+	}
+	attachments, err := host.ListNetworkAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list network attachments of host %s (%v)", host.ID(), err)
+	}
+	if len(attachments) != 1 || len(attachments[0].NameServers()) != 2 ||
+		attachments[0].NameServers()[0] != "192.0.2.53" {
+		t.Fatalf("Incorrect DNS servers on the network attachment: %v", attachments)
+	}
+
+	if err := host.SetupNetworks(
+		ovirtclient.HostNetworkSetupParams().MustWithModifiedAttachment(
+			ovirtclient.MustHostNetworkAttachmentParams(networks[1].ID(), "eth1").
+				MustWithNameServers("192.0.2.54"),
+		),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Setting DNS servers on a second network did not fail with EConflict (%v)", err)
+	}
+}
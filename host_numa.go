@@ -0,0 +1,117 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListHostNUMANodes(id HostID, retries ...RetryStrategy) (result []HostNUMANode, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []HostNUMANode{}
+	err = retry(
+		fmt.Sprintf("listing NUMA nodes of host %s", id),
+		reading(ResourceTypeHost, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				NumaNodesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Nodes()
+			if !ok {
+				return nil
+			}
+			result = make([]HostNUMANode, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKHostNUMANode(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert NUMA node during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListHostNUMANodes(id HostID, _ ...RetryStrategy) ([]HostNUMANode, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[id]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", id)
+	}
+	return m.mockHostNUMANodes(id), nil
+}
+
+func convertSDKHostNUMANode(sdkObject *ovirtsdk4.NumaNode) (*hostNUMANode, error) {
+	index, ok := sdkObject.Index()
+	if !ok {
+		return nil, newFieldNotFound("NUMA node", "index")
+	}
+	result := &hostNUMANode{
+		index: uint(index),
+	}
+	if memory, ok := sdkObject.Memory(); ok && memory > 0 {
+		// The engine reports the memory of NUMA nodes in MiB.
+		result.memory = uint64(memory) * 1024 * 1024
+	}
+	if cpu, ok := sdkObject.Cpu(); ok {
+		if cores, ok := cpu.Cores(); ok {
+			result.cpus = uint(len(cores.Slice()))
+		}
+	}
+	return result, nil
+}
+
+type hostNUMANode struct {
+	index  uint
+	memory uint64
+	cpus   uint
+}
+
+func (h *hostNUMANode) Index() uint {
+	return h.index
+}
+
+func (h *hostNUMANode) Memory() uint64 {
+	return h.memory
+}
+
+func (h *hostNUMANode) CPUs() uint {
+	return h.cpus
+}
+
+// generateMockHostNUMANodes splits the memory and CPUs of a mock host evenly between its sockets, one NUMA node for
+// each, like on most physical servers.
+func generateMockHostNUMANodes(h *host) []*hostNUMANode {
+	sockets := h.cpuTopology.sockets
+	if !h.numaSupported || sockets == 0 {
+		return []*hostNUMANode{}
+	}
+	result := make([]*hostNUMANode, sockets)
+	for i := uint(0); i < sockets; i++ {
+		result[i] = &hostNUMANode{
+			index:  i,
+			memory: h.memory / uint64(sockets),
+			cpus:   h.cpuTopology.LogicalCPUs() / sockets,
+		}
+	}
+	return result
+}
+
+// mockHostNUMANodes returns the NUMA nodes of a mock host. It must be called with the lock held.
+func (m *mockClient) mockHostNUMANodes(id HostID) []HostNUMANode {
+	result := make([]HostNUMANode, len(m.numaNodesByHost[id]))
+	for i, node := range m.numaNodesByHost[id] {
+		result[i] = node
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) SetHostPowerManagement(hostID HostID, enabled bool, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkHost, err := ovirtsdk.NewHostBuilder().
+		PowerManagement(ovirtsdk.NewPowerManagementBuilder().Enabled(enabled).MustBuild()).
+		Build()
+	if err != nil {
+		return wrap(err, EBug, "failed to build power management settings for host %s", hostID)
+	}
+	action := "disabling"
+	if enabled {
+		action = "enabling"
+	}
+	err = retry(
+		fmt.Sprintf("%s power management on host %s", action, hostID),
+		mutating(ResourceTypeHost, string(hostID)).
+			withParameters(map[string]string{
+				"enabled": fmt.Sprintf("%t", enabled),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				Update().
+				Host(sdkHost).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+	}
+	return err
+}
+
+func (m *mockClient) SetHostPowerManagement(hostID HostID, enabled bool, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	h, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if enabled && len(m.fenceAgentsByHost[hostID]) == 0 {
+		return newError(EConflict, "cannot enable power management on host %s without fence agents", hostID)
+	}
+	m.hosts[hostID] = h.withPowerManagementEnabled(enabled)
+	m.mutationListeners.notify(ResourceTypeHost, string(hostID), "", MutationTypeUpdated)
+	return nil
+}
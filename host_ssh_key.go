@@ -0,0 +1,120 @@
+package ovirtclient
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// defaultHostSSHPort is the port the engine uses to connect to hosts if no port is configured.
+const defaultHostSSHPort = 22
+
+func (o *oVirtClient) GetHostSSHKey(id HostID, retries ...RetryStrategy) (result HostSSHKey, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting SSH key of host %s", id),
+		reading(ResourceTypeHost, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Host()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no host returned when getting host ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKHostSSHKey(sdkObject)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert SSH key of host %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetHostSSHKey(id HostID, _ ...RetryStrategy) (HostSSHKey, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[id]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", id)
+	}
+	return generateMockHostSSHKey(id), nil
+}
+
+func convertSDKHostSSHKey(sdkObject *ovirtsdk4.Host) (*hostSSHKey, error) {
+	ssh, ok := sdkObject.Ssh()
+	if !ok {
+		return nil, newFieldNotFound("host", "SSH")
+	}
+	fingerprint, ok := ssh.Fingerprint()
+	if !ok {
+		return nil, newFieldNotFound("host SSH", "fingerprint")
+	}
+	result := &hostSSHKey{
+		fingerprint: fingerprint,
+		port:        defaultHostSSHPort,
+	}
+	if publicKey, ok := ssh.PublicKey(); ok {
+		result.publicKey = publicKey
+	}
+	if port, ok := ssh.Port(); ok && port > 0 {
+		result.port = uint16(port)
+	}
+	return result, nil
+}
+
+type hostSSHKey struct {
+	publicKey   string
+	fingerprint string
+	port        uint16
+}
+
+func (h *hostSSHKey) PublicKey() string {
+	return h.publicKey
+}
+
+func (h *hostSSHKey) Fingerprint() string {
+	return h.fingerprint
+}
+
+func (h *hostSSHKey) Port() uint16 {
+	return h.port
+}
+
+// generateMockHostSSHKey derives an Ed25519 host key from the host ID, so the key of a mock host stays the same
+// across calls without having to store it.
+func generateMockHostSSHKey(id HostID) *hostSSHKey {
+	seed := sha256.Sum256([]byte(id))
+	publicKey := ed25519.NewKeyFromSeed(seed[:]).Public().(ed25519.PublicKey)
+
+	// The OpenSSH wire format of a key is a list of length-prefixed strings, see RFC 8709.
+	var blob []byte
+	for _, part := range [][]byte{[]byte("ssh-ed25519"), publicKey} {
+		length := make([]byte, 4)
+		binary.BigEndian.PutUint32(length, uint32(len(part)))
+		blob = append(blob, length...)
+		blob = append(blob, part...)
+	}
+	sum := sha256.Sum256(blob)
+	return &hostSSHKey{
+		publicKey:   "ssh-ed25519 " + base64.StdEncoding.EncodeToString(blob),
+		fingerprint: "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]),
+		port:        defaultHostSSHPort,
+	}
+}
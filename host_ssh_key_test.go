@@ -0,0 +1,36 @@
+package ovirtclient_test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetHostSSHKey(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Skipf("No hosts available.")
+	}
+	key, err := hosts[0].SSHKey()
+	if err != nil {
+		t.Fatalf("Failed to get SSH key of host %s (%v)", hosts[0].ID(), err)
+	}
+	if !strings.HasPrefix(key.Fingerprint(), "SHA256:") {
+		t.Fatalf("Incorrect SSH key fingerprint: %s", key.Fingerprint())
+	}
+	if key.Port() == 0 {
+		t.Fatalf("The SSH port of host %s is not set.", hosts[0].ID())
+	}
+	secondKey, err := client.GetHostSSHKey(hosts[0].ID())
+	if err != nil {
+		t.Fatalf("Failed to get SSH key of host %s (%v)", hosts[0].ID(), err)
+	}
+	if secondKey.Fingerprint() != key.Fingerprint() {
+		t.Fatalf("The SSH key of host %s changed between calls.", hosts[0].ID())
+	}
+}
@@ -0,0 +1,340 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+// HostUpgradeClient contains the methods to check for and install package updates on hosts.
+type HostUpgradeClient interface {
+	// CheckHostUpgrade asks the engine to check the host for package updates. The check runs in the background, its
+	// result shows up in Host.UpdateAvailable once it is complete.
+	CheckHostUpgrade(id HostID, retries ...RetryStrategy) error
+	// UpgradeHost starts upgrading a host. The engine moves the host to maintenance first, migrating all VMs away,
+	// then installs the updates. Use WaitForHostUpgrade to wait for the upgrade to finish.
+	UpgradeHost(id HostID, params OptionalHostUpgradeParameters, retries ...RetryStrategy) error
+	// WaitForHostUpgrade waits until the host has been upgraded and returns the host. The progress of the upgrade
+	// can be followed in the log messages. It returns an error with the code EUnexpectedHostStatus if the upgrade
+	// failed. It keeps waiting while updates are still available, so it should only be called after UpgradeHost.
+	WaitForHostUpgrade(id HostID, retries ...RetryStrategy) (Host, error)
+}
+
+// HostUpgradeStatus is the state of a host in regard to upgrades.
+type HostUpgradeStatus string
+
+const (
+	// HostUpgradeStatusUpToDate indicates that the last upgrade check found no updates.
+	HostUpgradeStatusUpToDate HostUpgradeStatus = "up_to_date"
+	// HostUpgradeStatusUpdateAvailable indicates that the last upgrade check found updates that are not installed
+	// yet.
+	HostUpgradeStatusUpdateAvailable HostUpgradeStatus = "update_available"
+	// HostUpgradeStatusInProgress indicates that the host is being moved to maintenance, installed or rebooted. The
+	// engine does not distinguish between upgrades and other operations causing the same host status, so this
+	// status is only meaningful after an upgrade has been started.
+	HostUpgradeStatusInProgress HostUpgradeStatus = "in_progress"
+	// HostUpgradeStatusFailed indicates that the installation of the updates failed.
+	HostUpgradeStatusFailed HostUpgradeStatus = "failed"
+)
+
+// HostUpgradeStatusList is a list of HostUpgradeStatus values.
+type HostUpgradeStatusList []HostUpgradeStatus
+
+// Strings creates a string list of the values.
+func (l HostUpgradeStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
+}
+
+// HostUpgradeStatusValues returns all possible HostUpgradeStatus values.
+func HostUpgradeStatusValues() HostUpgradeStatusList {
+	return []HostUpgradeStatus{
+		HostUpgradeStatusUpToDate,
+		HostUpgradeStatusUpdateAvailable,
+		HostUpgradeStatusInProgress,
+		HostUpgradeStatusFailed,
+	}
+}
+
+func hostUpgradeStatus(status HostStatus, updateAvailable bool) HostUpgradeStatus {
+	switch status {
+	case HostStatusInstallFailed:
+		return HostUpgradeStatusFailed
+	case HostStatusPreparingForMaintenance, HostStatusInstalling, HostStatusReboot, HostStatusInitializing:
+		return HostUpgradeStatusInProgress
+	}
+	if updateAvailable {
+		return HostUpgradeStatusUpdateAvailable
+	}
+	return HostUpgradeStatusUpToDate
+}
+
+// OptionalHostUpgradeParameters contains the optional parameters for upgrading a host.
+type OptionalHostUpgradeParameters interface {
+	// Reboot returns false if the host should not be rebooted after the upgrade. Defaults to true.
+	Reboot() *bool
+	// MaintenanceTimeout returns how long the engine should wait for the host to move to maintenance before giving
+	// up the upgrade.
+	MaintenanceTimeout() *time.Duration
+	// Image returns the image to upgrade to. Only used for oVirt Node hosts.
+	Image() *string
+}
+
+// BuildableHostUpgradeParameters is a buildable version of OptionalHostUpgradeParameters.
+type BuildableHostUpgradeParameters interface {
+	OptionalHostUpgradeParameters
+
+	// WithReboot sets if the host should be rebooted after the upgrade.
+	WithReboot(reboot bool) BuildableHostUpgradeParameters
+
+	// WithMaintenanceTimeout sets how long the engine waits for the host to move to maintenance. The engine works
+	// with minute precision.
+	WithMaintenanceTimeout(timeout time.Duration) (BuildableHostUpgradeParameters, error)
+	// MustWithMaintenanceTimeout is identical to WithMaintenanceTimeout, but panics instead of returning an error.
+	MustWithMaintenanceTimeout(timeout time.Duration) BuildableHostUpgradeParameters
+
+	// WithImage sets the image to upgrade an oVirt Node host to.
+	WithImage(image string) (BuildableHostUpgradeParameters, error)
+	// MustWithImage is identical to WithImage, but panics instead of returning an error.
+	MustWithImage(image string) BuildableHostUpgradeParameters
+}
+
+// HostUpgradeParams creates a buildable set of parameters for upgrading a host.
+func HostUpgradeParams() BuildableHostUpgradeParameters {
+	return &hostUpgradeParams{}
+}
+
+type hostUpgradeParams struct {
+	reboot             *bool
+	maintenanceTimeout *time.Duration
+	image              *string
+}
+
+func (h *hostUpgradeParams) Reboot() *bool {
+	return h.reboot
+}
+
+func (h *hostUpgradeParams) MaintenanceTimeout() *time.Duration {
+	return h.maintenanceTimeout
+}
+
+func (h *hostUpgradeParams) Image() *string {
+	return h.image
+}
+
+func (h *hostUpgradeParams) WithReboot(reboot bool) BuildableHostUpgradeParameters {
+	h.reboot = &reboot
+	return h
+}
+
+func (h *hostUpgradeParams) WithMaintenanceTimeout(timeout time.Duration) (BuildableHostUpgradeParameters, error) {
+	if timeout < time.Minute {
+		return nil, newError(EBadArgument, "the maintenance timeout must be at least one minute (%s given)", timeout)
+	}
+	h.maintenanceTimeout = &timeout
+	return h, nil
+}
+
+func (h *hostUpgradeParams) MustWithMaintenanceTimeout(timeout time.Duration) BuildableHostUpgradeParameters {
+	builder, err := h.WithMaintenanceTimeout(timeout)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostUpgradeParams) WithImage(image string) (BuildableHostUpgradeParameters, error) {
+	if image == "" {
+		return nil, newError(EBadArgument, "the upgrade image cannot be empty")
+	}
+	h.image = &image
+	return h, nil
+}
+
+func (h *hostUpgradeParams) MustWithImage(image string) BuildableHostUpgradeParameters {
+	builder, err := h.WithImage(image)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) CheckHostUpgrade(id HostID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("checking host %s for updates", id),
+		mutating(ResourceTypeHost, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				UpgradeCheck().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (o *oVirtClient) UpgradeHost(id HostID, params OptionalHostUpgradeParameters, retries ...RetryStrategy) (err error) {
+	if params == nil {
+		params = HostUpgradeParams()
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("upgrading host %s", id),
+		mutating(ResourceTypeHost, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.SystemService().HostsService().HostService(string(id)).Upgrade()
+			if reboot := params.Reboot(); reboot != nil {
+				req.Reboot(*reboot)
+			}
+			if timeout := params.MaintenanceTimeout(); timeout != nil {
+				req.Timeout(int64(timeout.Minutes()))
+			}
+			if image := params.Image(); image != nil {
+				req.Image(*image)
+			}
+			_, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeHost, string(id), "", MutationTypeUpdated)
+	}
+	return err
+}
+
+func (o *oVirtClient) WaitForHostUpgrade(id HostID, retries ...RetryStrategy) (result Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("waiting for the upgrade of host %s", id),
+		reading(ResourceTypeHost, string(id)).viaClient(),
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkHostUpgradeFinished(result)
+		})
+	return result, err
+}
+
+func (m *mockClient) CheckHostUpgrade(id HostID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.hosts[id]; !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	// The mock always finds updates, the result becomes visible once the background check is done.
+	go func() {
+		time.Sleep(time.Second)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if h, ok := m.hosts[id]; ok {
+			m.hosts[id] = h.withUpdateAvailable(true)
+			m.mutationListeners.notify(ResourceTypeHost, string(id), "", MutationTypeUpdated)
+		}
+	}()
+	return nil
+}
+
+func (m *mockClient) UpgradeHost(id HostID, params OptionalHostUpgradeParameters, _ ...RetryStrategy) error {
+	if params == nil {
+		params = HostUpgradeParams()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	h, ok := m.hosts[id]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	if !h.updateAvailable {
+		return newError(EConflict, "there are no available updates for host %s", id)
+	}
+	if h.status != HostStatusUp && h.status != HostStatusMaintenance {
+		return newError(EConflict, "host %s cannot be upgraded in status %s", id, h.status)
+	}
+	for _, item := range m.vms {
+		// The mock has no live migration, so it refuses to drain a host that runs VMs.
+		if item.hostID != nil && *item.hostID == id {
+			return newError(EConflict, "host %s is running VM %s that cannot be migrated", id, item.id)
+		}
+	}
+	reboot := params.Reboot() == nil || *params.Reboot()
+	steps := []HostStatus{HostStatusInstalling}
+	if h.status == HostStatusUp {
+		steps = append([]HostStatus{HostStatusPreparingForMaintenance}, steps...)
+	}
+	if reboot {
+		steps = append(steps, HostStatusReboot)
+	}
+	m.hosts[id] = h.withStatus(steps[0])
+	go m.progressHostUpgrade(id, steps[1:], h.status)
+	m.mutationListeners.notify(ResourceTypeHost, string(id), "", MutationTypeUpdated)
+	return nil
+}
+
+// progressHostUpgrade moves a host through the remaining upgrade steps and finally back to the status it had before
+// the upgrade.
+func (m *mockClient) progressHostUpgrade(id HostID, steps []HostStatus, finalStatus HostStatus) {
+	for _, status := range append(steps, finalStatus) {
+		time.Sleep(time.Second)
+		m.lock.Lock()
+		h, ok := m.hosts[id]
+		if !ok {
+			m.lock.Unlock()
+			return
+		}
+		h = h.withStatus(status)
+		if status == finalStatus {
+			h = h.withUpdateAvailable(false)
+		}
+		m.hosts[id] = h
+		m.mutationListeners.notify(ResourceTypeHost, string(id), "", MutationTypeUpdated)
+		m.lock.Unlock()
+	}
+}
+
+func (m *mockClient) WaitForHostUpgrade(id HostID, retries ...RetryStrategy) (result Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	err = retry(
+		fmt.Sprintf("waiting for the upgrade of host %s", id),
+		reading(ResourceTypeHost, string(id)),
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkHostUpgradeFinished(result)
+		})
+	return result, err
+}
+
+func checkHostUpgradeFinished(h Host) error {
+	switch h.UpgradeStatus() {
+	case HostUpgradeStatusFailed:
+		return newError(EUnexpectedHostStatus, "upgrade of host %s failed (status: %s)", h.ID(), h.Status())
+	case HostUpgradeStatusInProgress:
+		return newError(EPending, "host %s is still being upgraded (status: %s)", h.ID(), h.Status())
+	case HostUpgradeStatusUpdateAvailable:
+		// The engine may take a moment to move the host out of its current status after the upgrade is started.
+		return newError(EPending, "the upgrade of host %s has not started yet", h.ID())
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHostUpgrade(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Upgrading a live host is disruptive, only running this test against the mock.")
+	}
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	host := hosts[0]
+
+	if err := host.Upgrade(nil); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Upgrading a host without available updates did not result in a conflict (%v)", err)
+	}
+	if err := host.CheckUpgrade(); err != nil {
+		t.Fatalf("Failed to check host %s for updates (%v)", host.ID(), err)
+	}
+	host = assertHostUpgradeStatus(t, client, host.ID(), ovirtclient.HostUpgradeStatusUpdateAvailable)
+
+	if err := host.Upgrade(ovirtclient.HostUpgradeParams().WithReboot(true)); err != nil {
+		t.Fatalf("Failed to upgrade host %s (%v)", host.ID(), err)
+	}
+	host, err = client.WaitForHostUpgrade(host.ID())
+	if err != nil {
+		t.Fatalf("Failed to wait for the upgrade of host %s (%v)", host.ID(), err)
+	}
+	if host.Status() != ovirtclient.HostStatusUp {
+		t.Fatalf("Host %s did not return to the up status after the upgrade (%s)", host.ID(), host.Status())
+	}
+	if host.UpgradeStatus() != ovirtclient.HostUpgradeStatusUpToDate {
+		t.Fatalf("Incorrect upgrade status after the upgrade (%s)", host.UpgradeStatus())
+	}
+}
+
+func assertHostUpgradeStatus(
+	t *testing.T,
+	client ovirtclient.Client,
+	hostID ovirtclient.HostID,
+	expected ovirtclient.HostUpgradeStatus,
+) ovirtclient.Host {
+	t.Helper()
+	var host ovirtclient.Host
+	var err error
+	for i := 0; i < 10; i++ {
+		host, err = client.GetHost(hostID)
+		if err != nil {
+			t.Fatalf("Failed to fetch host %s (%v)", hostID, err)
+		}
+		if host.UpgradeStatus() == expected {
+			return host
+		}
+		time.Sleep(time.Second)
+	}
+	t.Fatalf("Host %s did not reach the %s upgrade status (%s)", hostID, expected, host.UpgradeStatus())
+	return nil
+}
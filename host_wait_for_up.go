@@ -0,0 +1,65 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// hostStatusesStuck contains the host statuses the host does not leave on its own, so waiting for it to come up is
+// pointless.
+var hostStatusesStuck = []HostStatus{
+	HostStatusInstallFailed,
+	HostStatusNonOperational,
+	HostStatusMaintenance,
+	HostStatusError,
+}
+
+func (o *oVirtClient) WaitForHostUp(id HostID, retries ...RetryStrategy) (result Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("waiting for host %s to come up", id),
+		reading(ResourceTypeHost, string(id)).viaClient(),
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkHostUp(result)
+		})
+	return result, err
+}
+
+func (m *mockClient) WaitForHostUp(id HostID, retries ...RetryStrategy) (result Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	err = retry(
+		fmt.Sprintf("waiting for host %s to come up", id),
+		reading(ResourceTypeHost, string(id)),
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkHostUp(result)
+		})
+	return result, err
+}
+
+func checkHostUp(h Host) error {
+	if h.Status() == HostStatusUp {
+		return nil
+	}
+	for _, status := range hostStatusesStuck {
+		if h.Status() == status {
+			return newError(
+				EUnexpectedHostStatus,
+				"host %s is in status %s and will not come up without intervention",
+				h.ID(),
+				h.Status(),
+			)
+		}
+	}
+	return newError(EPending, "host %s status is %s, not %s", h.ID(), h.Status(), HostStatusUp)
+}
@@ -0,0 +1,165 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostedEngineStatus describes the state of the Hosted Engine agent on a host that is able to run the Hosted Engine
+// VM.
+type HostedEngineStatus interface {
+	// Configured returns true if the host has been deployed as a Hosted Engine host.
+	Configured() bool
+	// Active returns true if the Hosted Engine VM is currently running on the host.
+	Active() bool
+	// Score returns the HA score of the host. The Hosted Engine VM is started on the host with the highest score,
+	// the maximum being 3400. A score of 0 means the host cannot run the Hosted Engine VM.
+	Score() int64
+	// GlobalMaintenance returns true if the Hosted Engine HA agents are in global maintenance, so they do not start
+	// or stop the Hosted Engine VM on any host.
+	GlobalMaintenance() bool
+	// LocalMaintenance returns true if the host is in local maintenance and will not run the Hosted Engine VM.
+	LocalMaintenance() bool
+}
+
+type hostedEngineStatus struct {
+	configured        bool
+	active            bool
+	score             int64
+	globalMaintenance bool
+	localMaintenance  bool
+}
+
+func (h hostedEngineStatus) Configured() bool {
+	return h.configured
+}
+
+func (h hostedEngineStatus) Active() bool {
+	return h.active
+}
+
+func (h hostedEngineStatus) Score() int64 {
+	return h.score
+}
+
+func (h hostedEngineStatus) GlobalMaintenance() bool {
+	return h.globalMaintenance
+}
+
+func (h hostedEngineStatus) LocalMaintenance() bool {
+	return h.localMaintenance
+}
+
+func convertSDKHostedEngine(sdkObject *ovirtsdk4.HostedEngine) *hostedEngineStatus {
+	result := &hostedEngineStatus{}
+	result.configured, _ = sdkObject.Configured()
+	result.active, _ = sdkObject.Active()
+	result.score, _ = sdkObject.Score()
+	result.globalMaintenance, _ = sdkObject.GlobalMaintenance()
+	result.localMaintenance, _ = sdkObject.LocalMaintenance()
+	return result
+}
+
+func (o *oVirtClient) ListHostedEngineHosts(retries ...RetryStrategy) (result []Host, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []Host{}
+	err = retry(
+		"listing hosted engine hosts",
+		reading(ResourceTypeHost, ""),
+		o.logger,
+		retries,
+		func() error {
+			// The engine only includes the hosted engine details when asked for all content.
+			response, e := o.conn.
+				SystemService().
+				HostsService().
+				List().
+				AllContent(true).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Hosts()
+			if !ok {
+				return nil
+			}
+			result = []Host{}
+			for i, sdkObject := range sdkObjects.Slice() {
+				item, e := convertSDKHost(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host during listing item #%d", i)
+				}
+				if status := item.HostedEngine(); status != nil && status.Configured() {
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListHostedEngineHosts(_ ...RetryStrategy) ([]Host, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Host{}
+	for _, item := range m.hosts {
+		if item.hostedEngine != nil && item.hostedEngine.configured {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// AllowHostedEngine returns a strategy that lets RemoveVM, StopVM, ShutdownVM and UpdateVM act on the Hosted Engine
+// VM. Calls built on top of these, such as RenameVM and TeardownVMs, are covered as well. Like CorrelationID, it can
+// be passed alongside the retry strategies:
+//
+//	err := client.StopVM(id, false, ovirtclient.AllowHostedEngine())
+//
+// Without it, these calls return an EHostedEngine error for the Hosted Engine VM, since stopping, removing or
+// reconfiguring it takes the oVirt Engine down. Other calls, for example those managing the NICs, disks or tags of the
+// VM, are not checked.
+func AllowHostedEngine() RetryStrategy {
+	return &allowHostedEngineStrategy{}
+}
+
+type allowHostedEngineStrategy struct {
+	callOption
+}
+
+// isHostedEngineAllowed returns true if the retry strategies contain AllowHostedEngine.
+func isHostedEngineAllowed(retries []RetryStrategy) bool {
+	for _, r := range retries {
+		if _, ok := r.(*allowHostedEngineStrategy); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// checkHostedEngineVM fetches the VM and returns an EHostedEngine error if it is the Hosted Engine VM and the retry
+// strategies do not contain AllowHostedEngine.
+func checkHostedEngineVM(client Client, id VMID, operation string, retries []RetryStrategy) error {
+	if isHostedEngineAllowed(retries) {
+		return nil
+	}
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	return validateHostedEngineOperation(vm, operation, retries)
+}
+
+// validateHostedEngineOperation returns an EHostedEngine error if the VM is the Hosted Engine VM and the retry
+// strategies do not contain AllowHostedEngine.
+func validateHostedEngineOperation(vm VMData, operation string, retries []RetryStrategy) error {
+	if !vm.HostedEngine() || isHostedEngineAllowed(retries) {
+		return nil
+	}
+	return newError(
+		EHostedEngine,
+		"refusing to %s VM %s since it is the Hosted Engine VM, pass AllowHostedEngine() to override",
+		operation,
+		vm.ID(),
+	)
+}
@@ -0,0 +1,108 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHostedEngineVMProtection(t *testing.T) {
+	t.Parallel()
+	client, vmID := newMockWithHostedEngine(t)
+
+	vm, err := client.GetVM(vmID)
+	if err != nil {
+		t.Fatalf("Failed to get hosted engine VM %s (%v)", vmID, err)
+	}
+	if !vm.HostedEngine() {
+		t.Fatalf("VM %s is not reported as the hosted engine VM.", vmID)
+	}
+	if err := client.StopVM(vmID, true); !ovirtclient.HasErrorCode(err, ovirtclient.EHostedEngine) {
+		t.Fatalf("Stopping the hosted engine VM did not fail with an EHostedEngine error (%v)", err)
+	}
+	if _, err := client.RenameVM(vmID, "renamed"); !ovirtclient.HasErrorCode(err, ovirtclient.EHostedEngine) {
+		t.Fatalf("Renaming the hosted engine VM did not fail with an EHostedEngine error (%v)", err)
+	}
+	params := ovirtclient.UpdateVMParams().MustWithMemory(2 * 1024 * 1024 * 1024)
+	if _, err := client.UpdateVM(vmID, params); !ovirtclient.HasErrorCode(err, ovirtclient.EHostedEngine) {
+		t.Fatalf("Updating the memory of the hosted engine VM did not fail with an EHostedEngine error (%v)", err)
+	}
+	if err := client.RemoveVM(vmID); !ovirtclient.HasErrorCode(err, ovirtclient.EHostedEngine) {
+		t.Fatalf("Removing the hosted engine VM did not fail with an EHostedEngine error (%v)", err)
+	}
+	tag, err := client.CreateTag("hosted-engine-teardown", nil)
+	if err != nil {
+		t.Fatalf("Failed to create tag (%v)", err)
+	}
+	if err := client.AddTagToVM(vmID, tag.ID()); err != nil {
+		t.Fatalf("Failed to tag the hosted engine VM (%v)", err)
+	}
+	if _, err := client.TeardownVMs([]ovirtclient.VMID{vmID}); !ovirtclient.HasErrorCode(err, ovirtclient.EHostedEngine) {
+		t.Fatalf("Tearing down the hosted engine VM did not fail with an EHostedEngine error (%v)", err)
+	}
+	tags, err := client.ListVMTags(vmID)
+	if err != nil || len(tags) != 1 {
+		t.Fatalf("The tags of the hosted engine VM were changed by the rejected teardown (%v)", err)
+	}
+	if err := client.RemoveVM(vmID, ovirtclient.AllowHostedEngine()); err != nil {
+		t.Fatalf("Removing the hosted engine VM failed even though it was allowed (%v)", err)
+	}
+}
+
+func TestListHostedEngineHosts(t *testing.T) {
+	t.Parallel()
+	client, _ := newMockWithHostedEngine(t)
+
+	hosts, err := client.ListHostedEngineHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosted engine hosts (%v)", err)
+	}
+	if len(hosts) != 1 {
+		t.Fatalf("Incorrect number of hosted engine hosts: %d instead of 1", len(hosts))
+	}
+	status := hosts[0].HostedEngine()
+	if status == nil || !status.Active() || status.Score() != 3400 {
+		t.Fatalf("Incorrect hosted engine status on host %s: %v", hosts[0].ID(), status)
+	}
+}
+
+// newMockWithHostedEngine creates a mock client with a VM and a host marked as the hosted engine by editing a saved
+// state, since the hosted engine cannot be created through the API.
+func newMockWithHostedEngine(t *testing.T) (ovirtclient.MockClient, ovirtclient.VMID) {
+	source := ovirtclient.NewMock()
+	clusters, err := source.ListClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	vm, err := source.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "HostedEngine", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := source.SaveState(buf); err != nil {
+		t.Fatalf("Failed to save mock state (%v)", err)
+	}
+
+	state := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &state); err != nil {
+		t.Fatalf("Failed to decode mock state (%v)", err)
+	}
+	state["vms"].([]interface{})[0].(map[string]interface{})["hosted_engine"] = true
+	state["hosts"].([]interface{})[0].(map[string]interface{})["hosted_engine"] = map[string]interface{}{
+		"configured": true,
+		"active":     true,
+		"score":      3400,
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("Failed to encode mock state (%v)", err)
+	}
+	client, err := ovirtclient.NewMockFromState(bytes.NewReader(data), ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to load mock state (%v)", err)
+	}
+	return client, vm.ID()
+}
@@ -8,10 +8,17 @@ func (o *oVirtClient) GetInstanceType(id InstanceTypeID, retries ...RetryStrateg
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting instance type %s", id),
+		reading("", string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().InstanceTypesService().InstanceTypeService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				InstanceTypesService().
+				InstanceTypeService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
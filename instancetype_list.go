@@ -5,10 +5,16 @@ func (o *oVirtClient) ListInstanceTypes(retries ...RetryStrategy) (result []Inst
 	result = []InstanceType{}
 	err = retry(
 		"listing instance types",
+		reading("", ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().InstanceTypesService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				InstanceTypesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
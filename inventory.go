@@ -0,0 +1,639 @@
+package ovirtclient
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// Inventory is a local store of VMs, hosts and disks that is kept up to date by periodically listing them from the
+// oVirt Engine. Read-heavy components, such as controllers, can use the inventory to read resources without
+// sending an API call for each read.
+//
+// The inventory additionally listens to the mutations made through the client it was created with and refreshes the
+// affected resources immediately, so changes made by the same process are visible before the next resync.
+//
+// Data returned from the inventory may be stale by up to one resync interval for changes made outside the client.
+type Inventory interface {
+	// Start performs the initial synchronization and starts the periodic resync in the background. It returns an
+	// error if the initial synchronization fails.
+	Start(retries ...RetryStrategy) error
+	// Stop stops the periodic resync. The cached data remains readable after Stop.
+	Stop()
+	// Resync immediately lists all resources from the oVirt Engine and updates the store.
+	Resync(retries ...RetryStrategy) error
+	// LastSync returns the time of the last successful full synchronization. It returns the zero time if no sync
+	// has happened yet.
+	LastSync() time.Time
+
+	// ListVMs returns all VMs from the store.
+	ListVMs() []VM
+	// GetVM returns a single VM from the store, or an ENotFound error if the VM is not present.
+	GetVM(id VMID) (VM, error)
+	// ListHosts returns all hosts from the store.
+	ListHosts() []Host
+	// GetHost returns a single host from the store, or an ENotFound error if the host is not present.
+	GetHost(id HostID) (Host, error)
+	// ListDisks returns all disks from the store.
+	ListDisks() []Disk
+	// GetDisk returns a single disk from the store, or an ENotFound error if the disk is not present.
+	GetDisk(id DiskID) (Disk, error)
+
+	// AddEventHandler registers a handler that is called when a resource in the store is added, changed or removed.
+	// The returned function removes the handler. Handlers are called synchronously in the order the changes are
+	// detected, so they should not block for a long time. Handlers may read from the inventory, but must not call
+	// Resync, since that waits for the handlers to return.
+	AddEventHandler(handler InventoryEventHandler) (remove func())
+}
+
+// InventoryEventHandler receives change notifications from the Inventory.
+type InventoryEventHandler func(event InventoryEvent)
+
+// InventoryEvent describes a change in the Inventory.
+type InventoryEvent interface {
+	// ResourceType returns the type of the changed resource. This is one of ResourceTypeVM, ResourceTypeHost, or
+	// ResourceTypeDisk.
+	ResourceType() ResourceType
+	// ResourceID returns the ID of the changed resource.
+	ResourceID() string
+	// MutationType returns the kind of change that has been detected.
+	MutationType() MutationType
+	// Object returns the new version of the resource, or the last known version if it has been removed.
+	Object() interface{}
+}
+
+// InventoryParameters are the optional parameters for NewInventory.
+type InventoryParameters interface {
+	// ResyncInterval returns the time between two full synchronizations.
+	ResyncInterval() time.Duration
+	// Logger returns the logger the inventory logs background sync errors to.
+	Logger() Logger
+}
+
+// BuildableInventoryParameters is a buildable version of InventoryParameters.
+type BuildableInventoryParameters interface {
+	InventoryParameters
+
+	// WithResyncInterval sets the time between two full synchronizations.
+	WithResyncInterval(interval time.Duration) (BuildableInventoryParameters, error)
+	// MustWithResyncInterval is identical to WithResyncInterval, but panics instead of returning an error.
+	MustWithResyncInterval(interval time.Duration) BuildableInventoryParameters
+
+	// WithLogger sets the logger for background sync errors.
+	WithLogger(logger Logger) (BuildableInventoryParameters, error)
+	// MustWithLogger is identical to WithLogger, but panics instead of returning an error.
+	MustWithLogger(logger Logger) BuildableInventoryParameters
+}
+
+// InventoryParams creates a buildable set of InventoryParameters for NewInventory. The default resync interval is
+// 5 minutes and no logging takes place.
+func InventoryParams() BuildableInventoryParameters {
+	return &inventoryParams{
+		resyncInterval: 5 * time.Minute,
+		logger:         ovirtclientlog.NewNOOPLogger(),
+	}
+}
+
+type inventoryParams struct {
+	resyncInterval time.Duration
+	logger         Logger
+}
+
+func (i *inventoryParams) ResyncInterval() time.Duration {
+	return i.resyncInterval
+}
+
+func (i *inventoryParams) Logger() Logger {
+	return i.logger
+}
+
+func (i *inventoryParams) WithResyncInterval(interval time.Duration) (BuildableInventoryParameters, error) {
+	if interval <= 0 {
+		return i, newError(EBadArgument, "the resync interval must be positive (%s given)", interval)
+	}
+	i.resyncInterval = interval
+	return i, nil
+}
+
+func (i *inventoryParams) MustWithResyncInterval(interval time.Duration) BuildableInventoryParameters {
+	builder, err := i.WithResyncInterval(interval)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *inventoryParams) WithLogger(logger Logger) (BuildableInventoryParameters, error) {
+	if logger == nil {
+		return i, newError(EBadArgument, "the logger must not be nil")
+	}
+	i.logger = logger
+	return i, nil
+}
+
+func (i *inventoryParams) MustWithLogger(logger Logger) BuildableInventoryParameters {
+	builder, err := i.WithLogger(logger)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// NewInventory creates a new Inventory backed by the specified client. The inventory does not contain any data until
+// Start or Resync is called. If params is nil, the defaults of InventoryParams() are used.
+func NewInventory(client Client, params InventoryParameters) (Inventory, error) {
+	if client == nil {
+		return nil, newError(EBadArgument, "the client must not be nil")
+	}
+	if params == nil {
+		params = InventoryParams()
+	}
+	return &inventory{
+		client:       client,
+		params:       params,
+		lock:         &sync.Mutex{},
+		dispatchLock: &sync.Mutex{},
+		vms:          map[VMID]VM{},
+		hosts:        map[HostID]Host{},
+		disks:        map[DiskID]Disk{},
+		versions:     map[inventoryKey]uint64{},
+		handlers:     map[uint64]InventoryEventHandler{},
+	}, nil
+}
+
+type inventory struct {
+	client Client
+	params InventoryParameters
+
+	lock *sync.Mutex
+	// dispatchLock is held while delivering queued events, so the events of one change are delivered completely
+	// before those of the next change. It must never be taken while holding lock, since handlers may read the
+	// inventory.
+	dispatchLock *sync.Mutex
+	// queue holds the events waiting for delivery in the order the changes were applied.
+	queue    []inventoryDispatch
+	vms      map[VMID]VM
+	hosts    map[HostID]Host
+	disks    map[DiskID]Disk
+	lastSync time.Time
+	// seq is incremented every time a fetch from the engine starts. It orders the results of concurrent fetches.
+	seq uint64
+	// syncSeq is the sequence number of the last full synchronization applied to the store.
+	syncSeq uint64
+	// versions holds the sequence numbers of single-resource refreshes newer than syncSeq. Results older than the
+	// stored version are dropped.
+	versions       map[inventoryKey]uint64
+	handlers       map[uint64]InventoryEventHandler
+	nextHandlerID  uint64
+	stop           chan struct{}
+	removeListener func()
+}
+
+type inventoryKey struct {
+	resourceType ResourceType
+	id           string
+}
+
+type inventoryDispatch struct {
+	events   []InventoryEvent
+	handlers []InventoryEventHandler
+}
+
+func (i *inventory) Start(retries ...RetryStrategy) error {
+	i.lock.Lock()
+	if i.stop != nil {
+		i.lock.Unlock()
+		return newError(EConflict, "the inventory is already running")
+	}
+	stop := make(chan struct{})
+	i.stop = stop
+	i.lock.Unlock()
+
+	if err := i.Resync(retries...); err != nil {
+		i.lock.Lock()
+		defer i.lock.Unlock()
+		if i.stop == stop {
+			i.stop = nil
+		}
+		return err
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.stop != stop {
+		// Stop has been called during the initial synchronization.
+		return nil
+	}
+	i.removeListener = i.client.AddMutationListener(i.onMutation)
+	go i.run(stop, retries)
+	return nil
+}
+
+func (i *inventory) Stop() {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.stop == nil {
+		return
+	}
+	close(i.stop)
+	i.stop = nil
+	if i.removeListener != nil {
+		i.removeListener()
+		i.removeListener = nil
+	}
+}
+
+func (i *inventory) run(stop chan struct{}, retries []RetryStrategy) {
+	ticker := time.NewTicker(i.params.ResyncInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := i.Resync(retries...); err != nil {
+				i.params.Logger().Warningf("inventory resync failed, serving stale data (%v)", err)
+			}
+		}
+	}
+}
+
+func (i *inventory) Resync(retries ...RetryStrategy) error {
+	seq := i.nextSeq()
+	vms, err := i.client.ListVMs(retries...)
+	if err != nil {
+		return err
+	}
+	hosts, err := i.client.ListHosts(retries...)
+	if err != nil {
+		return err
+	}
+	disks, err := i.client.ListDisks(retries...)
+	if err != nil {
+		return err
+	}
+
+	newVMs := make(map[VMID]VM, len(vms))
+	for _, vm := range vms {
+		newVMs[vm.ID()] = vm
+	}
+	newHosts := make(map[HostID]Host, len(hosts))
+	for _, host := range hosts {
+		newHosts[host.ID()] = host
+	}
+	newDisks := make(map[DiskID]Disk, len(disks))
+	for _, disk := range disks {
+		newDisks[disk.ID()] = disk
+	}
+
+	i.lock.Lock()
+	if seq < i.syncSeq {
+		// A synchronization that started later has already been applied.
+		i.lock.Unlock()
+		return nil
+	}
+	i.keepNewerRefreshes(seq, newVMs, newHosts, newDisks)
+	var events []InventoryEvent
+	events = append(events, diffInventoryVMs(i.vms, newVMs)...)
+	events = append(events, diffInventoryHosts(i.hosts, newHosts)...)
+	events = append(events, diffInventoryDisks(i.disks, newDisks)...)
+	i.vms = newVMs
+	i.hosts = newHosts
+	i.disks = newDisks
+	i.lastSync = time.Now()
+	i.syncSeq = seq
+	i.enqueue(events)
+	i.lock.Unlock()
+	i.dispatch()
+	return nil
+}
+
+// keepNewerRefreshes copies the resources that have been refreshed after the synchronization with the sequence
+// number seq started into the new maps, so the synchronization does not overwrite them with older data. It must be
+// called with the lock held.
+func (i *inventory) keepNewerRefreshes(
+	seq uint64,
+	newVMs map[VMID]VM,
+	newHosts map[HostID]Host,
+	newDisks map[DiskID]Disk,
+) {
+	for key, version := range i.versions {
+		if version < seq {
+			delete(i.versions, key)
+			continue
+		}
+		switch key.resourceType {
+		case ResourceTypeVM:
+			if vm, ok := i.vms[VMID(key.id)]; ok {
+				newVMs[VMID(key.id)] = vm
+			} else {
+				delete(newVMs, VMID(key.id))
+			}
+		case ResourceTypeHost:
+			if host, ok := i.hosts[HostID(key.id)]; ok {
+				newHosts[HostID(key.id)] = host
+			} else {
+				delete(newHosts, HostID(key.id))
+			}
+		case ResourceTypeDisk:
+			if disk, ok := i.disks[DiskID(key.id)]; ok {
+				newDisks[DiskID(key.id)] = disk
+			} else {
+				delete(newDisks, DiskID(key.id))
+			}
+		}
+	}
+}
+
+// nextSeq returns the sequence number for a fetch that is about to start.
+func (i *inventory) nextSeq() uint64 {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.seq++
+	return i.seq
+}
+
+// onMutation refreshes a single resource in the store when the client reports a change. Mutation listeners are
+// called in no particular order, so the result is dropped if the store already holds a newer version.
+func (i *inventory) onMutation(event MutationEvent) {
+	seq := i.nextSeq()
+	switch event.ResourceType() {
+	case ResourceTypeVM:
+		id := VMID(event.ResourceID())
+		vm, err := i.client.GetVM(id)
+		i.refresh(event, seq, err, func() []InventoryEvent {
+			old := map[VMID]VM{}
+			if existing, ok := i.vms[id]; ok {
+				old[id] = existing
+			}
+			current := map[VMID]VM{}
+			if err == nil {
+				current[id] = vm
+				i.vms[id] = vm
+			} else {
+				delete(i.vms, id)
+			}
+			return diffInventoryVMs(old, current)
+		})
+	case ResourceTypeHost:
+		id := HostID(event.ResourceID())
+		host, err := i.client.GetHost(id)
+		i.refresh(event, seq, err, func() []InventoryEvent {
+			old := map[HostID]Host{}
+			if existing, ok := i.hosts[id]; ok {
+				old[id] = existing
+			}
+			current := map[HostID]Host{}
+			if err == nil {
+				current[id] = host
+				i.hosts[id] = host
+			} else {
+				delete(i.hosts, id)
+			}
+			return diffInventoryHosts(old, current)
+		})
+	case ResourceTypeDisk:
+		id := DiskID(event.ResourceID())
+		disk, err := i.client.GetDisk(id)
+		i.refresh(event, seq, err, func() []InventoryEvent {
+			old := map[DiskID]Disk{}
+			if existing, ok := i.disks[id]; ok {
+				old[id] = existing
+			}
+			current := map[DiskID]Disk{}
+			if err == nil {
+				current[id] = disk
+				i.disks[id] = disk
+			} else {
+				delete(i.disks, id)
+			}
+			return diffInventoryDisks(old, current)
+		})
+	}
+}
+
+// refresh applies a single-resource update under the lock unless the store already holds data fetched after seq.
+// Fetch errors other than ENotFound leave the store untouched, the next resync will pick up the change.
+func (i *inventory) refresh(event MutationEvent, seq uint64, err error, apply func() []InventoryEvent) {
+	if err != nil && !HasErrorCode(err, ENotFound) {
+		i.params.Logger().Warningf(
+			"failed to refresh %s %s in inventory (%v)",
+			event.ResourceType(),
+			event.ResourceID(),
+			err,
+		)
+		return
+	}
+	key := inventoryKey{event.ResourceType(), event.ResourceID()}
+	i.lock.Lock()
+	if seq < i.syncSeq || seq < i.versions[key] {
+		i.lock.Unlock()
+		return
+	}
+	i.versions[key] = seq
+	i.enqueue(apply())
+	i.lock.Unlock()
+	i.dispatch()
+}
+
+func (i *inventory) LastSync() time.Time {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return i.lastSync
+}
+
+func (i *inventory) ListVMs() []VM {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	result := make([]VM, 0, len(i.vms))
+	for _, item := range i.vms {
+		result = append(result, item)
+	}
+	return result
+}
+
+func (i *inventory) GetVM(id VMID) (VM, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if item, ok := i.vms[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "VM with ID %s not found in inventory", id)
+}
+
+func (i *inventory) ListHosts() []Host {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	result := make([]Host, 0, len(i.hosts))
+	for _, item := range i.hosts {
+		result = append(result, item)
+	}
+	return result
+}
+
+func (i *inventory) GetHost(id HostID) (Host, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if item, ok := i.hosts[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "host with ID %s not found in inventory", id)
+}
+
+func (i *inventory) ListDisks() []Disk {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	result := make([]Disk, 0, len(i.disks))
+	for _, item := range i.disks {
+		result = append(result, item)
+	}
+	return result
+}
+
+func (i *inventory) GetDisk(id DiskID) (Disk, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if item, ok := i.disks[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "disk with ID %s not found in inventory", id)
+}
+
+func (i *inventory) AddEventHandler(handler InventoryEventHandler) func() {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	id := i.nextHandlerID
+	i.nextHandlerID++
+	i.handlers[id] = handler
+	return func() {
+		i.lock.Lock()
+		defer i.lock.Unlock()
+		delete(i.handlers, id)
+	}
+}
+
+// enqueue adds the events to the delivery queue together with a copy of the current handlers. It must be called
+// with the lock held, dispatch must be called after releasing it.
+func (i *inventory) enqueue(events []InventoryEvent) {
+	if len(events) == 0 {
+		return
+	}
+	ids := make([]uint64, 0, len(i.handlers))
+	for id := range i.handlers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(a, b int) bool {
+		return ids[a] < ids[b]
+	})
+	handlers := make([]InventoryEventHandler, len(ids))
+	for n, id := range ids {
+		handlers[n] = i.handlers[id]
+	}
+	i.queue = append(i.queue, inventoryDispatch{events: events, handlers: handlers})
+}
+
+// dispatch delivers the queued events. When it returns, all events queued before the call have been delivered,
+// either by this call or by a concurrent one. It must be called without holding the lock.
+func (i *inventory) dispatch() {
+	i.dispatchLock.Lock()
+	defer i.dispatchLock.Unlock()
+	for {
+		i.lock.Lock()
+		queue := i.queue
+		i.queue = nil
+		i.lock.Unlock()
+		if len(queue) == 0 {
+			return
+		}
+		for _, item := range queue {
+			for _, event := range item.events {
+				for _, handler := range item.handlers {
+					handler(event)
+				}
+			}
+		}
+	}
+}
+
+func diffInventoryVMs(old map[VMID]VM, current map[VMID]VM) []InventoryEvent {
+	var events []InventoryEvent
+	for id, item := range current {
+		events = appendInventoryDiff(events, ResourceTypeVM, string(id), old[id], item, old[id] != nil)
+	}
+	for id, item := range old {
+		if _, ok := current[id]; !ok {
+			events = append(events, inventoryEvent{ResourceTypeVM, string(id), MutationTypeRemoved, item})
+		}
+	}
+	return events
+}
+
+func diffInventoryHosts(old map[HostID]Host, current map[HostID]Host) []InventoryEvent {
+	var events []InventoryEvent
+	for id, item := range current {
+		events = appendInventoryDiff(events, ResourceTypeHost, string(id), old[id], item, old[id] != nil)
+	}
+	for id, item := range old {
+		if _, ok := current[id]; !ok {
+			events = append(events, inventoryEvent{ResourceTypeHost, string(id), MutationTypeRemoved, item})
+		}
+	}
+	return events
+}
+
+func diffInventoryDisks(old map[DiskID]Disk, current map[DiskID]Disk) []InventoryEvent {
+	var events []InventoryEvent
+	for id, item := range current {
+		events = appendInventoryDiff(events, ResourceTypeDisk, string(id), old[id], item, old[id] != nil)
+	}
+	for id, item := range old {
+		if _, ok := current[id]; !ok {
+			events = append(events, inventoryEvent{ResourceTypeDisk, string(id), MutationTypeRemoved, item})
+		}
+	}
+	return events
+}
+
+func appendInventoryDiff(
+	events []InventoryEvent,
+	resourceType ResourceType,
+	id string,
+	old interface{},
+	current interface{},
+	existed bool,
+) []InventoryEvent {
+	switch {
+	case !existed:
+		return append(events, inventoryEvent{resourceType, id, MutationTypeCreated, current})
+	case !reflect.DeepEqual(resourceSnapshot(old), resourceSnapshot(current)):
+		return append(events, inventoryEvent{resourceType, id, MutationTypeUpdated, current})
+	default:
+		return events
+	}
+}
+
+type inventoryEvent struct {
+	resourceType ResourceType
+	resourceID   string
+	mutationType MutationType
+	object       interface{}
+}
+
+func (i inventoryEvent) ResourceType() ResourceType {
+	return i.resourceType
+}
+
+func (i inventoryEvent) ResourceID() string {
+	return i.resourceID
+}
+
+func (i inventoryEvent) MutationType() MutationType {
+	return i.mutationType
+}
+
+func (i inventoryEvent) Object() interface{} {
+	return i.object
+}
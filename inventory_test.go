@@ -0,0 +1,222 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestInventoryTracksDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	inventory, err := ovirtclient.NewInventory(
+		client,
+		ovirtclient.InventoryParams().
+			MustWithResyncInterval(time.Minute).
+			MustWithLogger(ovirtclientlog.NewTestLogger(t)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create inventory (%v)", err)
+	}
+	events := make(chan ovirtclient.InventoryEvent, 100)
+	removeHandler := inventory.AddEventHandler(func(event ovirtclient.InventoryEvent) {
+		if event.ResourceType() == ovirtclient.ResourceTypeDisk {
+			events <- event
+		}
+	})
+	defer removeHandler()
+
+	if err := inventory.Start(); err != nil {
+		t.Fatalf("Failed to start inventory (%v)", err)
+	}
+	defer inventory.Stop()
+	if inventory.LastSync().IsZero() {
+		t.Fatalf("Inventory has not recorded a sync after start.")
+	}
+	if len(inventory.ListHosts()) == 0 {
+		t.Fatalf("No hosts in the inventory after the initial sync.")
+	}
+
+	disk := assertCanCreateDisk(t, helper)
+	assertInventoryEvent(t, events, string(disk.ID()), ovirtclient.MutationTypeCreated)
+	if _, err := inventory.GetDisk(disk.ID()); err != nil {
+		t.Fatalf("Created disk %s not found in inventory (%v)", disk.ID(), err)
+	}
+
+	if err := disk.Remove(); err != nil {
+		t.Fatalf("Failed to remove disk %s (%v)", disk.ID(), err)
+	}
+	if err := inventory.Resync(); err != nil {
+		t.Fatalf("Failed to resync inventory (%v)", err)
+	}
+	assertInventoryEvent(t, events, string(disk.ID()), ovirtclient.MutationTypeRemoved)
+	if _, err := inventory.GetDisk(disk.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Removed disk %s still in inventory (%v)", disk.ID(), err)
+	}
+}
+
+func TestInventoryTracksHostMutations(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Changing the power management of a live host is disruptive, only running this test against the mock.")
+	}
+
+	inventory, err := ovirtclient.NewInventory(
+		client,
+		ovirtclient.InventoryParams().
+			MustWithResyncInterval(time.Hour).
+			MustWithLogger(ovirtclientlog.NewTestLogger(t)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create inventory (%v)", err)
+	}
+	events := make(chan ovirtclient.InventoryEvent, 100)
+	removeHandler := inventory.AddEventHandler(func(event ovirtclient.InventoryEvent) {
+		if event.ResourceType() == ovirtclient.ResourceTypeHost {
+			events <- event
+		}
+	})
+	defer removeHandler()
+
+	if err := inventory.Start(); err != nil {
+		t.Fatalf("Failed to start inventory (%v)", err)
+	}
+	defer inventory.Stop()
+	hosts := inventory.ListHosts()
+	if len(hosts) == 0 {
+		t.Fatalf("No hosts in the inventory after the initial sync.")
+	}
+	host := hosts[0]
+
+	agent, err := client.AddHostFenceAgent(
+		host.ID(),
+		ovirtclient.MustHostFenceAgentParams("ipmilan", "192.0.2.10", "admin", "secret"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to add fence agent to host %s (%v)", host.ID(), err)
+	}
+	if err := client.SetHostPowerManagement(host.ID(), true); err != nil {
+		t.Fatalf("Failed to enable power management on host %s (%v)", host.ID(), err)
+	}
+	assertInventoryEvent(t, events, string(host.ID()), ovirtclient.MutationTypeUpdated)
+	updatedHost, err := inventory.GetHost(host.ID())
+	if err != nil {
+		t.Fatalf("Host %s not found in inventory (%v)", host.ID(), err)
+	}
+	if !updatedHost.PowerManagementEnabled() {
+		t.Fatalf("The inventory did not refresh host %s after enabling power management.", host.ID())
+	}
+
+	if err := client.SetHostPowerManagement(host.ID(), false); err != nil {
+		t.Fatalf("Failed to disable power management on host %s (%v)", host.ID(), err)
+	}
+	if err := agent.Remove(); err != nil {
+		t.Fatalf("Failed to remove fence agent %s (%v)", agent.ID(), err)
+	}
+}
+
+func assertInventoryEvent(
+	t *testing.T,
+	events <-chan ovirtclient.InventoryEvent,
+	resourceID string,
+	mutationType ovirtclient.MutationType,
+) {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.ResourceID() == resourceID && event.MutationType() == mutationType {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("No %s inventory event received for resource %s.", mutationType, resourceID)
+		}
+	}
+}
+
+func TestInventoryHandlerCanReadInventory(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	inventory, err := ovirtclient.NewInventory(
+		client,
+		ovirtclient.InventoryParams().
+			MustWithResyncInterval(time.Minute).
+			MustWithLogger(ovirtclientlog.NewTestLogger(t)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create inventory (%v)", err)
+	}
+	found := make(chan error, 100)
+	removeHandler := inventory.AddEventHandler(func(event ovirtclient.InventoryEvent) {
+		if event.ResourceType() != ovirtclient.ResourceTypeDisk || event.MutationType() != ovirtclient.MutationTypeCreated {
+			return
+		}
+		_, err := inventory.GetDisk(ovirtclient.DiskID(event.ResourceID()))
+		found <- err
+	})
+	defer removeHandler()
+
+	if err := inventory.Start(); err != nil {
+		t.Fatalf("Failed to start inventory (%v)", err)
+	}
+	defer inventory.Stop()
+
+	disk := assertCanCreateDisk(t, helper)
+	select {
+	case err := <-found:
+		if err != nil {
+			t.Fatalf("Handler failed to read disk %s from the inventory (%v)", disk.ID(), err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Handler did not return, calling the inventory from a handler probably deadlocked.")
+	}
+}
+
+func TestInventoryConcurrentResyncWithReadingHandler(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	inventory, err := ovirtclient.NewInventory(
+		client,
+		ovirtclient.InventoryParams().
+			MustWithLogger(ovirtclientlog.NewTestLogger(t)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create inventory (%v)", err)
+	}
+	removeHandler := inventory.AddEventHandler(func(event ovirtclient.InventoryEvent) {
+		// Give the other resyncs time to apply their changes while this handler is running.
+		time.Sleep(10 * time.Millisecond)
+		_ = inventory.ListVMs()
+	})
+	defer removeHandler()
+
+	const resyncs = 4
+	done := make(chan error, resyncs)
+	for n := 0; n < resyncs; n++ {
+		go func() {
+			done <- inventory.Resync()
+		}()
+	}
+	timeout := time.After(time.Minute)
+	for n := 0; n < resyncs; n++ {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Failed to resync inventory (%v)", err)
+			}
+		case <-timeout:
+			t.Fatalf("Concurrent resyncs did not return, the inventory probably deadlocked.")
+		}
+	}
+}
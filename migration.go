@@ -0,0 +1,913 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// MigrationClient contains the methods to tune live migrations. The settings of a cluster apply to all VMs in it,
+// the settings of a VM override them for that VM. Large or busy VMs often only converge with a policy that allows a
+// longer downtime, auto-converge or post-copy, and with a dedicated migration network.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#sect-Migration_Policies for details.
+type MigrationClient interface {
+	// GetClusterMigrationSettings returns the migration settings of a cluster, including its migration network.
+	GetClusterMigrationSettings(clusterID ClusterID, retries ...RetryStrategy) (ClusterMigrationSettings, error)
+	// UpdateClusterMigrationSettings changes the migration settings of a cluster and returns the new settings.
+	UpdateClusterMigrationSettings(
+		clusterID ClusterID,
+		params ClusterMigrationParameters,
+		retries ...RetryStrategy,
+	) (ClusterMigrationSettings, error)
+	// GetVMMigrationSettings returns the migration settings a VM overrides.
+	GetVMMigrationSettings(vmID VMID, retries ...RetryStrategy) (VMMigrationSettings, error)
+	// UpdateVMMigrationSettings changes the migration settings a VM overrides and returns the new settings.
+	UpdateVMMigrationSettings(
+		vmID VMID,
+		params VMMigrationParameters,
+		retries ...RetryStrategy,
+	) (VMMigrationSettings, error)
+}
+
+// MigrationPolicyID is the identifier of a migration policy. The engine ships the policies listed as constants, which
+// have the same ID in every installation.
+type MigrationPolicyID string
+
+const (
+	// MigrationPolicyLegacy uses the behavior of oVirt 3.6. It is not recommended.
+	MigrationPolicyLegacy MigrationPolicyID = "00000000-0000-0000-0000-000000000000"
+	// MigrationPolicyMinimalDowntime migrates the VM with a low downtime and aborts the migration if it does not
+	// converge. This is the default for new clusters.
+	MigrationPolicyMinimalDowntime MigrationPolicyID = "80554327-0569-496b-bdeb-fcbbf52b827b"
+	// MigrationPolicyPostCopy is like MigrationPolicyMinimalDowntime, but switches to post-copy migration if the
+	// migration does not converge. The VM then runs on the destination host and fetches its remaining memory from
+	// the source host, so the migration always finishes, but the VM is lost if the network fails in this phase.
+	MigrationPolicyPostCopy MigrationPolicyID = "a7aeedb2-8d66-4e51-bb22-32595027ce71"
+	// MigrationPolicySuspendWorkload allows a longer downtime, so VMs with a high memory write rate also migrate.
+	MigrationPolicySuspendWorkload MigrationPolicyID = "80554327-0569-496b-bdeb-fcbbf52b827c"
+)
+
+// MigrationPolicyIDValues returns the IDs of the migration policies the engine ships.
+func MigrationPolicyIDValues() []MigrationPolicyID {
+	return []MigrationPolicyID{
+		MigrationPolicyLegacy,
+		MigrationPolicyMinimalDowntime,
+		MigrationPolicyPostCopy,
+		MigrationPolicySuspendWorkload,
+	}
+}
+
+// MigrationBandwidthMethod describes how the bandwidth limit of migrations is determined.
+type MigrationBandwidthMethod string
+
+const (
+	// MigrationBandwidthMethodAuto derives the limit from the rate limit of the QoS of the migration network, or from
+	// the link speed of the network interfaces if it has none.
+	MigrationBandwidthMethodAuto MigrationBandwidthMethod = "auto"
+	// MigrationBandwidthMethodHypervisorDefault uses the limit configured in VDSM on the hosts.
+	MigrationBandwidthMethodHypervisorDefault MigrationBandwidthMethod = "hypervisor_default"
+	// MigrationBandwidthMethodCustom uses the limit set with WithBandwidthLimit.
+	MigrationBandwidthMethodCustom MigrationBandwidthMethod = "custom"
+)
+
+// Validate returns an error if the bandwidth method is not a valid value.
+func (m MigrationBandwidthMethod) Validate() error {
+	for _, method := range MigrationBandwidthMethodValues() {
+		if method == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid migration bandwidth method: %s must be one of: %v",
+		m,
+		MigrationBandwidthMethodValues(),
+	)
+}
+
+// MigrationBandwidthMethodList is a list of MigrationBandwidthMethod values.
+type MigrationBandwidthMethodList []MigrationBandwidthMethod
+
+// Strings creates a string list of the values.
+func (l MigrationBandwidthMethodList) Strings() []string {
+	result := make([]string, len(l))
+	for i, method := range l {
+		result[i] = string(method)
+	}
+	return result
+}
+
+// MigrationBandwidthMethodValues returns all possible MigrationBandwidthMethod values.
+func MigrationBandwidthMethodValues() MigrationBandwidthMethodList {
+	return []MigrationBandwidthMethod{
+		MigrationBandwidthMethodAuto,
+		MigrationBandwidthMethodHypervisorDefault,
+		MigrationBandwidthMethodCustom,
+	}
+}
+
+// ClusterMigrationSettings are the migration settings of a cluster.
+type ClusterMigrationSettings interface {
+	// PolicyID returns the ID of the migration policy of the cluster.
+	PolicyID() MigrationPolicyID
+	// BandwidthMethod returns how the bandwidth limit of migrations is determined.
+	BandwidthMethod() MigrationBandwidthMethod
+	// BandwidthLimit returns the bandwidth limit in Mbps if the bandwidth method is MigrationBandwidthMethodCustom,
+	// nil otherwise.
+	BandwidthLimit() *uint
+	// AutoConverge returns if the hypervisor throttles the CPUs of the VM when the migration does not converge. It
+	// is nil if the cluster uses the global default of the engine.
+	AutoConverge() *bool
+	// Compressed returns if the migration traffic is compressed. It is nil if the cluster uses the global default of
+	// the engine.
+	Compressed() *bool
+	// NetworkID returns the ID of the network the migration traffic uses, or nil if the engine did not report a
+	// migration network, in which case the management network is used.
+	NetworkID() *NetworkID
+}
+
+// VMMigrationSettings are the migration settings a VM overrides. Settings that are not overridden use the values of
+// the cluster.
+type VMMigrationSettings interface {
+	// PolicyID returns the ID of the migration policy of the VM, or nil if it uses the policy of the cluster.
+	PolicyID() *MigrationPolicyID
+	// AutoConverge returns if the hypervisor throttles the CPUs of the VM when the migration does not converge, or
+	// nil if the VM uses the cluster setting.
+	AutoConverge() *bool
+	// Compressed returns if the migration traffic is compressed, or nil if the VM uses the cluster setting.
+	Compressed() *bool
+	// Downtime returns the maximum downtime of the VM when switching to the destination host, or nil if the VM uses
+	// the downtime of the migration policy.
+	Downtime() *time.Duration
+}
+
+// ClusterMigrationParameters contains the changes to the migration settings of a cluster. Values that are nil are
+// left unchanged.
+type ClusterMigrationParameters interface {
+	// PolicyID returns the ID of the new migration policy.
+	PolicyID() *MigrationPolicyID
+	// BandwidthMethod returns the new bandwidth method.
+	BandwidthMethod() *MigrationBandwidthMethod
+	// BandwidthLimit returns the new bandwidth limit in Mbps. It is only set with MigrationBandwidthMethodCustom.
+	BandwidthLimit() *uint
+	// AutoConverge returns the new auto-converge setting.
+	AutoConverge() *bool
+	// Compressed returns the new compression setting.
+	Compressed() *bool
+	// NetworkID returns the ID of the new migration network.
+	NetworkID() *NetworkID
+}
+
+// BuildableClusterMigrationParameters is a buildable version of ClusterMigrationParameters.
+type BuildableClusterMigrationParameters interface {
+	ClusterMigrationParameters
+
+	// WithPolicyID sets the migration policy, for example MigrationPolicyPostCopy.
+	WithPolicyID(id MigrationPolicyID) (BuildableClusterMigrationParameters, error)
+	// MustWithPolicyID is identical to WithPolicyID, but panics instead of returning an error.
+	MustWithPolicyID(id MigrationPolicyID) BuildableClusterMigrationParameters
+
+	// WithBandwidthMethod sets how the bandwidth limit is determined. Use WithBandwidthLimit to set a custom limit.
+	WithBandwidthMethod(method MigrationBandwidthMethod) (BuildableClusterMigrationParameters, error)
+	// MustWithBandwidthMethod is identical to WithBandwidthMethod, but panics instead of returning an error.
+	MustWithBandwidthMethod(method MigrationBandwidthMethod) BuildableClusterMigrationParameters
+
+	// WithBandwidthLimit sets a custom bandwidth limit for migrations in Mbps.
+	WithBandwidthLimit(mbps uint) (BuildableClusterMigrationParameters, error)
+	// MustWithBandwidthLimit is identical to WithBandwidthLimit, but panics instead of returning an error.
+	MustWithBandwidthLimit(mbps uint) BuildableClusterMigrationParameters
+
+	// WithAutoConverge enables or disables auto-converge.
+	WithAutoConverge(autoConverge bool) BuildableClusterMigrationParameters
+	// WithCompressed enables or disables the compression of the migration traffic.
+	WithCompressed(compressed bool) BuildableClusterMigrationParameters
+
+	// WithNetworkID sets the migration network. The network must be assigned to the cluster.
+	WithNetworkID(id NetworkID) (BuildableClusterMigrationParameters, error)
+	// MustWithNetworkID is identical to WithNetworkID, but panics instead of returning an error.
+	MustWithNetworkID(id NetworkID) BuildableClusterMigrationParameters
+}
+
+// ClusterMigrationParams creates a buildable set of changes to the migration settings of a cluster.
+func ClusterMigrationParams() BuildableClusterMigrationParameters {
+	return &clusterMigrationParams{}
+}
+
+type clusterMigrationParams struct {
+	policyID        *MigrationPolicyID
+	bandwidthMethod *MigrationBandwidthMethod
+	bandwidthLimit  *uint
+	autoConverge    *bool
+	compressed      *bool
+	networkID       *NetworkID
+}
+
+func (c *clusterMigrationParams) PolicyID() *MigrationPolicyID {
+	return c.policyID
+}
+
+func (c *clusterMigrationParams) BandwidthMethod() *MigrationBandwidthMethod {
+	return c.bandwidthMethod
+}
+
+func (c *clusterMigrationParams) BandwidthLimit() *uint {
+	return c.bandwidthLimit
+}
+
+func (c *clusterMigrationParams) AutoConverge() *bool {
+	return c.autoConverge
+}
+
+func (c *clusterMigrationParams) Compressed() *bool {
+	return c.compressed
+}
+
+func (c *clusterMigrationParams) NetworkID() *NetworkID {
+	return c.networkID
+}
+
+func (c *clusterMigrationParams) WithPolicyID(id MigrationPolicyID) (BuildableClusterMigrationParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the migration policy ID cannot be empty")
+	}
+	c.policyID = &id
+	return c, nil
+}
+
+func (c *clusterMigrationParams) MustWithPolicyID(id MigrationPolicyID) BuildableClusterMigrationParameters {
+	builder, err := c.WithPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterMigrationParams) WithBandwidthMethod(
+	method MigrationBandwidthMethod,
+) (BuildableClusterMigrationParameters, error) {
+	if err := method.Validate(); err != nil {
+		return nil, err
+	}
+	if method == MigrationBandwidthMethodCustom {
+		return nil, newError(EBadArgument, "use WithBandwidthLimit to set a custom migration bandwidth limit")
+	}
+	c.bandwidthMethod = &method
+	c.bandwidthLimit = nil
+	return c, nil
+}
+
+func (c *clusterMigrationParams) MustWithBandwidthMethod(
+	method MigrationBandwidthMethod,
+) BuildableClusterMigrationParameters {
+	builder, err := c.WithBandwidthMethod(method)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterMigrationParams) WithBandwidthLimit(mbps uint) (BuildableClusterMigrationParameters, error) {
+	if mbps == 0 {
+		return nil, newError(EBadArgument, "the migration bandwidth limit must be positive")
+	}
+	method := MigrationBandwidthMethodCustom
+	c.bandwidthMethod = &method
+	c.bandwidthLimit = &mbps
+	return c, nil
+}
+
+func (c *clusterMigrationParams) MustWithBandwidthLimit(mbps uint) BuildableClusterMigrationParameters {
+	builder, err := c.WithBandwidthLimit(mbps)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterMigrationParams) WithAutoConverge(autoConverge bool) BuildableClusterMigrationParameters {
+	c.autoConverge = &autoConverge
+	return c
+}
+
+func (c *clusterMigrationParams) WithCompressed(compressed bool) BuildableClusterMigrationParameters {
+	c.compressed = &compressed
+	return c
+}
+
+func (c *clusterMigrationParams) WithNetworkID(id NetworkID) (BuildableClusterMigrationParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the migration network ID cannot be empty")
+	}
+	c.networkID = &id
+	return c, nil
+}
+
+func (c *clusterMigrationParams) MustWithNetworkID(id NetworkID) BuildableClusterMigrationParameters {
+	builder, err := c.WithNetworkID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// VMMigrationParameters contains the changes to the migration settings of a VM. Values that are nil are left
+// unchanged.
+type VMMigrationParameters interface {
+	// PolicyID returns the ID of the new migration policy of the VM.
+	PolicyID() *MigrationPolicyID
+	// AutoConverge returns the new auto-converge setting of the VM.
+	AutoConverge() *bool
+	// Compressed returns the new compression setting of the VM.
+	Compressed() *bool
+	// Downtime returns the new maximum downtime of the VM.
+	Downtime() *time.Duration
+}
+
+// BuildableVMMigrationParameters is a buildable version of VMMigrationParameters.
+type BuildableVMMigrationParameters interface {
+	VMMigrationParameters
+
+	// WithPolicyID sets the migration policy of the VM, overriding the policy of the cluster.
+	WithPolicyID(id MigrationPolicyID) (BuildableVMMigrationParameters, error)
+	// MustWithPolicyID is identical to WithPolicyID, but panics instead of returning an error.
+	MustWithPolicyID(id MigrationPolicyID) BuildableVMMigrationParameters
+
+	// WithAutoConverge enables or disables auto-converge for the VM.
+	WithAutoConverge(autoConverge bool) BuildableVMMigrationParameters
+	// WithCompressed enables or disables the compression of the migration traffic of the VM.
+	WithCompressed(compressed bool) BuildableVMMigrationParameters
+
+	// WithDowntime sets the maximum downtime of the VM when switching to the destination host. The downtime is
+	// rounded down to milliseconds.
+	WithDowntime(downtime time.Duration) (BuildableVMMigrationParameters, error)
+	// MustWithDowntime is identical to WithDowntime, but panics instead of returning an error.
+	MustWithDowntime(downtime time.Duration) BuildableVMMigrationParameters
+}
+
+// VMMigrationParams creates a buildable set of changes to the migration settings of a VM.
+func VMMigrationParams() BuildableVMMigrationParameters {
+	return &vmMigrationParams{}
+}
+
+type vmMigrationParams struct {
+	policyID     *MigrationPolicyID
+	autoConverge *bool
+	compressed   *bool
+	downtime     *time.Duration
+}
+
+func (v *vmMigrationParams) PolicyID() *MigrationPolicyID {
+	return v.policyID
+}
+
+func (v *vmMigrationParams) AutoConverge() *bool {
+	return v.autoConverge
+}
+
+func (v *vmMigrationParams) Compressed() *bool {
+	return v.compressed
+}
+
+func (v *vmMigrationParams) Downtime() *time.Duration {
+	return v.downtime
+}
+
+func (v *vmMigrationParams) WithPolicyID(id MigrationPolicyID) (BuildableVMMigrationParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the migration policy ID cannot be empty")
+	}
+	v.policyID = &id
+	return v, nil
+}
+
+func (v *vmMigrationParams) MustWithPolicyID(id MigrationPolicyID) BuildableVMMigrationParameters {
+	builder, err := v.WithPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmMigrationParams) WithAutoConverge(autoConverge bool) BuildableVMMigrationParameters {
+	v.autoConverge = &autoConverge
+	return v
+}
+
+func (v *vmMigrationParams) WithCompressed(compressed bool) BuildableVMMigrationParameters {
+	v.compressed = &compressed
+	return v
+}
+
+func (v *vmMigrationParams) WithDowntime(downtime time.Duration) (BuildableVMMigrationParameters, error) {
+	if downtime < time.Millisecond {
+		return nil, newError(EBadArgument, "the migration downtime must be at least 1ms, %s given", downtime)
+	}
+	downtime = downtime.Truncate(time.Millisecond)
+	v.downtime = &downtime
+	return v, nil
+}
+
+func (v *vmMigrationParams) MustWithDowntime(downtime time.Duration) BuildableVMMigrationParameters {
+	builder, err := v.WithDowntime(downtime)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type clusterMigrationSettings struct {
+	policyID        MigrationPolicyID
+	bandwidthMethod MigrationBandwidthMethod
+	bandwidthLimit  *uint
+	autoConverge    *bool
+	compressed      *bool
+	networkID       *NetworkID
+}
+
+func (c *clusterMigrationSettings) PolicyID() MigrationPolicyID {
+	return c.policyID
+}
+
+func (c *clusterMigrationSettings) BandwidthMethod() MigrationBandwidthMethod {
+	return c.bandwidthMethod
+}
+
+func (c *clusterMigrationSettings) BandwidthLimit() *uint {
+	return c.bandwidthLimit
+}
+
+func (c *clusterMigrationSettings) AutoConverge() *bool {
+	return c.autoConverge
+}
+
+func (c *clusterMigrationSettings) Compressed() *bool {
+	return c.compressed
+}
+
+func (c *clusterMigrationSettings) NetworkID() *NetworkID {
+	return c.networkID
+}
+
+type vmMigrationSettings struct {
+	policyID     *MigrationPolicyID
+	autoConverge *bool
+	compressed   *bool
+	downtime     *time.Duration
+}
+
+func (v *vmMigrationSettings) PolicyID() *MigrationPolicyID {
+	return v.policyID
+}
+
+func (v *vmMigrationSettings) AutoConverge() *bool {
+	return v.autoConverge
+}
+
+func (v *vmMigrationSettings) Compressed() *bool {
+	return v.compressed
+}
+
+func (v *vmMigrationSettings) Downtime() *time.Duration {
+	return v.downtime
+}
+
+func convertSDKInheritableBoolean(value ovirtsdk4.InheritableBoolean, ok bool) *bool {
+	if !ok || value == ovirtsdk4.INHERITABLEBOOLEAN_INHERIT {
+		return nil
+	}
+	result := value == ovirtsdk4.INHERITABLEBOOLEAN_TRUE
+	return &result
+}
+
+func buildSDKInheritableBoolean(value bool) ovirtsdk4.InheritableBoolean {
+	if value {
+		return ovirtsdk4.INHERITABLEBOOLEAN_TRUE
+	}
+	return ovirtsdk4.INHERITABLEBOOLEAN_FALSE
+}
+
+func convertSDKClusterMigrationSettings(
+	sdkCluster *ovirtsdk4.Cluster,
+	sdkNetworks []*ovirtsdk4.Network,
+) *clusterMigrationSettings {
+	result := &clusterMigrationSettings{
+		bandwidthMethod: MigrationBandwidthMethodAuto,
+	}
+	if options, ok := sdkCluster.Migration(); ok {
+		if policy, ok := options.Policy(); ok {
+			if id, ok := policy.Id(); ok {
+				result.policyID = MigrationPolicyID(id)
+			}
+		}
+		if bandwidth, ok := options.Bandwidth(); ok {
+			if method, ok := bandwidth.AssignmentMethod(); ok {
+				result.bandwidthMethod = MigrationBandwidthMethod(method)
+			}
+			if limit, ok := bandwidth.CustomValue(); ok && result.bandwidthMethod == MigrationBandwidthMethodCustom {
+				l := uint(limit)
+				result.bandwidthLimit = &l
+			}
+		}
+		result.autoConverge = convertSDKInheritableBoolean(options.AutoConverge())
+		result.compressed = convertSDKInheritableBoolean(options.Compressed())
+	}
+	for _, sdkNetwork := range sdkNetworks {
+		usages, _ := sdkNetwork.Usages()
+		for _, usage := range usages {
+			if usage != ovirtsdk4.NETWORKUSAGE_MIGRATION {
+				continue
+			}
+			if id, ok := sdkNetwork.Id(); ok {
+				networkID := NetworkID(id)
+				result.networkID = &networkID
+			}
+		}
+	}
+	return result
+}
+
+func convertSDKVMMigrationSettings(sdkVM *ovirtsdk4.Vm) *vmMigrationSettings {
+	result := &vmMigrationSettings{}
+	if options, ok := sdkVM.Migration(); ok {
+		if policy, ok := options.Policy(); ok {
+			if id, ok := policy.Id(); ok && id != "" {
+				policyID := MigrationPolicyID(id)
+				result.policyID = &policyID
+			}
+		}
+		result.autoConverge = convertSDKInheritableBoolean(options.AutoConverge())
+		result.compressed = convertSDKInheritableBoolean(options.Compressed())
+	}
+	if downtime, ok := sdkVM.MigrationDowntime(); ok && downtime > 0 {
+		d := time.Duration(downtime) * time.Millisecond
+		result.downtime = &d
+	}
+	return result
+}
+
+func (o *oVirtClient) GetClusterMigrationSettings(
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) (result ClusterMigrationSettings, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting migration settings of cluster %s", clusterID),
+		reading(ResourceTypeCluster, string(clusterID)),
+		o.logger,
+		retries,
+		func() error {
+			clusterService := o.conn.SystemService().ClustersService().ClusterService(string(clusterID))
+			response, err := clusterService.Get().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkCluster, ok := response.Cluster()
+			if !ok {
+				return newError(ENotFound, "no cluster returned when getting cluster %s", clusterID)
+			}
+			networksResponse, err := clusterService.
+				NetworksService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			var sdkNetworks []*ovirtsdk4.Network
+			if networks, ok := networksResponse.Networks(); ok {
+				sdkNetworks = networks.Slice()
+			}
+			result = convertSDKClusterMigrationSettings(sdkCluster, sdkNetworks)
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) UpdateClusterMigrationSettings(
+	clusterID ClusterID,
+	params ClusterMigrationParameters,
+	retries ...RetryStrategy,
+) (ClusterMigrationSettings, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating the migration settings of %s", clusterID)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	options := ovirtsdk4.NewMigrationOptionsBuilder()
+	changed := false
+	if policyID := params.PolicyID(); policyID != nil {
+		options.Policy(ovirtsdk4.NewMigrationPolicyBuilder().Id(string(*policyID)).MustBuild())
+		changed = true
+	}
+	if method := params.BandwidthMethod(); method != nil {
+		bandwidth := ovirtsdk4.NewMigrationBandwidthBuilder().
+			AssignmentMethod(ovirtsdk4.MigrationBandwidthAssignmentMethod(*method))
+		if limit := params.BandwidthLimit(); limit != nil {
+			bandwidth.CustomValue(int64(*limit))
+		}
+		options.Bandwidth(bandwidth.MustBuild())
+		changed = true
+	}
+	if autoConverge := params.AutoConverge(); autoConverge != nil {
+		options.AutoConverge(buildSDKInheritableBoolean(*autoConverge))
+		changed = true
+	}
+	if compressed := params.Compressed(); compressed != nil {
+		options.Compressed(buildSDKInheritableBoolean(*compressed))
+		changed = true
+	}
+	clusterService := o.conn.SystemService().ClustersService().ClusterService(string(clusterID))
+	if changed {
+		sdkCluster, err := ovirtsdk4.NewClusterBuilder().Migration(options.MustBuild()).Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build migration settings for cluster %s", clusterID)
+		}
+		if err := retry(
+			fmt.Sprintf("updating migration settings of cluster %s", clusterID),
+			mutating(ResourceTypeCluster, string(clusterID)),
+			o.logger,
+			retries,
+			func() error {
+				_, err := clusterService.Update().Cluster(sdkCluster).Query("correlation_id", requestCorrelationID(retries)).Send()
+				return err
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if networkID := params.NetworkID(); networkID != nil {
+		if err := retry(
+			fmt.Sprintf("setting network %s as the migration network of cluster %s", *networkID, clusterID),
+			mutating(ResourceTypeCluster, string(clusterID)).
+				withParameters(map[string]string{
+					"network_id": string(*networkID),
+				}),
+			o.logger,
+			retries,
+			func() error {
+				return o.setClusterMigrationNetwork(
+					clusterService.NetworksService().NetworkService(string(*networkID)),
+					retries,
+				)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	o.mutationListeners.notify(ResourceTypeCluster, string(clusterID), "", MutationTypeUpdated)
+	return o.GetClusterMigrationSettings(clusterID, retries...)
+}
+
+// setClusterMigrationNetwork adds the migration role to a network of a cluster, keeping its other roles. The engine
+// removes the role from the previous migration network.
+func (o *oVirtClient) setClusterMigrationNetwork(
+	networkService *ovirtsdk4.ClusterNetworkService,
+	retries []RetryStrategy,
+) error {
+	response, err := networkService.Get().Query("correlation_id", requestCorrelationID(retries)).Send()
+	if err != nil {
+		return err
+	}
+	sdkNetwork, ok := response.Network()
+	if !ok {
+		return newFieldNotFound("cluster network response", "network")
+	}
+	usages, _ := sdkNetwork.Usages()
+	for _, usage := range usages {
+		if usage == ovirtsdk4.NETWORKUSAGE_MIGRATION {
+			return nil
+		}
+	}
+	update, err := ovirtsdk4.NewNetworkBuilder().Usages(append(usages, ovirtsdk4.NETWORKUSAGE_MIGRATION)).Build()
+	if err != nil {
+		return wrap(err, EBug, "failed to build network usages")
+	}
+	_, err = networkService.Update().Network(update).Query("correlation_id", requestCorrelationID(retries)).Send()
+	return err
+}
+
+func (o *oVirtClient) GetVMMigrationSettings(
+	vmID VMID,
+	retries ...RetryStrategy,
+) (result VMMigrationSettings, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting migration settings of VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkVM, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no VM returned when getting VM %s", vmID)
+			}
+			result = convertSDKVMMigrationSettings(sdkVM)
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) UpdateVMMigrationSettings(
+	vmID VMID,
+	params VMMigrationParameters,
+	retries ...RetryStrategy,
+) (result VMMigrationSettings, err error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating the migration settings of %s", vmID)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	options := ovirtsdk4.NewMigrationOptionsBuilder()
+	if policyID := params.PolicyID(); policyID != nil {
+		options.Policy(ovirtsdk4.NewMigrationPolicyBuilder().Id(string(*policyID)).MustBuild())
+	}
+	if autoConverge := params.AutoConverge(); autoConverge != nil {
+		options.AutoConverge(buildSDKInheritableBoolean(*autoConverge))
+	}
+	if compressed := params.Compressed(); compressed != nil {
+		options.Compressed(buildSDKInheritableBoolean(*compressed))
+	}
+	builder := ovirtsdk4.NewVmBuilder().Migration(options.MustBuild())
+	if downtime := params.Downtime(); downtime != nil {
+		builder.MigrationDowntime(downtime.Milliseconds())
+	}
+	sdkVM, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build migration settings for VM %s", vmID)
+	}
+	err = retry(
+		fmt.Sprintf("updating migration settings of VM %s", vmID),
+		mutating(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				Update().
+				Vm(sdkVM).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			updated, ok := response.Vm()
+			if !ok {
+				return newFieldNotFound("VM update response", "VM")
+			}
+			result = convertSDKVMMigrationSettings(updated)
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(vmID), "", MutationTypeUpdated)
+	}
+	return result, err
+}
+
+// defaultMockClusterMigrationSettings returns the migration settings of a new cluster in the engine.
+func defaultMockClusterMigrationSettings() *clusterMigrationSettings {
+	return &clusterMigrationSettings{
+		policyID:        MigrationPolicyMinimalDowntime,
+		bandwidthMethod: MigrationBandwidthMethodAuto,
+	}
+}
+
+func (m *mockClient) GetClusterMigrationSettings(
+	clusterID ClusterID,
+	_ ...RetryStrategy,
+) (ClusterMigrationSettings, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	return m.mockClusterMigrationSettings(clusterID), nil
+}
+
+// mockClusterMigrationSettings returns the migration settings of a cluster, which are only stored once they are
+// changed. It must be called with the lock held.
+func (m *mockClient) mockClusterMigrationSettings(clusterID ClusterID) *clusterMigrationSettings {
+	if settings, ok := m.clusterMigrationSettings[clusterID]; ok {
+		return settings
+	}
+	return defaultMockClusterMigrationSettings()
+}
+
+func (m *mockClient) UpdateClusterMigrationSettings(
+	clusterID ClusterID,
+	params ClusterMigrationParameters,
+	_ ...RetryStrategy,
+) (ClusterMigrationSettings, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating the migration settings of %s", clusterID)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	settings := *m.mockClusterMigrationSettings(clusterID)
+	if policyID := params.PolicyID(); policyID != nil {
+		if err := validateMockMigrationPolicy(*policyID); err != nil {
+			return nil, err
+		}
+		settings.policyID = *policyID
+	}
+	if method := params.BandwidthMethod(); method != nil {
+		settings.bandwidthMethod = *method
+		settings.bandwidthLimit = params.BandwidthLimit()
+	}
+	if autoConverge := params.AutoConverge(); autoConverge != nil {
+		settings.autoConverge = autoConverge
+	}
+	if compressed := params.Compressed(); compressed != nil {
+		settings.compressed = compressed
+	}
+	if networkID := params.NetworkID(); networkID != nil {
+		n, ok := m.networks[*networkID]
+		if !ok {
+			return nil, newError(ENotFound, "network with ID %s not found", *networkID)
+		}
+		if !m.clusterInDatacenter(clusterID, n.dcID) {
+			return nil, newError(
+				EBadArgument,
+				"network %s is not in the datacenter of cluster %s",
+				*networkID,
+				clusterID,
+			)
+		}
+		settings.networkID = networkID
+	}
+	m.clusterMigrationSettings[clusterID] = &settings
+	m.mutationListeners.notify(ResourceTypeCluster, string(clusterID), "", MutationTypeUpdated)
+	return &settings, nil
+}
+
+func (m *mockClient) GetVMMigrationSettings(vmID VMID, _ ...RetryStrategy) (VMMigrationSettings, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	if settings, ok := m.vmMigrationSettings[vmID]; ok {
+		return settings, nil
+	}
+	return &vmMigrationSettings{}, nil
+}
+
+func (m *mockClient) UpdateVMMigrationSettings(
+	vmID VMID,
+	params VMMigrationParameters,
+	_ ...RetryStrategy,
+) (VMMigrationSettings, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating the migration settings of %s", vmID)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	settings := vmMigrationSettings{}
+	if existing, ok := m.vmMigrationSettings[vmID]; ok {
+		settings = *existing
+	}
+	if policyID := params.PolicyID(); policyID != nil {
+		if err := validateMockMigrationPolicy(*policyID); err != nil {
+			return nil, err
+		}
+		settings.policyID = policyID
+	}
+	if autoConverge := params.AutoConverge(); autoConverge != nil {
+		settings.autoConverge = autoConverge
+	}
+	if compressed := params.Compressed(); compressed != nil {
+		settings.compressed = compressed
+	}
+	if downtime := params.Downtime(); downtime != nil {
+		settings.downtime = downtime
+	}
+	m.vmMigrationSettings[vmID] = &settings
+	m.mutationListeners.notify(ResourceTypeVM, string(vmID), "", MutationTypeUpdated)
+	return &settings, nil
+}
+
+// validateMockMigrationPolicy checks that the policy is one of the policies the engine ships, since the mock has no
+// custom policies.
+func validateMockMigrationPolicy(id MigrationPolicyID) error {
+	for _, policyID := range MigrationPolicyIDValues() {
+		if policyID == id {
+			return nil
+		}
+	}
+	return newError(ENotFound, "migration policy with ID %s not found", id)
+}
@@ -0,0 +1,84 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestUpdateClusterMigrationSettings(t *testing.T) {
+	t.Parallel()
+	// Changing the migration settings affects every VM in the cluster, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	clusterID := clusters[0].ID()
+	networks, err := client.ListNetworks()
+	if err != nil {
+		t.Fatalf("Failed to list networks (%v)", err)
+	}
+	if _, err := client.UpdateClusterMigrationSettings(
+		clusterID,
+		ovirtclient.ClusterMigrationParams().MustWithPolicyID("not-a-policy"),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Setting a non-existent migration policy did not fail with ENotFound (%v)", err)
+	}
+
+	if _, err := client.UpdateClusterMigrationSettings(
+		clusterID,
+		ovirtclient.ClusterMigrationParams().
+			MustWithPolicyID(ovirtclient.MigrationPolicyPostCopy).
+			MustWithBandwidthLimit(5000).
+			WithAutoConverge(true).
+			MustWithNetworkID(networks[0].ID()),
+	); err != nil {
+		t.Fatalf("Failed to update migration settings of cluster %s (%v)", clusterID, err)
+	}
+	settings, err := client.GetClusterMigrationSettings(clusterID)
+	if err != nil {
+		t.Fatalf("Failed to get migration settings of cluster %s (%v)", clusterID, err)
+	}
+	if settings.PolicyID() != ovirtclient.MigrationPolicyPostCopy {
+		t.Fatalf("Incorrect migration policy: %s", settings.PolicyID())
+	}
+	if settings.BandwidthMethod() != ovirtclient.MigrationBandwidthMethodCustom ||
+		settings.BandwidthLimit() == nil || *settings.BandwidthLimit() != 5000 {
+		t.Fatalf("Incorrect migration bandwidth: %s %v", settings.BandwidthMethod(), settings.BandwidthLimit())
+	}
+	if settings.AutoConverge() == nil || !*settings.AutoConverge() {
+		t.Fatalf("Auto-converge was not enabled.")
+	}
+	if settings.NetworkID() == nil || *settings.NetworkID() != networks[0].ID() {
+		t.Fatalf("Incorrect migration network: %v", settings.NetworkID())
+	}
+}
+
+func TestUpdateVMMigrationDowntime(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	client := helper.GetClient()
+
+	settings, err := client.UpdateVMMigrationSettings(
+		vm.ID(),
+		ovirtclient.VMMigrationParams().MustWithDowntime(2*time.Second),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update migration settings of VM %s (%v)", vm.ID(), err)
+	}
+	if settings.Downtime() == nil || *settings.Downtime() != 2*time.Second {
+		t.Fatalf("Incorrect migration downtime: %v", settings.Downtime())
+	}
+	settings, err = client.GetVMMigrationSettings(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get migration settings of VM %s (%v)", vm.ID(), err)
+	}
+	if settings.Downtime() == nil || *settings.Downtime() != 2*time.Second {
+		t.Fatalf("Incorrect migration downtime after fetching the VM: %v", settings.Downtime())
+	}
+	if settings.PolicyID() != nil {
+		t.Fatalf("The VM overrides the migration policy of the cluster: %s", *settings.PolicyID())
+	}
+}
@@ -13,9 +13,13 @@ import (
 // information.
 type MockClient interface {
 	Client
+	MockStateClient
 
 	// GenerateUUID generates a UUID for testing purposes.
 	GenerateUUID() string
+	// SetVMTransitionDelays sets how long VMs stay in transitional statuses, such as powering_up. The new delays
+	// apply to transitions starting after this call.
+	SetVMTransitionDelays(delays MockVMTransitionDelays)
 }
 
 type mockClient struct {
@@ -35,14 +39,39 @@ type mockClient struct {
 	networks                          map[NetworkID]*network
 	dataCenters                       map[DatacenterID]*datacenterWithClusters
 	vmDiskAttachmentsByVM             map[VMID]map[DiskAttachmentID]*diskAttachment
-	vmDiskAttachmentsByDisk           map[DiskID]*diskAttachment
+	vmDiskAttachmentsByDisk           map[DiskID]map[DiskAttachmentID]*diskAttachment
 	templateDiskAttachmentsByTemplate map[TemplateID][]*templateDiskAttachment
 	templateDiskAttachmentsByDisk     map[DiskID]*templateDiskAttachment
+	templateNICsByTemplate            map[TemplateID][]*templateNIC
 	tags                              map[TagID]*tag
 	affinityGroups                    map[ClusterID]map[AffinityGroupID]*affinityGroup
 	vmIPs                             map[VMID]map[string][]net.IP
 	instanceTypes                     map[InstanceTypeID]*instanceType
+	schedulingPolicies                map[SchedulingPolicyID]*schedulingPolicy
+	cpuProfiles                       map[CPUProfileID]*cpuProfile
+	diskProfiles                      map[DiskProfileID]*diskProfile
+	qos                               map[QoSID]*qos
 	graphicsConsolesByVM              map[VMID][]*vmGraphicsConsole
+	mutationListeners                 *mutationListeners
+	backups                           map[BackupID]*backup
+	checkpointsByVM                   map[VMID][]*checkpoint
+	cdroms                            map[VMID]*mockCDROM
+	watchdogsByVM                     map[VMID][]*vmWatchdog
+	openStackImageProviders           map[OpenStackImageProviderID]*mockOpenStackImageProvider
+	fenceAgentsByHost                 map[HostID][]*hostFenceAgent
+	errataByHost                      map[HostID][]*erratum
+	numaNodesByHost                   map[HostID][]*hostNUMANode
+	reportedDevicesByVM               map[VMID][]*vmReportedDevice
+	unregisteredByStorageDomain       map[StorageDomainID]*unregisteredEntities
+	iscsiLogicalUnitsByHost           map[HostID][]*hostLogicalUnit
+	hostNICsByHost                    map[HostID][]*hostNIC
+	networkAttachmentsByHost          map[HostID][]*hostNetworkAttachment
+	clusterMigrationSettings          map[ClusterID]*clusterMigrationSettings
+	vmMigrationSettings               map[VMID]*vmMigrationSettings
+	externalVMImports                 map[string]*externalVMImportProgress
+	templateExports                   map[string]TemplateID
+	errorIdentifiers                  *errorIdentifiers
+	vmTransitionDelays                *mockVMTransitionDelays
 }
 
 func (m *mockClient) WithContext(ctx context.Context) Client {
@@ -66,11 +95,36 @@ func (m *mockClient) WithContext(ctx context.Context) Client {
 		m.vmDiskAttachmentsByDisk,
 		m.templateDiskAttachmentsByTemplate,
 		m.templateDiskAttachmentsByDisk,
+		m.templateNICsByTemplate,
 		m.tags,
 		m.affinityGroups,
 		m.vmIPs,
 		m.instanceTypes,
+		m.schedulingPolicies,
+		m.cpuProfiles,
+		m.diskProfiles,
+		m.qos,
 		m.graphicsConsolesByVM,
+		m.mutationListeners,
+		m.backups,
+		m.checkpointsByVM,
+		m.cdroms,
+		m.watchdogsByVM,
+		m.openStackImageProviders,
+		m.fenceAgentsByHost,
+		m.errataByHost,
+		m.numaNodesByHost,
+		m.reportedDevicesByVM,
+		m.unregisteredByStorageDomain,
+		m.iscsiLogicalUnitsByHost,
+		m.hostNICsByHost,
+		m.networkAttachmentsByHost,
+		m.clusterMigrationSettings,
+		m.vmMigrationSettings,
+		m.externalVMImports,
+		m.templateExports,
+		m.errorIdentifiers,
+		m.vmTransitionDelays,
 	}
 }
 
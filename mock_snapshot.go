@@ -0,0 +1,386 @@
+package ovirtclient
+
+// NewMockFromClient creates a mock client with a snapshot of the inventory of the specified client, typically a
+// client connected to a live engine. Only read calls are made to the source client. The snapshot contains the same
+// parts of the inventory as SaveState, except for the disk data, which is not downloaded, and the unregistered entities
+// of the storage domains.
+func NewMockFromClient(client Client, logger Logger, retries ...RetryStrategy) (MockClient, error) {
+	state, err := snapshotMockState(client, retries)
+	if err != nil {
+		return nil, err
+	}
+	mock := NewMockWithLogger(logger).(*mockClient)
+	mock.lock.Lock()
+	defer mock.lock.Unlock()
+	if err := mock.loadState(state); err != nil {
+		return nil, wrap(err, EUnidentified, "failed to load the inventory snapshot into the mock client")
+	}
+	return mock, nil
+}
+
+// snapshotMockState reads the inventory of the client into a mock state.
+func snapshotMockState(client Client, retries []RetryStrategy) (*mockState, error) {
+	state := &mockState{Version: mockStateVersion}
+	snapshotters := []func(Client, *mockState, []RetryStrategy) error{
+		snapshotMockStateInfrastructure,
+		snapshotMockStateProfiles,
+		snapshotMockStateNetworks,
+		snapshotMockStateDisks,
+		snapshotMockStateTemplates,
+		snapshotMockStateVMs,
+	}
+	for _, snapshotter := range snapshotters {
+		if err := snapshotter(client, state, retries); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+func snapshotMockStateInfrastructure(client Client, state *mockState, retries []RetryStrategy) error {
+	storageDomains, err := client.ListStorageDomains(retries...)
+	if err != nil {
+		return err
+	}
+	for _, sd := range storageDomains {
+		state.StorageDomains = append(state.StorageDomains, mockStateStorageDomain{
+			ID:             sd.ID(),
+			Name:           sd.Name(),
+			Capacity:       sd.Available() + sd.Used(),
+			StorageType:    sd.StorageType(),
+			Status:         sd.Status(),
+			ExternalStatus: sd.ExternalStatus(),
+		})
+	}
+	clusters, err := client.ListClusters(retries...)
+	if err != nil {
+		return err
+	}
+	for _, c := range clusters {
+		compatibilityVersion := ""
+		if version := c.CompatibilityVersion(); version != nil {
+			compatibilityVersion = version.String()
+		}
+		state.Clusters = append(state.Clusters, mockStateCluster{
+			ID:                         c.ID(),
+			Name:                       c.Name(),
+			CompatibilityVersion:       compatibilityVersion,
+			SchedulingPolicyID:         c.SchedulingPolicyID(),
+			SchedulingPolicyProperties: c.SchedulingPolicyProperties(),
+		})
+	}
+	datacenters, err := client.ListDatacenters(retries...)
+	if err != nil {
+		return err
+	}
+	for _, dc := range datacenters {
+		dcClusters, err := client.ListDatacenterClusters(dc.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		clusterIDs := make([]ClusterID, len(dcClusters))
+		for i, c := range dcClusters {
+			clusterIDs[i] = c.ID()
+		}
+		state.Datacenters = append(state.Datacenters, mockStateDatacenter{
+			ID:       dc.ID(),
+			Name:     dc.Name(),
+			Clusters: clusterIDs,
+		})
+	}
+	hosts, err := client.ListHosts(retries...)
+	if err != nil {
+		return err
+	}
+	// The hosted engine details are only returned when listing the hosted engine hosts.
+	hostedEngineHosts, err := client.ListHostedEngineHosts(retries...)
+	if err != nil {
+		return err
+	}
+	hostedEngines := map[HostID]HostedEngineStatus{}
+	for _, h := range hostedEngineHosts {
+		hostedEngines[h.ID()] = h.HostedEngine()
+	}
+	for _, h := range hosts {
+		numaNodes, err := client.ListHostNUMANodes(h.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		state.Hosts = append(state.Hosts, mockStateHost{
+			ID:                     h.ID(),
+			Name:                   h.Name(),
+			Address:                h.Address(),
+			ClusterID:              h.ClusterID(),
+			Status:                 h.Status(),
+			PowerManagementEnabled: h.PowerManagementEnabled(),
+			ReinstallationRequired: h.ReinstallationRequired(),
+			HostedEngine:           saveMockStateHostedEngine(hostedEngines[h.ID()]),
+			Capacity:               saveMockStateHostCapacity(h, numaNodes),
+		})
+	}
+	tags, err := client.ListTags(retries...)
+	if err != nil {
+		return err
+	}
+	for _, t := range tags {
+		state.Tags = append(state.Tags, mockStateTag{
+			ID:          t.ID(),
+			Name:        t.Name(),
+			Description: t.Description(),
+		})
+	}
+	return nil
+}
+
+func snapshotMockStateProfiles(client Client, state *mockState, retries []RetryStrategy) error {
+	cpuProfiles, err := client.ListCPUProfiles(retries...)
+	if err != nil {
+		return err
+	}
+	for _, p := range cpuProfiles {
+		state.CPUProfiles = append(state.CPUProfiles, mockStateCPUProfile{
+			ID:          p.ID(),
+			Name:        p.Name(),
+			Description: p.Description(),
+			ClusterID:   p.ClusterID(),
+			QoSID:       p.QoSID(),
+		})
+	}
+	diskProfiles, err := client.ListDiskProfiles(retries...)
+	if err != nil {
+		return err
+	}
+	for _, p := range diskProfiles {
+		state.DiskProfiles = append(state.DiskProfiles, mockStateDiskProfile{
+			ID:              p.ID(),
+			Name:            p.Name(),
+			Description:     p.Description(),
+			StorageDomainID: p.StorageDomainID(),
+			QoSID:           p.QoSID(),
+		})
+	}
+	return snapshotMockStateQoS(client, state, retries)
+}
+
+func snapshotMockStateQoS(client Client, state *mockState, retries []RetryStrategy) error {
+	datacenters, err := client.ListDatacenters(retries...)
+	if err != nil {
+		return err
+	}
+	for _, dc := range datacenters {
+		entries, err := client.ListDatacenterQoS(dc.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		for _, q := range entries {
+			state.QoS = append(state.QoS, mockStateQoS{
+				ID:                 q.ID(),
+				Name:               q.Name(),
+				Description:        q.Description(),
+				Type:               q.Type(),
+				DatacenterID:       dc.ID(),
+				MaxIOPS:            q.MaxIOPS(),
+				MaxReadIOPS:        q.MaxReadIOPS(),
+				MaxWriteIOPS:       q.MaxWriteIOPS(),
+				MaxThroughput:      q.MaxThroughput(),
+				MaxReadThroughput:  q.MaxReadThroughput(),
+				MaxWriteThroughput: q.MaxWriteThroughput(),
+				CPULimit:           q.CPULimit(),
+				InboundAverage:     q.InboundAverage(),
+				InboundPeak:        q.InboundPeak(),
+				InboundBurst:       q.InboundBurst(),
+				OutboundAverage:    q.OutboundAverage(),
+				OutboundPeak:       q.OutboundPeak(),
+				OutboundBurst:      q.OutboundBurst(),
+			})
+		}
+	}
+	return nil
+}
+
+func snapshotMockStateNetworks(client Client, state *mockState, retries []RetryStrategy) error {
+	networks, err := client.ListNetworks(retries...)
+	if err != nil {
+		return err
+	}
+	for _, n := range networks {
+		state.Networks = append(state.Networks, mockStateNetwork{
+			ID:           n.ID(),
+			Name:         n.Name(),
+			DatacenterID: n.DatacenterID(),
+		})
+	}
+	profiles, err := client.ListVNICProfiles(retries...)
+	if err != nil {
+		return err
+	}
+	for _, p := range profiles {
+		state.VNICProfiles = append(state.VNICProfiles, mockStateVNICProfile{
+			ID:        p.ID(),
+			Name:      p.Name(),
+			NetworkID: p.NetworkID(),
+		})
+	}
+	return nil
+}
+
+func snapshotMockStateDisks(client Client, state *mockState, retries []RetryStrategy) error {
+	disks, err := client.ListDisks(retries...)
+	if err != nil {
+		return err
+	}
+	for _, d := range disks {
+		var logicalUnit *mockStateLUN
+		if lu := d.LogicalUnit(); lu != nil {
+			logicalUnit = &mockStateLUN{
+				ID:          lu.ID(),
+				StorageType: lu.StorageType(),
+				Address:     lu.Address(),
+				Port:        lu.Port(),
+				Target:      lu.Target(),
+				Size:        lu.Size(),
+			}
+		}
+		state.Disks = append(state.Disks, mockStateDisk{
+			ID:               d.ID(),
+			Alias:            d.Alias(),
+			ProvisionedSize:  d.ProvisionedSize(),
+			TotalSize:        d.TotalSize(),
+			Format:           d.Format(),
+			StorageDomainIDs: d.StorageDomainIDs(),
+			Sparse:           d.Sparse(),
+			ContentType:      d.ContentType(),
+			WipeAfterDelete:  d.WipeAfterDelete(),
+			Shareable:        d.Shareable(),
+			Backup:           d.Backup(),
+			StorageType:      d.StorageType(),
+			LogicalUnit:      logicalUnit,
+			DiskProfileID:    d.DiskProfileID(),
+		})
+	}
+	return nil
+}
+
+func snapshotMockStateTemplates(client Client, state *mockState, retries []RetryStrategy) error {
+	templates, err := client.ListTemplates(retries...)
+	if err != nil {
+		return err
+	}
+	for _, tpl := range templates {
+		nics, err := client.ListTemplateNICs(tpl.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		var stateNICs []mockStateTemplateNIC
+		for _, item := range nics {
+			stateNICs = append(stateNICs, mockStateTemplateNIC{
+				ID:            item.ID(),
+				Name:          item.Name(),
+				VNICProfileID: item.VNICProfileID(),
+			})
+		}
+		state.Templates = append(state.Templates, mockStateTemplate{
+			ID:             tpl.ID(),
+			Name:           tpl.Name(),
+			Description:    tpl.Description(),
+			Status:         tpl.Status(),
+			CPU:            snapshotMockStateCPU(tpl.CPU()),
+			CreationTime:   tpl.CreationTime(),
+			NICs:           stateNICs,
+			VersionNumber:  tpl.VersionNumber(),
+			VersionName:    tpl.VersionName(),
+			BaseTemplateID: tpl.BaseTemplateID(),
+		})
+		attachments, err := client.ListTemplateDiskAttachments(tpl.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		for _, attachment := range attachments {
+			state.TemplateDisks = append(state.TemplateDisks, mockStateTemplateAttachment{
+				ID:            attachment.ID(),
+				TemplateID:    attachment.TemplateID(),
+				DiskID:        attachment.DiskID(),
+				DiskInterface: attachment.DiskInterface(),
+				Bootable:      attachment.Bootable(),
+				Active:        attachment.Active(),
+			})
+		}
+	}
+	return nil
+}
+
+func snapshotMockStateVMs(client Client, state *mockState, retries []RetryStrategy) error {
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return err
+	}
+	for _, v := range vms {
+		customCompatibilityVersion := ""
+		if version := v.CustomCompatibilityVersion(); version != nil {
+			customCompatibilityVersion = version.String()
+		}
+		state.VMs = append(state.VMs, mockStateVM{
+			ID:                         v.ID(),
+			Name:                       v.Name(),
+			Comment:                    v.Comment(),
+			Description:                v.Description(),
+			ClusterID:                  v.ClusterID(),
+			TemplateID:                 v.TemplateID(),
+			Status:                     v.Status(),
+			HostID:                     v.HostID(),
+			CPU:                        snapshotMockStateCPU(v.CPU()),
+			Memory:                     v.Memory(),
+			TagIDs:                     v.TagIDs(),
+			OSType:                     v.OS().Type(),
+			VMType:                     v.VMType(),
+			BIOSType:                   v.BIOSType(),
+			SerialConsole:              v.SerialConsole(),
+			SoundcardEnabled:           v.SoundcardEnabled(),
+			TPMEnabled:                 v.TPMEnabled(),
+			TimeZone:                   v.TimeZone(),
+			DeleteProtected:            v.DeleteProtected(),
+			CreationTime:               v.CreationTime(),
+			EmulatedMachine:            v.EmulatedMachine(),
+			CustomCompatibilityVersion: customCompatibilityVersion,
+			HostedEngine:               v.HostedEngine(),
+			CPUProfileID:               v.CPUProfileID(),
+		})
+		attachments, err := client.ListDiskAttachments(v.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		for _, a := range attachments {
+			state.DiskAttachments = append(state.DiskAttachments, mockStateDiskAttachment{
+				ID:            a.ID(),
+				VMID:          a.VMID(),
+				DiskID:        a.DiskID(),
+				DiskInterface: a.DiskInterface(),
+				Bootable:      a.Bootable(),
+				Active:        a.Active(),
+			})
+		}
+		nics, err := client.ListNICs(v.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		for _, n := range nics {
+			state.NICs = append(state.NICs, mockStateNIC{
+				ID:            n.ID(),
+				Name:          n.Name(),
+				VMID:          n.VMID(),
+				VNICProfileID: n.VNICProfileID(),
+				Mac:           n.Mac(),
+			})
+		}
+	}
+	return nil
+}
+
+// snapshotMockStateCPU converts the CPU topology of a VM or template.
+func snapshotMockStateCPU(cpu VMCPU) mockStateCPU {
+	return mockStateCPU{
+		Sockets: cpu.Topo().Sockets(),
+		Cores:   cpu.Topo().Cores(),
+		Threads: cpu.Topo().Threads(),
+	}
+}
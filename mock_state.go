@@ -0,0 +1,1335 @@
+package ovirtclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// mockStateVersion is the version of the mock state format. It must be increased when the format changes in an
+// incompatible way.
+const mockStateVersion = 1
+
+// MockStateClient provides the ability to save the inventory of a mock client. The saved state can be loaded
+// using NewMockFromState or NewMockFromStateFile.
+//
+// The state contains the storage domains, datacenters, clusters, hosts, networks, VNIC profiles, tags, templates,
+// disks including their data, VMs, disk attachments and NICs. Other parts of the mock, such as affinity groups,
+// backups or graphics consoles, are not saved and are initialized empty or with their defaults when loading.
+type MockStateClient interface {
+	// SaveState writes the inventory of the mock client to the specified writer in JSON format.
+	SaveState(w io.Writer) error
+	// SaveStateFile writes the inventory of the mock client to the specified file in JSON format.
+	SaveStateFile(file string) error
+}
+
+// NewMockFromState creates a mock client with the inventory read from the JSON state written by SaveState.
+func NewMockFromState(r io.Reader, logger Logger) (MockClient, error) {
+	state := &mockState{}
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(state); err != nil {
+		return nil, wrap(err, EBadArgument, "failed to decode mock state")
+	}
+	if state.Version != mockStateVersion {
+		return nil, newError(
+			EBadArgument,
+			"unsupported mock state version %d, expected %d",
+			state.Version,
+			mockStateVersion,
+		)
+	}
+	client := NewMockWithLogger(logger).(*mockClient)
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if err := client.loadState(state); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// NewMockFromStateFile creates a mock client with the inventory read from the JSON state file written by
+// SaveStateFile.
+func NewMockFromStateFile(file string, logger Logger) (MockClient, error) {
+	fh, err := os.Open(file) //nolint:gosec
+	if err != nil {
+		return nil, wrap(err, EFileReadFailed, "failed to open mock state file %s", file)
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+	return NewMockFromState(fh, logger)
+}
+
+type mockState struct {
+	Version         int                           `json:"version"`
+	StorageDomains  []mockStateStorageDomain      `json:"storage_domains"`
+	Datacenters     []mockStateDatacenter         `json:"datacenters"`
+	Clusters        []mockStateCluster            `json:"clusters"`
+	Hosts           []mockStateHost               `json:"hosts"`
+	Networks        []mockStateNetwork            `json:"networks"`
+	VNICProfiles    []mockStateVNICProfile        `json:"vnic_profiles"`
+	Tags            []mockStateTag                `json:"tags"`
+	Templates       []mockStateTemplate           `json:"templates"`
+	Disks           []mockStateDisk               `json:"disks"`
+	VMs             []mockStateVM                 `json:"vms"`
+	DiskAttachments []mockStateDiskAttachment     `json:"disk_attachments"`
+	TemplateDisks   []mockStateTemplateAttachment `json:"template_disk_attachments"`
+	NICs            []mockStateNIC                `json:"nics"`
+	CPUProfiles     []mockStateCPUProfile         `json:"cpu_profiles,omitempty"`
+	DiskProfiles    []mockStateDiskProfile        `json:"disk_profiles,omitempty"`
+	QoS             []mockStateQoS                `json:"qos,omitempty"`
+}
+
+type mockStateStorageDomain struct {
+	ID             StorageDomainID             `json:"id"`
+	Name           string                      `json:"name"`
+	Capacity       uint64                      `json:"capacity"`
+	StorageType    StorageDomainType           `json:"storage_type"`
+	Status         StorageDomainStatus         `json:"status"`
+	ExternalStatus StorageDomainExternalStatus `json:"external_status"`
+	Unregistered   *mockStateUnregistered      `json:"unregistered,omitempty"`
+}
+
+// mockStateUnregistered holds the VMs, templates and disks of a storage domain that are not registered, for example
+// because the storage domain was imported from another engine.
+type mockStateUnregistered struct {
+	VMs       []mockStateUnregisteredVM       `json:"vms,omitempty"`
+	Templates []mockStateUnregisteredTemplate `json:"templates,omitempty"`
+	Disks     []mockStateUnregisteredDisk     `json:"disks,omitempty"`
+}
+
+type mockStateUnregisteredVM struct {
+	ID          VMID                                  `json:"id"`
+	Name        string                                `json:"name"`
+	ClusterName string                                `json:"cluster_name"`
+	CPU         mockStateCPU                          `json:"cpu"`
+	Memory      int64                                 `json:"memory"`
+	Disks       []mockStateUnregisteredDiskAttachment `json:"disks,omitempty"`
+	NICs        []mockStateUnregisteredNIC            `json:"nics,omitempty"`
+}
+
+type mockStateUnregisteredTemplate struct {
+	ID          TemplateID                            `json:"id"`
+	Name        string                                `json:"name"`
+	ClusterName string                                `json:"cluster_name"`
+	CPU         mockStateCPU                          `json:"cpu"`
+	Disks       []mockStateUnregisteredDiskAttachment `json:"disks,omitempty"`
+}
+
+type mockStateUnregisteredDiskAttachment struct {
+	DiskID        DiskID        `json:"disk_id"`
+	DiskInterface DiskInterface `json:"disk_interface"`
+	Bootable      bool          `json:"bootable"`
+}
+
+type mockStateUnregisteredNIC struct {
+	Name            string `json:"name"`
+	Mac             string `json:"mac"`
+	NetworkName     string `json:"network_name"`
+	VNICProfileName string `json:"vnic_profile_name"`
+}
+
+type mockStateUnregisteredDisk struct {
+	ID              DiskID      `json:"id"`
+	Alias           string      `json:"alias"`
+	ProvisionedSize uint64      `json:"provisioned_size"`
+	Format          ImageFormat `json:"format"`
+}
+
+// saveMockStateUnregistered returns the unregistered entities in a stable order, or nil if there are none.
+func saveMockStateUnregistered(entities *unregisteredEntities) *mockStateUnregistered {
+	if entities == nil || len(entities.vms)+len(entities.templates)+len(entities.disks) == 0 {
+		return nil
+	}
+	result := &mockStateUnregistered{}
+	for _, v := range entities.vms {
+		item := mockStateUnregisteredVM{
+			ID:          v.id,
+			Name:        v.name,
+			ClusterName: v.clusterName,
+			CPU:         saveMockStateCPU(v.cpu),
+			Memory:      v.memory,
+			Disks:       saveMockStateUnregisteredDiskAttachments(v.diskAttachments),
+		}
+		for _, n := range v.nics {
+			item.NICs = append(item.NICs, mockStateUnregisteredNIC{
+				Name:            n.name,
+				Mac:             n.mac,
+				NetworkName:     n.networkName,
+				VNICProfileName: n.vnicProfileName,
+			})
+		}
+		result.VMs = append(result.VMs, item)
+	}
+	for _, t := range entities.templates {
+		result.Templates = append(result.Templates, mockStateUnregisteredTemplate{
+			ID:          t.id,
+			Name:        t.name,
+			ClusterName: t.clusterName,
+			CPU:         saveMockStateCPU(t.cpu),
+			Disks:       saveMockStateUnregisteredDiskAttachments(t.diskAttachments),
+		})
+	}
+	for _, d := range entities.disks {
+		result.Disks = append(result.Disks, mockStateUnregisteredDisk{
+			ID:              d.id,
+			Alias:           d.alias,
+			ProvisionedSize: d.provisionedSize,
+			Format:          d.format,
+		})
+	}
+	sort.Slice(result.VMs, func(i, j int) bool { return result.VMs[i].ID < result.VMs[j].ID })
+	sort.Slice(result.Templates, func(i, j int) bool { return result.Templates[i].ID < result.Templates[j].ID })
+	sort.Slice(result.Disks, func(i, j int) bool { return result.Disks[i].ID < result.Disks[j].ID })
+	return result
+}
+
+func saveMockStateUnregisteredDiskAttachments(
+	attachments []*unregisteredDiskAttachment,
+) []mockStateUnregisteredDiskAttachment {
+	var result []mockStateUnregisteredDiskAttachment
+	for _, a := range attachments {
+		result = append(result, mockStateUnregisteredDiskAttachment{
+			DiskID:        a.diskID,
+			DiskInterface: a.diskInterface,
+			Bootable:      a.bootable,
+		})
+	}
+	return result
+}
+
+// toUnregisteredEntities converts the saved unregistered entities of the specified storage domain.
+func (s *mockStateUnregistered) toUnregisteredEntities(
+	client Client,
+	storageDomainID StorageDomainID,
+) (*unregisteredEntities, error) {
+	result := newUnregisteredEntities()
+	if s == nil {
+		return result, nil
+	}
+	for _, d := range s.Disks {
+		if err := d.Format.Validate(); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid format for unregistered disk %s", d.ID)
+		}
+		result.disks[d.ID] = &unregisteredDisk{
+			client:          client,
+			id:              d.ID,
+			alias:           d.Alias,
+			provisionedSize: d.ProvisionedSize,
+			format:          d.Format,
+			storageDomainID: storageDomainID,
+		}
+	}
+	for _, v := range s.VMs {
+		item := &unregisteredVM{
+			client:          client,
+			id:              v.ID,
+			name:            v.Name,
+			storageDomainID: storageDomainID,
+			memory:          v.Memory,
+			clusterName:     v.ClusterName,
+			cpu:             v.CPU.toVMCPU(),
+			diskAttachments: loadMockStateUnregisteredDiskAttachments(v.Disks),
+		}
+		for _, n := range v.NICs {
+			item.nics = append(item.nics, &unregisteredNIC{
+				name:            n.Name,
+				mac:             n.Mac,
+				networkName:     n.NetworkName,
+				vnicProfileName: n.VNICProfileName,
+			})
+		}
+		result.vms[v.ID] = item
+	}
+	for _, t := range s.Templates {
+		result.templates[t.ID] = &unregisteredTemplate{
+			client:          client,
+			id:              t.ID,
+			name:            t.Name,
+			storageDomainID: storageDomainID,
+			clusterName:     t.ClusterName,
+			cpu:             t.CPU.toVMCPU(),
+			diskAttachments: loadMockStateUnregisteredDiskAttachments(t.Disks),
+		}
+	}
+	return result, nil
+}
+
+func loadMockStateUnregisteredDiskAttachments(
+	attachments []mockStateUnregisteredDiskAttachment,
+) []*unregisteredDiskAttachment {
+	result := make([]*unregisteredDiskAttachment, len(attachments))
+	for i, a := range attachments {
+		diskInterface := a.DiskInterface
+		if diskInterface == "" {
+			diskInterface = DiskInterfaceVirtIO
+		}
+		result[i] = &unregisteredDiskAttachment{
+			diskID:        a.DiskID,
+			diskInterface: diskInterface,
+			bootable:      a.Bootable,
+		}
+	}
+	return result
+}
+
+type mockStateDatacenter struct {
+	ID       DatacenterID `json:"id"`
+	Name     string       `json:"name"`
+	Clusters []ClusterID  `json:"clusters"`
+}
+
+type mockStateCluster struct {
+	ID                         ClusterID          `json:"id"`
+	Name                       string             `json:"name"`
+	CompatibilityVersion       string             `json:"compatibility_version"`
+	SchedulingPolicyID         SchedulingPolicyID `json:"scheduling_policy_id,omitempty"`
+	SchedulingPolicyProperties map[string]string  `json:"scheduling_policy_properties,omitempty"`
+}
+
+// mockStateCPUProfile is a CPU profile in the mock state. Clusters without a CPU profile in the state get a default
+// profile on load.
+type mockStateCPUProfile struct {
+	ID          CPUProfileID `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	ClusterID   ClusterID    `json:"cluster_id"`
+	QoSID       *QoSID       `json:"qos_id,omitempty"`
+}
+
+// mockStateDiskProfile is a disk profile in the mock state. Storage domains without a disk profile in the state get
+// a default profile on load.
+type mockStateDiskProfile struct {
+	ID              DiskProfileID   `json:"id"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description,omitempty"`
+	StorageDomainID StorageDomainID `json:"storage_domain_id"`
+	QoSID           *QoSID          `json:"qos_id,omitempty"`
+}
+
+// mockStateQoS is a datacenter QoS entry in the mock state. Limits not applicable to the type of the entry are
+// omitted.
+type mockStateQoS struct {
+	ID                 QoSID        `json:"id"`
+	Name               string       `json:"name"`
+	Description        string       `json:"description,omitempty"`
+	Type               QoSType      `json:"type"`
+	DatacenterID       DatacenterID `json:"datacenter_id"`
+	MaxIOPS            uint64       `json:"max_iops,omitempty"`
+	MaxReadIOPS        uint64       `json:"max_read_iops,omitempty"`
+	MaxWriteIOPS       uint64       `json:"max_write_iops,omitempty"`
+	MaxThroughput      uint64       `json:"max_throughput,omitempty"`
+	MaxReadThroughput  uint64       `json:"max_read_throughput,omitempty"`
+	MaxWriteThroughput uint64       `json:"max_write_throughput,omitempty"`
+	CPULimit           uint64       `json:"cpu_limit,omitempty"`
+	InboundAverage     uint64       `json:"inbound_average,omitempty"`
+	InboundPeak        uint64       `json:"inbound_peak,omitempty"`
+	InboundBurst       uint64       `json:"inbound_burst,omitempty"`
+	OutboundAverage    uint64       `json:"outbound_average,omitempty"`
+	OutboundPeak       uint64       `json:"outbound_peak,omitempty"`
+	OutboundBurst      uint64       `json:"outbound_burst,omitempty"`
+}
+
+type mockStateHost struct {
+	ID                     HostID     `json:"id"`
+	Name                   string     `json:"name"`
+	Address                string     `json:"address"`
+	ClusterID              ClusterID  `json:"cluster_id"`
+	Status                 HostStatus `json:"status"`
+	PowerManagementEnabled bool       `json:"power_management_enabled"`
+	ReinstallationRequired bool       `json:"reinstallation_required,omitempty"`
+	// HostedEngine is only set for Hosted Engine hosts.
+	HostedEngine *mockStateHostedEngine `json:"hosted_engine,omitempty"`
+	// Capacity is the memory and CPU layout of the host. Hosts without it get the default capacity of mock hosts.
+	Capacity *mockStateHostCapacity `json:"capacity,omitempty"`
+}
+
+type mockStateHostCapacity struct {
+	Memory              uint64                  `json:"memory"`
+	MaxSchedulingMemory uint64                  `json:"max_scheduling_memory"`
+	Sockets             uint                    `json:"sockets"`
+	Cores               uint                    `json:"cores"`
+	Threads             uint                    `json:"threads"`
+	NUMANodes           []mockStateHostNUMANode `json:"numa_nodes,omitempty"`
+}
+
+type mockStateHostNUMANode struct {
+	Index  uint   `json:"index"`
+	Memory uint64 `json:"memory"`
+	CPUs   uint   `json:"cpus"`
+}
+
+func saveMockStateHostCapacity(h HostData, numaNodes []HostNUMANode) *mockStateHostCapacity {
+	result := &mockStateHostCapacity{
+		Memory:              h.Memory(),
+		MaxSchedulingMemory: h.MaxSchedulingMemory(),
+		Sockets:             h.CPUTopology().Sockets(),
+		Cores:               h.CPUTopology().Cores(),
+		Threads:             h.CPUTopology().Threads(),
+	}
+	for _, node := range numaNodes {
+		result.NUMANodes = append(result.NUMANodes, mockStateHostNUMANode{
+			Index:  node.Index(),
+			Memory: node.Memory(),
+			CPUs:   node.CPUs(),
+		})
+	}
+	return result
+}
+
+// applyTo sets the capacity on the host and returns its NUMA nodes. A nil capacity results in the default capacity
+// of mock hosts.
+func (c *mockStateHostCapacity) applyTo(h *host) (*host, []*hostNUMANode) {
+	if c == nil {
+		h = h.withMockCapacity()
+		return h, generateMockHostNUMANodes(h)
+	}
+	h.memory = c.Memory
+	h.maxSchedulingMemory = c.MaxSchedulingMemory
+	h.cpuTopology = hostCPUTopology{sockets: c.Sockets, cores: c.Cores, threads: c.Threads}
+	h.numaSupported = len(c.NUMANodes) > 0
+	numaNodes := make([]*hostNUMANode, len(c.NUMANodes))
+	for i, node := range c.NUMANodes {
+		numaNodes[i] = &hostNUMANode{
+			index:  node.Index,
+			memory: node.Memory,
+			cpus:   node.CPUs,
+		}
+	}
+	return h, numaNodes
+}
+
+type mockStateHostedEngine struct {
+	Configured        bool  `json:"configured"`
+	Active            bool  `json:"active"`
+	Score             int64 `json:"score"`
+	GlobalMaintenance bool  `json:"global_maintenance"`
+	LocalMaintenance  bool  `json:"local_maintenance"`
+}
+
+func saveMockStateHostedEngine(status HostedEngineStatus) *mockStateHostedEngine {
+	if status == nil {
+		return nil
+	}
+	return &mockStateHostedEngine{
+		Configured:        status.Configured(),
+		Active:            status.Active(),
+		Score:             status.Score(),
+		GlobalMaintenance: status.GlobalMaintenance(),
+		LocalMaintenance:  status.LocalMaintenance(),
+	}
+}
+
+func (h *mockStateHostedEngine) toHostedEngineStatus() *hostedEngineStatus {
+	if h == nil {
+		return nil
+	}
+	return &hostedEngineStatus{
+		configured:        h.Configured,
+		active:            h.Active,
+		score:             h.Score,
+		globalMaintenance: h.GlobalMaintenance,
+		localMaintenance:  h.LocalMaintenance,
+	}
+}
+
+type mockStateNetwork struct {
+	ID           NetworkID    `json:"id"`
+	Name         string       `json:"name"`
+	DatacenterID DatacenterID `json:"datacenter_id"`
+}
+
+type mockStateVNICProfile struct {
+	ID        VNICProfileID `json:"id"`
+	Name      string        `json:"name"`
+	NetworkID NetworkID     `json:"network_id"`
+}
+
+type mockStateTag struct {
+	ID          TagID   `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+type mockStateCPU struct {
+	Sockets uint `json:"sockets"`
+	Cores   uint `json:"cores"`
+	Threads uint `json:"threads"`
+}
+
+type mockStateTemplate struct {
+	ID           TemplateID             `json:"id"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description"`
+	Status       TemplateStatus         `json:"status"`
+	CPU          mockStateCPU           `json:"cpu"`
+	CreationTime time.Time              `json:"creation_time"`
+	NICs         []mockStateTemplateNIC `json:"nics,omitempty"`
+
+	VersionNumber  uint       `json:"version_number,omitempty"`
+	VersionName    string     `json:"version_name,omitempty"`
+	BaseTemplateID TemplateID `json:"base_template_id,omitempty"`
+}
+
+type mockStateTemplateNIC struct {
+	ID            NICID         `json:"id"`
+	Name          string        `json:"name"`
+	VNICProfileID VNICProfileID `json:"vnic_profile_id"`
+}
+
+type mockStateDisk struct {
+	ID               DiskID            `json:"id"`
+	Alias            string            `json:"alias"`
+	ProvisionedSize  uint64            `json:"provisioned_size"`
+	TotalSize        uint64            `json:"total_size"`
+	Format           ImageFormat       `json:"format"`
+	StorageDomainIDs []StorageDomainID `json:"storage_domain_ids"`
+	Sparse           bool              `json:"sparse"`
+	ContentType      DiskContentType   `json:"content_type"`
+	WipeAfterDelete  bool              `json:"wipe_after_delete,omitempty"`
+	Shareable        bool              `json:"shareable,omitempty"`
+	Backup           DiskBackup        `json:"backup,omitempty"`
+	StorageType      DiskStorageType   `json:"storage_type,omitempty"`
+	LogicalUnit      *mockStateLUN     `json:"logical_unit,omitempty"`
+	DiskProfileID    *DiskProfileID    `json:"disk_profile_id,omitempty"`
+	Data             []byte            `json:"data,omitempty"`
+}
+
+type mockStateLUN struct {
+	ID          string            `json:"id"`
+	StorageType StorageDomainType `json:"storage_type"`
+	Address     string            `json:"address,omitempty"`
+	Port        uint16            `json:"port,omitempty"`
+	Target      string            `json:"target,omitempty"`
+	Size        uint64            `json:"size,omitempty"`
+}
+
+type mockStateVM struct {
+	ID                         VMID         `json:"id"`
+	Name                       string       `json:"name"`
+	Comment                    string       `json:"comment"`
+	Description                string       `json:"description"`
+	ClusterID                  ClusterID    `json:"cluster_id"`
+	TemplateID                 TemplateID   `json:"template_id"`
+	Status                     VMStatus     `json:"status"`
+	HostID                     *HostID      `json:"host_id,omitempty"`
+	CPU                        mockStateCPU `json:"cpu"`
+	Memory                     int64        `json:"memory"`
+	TagIDs                     []TagID      `json:"tag_ids"`
+	OSType                     string       `json:"os_type"`
+	VMType                     VMType       `json:"vm_type"`
+	BIOSType                   VMBIOSType   `json:"bios_type"`
+	SerialConsole              bool         `json:"serial_console"`
+	SoundcardEnabled           bool         `json:"soundcard_enabled"`
+	TPMEnabled                 bool         `json:"tpm_enabled"`
+	TimeZone                   string       `json:"time_zone"`
+	DeleteProtected            bool         `json:"delete_protected"`
+	CreationTime               time.Time    `json:"creation_time"`
+	EmulatedMachine            string       `json:"emulated_machine,omitempty"`
+	CustomCompatibilityVersion string       `json:"custom_compatibility_version,omitempty"`
+	HostedEngine               bool         `json:"hosted_engine,omitempty"`
+	CPUProfileID               CPUProfileID `json:"cpu_profile_id,omitempty"`
+}
+
+type mockStateDiskAttachment struct {
+	ID            DiskAttachmentID `json:"id"`
+	VMID          VMID             `json:"vm_id"`
+	DiskID        DiskID           `json:"disk_id"`
+	DiskInterface DiskInterface    `json:"disk_interface"`
+	Bootable      bool             `json:"bootable"`
+	Active        bool             `json:"active"`
+}
+
+type mockStateTemplateAttachment struct {
+	ID            TemplateDiskAttachmentID `json:"id"`
+	TemplateID    TemplateID               `json:"template_id"`
+	DiskID        DiskID                   `json:"disk_id"`
+	DiskInterface DiskInterface            `json:"disk_interface"`
+	Bootable      bool                     `json:"bootable"`
+	Active        bool                     `json:"active"`
+}
+
+type mockStateNIC struct {
+	ID            NICID         `json:"id"`
+	Name          string        `json:"name"`
+	VMID          VMID          `json:"vm_id"`
+	VNICProfileID VNICProfileID `json:"vnic_profile_id"`
+	Mac           string        `json:"mac"`
+}
+
+func (m *mockClient) SaveStateFile(file string) error {
+	fh, err := os.Create(file) //nolint:gosec
+	if err != nil {
+		return wrap(err, EUnidentified, "failed to create mock state file %s", file)
+	}
+	if err := m.SaveState(fh); err != nil {
+		_ = fh.Close()
+		return err
+	}
+	if err := fh.Close(); err != nil {
+		return wrap(err, EUnidentified, "failed to close mock state file %s", file)
+	}
+	return nil
+}
+
+func (m *mockClient) SaveState(w io.Writer) error {
+	m.lock.Lock()
+	state := m.saveState()
+	m.lock.Unlock()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(state); err != nil {
+		return wrap(err, EUnidentified, "failed to encode mock state")
+	}
+	return nil
+}
+
+// saveState collects the inventory of the mock. It must be called with the lock held.
+func (m *mockClient) saveState() *mockState {
+	state := &mockState{Version: mockStateVersion}
+	for _, sd := range m.storageDomains {
+		state.StorageDomains = append(state.StorageDomains, mockStateStorageDomain{
+			ID:             sd.id,
+			Name:           sd.name,
+			Capacity:       sd.available,
+			StorageType:    sd.storageType,
+			Status:         sd.status,
+			ExternalStatus: sd.externalStatus,
+			Unregistered:   saveMockStateUnregistered(m.unregisteredByStorageDomain[sd.id]),
+		})
+	}
+	for _, dc := range m.dataCenters {
+		state.Datacenters = append(state.Datacenters, mockStateDatacenter{
+			ID:       dc.id,
+			Name:     dc.name,
+			Clusters: dc.clusters,
+		})
+	}
+	for _, c := range m.clusters {
+		state.Clusters = append(state.Clusters, mockStateCluster{
+			ID:                         c.id,
+			Name:                       c.name,
+			CompatibilityVersion:       c.compatibilityVersion.String(),
+			SchedulingPolicyID:         c.schedulingPolicyID,
+			SchedulingPolicyProperties: c.SchedulingPolicyProperties(),
+		})
+	}
+	for _, h := range m.hosts {
+		state.Hosts = append(state.Hosts, mockStateHost{
+			ID:                     h.id,
+			Name:                   h.name,
+			Address:                h.address,
+			ClusterID:              h.clusterID,
+			Status:                 h.status,
+			PowerManagementEnabled: h.powerManagementEnabled,
+			ReinstallationRequired: h.reinstallationRequired,
+			HostedEngine:           saveMockStateHostedEngine(h.HostedEngine()),
+			Capacity:               saveMockStateHostCapacity(h, m.mockHostNUMANodes(h.id)),
+		})
+	}
+	for _, n := range m.networks {
+		state.Networks = append(state.Networks, mockStateNetwork{
+			ID:           n.id,
+			Name:         n.name,
+			DatacenterID: n.dcID,
+		})
+	}
+	for _, p := range m.vnicProfiles {
+		state.VNICProfiles = append(state.VNICProfiles, mockStateVNICProfile{
+			ID:        p.id,
+			Name:      p.name,
+			NetworkID: p.networkID,
+		})
+	}
+	for _, p := range m.cpuProfiles {
+		state.CPUProfiles = append(state.CPUProfiles, mockStateCPUProfile{
+			ID:          p.id,
+			Name:        p.name,
+			Description: p.description,
+			ClusterID:   p.clusterID,
+			QoSID:       p.qosID,
+		})
+	}
+	for _, p := range m.diskProfiles {
+		state.DiskProfiles = append(state.DiskProfiles, mockStateDiskProfile{
+			ID:              p.id,
+			Name:            p.name,
+			Description:     p.description,
+			StorageDomainID: p.storageDomainID,
+			QoSID:           p.qosID,
+		})
+	}
+	for _, q := range m.qos {
+		state.QoS = append(state.QoS, mockStateQoS{
+			ID:                 q.id,
+			Name:               q.name,
+			Description:        q.description,
+			Type:               q.qosType,
+			DatacenterID:       q.datacenterID,
+			MaxIOPS:            q.maxIOPS,
+			MaxReadIOPS:        q.maxReadIOPS,
+			MaxWriteIOPS:       q.maxWriteIOPS,
+			MaxThroughput:      q.maxThroughput,
+			MaxReadThroughput:  q.maxReadThroughput,
+			MaxWriteThroughput: q.maxWriteThroughput,
+			CPULimit:           q.cpuLimit,
+			InboundAverage:     q.inboundAverage,
+			InboundPeak:        q.inboundPeak,
+			InboundBurst:       q.inboundBurst,
+			OutboundAverage:    q.outboundAverage,
+			OutboundPeak:       q.outboundPeak,
+			OutboundBurst:      q.outboundBurst,
+		})
+	}
+	for _, t := range m.tags {
+		state.Tags = append(state.Tags, mockStateTag{
+			ID:          t.id,
+			Name:        t.name,
+			Description: t.description,
+		})
+	}
+	for _, tpl := range m.templates {
+		var nics []mockStateTemplateNIC
+		for _, item := range m.templateNICsByTemplate[tpl.id] {
+			nics = append(nics, mockStateTemplateNIC{
+				ID:            item.id,
+				Name:          item.name,
+				VNICProfileID: item.vnicProfileID,
+			})
+		}
+		state.Templates = append(state.Templates, mockStateTemplate{
+			ID:           tpl.id,
+			Name:         tpl.name,
+			Description:  tpl.description,
+			Status:       tpl.status,
+			CPU:          saveMockStateCPU(tpl.cpu),
+			CreationTime: tpl.creationTime,
+			NICs:         nics,
+
+			VersionNumber:  tpl.versionNumber,
+			VersionName:    tpl.versionName,
+			BaseTemplateID: tpl.baseTemplateID,
+		})
+		for _, attachment := range m.templateDiskAttachmentsByTemplate[tpl.id] {
+			state.TemplateDisks = append(state.TemplateDisks, mockStateTemplateAttachment{
+				ID:            attachment.id,
+				TemplateID:    attachment.templateID,
+				DiskID:        attachment.diskID,
+				DiskInterface: attachment.diskInterface,
+				Bootable:      attachment.bootable,
+				Active:        attachment.active,
+			})
+		}
+	}
+	for _, d := range m.disks {
+		var logicalUnit *mockStateLUN
+		if lu := d.logicalUnit; lu != nil {
+			logicalUnit = &mockStateLUN{
+				ID:          lu.id,
+				StorageType: lu.storageType,
+				Address:     lu.address,
+				Port:        lu.port,
+				Target:      lu.target,
+				Size:        lu.size,
+			}
+		}
+		state.Disks = append(state.Disks, mockStateDisk{
+			ID:               d.id,
+			Alias:            d.alias,
+			ProvisionedSize:  d.provisionedSize,
+			TotalSize:        d.totalSize,
+			Format:           d.format,
+			StorageDomainIDs: d.storageDomainIDs,
+			Sparse:           d.sparse,
+			ContentType:      d.contentType,
+			WipeAfterDelete:  d.wipeAfterDelete,
+			Shareable:        d.shareable,
+			Backup:           d.backup,
+			StorageType:      d.storageType,
+			LogicalUnit:      logicalUnit,
+			DiskProfileID:    d.diskProfileID,
+			Data:             d.data,
+		})
+	}
+	for _, v := range m.vms {
+		state.VMs = append(state.VMs, m.saveMockStateVM(v))
+		for _, attachment := range m.vmDiskAttachmentsByVM[v.id] {
+			state.DiskAttachments = append(state.DiskAttachments, mockStateDiskAttachment{
+				ID:            attachment.id,
+				VMID:          attachment.vmid,
+				DiskID:        attachment.diskID,
+				DiskInterface: attachment.diskInterface,
+				Bootable:      attachment.bootable,
+				Active:        attachment.active,
+			})
+		}
+	}
+	for _, n := range m.nics {
+		state.NICs = append(state.NICs, mockStateNIC{
+			ID:            n.id,
+			Name:          n.name,
+			VMID:          n.vmid,
+			VNICProfileID: n.vnicProfileID,
+			Mac:           n.mac,
+		})
+	}
+	state.sort()
+	return state
+}
+
+// sort orders the items in the state by ID so saving the same inventory twice produces the same output.
+func (s *mockState) sort() {
+	sort.Slice(s.StorageDomains, func(i, j int) bool { return s.StorageDomains[i].ID < s.StorageDomains[j].ID })
+	sort.Slice(s.Datacenters, func(i, j int) bool { return s.Datacenters[i].ID < s.Datacenters[j].ID })
+	sort.Slice(s.Clusters, func(i, j int) bool { return s.Clusters[i].ID < s.Clusters[j].ID })
+	sort.Slice(s.Hosts, func(i, j int) bool { return s.Hosts[i].ID < s.Hosts[j].ID })
+	sort.Slice(s.Networks, func(i, j int) bool { return s.Networks[i].ID < s.Networks[j].ID })
+	sort.Slice(s.VNICProfiles, func(i, j int) bool { return s.VNICProfiles[i].ID < s.VNICProfiles[j].ID })
+	sort.Slice(s.Tags, func(i, j int) bool { return s.Tags[i].ID < s.Tags[j].ID })
+	sort.Slice(s.Templates, func(i, j int) bool { return s.Templates[i].ID < s.Templates[j].ID })
+	sort.Slice(s.Disks, func(i, j int) bool { return s.Disks[i].ID < s.Disks[j].ID })
+	sort.Slice(s.VMs, func(i, j int) bool { return s.VMs[i].ID < s.VMs[j].ID })
+	sort.Slice(s.DiskAttachments, func(i, j int) bool { return s.DiskAttachments[i].ID < s.DiskAttachments[j].ID })
+	sort.Slice(s.TemplateDisks, func(i, j int) bool { return s.TemplateDisks[i].ID < s.TemplateDisks[j].ID })
+	sort.Slice(s.NICs, func(i, j int) bool { return s.NICs[i].ID < s.NICs[j].ID })
+	sort.Slice(s.CPUProfiles, func(i, j int) bool { return s.CPUProfiles[i].ID < s.CPUProfiles[j].ID })
+	sort.Slice(s.DiskProfiles, func(i, j int) bool { return s.DiskProfiles[i].ID < s.DiskProfiles[j].ID })
+	sort.Slice(s.QoS, func(i, j int) bool { return s.QoS[i].ID < s.QoS[j].ID })
+}
+
+func (m *mockClient) saveMockStateVM(v *vm) mockStateVM {
+	result := mockStateVM{
+		ID:               v.id,
+		Name:             v.name,
+		Comment:          v.comment,
+		Description:      v.description,
+		ClusterID:        v.clusterID,
+		TemplateID:       v.templateID,
+		Status:           v.status,
+		HostID:           v.hostID,
+		CPU:              saveMockStateCPU(v.cpu),
+		Memory:           v.memory,
+		TagIDs:           v.tagIDs,
+		OSType:           v.os.t,
+		VMType:           v.vmType,
+		BIOSType:         v.biosType,
+		SerialConsole:    v.serialConsole,
+		SoundcardEnabled: v.soundcardEnabled,
+		TPMEnabled:       v.tpmEnabled,
+		TimeZone:         v.timeZone,
+		DeleteProtected:  v.deleteProtected,
+		CreationTime:     v.creationTime,
+		EmulatedMachine:  v.emulatedMachine,
+		HostedEngine:     v.hostedEngine,
+		CPUProfileID:     v.cpuProfileID,
+	}
+	if v.customCompatibilityVersion != nil {
+		result.CustomCompatibilityVersion = v.customCompatibilityVersion.String()
+	}
+	return result
+}
+
+func saveMockStateCPU(cpu *vmCPU) mockStateCPU {
+	if cpu == nil || cpu.topo == nil {
+		return mockStateCPU{Sockets: 1, Cores: 1, Threads: 1}
+	}
+	return mockStateCPU{
+		Sockets: cpu.topo.sockets,
+		Cores:   cpu.topo.cores,
+		Threads: cpu.topo.threads,
+	}
+}
+
+func (s mockStateCPU) toVMCPU() *vmCPU {
+	return &vmCPU{
+		topo: &vmCPUTopo{
+			cores:   s.Cores,
+			threads: s.Threads,
+			sockets: s.Sockets,
+		},
+	}
+}
+
+// loadState replaces the inventory of the mock with the specified state. It must be called with the lock held.
+func (m *mockClient) loadState(state *mockState) error {
+	m.resetInventory()
+	for _, sd := range state.StorageDomains {
+		m.storageDomains[sd.ID] = &storageDomain{
+			client:         m,
+			id:             sd.ID,
+			name:           sd.Name,
+			available:      sd.Capacity,
+			storageType:    sd.StorageType,
+			status:         sd.Status,
+			externalStatus: sd.ExternalStatus,
+		}
+		unregistered, err := sd.Unregistered.toUnregisteredEntities(m, sd.ID)
+		if err != nil {
+			return err
+		}
+		m.unregisteredByStorageDomain[sd.ID] = unregistered
+	}
+	for _, c := range state.Clusters {
+		compatibilityVersion, err := parseMockStateVersion(c.CompatibilityVersion)
+		if err != nil {
+			return err
+		}
+		schedulingPolicyID := c.SchedulingPolicyID
+		if schedulingPolicyID == "" {
+			schedulingPolicyID = mockDefaultSchedulingPolicyID
+		}
+		if _, ok := m.schedulingPolicies[schedulingPolicyID]; !ok {
+			return newError(
+				EBadArgument,
+				"cluster %s refers to non-existent scheduling policy %s",
+				c.ID,
+				schedulingPolicyID,
+			)
+		}
+		m.clusters[c.ID] = (&cluster{
+			client:               m,
+			id:                   c.ID,
+			name:                 c.Name,
+			compatibilityVersion: compatibilityVersion,
+		}).withSchedulingPolicy(schedulingPolicyID, c.SchedulingPolicyProperties)
+		m.affinityGroups[c.ID] = map[AffinityGroupID]*affinityGroup{}
+	}
+	for _, dc := range state.Datacenters {
+		for _, clusterID := range dc.Clusters {
+			if _, ok := m.clusters[clusterID]; !ok {
+				return newError(EBadArgument, "datacenter %s refers to non-existent cluster %s", dc.ID, clusterID)
+			}
+		}
+		m.dataCenters[dc.ID] = &datacenterWithClusters{
+			datacenter: datacenter{
+				client: m,
+				id:     dc.ID,
+				name:   dc.Name,
+			},
+			clusters: dc.Clusters,
+		}
+	}
+	for _, h := range state.Hosts {
+		if _, ok := m.clusters[h.ClusterID]; !ok {
+			return newError(EBadArgument, "host %s refers to non-existent cluster %s", h.ID, h.ClusterID)
+		}
+		item, numaNodes := h.Capacity.applyTo(&host{
+			client:                 m,
+			id:                     h.ID,
+			name:                   h.Name,
+			address:                h.Address,
+			clusterID:              h.ClusterID,
+			status:                 h.Status,
+			powerManagementEnabled: h.PowerManagementEnabled,
+			reinstallationRequired: h.ReinstallationRequired,
+			hostedEngine:           h.HostedEngine.toHostedEngineStatus(),
+		})
+		m.hosts[h.ID] = item
+		m.numaNodesByHost[h.ID] = numaNodes
+		m.fenceAgentsByHost[h.ID] = []*hostFenceAgent{}
+		m.errataByHost[h.ID] = []*erratum{}
+		m.hostNICsByHost[h.ID] = generateMockHostNICs(item)
+		m.networkAttachmentsByHost[h.ID] = []*hostNetworkAttachment{}
+	}
+	for _, n := range state.Networks {
+		if _, ok := m.dataCenters[n.DatacenterID]; !ok {
+			return newError(EBadArgument, "network %s refers to non-existent datacenter %s", n.ID, n.DatacenterID)
+		}
+		m.networks[n.ID] = &network{
+			client: m,
+			id:     n.ID,
+			name:   n.Name,
+			dcID:   n.DatacenterID,
+		}
+	}
+	for _, p := range state.VNICProfiles {
+		if _, ok := m.networks[p.NetworkID]; !ok {
+			return newError(EBadArgument, "VNIC profile %s refers to non-existent network %s", p.ID, p.NetworkID)
+		}
+		m.vnicProfiles[p.ID] = &vnicProfile{
+			client:    m,
+			id:        p.ID,
+			name:      p.Name,
+			networkID: p.NetworkID,
+		}
+	}
+	for _, t := range state.Tags {
+		m.tags[t.ID] = &tag{
+			client:      m,
+			id:          t.ID,
+			name:        t.Name,
+			description: t.Description,
+		}
+	}
+	if err := m.loadStateQoS(state); err != nil {
+		return err
+	}
+	if err := m.loadStateProfiles(state); err != nil {
+		return err
+	}
+	if err := m.loadStateDisks(state); err != nil {
+		return err
+	}
+	if err := m.loadStateTemplates(state); err != nil {
+		return err
+	}
+	return m.loadStateVMs(state)
+}
+
+// loadStateQoS loads the datacenter QoS entries from the state.
+func (m *mockClient) loadStateQoS(state *mockState) error {
+	for _, q := range state.QoS {
+		if _, ok := m.dataCenters[q.DatacenterID]; !ok {
+			return newError(EBadArgument, "QoS entry %s refers to non-existent datacenter %s", q.ID, q.DatacenterID)
+		}
+		if err := q.Type.Validate(); err != nil {
+			return wrap(err, EBadArgument, "invalid type for QoS entry %s", q.ID)
+		}
+		m.qos[q.ID] = &qos{
+			qosLimits: qosLimits{
+				maxIOPS:            q.MaxIOPS,
+				maxReadIOPS:        q.MaxReadIOPS,
+				maxWriteIOPS:       q.MaxWriteIOPS,
+				maxThroughput:      q.MaxThroughput,
+				maxReadThroughput:  q.MaxReadThroughput,
+				maxWriteThroughput: q.MaxWriteThroughput,
+				cpuLimit:           q.CPULimit,
+				inboundAverage:     q.InboundAverage,
+				inboundPeak:        q.InboundPeak,
+				inboundBurst:       q.InboundBurst,
+				outboundAverage:    q.OutboundAverage,
+				outboundPeak:       q.OutboundPeak,
+				outboundBurst:      q.OutboundBurst,
+			},
+			client:       m,
+			id:           q.ID,
+			name:         q.Name,
+			description:  q.Description,
+			qosType:      q.Type,
+			datacenterID: q.DatacenterID,
+		}
+	}
+	return nil
+}
+
+// loadStateProfiles loads the CPU and disk profiles from the state and adds a default profile to the clusters and
+// storage domains that have none, like the engine does when they are created.
+func (m *mockClient) loadStateProfiles(state *mockState) error {
+	for _, p := range state.CPUProfiles {
+		if _, ok := m.clusters[p.ClusterID]; !ok {
+			return newError(EBadArgument, "CPU profile %s refers to non-existent cluster %s", p.ID, p.ClusterID)
+		}
+		if err := m.validateMockProfileQoS(p.QoSID, QoSTypeCPU); err != nil {
+			return wrap(err, EBadArgument, "invalid QoS entry for CPU profile %s", p.ID)
+		}
+		m.cpuProfiles[p.ID] = &cpuProfile{
+			client:      m,
+			id:          p.ID,
+			name:        p.Name,
+			description: p.Description,
+			clusterID:   p.ClusterID,
+			qosID:       p.QoSID,
+		}
+	}
+	for _, c := range m.clusters {
+		if m.defaultCPUProfileID(c.id) == "" {
+			m.addDefaultCPUProfile(c)
+		}
+	}
+	for _, p := range state.DiskProfiles {
+		if _, ok := m.storageDomains[p.StorageDomainID]; !ok {
+			return newError(
+				EBadArgument,
+				"disk profile %s refers to non-existent storage domain %s",
+				p.ID,
+				p.StorageDomainID,
+			)
+		}
+		if err := m.validateMockProfileQoS(p.QoSID, QoSTypeStorage); err != nil {
+			return wrap(err, EBadArgument, "invalid QoS entry for disk profile %s", p.ID)
+		}
+		m.diskProfiles[p.ID] = &diskProfile{
+			client:          m,
+			id:              p.ID,
+			name:            p.Name,
+			description:     p.Description,
+			storageDomainID: p.StorageDomainID,
+			qosID:           p.QoSID,
+		}
+	}
+	for _, sd := range m.storageDomains {
+		if m.defaultDiskProfileID(sd.id) == nil {
+			m.addDefaultDiskProfile(sd)
+		}
+	}
+	return nil
+}
+
+func (m *mockClient) loadStateDisks(state *mockState) error {
+	for _, d := range state.Disks {
+		for _, storageDomainID := range d.StorageDomainIDs {
+			if _, ok := m.storageDomains[storageDomainID]; !ok {
+				return newError(
+					EBadArgument,
+					"disk %s refers to non-existent storage domain %s",
+					d.ID,
+					storageDomainID,
+				)
+			}
+		}
+		backup := d.Backup
+		if backup == "" {
+			backup = DiskBackupNone
+		}
+		storageType := d.StorageType
+		if storageType == "" {
+			storageType = DiskStorageTypeImage
+		}
+		diskProfileID := d.DiskProfileID
+		if diskProfileID != nil {
+			if _, ok := m.diskProfiles[*diskProfileID]; !ok {
+				return newError(EBadArgument, "disk %s refers to non-existent disk profile %s", d.ID, *diskProfileID)
+			}
+		} else if storageType == DiskStorageTypeImage && len(d.StorageDomainIDs) > 0 {
+			diskProfileID = m.defaultDiskProfileID(d.StorageDomainIDs[0])
+		}
+		var logicalUnit *diskLogicalUnit
+		if lu := d.LogicalUnit; lu != nil {
+			logicalUnit = &diskLogicalUnit{
+				id:          lu.ID,
+				storageType: lu.StorageType,
+				address:     lu.Address,
+				port:        lu.Port,
+				target:      lu.Target,
+				size:        lu.Size,
+			}
+		}
+		m.disks[d.ID] = &diskWithData{
+			disk: disk{
+				client:           m,
+				id:               d.ID,
+				alias:            d.Alias,
+				provisionedSize:  d.ProvisionedSize,
+				totalSize:        d.TotalSize,
+				format:           d.Format,
+				storageDomainIDs: d.StorageDomainIDs,
+				status:           DiskStatusOK,
+				sparse:           d.Sparse,
+				contentType:      d.ContentType,
+				wipeAfterDelete:  d.WipeAfterDelete,
+				shareable:        d.Shareable,
+				backup:           backup,
+				storageType:      storageType,
+				logicalUnit:      logicalUnit,
+				diskProfileID:    diskProfileID,
+			},
+			lock: &sync.Mutex{},
+			data: d.Data,
+		}
+	}
+	return nil
+}
+
+func (m *mockClient) loadStateTemplates(state *mockState) error {
+	for _, t := range state.Templates {
+		versionNumber := t.VersionNumber
+		if versionNumber == 0 {
+			versionNumber = 1
+		}
+		baseTemplateID := t.BaseTemplateID
+		if baseTemplateID == "" {
+			baseTemplateID = t.ID
+		}
+		m.templates[t.ID] = &template{
+			client:         m,
+			id:             t.ID,
+			name:           t.Name,
+			description:    t.Description,
+			status:         t.Status,
+			cpu:            t.CPU.toVMCPU(),
+			creationTime:   t.CreationTime,
+			versionNumber:  versionNumber,
+			versionName:    t.VersionName,
+			baseTemplateID: baseTemplateID,
+		}
+		m.templateDiskAttachmentsByTemplate[t.ID] = []*templateDiskAttachment{}
+		for _, n := range t.NICs {
+			m.templateNICsByTemplate[t.ID] = append(m.templateNICsByTemplate[t.ID], &templateNIC{
+				client:        m,
+				id:            n.ID,
+				name:          n.Name,
+				templateID:    t.ID,
+				vnicProfileID: n.VNICProfileID,
+			})
+		}
+	}
+	for _, tpl := range m.templates {
+		if _, ok := m.templates[tpl.baseTemplateID]; !ok {
+			return newError(EBadArgument, "template %s refers to non-existent base template %s", tpl.id, tpl.baseTemplateID)
+		}
+	}
+	for _, a := range state.TemplateDisks {
+		if _, ok := m.templates[a.TemplateID]; !ok {
+			return newError(EBadArgument, "template disk attachment %s refers to non-existent template %s", a.ID, a.TemplateID)
+		}
+		if _, ok := m.disks[a.DiskID]; !ok {
+			return newError(EBadArgument, "template disk attachment %s refers to non-existent disk %s", a.ID, a.DiskID)
+		}
+		attachment := &templateDiskAttachment{
+			client:        m,
+			id:            a.ID,
+			templateID:    a.TemplateID,
+			diskID:        a.DiskID,
+			diskInterface: a.DiskInterface,
+			bootable:      a.Bootable,
+			active:        a.Active,
+		}
+		m.templateDiskAttachmentsByTemplate[a.TemplateID] = append(
+			m.templateDiskAttachmentsByTemplate[a.TemplateID],
+			attachment,
+		)
+		m.templateDiskAttachmentsByDisk[a.DiskID] = attachment
+	}
+	return nil
+}
+
+func (m *mockClient) loadStateVMs(state *mockState) error {
+	for _, v := range state.VMs {
+		if _, ok := m.clusters[v.ClusterID]; !ok {
+			return newError(EBadArgument, "VM %s refers to non-existent cluster %s", v.ID, v.ClusterID)
+		}
+		if _, ok := m.templates[v.TemplateID]; !ok {
+			return newError(EBadArgument, "VM %s refers to non-existent template %s", v.ID, v.TemplateID)
+		}
+		for _, tagID := range v.TagIDs {
+			if _, ok := m.tags[tagID]; !ok {
+				return newError(EBadArgument, "VM %s refers to non-existent tag %s", v.ID, tagID)
+			}
+		}
+		// Creating the VM through the regular path fills in the defaults for the parts that are not saved.
+		item := m.createVM(v.Name, &vmParams{}, v.ClusterID, v.TemplateID, v.CPU.toVMCPU())
+		delete(m.vms, item.id)
+		item.id = v.ID
+		item.comment = v.Comment
+		item.description = v.Description
+		item.status = v.Status
+		item.hostID = v.HostID
+		item.memory = v.Memory
+		item.tagIDs = v.TagIDs
+		item.os.t = v.OSType
+		item.vmType = v.VMType
+		item.biosType = v.BIOSType
+		item.serialConsole = v.SerialConsole
+		item.soundcardEnabled = v.SoundcardEnabled
+		item.tpmEnabled = v.TPMEnabled
+		item.timeZone = v.TimeZone
+		item.deleteProtected = v.DeleteProtected
+		item.creationTime = v.CreationTime
+		item.emulatedMachine = v.EmulatedMachine
+		item.hostedEngine = v.HostedEngine
+		if v.CPUProfileID != "" {
+			if _, ok := m.cpuProfiles[v.CPUProfileID]; !ok {
+				return newError(EBadArgument, "VM %s refers to non-existent CPU profile %s", v.ID, v.CPUProfileID)
+			}
+			item.cpuProfileID = v.CPUProfileID
+		}
+		if v.CustomCompatibilityVersion != "" {
+			version, err := parseMockStateVersion(v.CustomCompatibilityVersion)
+			if err != nil {
+				return err
+			}
+			item.customCompatibilityVersion = version
+		}
+		m.vms[item.id] = item
+		m.vmDiskAttachmentsByVM[item.id] = map[DiskAttachmentID]*diskAttachment{}
+		m.vmIPs[item.id] = map[string][]net.IP{}
+		m.addGraphicsConsoles(item)
+	}
+	for _, a := range state.DiskAttachments {
+		if _, ok := m.vms[a.VMID]; !ok {
+			return newError(EBadArgument, "disk attachment %s refers to non-existent VM %s", a.ID, a.VMID)
+		}
+		if _, ok := m.disks[a.DiskID]; !ok {
+			return newError(EBadArgument, "disk attachment %s refers to non-existent disk %s", a.ID, a.DiskID)
+		}
+		attachment := &diskAttachment{
+			client:        m,
+			id:            a.ID,
+			vmid:          a.VMID,
+			diskID:        a.DiskID,
+			diskInterface: a.DiskInterface,
+			bootable:      a.Bootable,
+			active:        a.Active,
+		}
+		m.vmDiskAttachmentsByVM[a.VMID][a.ID] = attachment
+		m.addDiskAttachmentByDisk(attachment)
+	}
+	for _, n := range state.NICs {
+		if _, ok := m.vms[n.VMID]; !ok {
+			return newError(EBadArgument, "NIC %s refers to non-existent VM %s", n.ID, n.VMID)
+		}
+		if _, ok := m.vnicProfiles[n.VNICProfileID]; !ok {
+			return newError(EBadArgument, "NIC %s refers to non-existent VNIC profile %s", n.ID, n.VNICProfileID)
+		}
+		m.nics[n.ID] = &nic{
+			client:        m,
+			id:            n.ID,
+			name:          n.Name,
+			vmid:          n.VMID,
+			vnicProfileID: n.VNICProfileID,
+			mac:           n.Mac,
+		}
+	}
+	return nil
+}
+
+// resetInventory empties the parts of the mock that are covered by the saved state, as well as the parts that
+// refer to them. It must be called with the lock held.
+func (m *mockClient) resetInventory() {
+	m.vms = map[VMID]*vm{}
+	m.storageDomains = map[StorageDomainID]*storageDomain{}
+	m.disks = map[DiskID]*diskWithData{}
+	m.clusters = map[ClusterID]*cluster{}
+	m.cpuProfiles = map[CPUProfileID]*cpuProfile{}
+	m.diskProfiles = map[DiskProfileID]*diskProfile{}
+	m.qos = map[QoSID]*qos{}
+	m.hosts = map[HostID]*host{}
+	m.templates = map[TemplateID]*template{}
+	m.nics = map[NICID]*nic{}
+	m.vnicProfiles = map[VNICProfileID]*vnicProfile{}
+	m.networks = map[NetworkID]*network{}
+	m.dataCenters = map[DatacenterID]*datacenterWithClusters{}
+	m.vmDiskAttachmentsByVM = map[VMID]map[DiskAttachmentID]*diskAttachment{}
+	m.vmDiskAttachmentsByDisk = map[DiskID]map[DiskAttachmentID]*diskAttachment{}
+	m.templateDiskAttachmentsByTemplate = map[TemplateID][]*templateDiskAttachment{}
+	m.templateDiskAttachmentsByDisk = map[DiskID]*templateDiskAttachment{}
+	m.templateNICsByTemplate = map[TemplateID][]*templateNIC{}
+	m.tags = map[TagID]*tag{}
+	m.affinityGroups = map[ClusterID]map[AffinityGroupID]*affinityGroup{}
+	m.vmIPs = map[VMID]map[string][]net.IP{}
+	m.graphicsConsolesByVM = map[VMID][]*vmGraphicsConsole{}
+	m.fenceAgentsByHost = map[HostID][]*hostFenceAgent{}
+	m.errataByHost = map[HostID][]*erratum{}
+	m.numaNodesByHost = map[HostID][]*hostNUMANode{}
+	m.unregisteredByStorageDomain = map[StorageDomainID]*unregisteredEntities{}
+	m.iscsiLogicalUnitsByHost = map[HostID][]*hostLogicalUnit{}
+	m.hostNICsByHost = map[HostID][]*hostNIC{}
+	m.networkAttachmentsByHost = map[HostID][]*hostNetworkAttachment{}
+	m.clusterMigrationSettings = map[ClusterID]*clusterMigrationSettings{}
+	m.vmMigrationSettings = map[VMID]*vmMigrationSettings{}
+}
+
+func parseMockStateVersion(v string) (Version, error) {
+	var major, minor uint
+	if _, err := fmt.Sscanf(v, "%d.%d", &major, &minor); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid compatibility version in mock state: %s", v)
+	}
+	return NewVersion(major, minor), nil
+}
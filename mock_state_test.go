@@ -0,0 +1,104 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestMockStateRoundTrip(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("Failed to list storage domains (%v)", err)
+	}
+	vnicProfiles, err := client.ListVNICProfiles()
+	if err != nil {
+		t.Fatalf("Failed to list VNIC profiles (%v)", err)
+	}
+
+	vm, err := client.CreateVM(
+		clusters[0].ID(),
+		ovirtclient.DefaultBlankTemplateID,
+		"test",
+		ovirtclient.NewCreateVMParams().MustWithComment("saved"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	disk, err := client.CreateDisk(storageDomains[0].ID(), ovirtclient.ImageFormatRaw, 1048576, nil)
+	if err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+	if _, err := client.CreateDiskAttachment(vm.ID(), disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		t.Fatalf("Failed to attach disk (%v)", err)
+	}
+	if _, err := vm.CreateNIC("eth0", vnicProfiles[0].ID(), nil); err != nil {
+		t.Fatalf("Failed to create NIC (%v)", err)
+	}
+	tag, err := client.CreateTag("saved", nil)
+	if err != nil {
+		t.Fatalf("Failed to create tag (%v)", err)
+	}
+	if err := vm.AddTag(tag.ID()); err != nil {
+		t.Fatalf("Failed to add tag (%v)", err)
+	}
+
+	file := filepath.Join(t.TempDir(), "state.json")
+	if err := client.SaveStateFile(file); err != nil {
+		t.Fatalf("Failed to save mock state (%v)", err)
+	}
+	loaded, err := ovirtclient.NewMockFromStateFile(file, ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to load mock state (%v)", err)
+	}
+
+	loadedVM, err := loaded.GetVMByName("test")
+	if err != nil {
+		t.Fatalf("Failed to fetch VM from loaded state (%v)", err)
+	}
+	if loadedVM.ID() != vm.ID() || loadedVM.Comment() != "saved" {
+		t.Fatalf("The loaded VM does not match the saved one (ID: %s, comment: %s).", loadedVM.ID(), loadedVM.Comment())
+	}
+	attachments, err := loadedVM.ListDiskAttachments()
+	if err != nil || len(attachments) != 1 || attachments[0].DiskID() != disk.ID() {
+		t.Fatalf("The disk attachment was not loaded (%v)", err)
+	}
+	nics, err := loadedVM.ListNICs()
+	if err != nil || len(nics) != 1 || nics[0].Name() != "eth0" {
+		t.Fatalf("The NIC was not loaded (%v)", err)
+	}
+	if tagIDs := loadedVM.TagIDs(); len(tagIDs) != 1 || tagIDs[0] != tag.ID() {
+		t.Fatalf("The tags of the VM were not loaded: %v", tagIDs)
+	}
+
+	original := &bytes.Buffer{}
+	if err := client.SaveState(original); err != nil {
+		t.Fatalf("Failed to save mock state (%v)", err)
+	}
+	reloaded := &bytes.Buffer{}
+	if err := loaded.SaveState(reloaded); err != nil {
+		t.Fatalf("Failed to save loaded mock state (%v)", err)
+	}
+	if original.String() != reloaded.String() {
+		t.Fatalf("Saving the loaded state produced a different result.")
+	}
+}
+
+func TestMockStateRejectsDanglingReferences(t *testing.T) {
+	t.Parallel()
+	state := `{"version": 1, "nics": [{"id": "1", "name": "eth0", "vm_id": "missing"}]}`
+	_, err := ovirtclient.NewMockFromState(strings.NewReader(state), ovirtclientlog.NewTestLogger(t))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Loading a NIC for a non-existent VM did not fail with EBadArgument (%v)", err)
+	}
+}
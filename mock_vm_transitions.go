@@ -0,0 +1,194 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// MockVMTransitionDelays describes how long the VMs in the mock client stay in a transitional status. This can be
+// used to test the code waiting for VM statuses, including the handling of timeouts.
+type MockVMTransitionDelays interface {
+	// ImageLocked returns how long a newly created VM stays in the image_locked status before going down. If zero,
+	// VMs are created in the down status.
+	ImageLocked() time.Duration
+	// WaitForLaunch returns how long a started VM stays in the wait_for_launch status.
+	WaitForLaunch() time.Duration
+	// PoweringUp returns how long a started VM stays in the powering_up status before it is up.
+	PoweringUp() time.Duration
+	// PoweringDown returns how long a stopped or shut down VM stays in the powering_down status.
+	PoweringDown() time.Duration
+	// IPReport returns how long it takes after a VM is up until its IP addresses are reported.
+	IPReport() time.Duration
+}
+
+// BuildableMockVMTransitionDelays is a buildable version of MockVMTransitionDelays.
+type BuildableMockVMTransitionDelays interface {
+	MockVMTransitionDelays
+
+	// WithImageLocked sets how long a newly created VM stays in the image_locked status.
+	WithImageLocked(delay time.Duration) (BuildableMockVMTransitionDelays, error)
+	// MustWithImageLocked is identical to WithImageLocked, but panics instead of returning an error.
+	MustWithImageLocked(delay time.Duration) BuildableMockVMTransitionDelays
+
+	// WithWaitForLaunch sets how long a started VM stays in the wait_for_launch status.
+	WithWaitForLaunch(delay time.Duration) (BuildableMockVMTransitionDelays, error)
+	// MustWithWaitForLaunch is identical to WithWaitForLaunch, but panics instead of returning an error.
+	MustWithWaitForLaunch(delay time.Duration) BuildableMockVMTransitionDelays
+
+	// WithPoweringUp sets how long a started VM stays in the powering_up status.
+	WithPoweringUp(delay time.Duration) (BuildableMockVMTransitionDelays, error)
+	// MustWithPoweringUp is identical to WithPoweringUp, but panics instead of returning an error.
+	MustWithPoweringUp(delay time.Duration) BuildableMockVMTransitionDelays
+
+	// WithPoweringDown sets how long a stopped or shut down VM stays in the powering_down status.
+	WithPoweringDown(delay time.Duration) (BuildableMockVMTransitionDelays, error)
+	// MustWithPoweringDown is identical to WithPoweringDown, but panics instead of returning an error.
+	MustWithPoweringDown(delay time.Duration) BuildableMockVMTransitionDelays
+
+	// WithIPReport sets how long it takes after a VM is up until its IP addresses are reported.
+	WithIPReport(delay time.Duration) (BuildableMockVMTransitionDelays, error)
+	// MustWithIPReport is identical to WithIPReport, but panics instead of returning an error.
+	MustWithIPReport(delay time.Duration) BuildableMockVMTransitionDelays
+}
+
+// NewMockVMTransitionDelays creates a builder for the VM transition delays of the mock client, pre-filled with the
+// defaults the mock uses.
+func NewMockVMTransitionDelays() BuildableMockVMTransitionDelays {
+	return defaultMockVMTransitionDelays()
+}
+
+func defaultMockVMTransitionDelays() *mockVMTransitionDelays {
+	return &mockVMTransitionDelays{
+		imageLocked:   0,
+		waitForLaunch: 2 * time.Second,
+		poweringUp:    2 * time.Second,
+		poweringDown:  2 * time.Second,
+		ipReport:      10 * time.Second,
+	}
+}
+
+type mockVMTransitionDelays struct {
+	imageLocked   time.Duration
+	waitForLaunch time.Duration
+	poweringUp    time.Duration
+	poweringDown  time.Duration
+	ipReport      time.Duration
+}
+
+func (m *mockVMTransitionDelays) ImageLocked() time.Duration {
+	return m.imageLocked
+}
+
+func (m *mockVMTransitionDelays) WaitForLaunch() time.Duration {
+	return m.waitForLaunch
+}
+
+func (m *mockVMTransitionDelays) PoweringUp() time.Duration {
+	return m.poweringUp
+}
+
+func (m *mockVMTransitionDelays) PoweringDown() time.Duration {
+	return m.poweringDown
+}
+
+func (m *mockVMTransitionDelays) IPReport() time.Duration {
+	return m.ipReport
+}
+
+func validateMockVMTransitionDelay(delay time.Duration) error {
+	if delay < 0 {
+		return newError(EBadArgument, "VM transition delays must not be negative (%s)", delay)
+	}
+	return nil
+}
+
+func (m *mockVMTransitionDelays) WithImageLocked(delay time.Duration) (BuildableMockVMTransitionDelays, error) {
+	if err := validateMockVMTransitionDelay(delay); err != nil {
+		return m, err
+	}
+	m.imageLocked = delay
+	return m, nil
+}
+
+func (m *mockVMTransitionDelays) MustWithImageLocked(delay time.Duration) BuildableMockVMTransitionDelays {
+	builder, err := m.WithImageLocked(delay)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *mockVMTransitionDelays) WithWaitForLaunch(delay time.Duration) (BuildableMockVMTransitionDelays, error) {
+	if err := validateMockVMTransitionDelay(delay); err != nil {
+		return m, err
+	}
+	m.waitForLaunch = delay
+	return m, nil
+}
+
+func (m *mockVMTransitionDelays) MustWithWaitForLaunch(delay time.Duration) BuildableMockVMTransitionDelays {
+	builder, err := m.WithWaitForLaunch(delay)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *mockVMTransitionDelays) WithPoweringUp(delay time.Duration) (BuildableMockVMTransitionDelays, error) {
+	if err := validateMockVMTransitionDelay(delay); err != nil {
+		return m, err
+	}
+	m.poweringUp = delay
+	return m, nil
+}
+
+func (m *mockVMTransitionDelays) MustWithPoweringUp(delay time.Duration) BuildableMockVMTransitionDelays {
+	builder, err := m.WithPoweringUp(delay)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *mockVMTransitionDelays) WithPoweringDown(delay time.Duration) (BuildableMockVMTransitionDelays, error) {
+	if err := validateMockVMTransitionDelay(delay); err != nil {
+		return m, err
+	}
+	m.poweringDown = delay
+	return m, nil
+}
+
+func (m *mockVMTransitionDelays) MustWithPoweringDown(delay time.Duration) BuildableMockVMTransitionDelays {
+	builder, err := m.WithPoweringDown(delay)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *mockVMTransitionDelays) WithIPReport(delay time.Duration) (BuildableMockVMTransitionDelays, error) {
+	if err := validateMockVMTransitionDelay(delay); err != nil {
+		return m, err
+	}
+	m.ipReport = delay
+	return m, nil
+}
+
+func (m *mockVMTransitionDelays) MustWithIPReport(delay time.Duration) BuildableMockVMTransitionDelays {
+	builder, err := m.WithIPReport(delay)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *mockClient) SetVMTransitionDelays(delays MockVMTransitionDelays) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	*m.vmTransitionDelays = mockVMTransitionDelays{
+		imageLocked:   delays.ImageLocked(),
+		waitForLaunch: delays.WaitForLaunch(),
+		poweringUp:    delays.PoweringUp(),
+		poweringDown:  delays.PoweringDown(),
+		ipReport:      delays.IPReport(),
+	}
+}
@@ -0,0 +1,86 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func createMockClientWithVMTransitionDelays(
+	t *testing.T,
+	delays ovirtclient.MockVMTransitionDelays,
+) (ovirtclient.MockClient, ovirtclient.ClusterID) {
+	client := ovirtclient.NewMock()
+	client.SetVMTransitionDelays(delays)
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	return client, clusters[0].ID()
+}
+
+func TestMockVMTransitions(t *testing.T) {
+	t.Parallel()
+	client, clusterID := createMockClientWithVMTransitionDelays(
+		t,
+		ovirtclient.NewMockVMTransitionDelays().
+			MustWithImageLocked(100*time.Millisecond).
+			MustWithWaitForLaunch(10*time.Millisecond).
+			MustWithPoweringUp(10*time.Millisecond).
+			MustWithPoweringDown(10*time.Millisecond),
+	)
+
+	vm, err := client.CreateVM(clusterID, ovirtclient.DefaultBlankTemplateID, "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	if vm.Status() != ovirtclient.VMStatusImageLocked {
+		t.Fatalf("The new VM is not image locked (%s).", vm.Status())
+	}
+	if err := vm.Start(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Starting an image locked VM did not fail with EConflict (%v)", err)
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusDown); err != nil {
+		t.Fatalf("The VM did not go down after the image was unlocked (%v)", err)
+	}
+	if err := vm.Start(); err != nil {
+		t.Fatalf("Failed to start VM (%v)", err)
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusUp); err != nil {
+		t.Fatalf("The VM did not come up (%v)", err)
+	}
+	if err := vm.Stop(false); err != nil {
+		t.Fatalf("Failed to stop VM (%v)", err)
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusDown); err != nil {
+		t.Fatalf("The VM did not go down (%v)", err)
+	}
+}
+
+func TestMockVMTransitionTimeout(t *testing.T) {
+	t.Parallel()
+	client, clusterID := createMockClientWithVMTransitionDelays(
+		t,
+		ovirtclient.NewMockVMTransitionDelays().MustWithPoweringUp(time.Minute),
+	)
+
+	vm, err := client.CreateVM(clusterID, ovirtclient.DefaultBlankTemplateID, "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	if err := vm.Start(); err != nil {
+		t.Fatalf("Failed to start VM (%v)", err)
+	}
+	_, err = vm.WaitForStatus(ovirtclient.VMStatusUp, ovirtclient.Timeout(3*time.Second))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETimeout) {
+		t.Fatalf("Waiting for a slow VM did not time out (%v)", err)
+	}
+}
+
+func TestMockVMTransitionDelaysRejectNegative(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.NewMockVMTransitionDelays().WithPoweringUp(-time.Second); err == nil {
+		t.Fatalf("Setting a negative transition delay did not fail.")
+	}
+}
@@ -0,0 +1,177 @@
+package ovirtclient
+
+import (
+	"sync"
+)
+
+// MutationListenerClient is the client interface part that lets external components, such as caches, receive
+// notifications when the client changes a resource.
+type MutationListenerClient interface {
+	// AddMutationListener registers a listener that is called after the client has successfully changed a resource.
+	// The returned function removes the listener again.
+	//
+	// Listeners are called in a separate goroutine, so they may safely call the client. The order in which events
+	// are delivered is not guaranteed, and only changes made through this client (and its subclients created using
+	// WithContext) are reported. Changes made by other clients or in the oVirt Engine UI are not reported.
+	AddMutationListener(listener MutationListener) (remove func())
+}
+
+// MutationListener is a function that receives mutation events.
+type MutationListener func(event MutationEvent)
+
+// MutationEvent describes a change the client has made to a resource.
+type MutationEvent interface {
+	// ResourceType returns the type of the resource that has been changed.
+	ResourceType() ResourceType
+	// ResourceID returns the ID of the resource that has been changed.
+	ResourceID() string
+	// ParentID returns the ID of the resource the changed resource belongs to, for example the VM ID of a NIC or
+	// the cluster ID of an affinity group. It is empty for top-level resources.
+	ParentID() string
+	// MutationType returns the kind of change that happened.
+	MutationType() MutationType
+}
+
+// ResourceType is the type of resource a MutationEvent refers to.
+type ResourceType string
+
+const (
+	// ResourceTypeVM is a virtual machine. The resource ID is a VMID.
+	ResourceTypeVM ResourceType = "vm"
+	// ResourceTypeDisk is a disk. The resource ID is a DiskID.
+	ResourceTypeDisk ResourceType = "disk"
+	// ResourceTypeDiskAttachment is a disk attachment. The resource ID is a DiskAttachmentID, the parent ID is a VMID.
+	ResourceTypeDiskAttachment ResourceType = "disk_attachment"
+	// ResourceTypeNIC is a network interface. The resource ID is a NICID, the parent ID is a VMID.
+	ResourceTypeNIC ResourceType = "nic"
+	// ResourceTypeVNICProfile is a VNIC profile. The resource ID is a VNICProfileID.
+	ResourceTypeVNICProfile ResourceType = "vnic_profile"
+	// ResourceTypeTag is a tag. The resource ID is a TagID.
+	ResourceTypeTag ResourceType = "tag"
+	// ResourceTypeTemplate is a template. The resource ID is a TemplateID.
+	ResourceTypeTemplate ResourceType = "template"
+	// ResourceTypeAffinityGroup is an affinity group. The resource ID is an AffinityGroupID, the parent ID is a
+	// ClusterID.
+	ResourceTypeAffinityGroup ResourceType = "affinity_group"
+	// ResourceTypeGraphicsConsole is a graphics console. The resource ID is a VMGraphicsConsoleID, the parent ID is a
+	// VMID.
+	ResourceTypeGraphicsConsole ResourceType = "graphics_console"
+	// ResourceTypeCluster is a cluster. The resource ID is a ClusterID.
+	ResourceTypeCluster ResourceType = "cluster"
+	// ResourceTypeHost is a host. The resource ID is a HostID.
+	ResourceTypeHost ResourceType = "host"
+	// ResourceTypeBackup is a VM backup. The resource ID is a BackupID, the parent ID is a VMID.
+	ResourceTypeBackup ResourceType = "backup"
+	// ResourceTypeWatchdog is a watchdog device. The resource ID is a VMWatchdogID, the parent ID is a VMID.
+	ResourceTypeWatchdog ResourceType = "watchdog"
+	// ResourceTypeFenceAgent is a fence agent of a host. The resource ID is a HostFenceAgentID, the parent ID is a
+	// HostID.
+	ResourceTypeFenceAgent ResourceType = "fence_agent"
+	// ResourceTypeQoS is a QoS entry. The resource ID is a QoSID, the parent ID is a DatacenterID.
+	ResourceTypeQoS ResourceType = "qos"
+	// ResourceTypeCPUProfile is a CPU profile. The resource ID is a CPUProfileID, the parent ID is a ClusterID.
+	ResourceTypeCPUProfile ResourceType = "cpu_profile"
+	// ResourceTypeDiskProfile is a disk profile. The resource ID is a DiskProfileID, the parent ID is a
+	// StorageDomainID.
+	ResourceTypeDiskProfile ResourceType = "disk_profile"
+	// ResourceTypeStorageDomain is a storage domain. The resource ID is a StorageDomainID.
+	ResourceTypeStorageDomain ResourceType = "storage_domain"
+)
+
+// MutationType describes the kind of change in a MutationEvent.
+type MutationType string
+
+const (
+	// MutationTypeCreated indicates that a resource has been created.
+	MutationTypeCreated MutationType = "created"
+	// MutationTypeUpdated indicates that a resource has been changed, including status changes such as starting a
+	// VM.
+	MutationTypeUpdated MutationType = "updated"
+	// MutationTypeRemoved indicates that a resource has been removed.
+	MutationTypeRemoved MutationType = "removed"
+)
+
+type mutationEvent struct {
+	resourceType ResourceType
+	resourceID   string
+	parentID     string
+	mutationType MutationType
+}
+
+func (m mutationEvent) ResourceType() ResourceType {
+	return m.resourceType
+}
+
+func (m mutationEvent) ResourceID() string {
+	return m.resourceID
+}
+
+func (m mutationEvent) ParentID() string {
+	return m.parentID
+}
+
+func (m mutationEvent) MutationType() MutationType {
+	return m.mutationType
+}
+
+// mutationListeners holds the registered mutation listeners. It is shared between a client and its subclients.
+type mutationListeners struct {
+	lock      *sync.Mutex
+	nextID    uint64
+	listeners map[uint64]MutationListener
+	// recorders are called synchronously in the order the mutations happen. They must not call the client, since
+	// the mock client notifies listeners with its lock held.
+	recorders []MutationListener
+}
+
+func newMutationListeners() *mutationListeners {
+	return &mutationListeners{
+		lock:      &sync.Mutex{},
+		listeners: map[uint64]MutationListener{},
+	}
+}
+
+func (l *mutationListeners) add(listener MutationListener) func() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	id := l.nextID
+	l.nextID++
+	l.listeners[id] = listener
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		delete(l.listeners, id)
+	}
+}
+
+// addRecorder registers a listener that is called synchronously for every mutation.
+func (l *mutationListeners) addRecorder(recorder MutationListener) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.recorders = append(l.recorders, recorder)
+}
+
+func (l *mutationListeners) notify(resourceType ResourceType, id string, parentID string, mutationType MutationType) {
+	event := mutationEvent{
+		resourceType: resourceType,
+		resourceID:   id,
+		parentID:     parentID,
+		mutationType: mutationType,
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, recorder := range l.recorders {
+		recorder(event)
+	}
+	for _, listener := range l.listeners {
+		go listener(event)
+	}
+}
+
+func (o *oVirtClient) AddMutationListener(listener MutationListener) func() {
+	return o.mutationListeners.add(listener)
+}
+
+func (m *mockClient) AddMutationListener(listener MutationListener) func() {
+	return m.mutationListeners.add(listener)
+}
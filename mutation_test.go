@@ -0,0 +1,53 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestMutationListenerReceivesTagEvents(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	events := make(chan ovirtclient.MutationEvent, 100)
+	removeListener := client.AddMutationListener(
+		func(event ovirtclient.MutationEvent) {
+			if event.ResourceType() == ovirtclient.ResourceTypeTag {
+				events <- event
+			}
+		},
+	)
+	defer removeListener()
+
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	assertMutationEvent(t, events, string(tag.ID()), ovirtclient.MutationTypeCreated)
+
+	if err := tag.Remove(); err != nil {
+		t.Fatalf("Failed to remove tag %s (%v)", tag.ID(), err)
+	}
+	assertMutationEvent(t, events, string(tag.ID()), ovirtclient.MutationTypeRemoved)
+}
+
+func assertMutationEvent(
+	t *testing.T,
+	events <-chan ovirtclient.MutationEvent,
+	resourceID string,
+	mutationType ovirtclient.MutationType,
+) {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.ResourceID() == resourceID && event.MutationType() == mutationType {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("No %s mutation event received for resource %s.", mutationType, resourceID)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGetClusterByName(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	cluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("failed to get cluster (%v)", err)
+	}
+	fetched, err := client.GetClusterByName(cluster.Name())
+	if err != nil {
+		t.Fatalf("failed to get cluster by name (%v)", err)
+	}
+	if fetched.ID() != cluster.ID() {
+		t.Fatalf("cluster ID mismatch after fetching by name (expected: %s, got: %s)", cluster.ID(), fetched.ID())
+	}
+}
+
+func TestGetStorageDomainByName(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	sd, err := client.GetStorageDomain(helper.GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("failed to get storage domain (%v)", err)
+	}
+	fetched, err := client.GetStorageDomainByName(sd.Name())
+	if err != nil {
+		t.Fatalf("failed to get storage domain by name (%v)", err)
+	}
+	if fetched.ID() != sd.ID() {
+		t.Fatalf("storage domain ID mismatch after fetching by name (expected: %s, got: %s)", sd.ID(), fetched.ID())
+	}
+}
+
+func TestGetHostByName(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Skipf("no hosts available")
+	}
+	fetched, err := client.GetHostByName(hosts[0].Name())
+	if err != nil {
+		t.Fatalf("failed to get host by name (%v)", err)
+	}
+	if fetched.ID() != hosts[0].ID() {
+		t.Fatalf("host ID mismatch after fetching by name (expected: %s, got: %s)", hosts[0].ID(), fetched.ID())
+	}
+}
+
+func TestGetByNameNotFound(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := helper.GenerateRandomID(5)
+
+	if _, err := client.GetClusterByName(name); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("fetching a nonexistent cluster by name did not return an ENotFound error (%v)", err)
+	}
+	if _, err := client.GetStorageDomainByName(name); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("fetching a nonexistent storage domain by name did not return an ENotFound error (%v)", err)
+	}
+	if _, err := client.GetHostByName(name); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("fetching a nonexistent host by name did not return an ENotFound error (%v)", err)
+	}
+	if _, err := client.GetTemplateByName(name); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("fetching a nonexistent template by name did not return an ENotFound error (%v)", err)
+	}
+}
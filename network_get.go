@@ -10,10 +10,17 @@ func (o *oVirtClient) GetNetwork(id NetworkID, retries ...RetryStrategy) (result
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting network %s", id),
+		reading("", string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().NetworksService().NetworkService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				NetworksService().
+				NetworkService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
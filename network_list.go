@@ -7,10 +7,16 @@ func (o *oVirtClient) ListNetworks(retries ...RetryStrategy) (result []Network,
 	result = []Network{}
 	err = retry(
 		"listing networks",
+		reading("", ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().NetworksService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				NetworksService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -25,9 +25,57 @@ type ExtraSettings interface {
 	Proxy() *string
 }
 
+// ExtraSettingsV2 extends ExtraSettings with a client-level correlation ID.
+type ExtraSettingsV2 interface {
+	ExtraSettings
+
+	// CorrelationID returns the correlation ID to send with every request to the oVirt Engine. The engine records
+	// the ID in its logs. The ID is also included in the log messages and errors of the client. Returns an empty
+	// string if each call should send a generated correlation ID.
+	CorrelationID() string
+}
+
+// ExtraSettingsV3 extends ExtraSettingsV2 with client-side rate limiting.
+type ExtraSettingsV3 interface {
+	ExtraSettingsV2
+
+	// RequestsPerSecond returns the average number of API calls per second the client may send to the oVirt Engine.
+	// Calls above the limit are delayed. Returns 0 if the calls should not be limited.
+	RequestsPerSecond() float64
+	// RequestBurst returns the number of API calls the client may send at once before the rate limit applies. It is
+	// ignored if RequestsPerSecond returns 0.
+	RequestBurst() uint
+}
+
+// ExtraSettingsV4 extends ExtraSettingsV3 with an audit sink.
+type ExtraSettingsV4 interface {
+	ExtraSettingsV3
+
+	// AuditSink returns the sink receiving a record of every mutating call. Returns nil if calls should not be
+	// recorded.
+	AuditSink() AuditSink
+}
+
+// ExtraSettingsV5 extends ExtraSettingsV4 with request hooks.
+type ExtraSettingsV5 interface {
+	ExtraSettingsV4
+
+	// RequestHooks returns the hooks called for the HTTP requests sent using the client returned by GetHTTPClient.
+	// Requests sent by the SDK are not covered. Returns nil if there are no hooks.
+	RequestHooks() []RequestHook
+}
+
+// ExtraSettingsV6 extends ExtraSettingsV5 with an operation hook.
+type ExtraSettingsV6 interface {
+	ExtraSettingsV5
+
+	// OperationHook returns the hook called for every call to the oVirt Engine. Returns nil if there is no hook.
+	OperationHook() OperationHook
+}
+
 // ExtraSettingsBuilder is a buildable version of ExtraSettings.
 type ExtraSettingsBuilder interface {
-	ExtraSettings
+	ExtraSettingsV6
 
 	// WithExtraHeaders adds extra headers to send along with each request.
 	WithExtraHeaders(map[string]string) ExtraSettingsBuilder
@@ -35,6 +83,25 @@ type ExtraSettingsBuilder interface {
 	WithCompression() ExtraSettingsBuilder
 	// WithProxy explicitly sets a proxy server to use for requests.
 	WithProxy(string) ExtraSettingsBuilder
+	// WithCorrelationID sets a correlation ID to send along with each request. A different correlation ID can be
+	// passed to individual calls using CorrelationID(), which takes precedence for the requests of that call.
+	WithCorrelationID(string) ExtraSettingsBuilder
+	// WithRateLimit limits the client to requestsPerSecond API calls on average, allowing up to burst calls at once.
+	// Each retry counts as a separate call, while functions built on other client functions, such as WaitForVMStatus,
+	// only count the calls they make. The limit is shared between the client and all subclients created using
+	// WithContext, which makes it useful for controllers with many reconcilers using the same connection. A wait for
+	// the limit is canceled by the context of the subclient.
+	WithRateLimit(requestsPerSecond float64, burst uint) ExtraSettingsBuilder
+	// WithAuditSink records every mutating call, including its duration, result and correlation ID, to the
+	// specified sink. Use NewJSONAuditSink to write the records to a file.
+	WithAuditSink(AuditSink) ExtraSettingsBuilder
+	// WithRequestHook registers a hook receiving the method, URL, status and duration of the HTTP requests sent
+	// using the client returned by GetHTTPClient, such as image transfers. Calls made through the SDK are not
+	// reported, see RequestHook. This function can be called multiple times to register multiple hooks.
+	WithRequestHook(RequestHook) ExtraSettingsBuilder
+	// WithOperationHook sets a hook called at the start and end of every call, for example to create tracing spans.
+	// See the ovirtotel package for OpenTelemetry support.
+	WithOperationHook(OperationHook) ExtraSettingsBuilder
 }
 
 // NewExtraSettings creates a builder for ExtraSettings.
@@ -43,9 +110,56 @@ func NewExtraSettings() ExtraSettingsBuilder {
 }
 
 type extraSettings struct {
-	headers     map[string]string
-	compression bool
-	proxy       *string
+	headers           map[string]string
+	compression       bool
+	proxy             *string
+	correlationID     string
+	requestsPerSecond float64
+	requestBurst      uint
+	auditSink         AuditSink
+	requestHooks      []RequestHook
+	operationHook     OperationHook
+}
+
+func (e *extraSettings) OperationHook() OperationHook {
+	return e.operationHook
+}
+
+func (e *extraSettings) WithOperationHook(hook OperationHook) ExtraSettingsBuilder {
+	e.operationHook = hook
+	return e
+}
+
+func (e *extraSettings) RequestHooks() []RequestHook {
+	return e.requestHooks
+}
+
+func (e *extraSettings) WithRequestHook(hook RequestHook) ExtraSettingsBuilder {
+	e.requestHooks = append(e.requestHooks, hook)
+	return e
+}
+
+func (e *extraSettings) AuditSink() AuditSink {
+	return e.auditSink
+}
+
+func (e *extraSettings) WithAuditSink(sink AuditSink) ExtraSettingsBuilder {
+	e.auditSink = sink
+	return e
+}
+
+func (e *extraSettings) RequestsPerSecond() float64 {
+	return e.requestsPerSecond
+}
+
+func (e *extraSettings) RequestBurst() uint {
+	return e.requestBurst
+}
+
+func (e *extraSettings) WithRateLimit(requestsPerSecond float64, burst uint) ExtraSettingsBuilder {
+	e.requestsPerSecond = requestsPerSecond
+	e.requestBurst = burst
+	return e
 }
 
 func (e *extraSettings) ExtraHeaders() map[string]string {
@@ -60,6 +174,10 @@ func (e *extraSettings) Proxy() *string {
 	return e.proxy
 }
 
+func (e *extraSettings) CorrelationID() string {
+	return e.correlationID
+}
+
 func (e *extraSettings) WithExtraHeaders(m map[string]string) ExtraSettingsBuilder {
 	e.headers = m
 	return e
@@ -75,6 +193,11 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 	return e
 }
 
+func (e *extraSettings) WithCorrelationID(id string) ExtraSettingsBuilder {
+	e.correlationID = id
+	return e
+}
+
 // New creates a new copy of the enhanced oVirt client. It accepts the following options:
 //
 //	url
@@ -84,7 +207,8 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 //	username
 //
 // This is the username for the oVirt engine. This must contain the profile separated with an @ sign. For example,
-// admin@internal.
+// admin@internal. To pass the profile separately, use NewWithCredentialsProvider with ProfileCredentials. If the
+// engine does not know the profile, New returns an EInvalidProfile error.
 //
 //	password
 //
@@ -100,8 +224,8 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 //
 //	extraSettings
 //
-// This is an implementation of the ExtraSettings interface, allowing for customization of headers and turning on
-// compression.
+// This is an implementation of the ExtraSettings interface, allowing for customization of headers, compression,
+// rate limiting and request hooks.
 //
 // # TLS
 //
@@ -124,6 +248,9 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 //	// Add system certificates
 //	tls.CACertsFromSystem()
 //
+//	// Present a client certificate, for example to a proxy requiring mutual TLS.
+//	tls.ClientCertificateFromFile("/path/to/client.pem", "/path/to/client.key")
+//
 //	// Disable certificate verification. This is a bad idea.
 //	tls.Insecure()
 //
@@ -160,12 +287,46 @@ func NewWithVerify(
 	extraSettings ExtraSettings,
 	verify func(connection Client) error,
 ) (ClientWithLegacySupport, error) {
-	if err := validateURL(u); err != nil {
-		return nil, wrap(err, EBadArgument, "invalid URL: %s", u)
-	}
 	if err := validateUsername(username); err != nil {
 		return nil, wrap(err, EBadArgument, "invalid username: %s", username)
 	}
+	return newWithCredentialsProvider(
+		u,
+		StaticCredentials(username, password),
+		tls,
+		logger,
+		extraSettings,
+		verify,
+	)
+}
+
+// NewWithCredentialsProvider is equivalent to New, but fetches the username and password from the passed
+// CredentialsProvider every time the client connects or reauthenticates. This allows for using credentials that are
+// rotated while the client is running.
+func NewWithCredentialsProvider(
+	u string,
+	credentials CredentialsProvider,
+	tls TLSProvider,
+	logger Logger,
+	extraSettings ExtraSettings,
+) (ClientWithLegacySupport, error) {
+	if credentials == nil {
+		return nil, newError(EBadArgument, "the credentials provider must not be nil")
+	}
+	return newWithCredentialsProvider(u, credentials, tls, logger, extraSettings, testConnection)
+}
+
+func newWithCredentialsProvider(
+	u string,
+	credentials CredentialsProvider,
+	tls TLSProvider,
+	logger Logger,
+	extraSettings ExtraSettings,
+	verify func(connection Client) error,
+) (ClientWithLegacySupport, error) {
+	if err := validateURL(u); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid URL: %s", u)
+	}
 	tlsConfig, err := tls.CreateTLSConfig()
 	if err != nil {
 		return nil, wrap(err, ETLSError, "failed to create TLS configuration")
@@ -176,10 +337,13 @@ func NewWithVerify(
 		return nil, err
 	}
 	httpClient := http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-			Proxy:           proxyFunc,
-		},
+		Transport: newRequestHookTransport(
+			&http.Transport{
+				TLSClientConfig: tlsConfig,
+				Proxy:           proxyFunc,
+			},
+			getRequestHooks(extraSettings),
+		),
 	}
 
 	client := &oVirtClient{
@@ -189,12 +353,14 @@ func NewWithVerify(
 		httpClient,
 		logger,
 		u,
-		username,
-		password,
+		credentials,
 		tlsConfig,
 		extraSettings,
 		rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
 		verify,
+		newMutationListeners(),
+		newErrorIdentifiers(),
+		newRateLimiterFromSettings(extraSettings),
 	}
 
 	if err := client.Reconnect(); err != nil {
@@ -227,6 +393,15 @@ func getProxyFunc(extraSettings ExtraSettings) (func(req *http.Request) (*url.UR
 	return proxyFunc, nil
 }
 
+// newRateLimiterFromSettings creates the rate limiter configured in the extra settings, or nil if there is no limit.
+func newRateLimiterFromSettings(extraSettings ExtraSettings) *rateLimiter {
+	v3, ok := extraSettings.(ExtraSettingsV3)
+	if !ok {
+		return nil
+	}
+	return newRateLimiter(v3.RequestsPerSecond(), v3.RequestBurst())
+}
+
 func processExtraSettings(
 	extraSettings ExtraSettings,
 	connBuilder *ovirtsdk4.ConnectionBuilder,
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
@@ -270,6 +271,99 @@ func TestProxy(t *testing.T) {
 	}
 }
 
+type countingCredentialsProvider struct {
+	lock  *sync.Mutex
+	calls int
+	err   error
+}
+
+func (c *countingCredentialsProvider) GetCredentials() (string, string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls++
+	return "admin@internal", "asdf", c.err
+}
+
+func TestCredentialsProvider(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if err := request.ParseForm(); err != nil || request.PostForm.Get("username") != "admin@internal" {
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writer.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+
+	logger := ovirtclientlog.NewTestLogger(t)
+	credentials := &countingCredentialsProvider{lock: &sync.Mutex{}}
+	_, err := ovirtclient.NewWithCredentialsProvider(
+		srv.URL+"/ovirt-engine/api",
+		credentials,
+		ovirtclient.TLS().Insecure(),
+		logger,
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EAccessDenied) {
+		t.Fatalf("incorrect error returned for credentials rejected by the engine: %v", err)
+	}
+	if credentials.calls != 1 {
+		t.Fatalf("the credentials provider was not called exactly once (calls: %d)", credentials.calls)
+	}
+
+	credentials.err = fmt.Errorf("secret not found")
+	_, err = ovirtclient.NewWithCredentialsProvider(
+		srv.URL+"/ovirt-engine/api",
+		credentials,
+		ovirtclient.TLS().Insecure(),
+		logger,
+		nil,
+	)
+	if err == nil || !strings.Contains(err.Error(), "secret not found") {
+		t.Fatalf("the error of the credentials provider was not returned (%v)", err)
+	}
+}
+
+func TestProfileCredentials(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if err := request.ParseForm(); err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusBadRequest)
+		_, _ = writer.Write([]byte(fmt.Sprintf(
+			`{"error_code":"access_denied","error":"Cannot authenticate user '%s': No valid profile found in credentials.."}`,
+			request.PostForm.Get("username"),
+		)))
+	}))
+	t.Cleanup(srv.Close)
+
+	logger := ovirtclientlog.NewTestLogger(t)
+	_, err := ovirtclient.NewWithCredentialsProvider(
+		srv.URL+"/ovirt-engine/api",
+		ovirtclient.ProfileCredentials("admin", "nonexistent", "secret"),
+		ovirtclient.TLS().Insecure(),
+		logger,
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EInvalidProfile) {
+		t.Fatalf("incorrect error returned for a profile the engine does not know: %v", err)
+	}
+
+	_, err = ovirtclient.NewWithCredentialsProvider(
+		srv.URL+"/ovirt-engine/api",
+		ovirtclient.ProfileCredentials("admin", "internal@extra", "secret"),
+		ovirtclient.TLS().Insecure(),
+		logger,
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("incorrect error returned for a profile containing an @ sign: %v", err)
+	}
+}
+
 func startProxyServer(t *testing.T, counter *int) string {
 	wg := sync.WaitGroup{}
 	wg.Add(1)
@@ -21,11 +21,13 @@ func NewMock() MockClient {
 func NewMockWithLogger(logger Logger) MockClient {
 	testCluster := generateTestCluster()
 	testHost := generateTestHost(testCluster)
-	testStorageDomain := generateTestStorageDomain()
-	secondaryStorageDomain := generateTestStorageDomain()
+	testStorageDomain := generateTestStorageDomain("Test storage domain")
+	secondaryStorageDomain := generateTestStorageDomain("Secondary test storage domain")
 	testDatacenter := generateTestDatacenter(testCluster)
-	testNetwork := generateTestNetwork(testDatacenter)
-	testVNICProfile := generateTestVNICProfile(testNetwork)
+	testNetwork := generateTestNetwork(testDatacenter, "test")
+	testVNICProfile := generateTestVNICProfile(testNetwork, "test")
+	secondaryNetwork := generateTestNetwork(testDatacenter, "test-secondary")
+	secondaryVNICProfile := generateTestVNICProfile(secondaryNetwork, "test-secondary")
 	blankTemplate := &template{
 		nil,
 		DefaultBlankTemplateID,
@@ -40,6 +42,11 @@ func NewMockWithLogger(logger Logger) MockClient {
 			},
 			nil,
 		},
+		// The blank template of the oVirt Engine carries this creation date.
+		time.Date(2008, 4, 1, 0, 0, 0, 0, time.UTC),
+		1,
+		"base version",
+		DefaultBlankTemplateID,
 	}
 
 	client := getClient(
@@ -50,7 +57,9 @@ func NewMockWithLogger(logger Logger) MockClient {
 		testHost,
 		blankTemplate,
 		testVNICProfile,
+		secondaryVNICProfile,
 		testNetwork,
+		secondaryNetwork,
 		testDatacenter,
 	)
 
@@ -62,6 +71,8 @@ func NewMockWithLogger(logger Logger) MockClient {
 	testDatacenter.client = client
 	testNetwork.client = client
 	testVNICProfile.client = client
+	secondaryNetwork.client = client
+	secondaryVNICProfile.client = client
 
 	return client
 }
@@ -74,7 +85,9 @@ func getClient(
 	testHost *host,
 	blankTemplate *template,
 	testVNICProfile *vnicProfile,
+	secondaryVNICProfile *vnicProfile,
 	testNetwork *network,
+	secondaryNetwork *network,
 	testDatacenter *datacenterWithClusters,
 ) *mockClient {
 	client := &mockClient{
@@ -101,31 +114,99 @@ func getClient(
 		},
 		nics: map[NICID]*nic{},
 		vnicProfiles: map[VNICProfileID]*vnicProfile{
-			testVNICProfile.ID(): testVNICProfile,
+			testVNICProfile.ID():      testVNICProfile,
+			secondaryVNICProfile.ID(): secondaryVNICProfile,
 		},
 		networks: map[NetworkID]*network{
-			testNetwork.ID(): testNetwork,
+			testNetwork.ID():      testNetwork,
+			secondaryNetwork.ID(): secondaryNetwork,
 		},
 		dataCenters: map[DatacenterID]*datacenterWithClusters{
 			testDatacenter.ID(): testDatacenter,
 		},
 		vmDiskAttachmentsByVM:   map[VMID]map[DiskAttachmentID]*diskAttachment{},
-		vmDiskAttachmentsByDisk: map[DiskID]*diskAttachment{},
+		vmDiskAttachmentsByDisk: map[DiskID]map[DiskAttachmentID]*diskAttachment{},
 		templateDiskAttachmentsByTemplate: map[TemplateID][]*templateDiskAttachment{
 			blankTemplate.ID(): {},
 		},
 		templateDiskAttachmentsByDisk: map[DiskID]*templateDiskAttachment{},
+		templateNICsByTemplate:        map[TemplateID][]*templateNIC{},
 		affinityGroups: map[ClusterID]map[AffinityGroupID]*affinityGroup{
 			testCluster.ID(): {},
 		},
 		vmIPs:                map[VMID]map[string][]net.IP{},
 		instanceTypes:        nil,
+		schedulingPolicies:   nil,
+		cpuProfiles:          map[CPUProfileID]*cpuProfile{},
+		diskProfiles:         map[DiskProfileID]*diskProfile{},
+		qos:                  map[QoSID]*qos{},
 		graphicsConsolesByVM: map[VMID][]*vmGraphicsConsole{},
+		mutationListeners:    newMutationListeners(),
+		backups:              map[BackupID]*backup{},
+		checkpointsByVM:      map[VMID][]*checkpoint{},
+		cdroms:               map[VMID]*mockCDROM{},
+		watchdogsByVM:        map[VMID][]*vmWatchdog{},
+		fenceAgentsByHost: map[HostID][]*hostFenceAgent{
+			testHost.ID(): {},
+		},
+		errataByHost: map[HostID][]*erratum{
+			testHost.ID(): generateTestErrata(),
+		},
+		numaNodesByHost: map[HostID][]*hostNUMANode{
+			testHost.ID(): generateMockHostNUMANodes(testHost),
+		},
+		reportedDevicesByVM:         map[VMID][]*vmReportedDevice{},
+		unregisteredByStorageDomain: map[StorageDomainID]*unregisteredEntities{},
+		iscsiLogicalUnitsByHost:     map[HostID][]*hostLogicalUnit{},
+		hostNICsByHost: map[HostID][]*hostNIC{
+			testHost.ID(): generateMockHostNICs(testHost),
+		},
+		networkAttachmentsByHost: map[HostID][]*hostNetworkAttachment{
+			testHost.ID(): {},
+		},
+		clusterMigrationSettings: map[ClusterID]*clusterMigrationSettings{},
+		vmMigrationSettings:      map[VMID]*vmMigrationSettings{},
+		externalVMImports:        map[string]*externalVMImportProgress{},
+		templateExports:          map[string]TemplateID{},
+		errorIdentifiers:         newErrorIdentifiers(),
+		vmTransitionDelays:       defaultMockVMTransitionDelays(),
 	}
 	client.instanceTypes = getInstanceTypes(client)
+	client.schedulingPolicies = getSchedulingPolicies(client)
+	client.addDefaultCPUProfile(testCluster)
+	client.addDefaultDiskProfile(testStorageDomain)
+	client.addDefaultDiskProfile(secondaryStorageDomain)
+	client.openStackImageProviders = getOpenStackImageProviders(client)
 	return client
 }
 
+// getOpenStackImageProviders returns the Glance provider that is present on a fresh oVirt Engine.
+func getOpenStackImageProviders(client *mockClient) map[OpenStackImageProviderID]*mockOpenStackImageProvider {
+	providerID := OpenStackImageProviderID(uuid.NewString())
+	imageID := OpenStackImageID(uuid.NewString())
+	return map[OpenStackImageProviderID]*mockOpenStackImageProvider{
+		providerID: {
+			provider: &openStackImageProvider{
+				client: client,
+				id:     providerID,
+				name:   "ovirt-image-repository",
+				url:    "http://glance.ovirt.org:9292",
+			},
+			images: map[OpenStackImageID]*openStackImage{
+				imageID: {
+					client:     client,
+					id:         imageID,
+					providerID: providerID,
+					name:       "CirrOS 0.5.1 Custom for x86_64",
+				},
+			},
+			imageSizes: map[OpenStackImageID]uint64{
+				imageID: 117440512,
+			},
+		},
+	}
+}
+
 func getInstanceTypes(client *mockClient) map[InstanceTypeID]*instanceType {
 	instanceTypes := map[InstanceTypeID]*instanceType{
 		"00000009-0009-0009-0009-0000000000f1": {
@@ -157,18 +238,87 @@ func getInstanceTypes(client *mockClient) map[InstanceTypeID]*instanceType {
 	return instanceTypes
 }
 
-func generateTestVNICProfile(testNetwork *network) *vnicProfile {
+// mockDefaultSchedulingPolicyID is the ID of the none scheduling policy, which new clusters use by default.
+const mockDefaultSchedulingPolicyID SchedulingPolicyID = "b4ed2332-a7ac-4d5f-9596-99a439cb2812"
+
+// getSchedulingPolicies returns the built-in scheduling policies of the oVirt Engine.
+func getSchedulingPolicies(client *mockClient) map[SchedulingPolicyID]*schedulingPolicy {
+	policies := []*schedulingPolicy{
+		{
+			client:        client,
+			id:            mockDefaultSchedulingPolicyID,
+			name:          "none",
+			description:   "No load balancing operation",
+			defaultPolicy: true,
+			locked:        true,
+			properties:    map[string]string{},
+		},
+		{
+			client:      client,
+			id:          "8d5d7bec-68de-4a67-b53e-0ac54686d579",
+			name:        "evenly_distributed",
+			description: "Load balancing VMs in cluster according to hosts CPU load",
+			locked:      true,
+			properties: map[string]string{
+				"CpuOverCommitDurationMinutes": "2",
+				"HighUtilization":              "80",
+				"HeSparesCount":                "0",
+			},
+		},
+		{
+			client:      client,
+			id:          "736999d0-1023-46a4-9a75-1316ed50e151",
+			name:        "power_saving",
+			description: "Concentrating VMs on fewer hosts in cluster according to hosts CPU load",
+			locked:      true,
+			properties: map[string]string{
+				"CpuOverCommitDurationMinutes": "2",
+				"HighUtilization":              "80",
+				"LowUtilization":               "20",
+				"HeSparesCount":                "0",
+			},
+		},
+		{
+			client:      client,
+			id:          "8d5d7bec-68de-4a67-b53e-0ac54686d586",
+			name:        "vm_evenly_distributed",
+			description: "Load balancing VMs in cluster according to hosts VMs count",
+			locked:      true,
+			properties: map[string]string{
+				"HighVmCount":        "10",
+				"MigrationThreshold": "5",
+				"SpmVmGrace":         "5",
+				"HeSparesCount":      "0",
+			},
+		},
+		{
+			client:      client,
+			id:          "7677771e-5eab-422e-83fa-dc04080d21b7",
+			name:        "cluster_maintenance",
+			description: "Cluster maintenance policy, only running VMs are allowed, no new VMs can be started",
+			locked:      true,
+			properties:  map[string]string{},
+		},
+	}
+	result := make(map[SchedulingPolicyID]*schedulingPolicy, len(policies))
+	for _, policy := range policies {
+		result[policy.id] = policy
+	}
+	return result
+}
+
+func generateTestVNICProfile(testNetwork *network, name string) *vnicProfile {
 	return &vnicProfile{
 		id:        VNICProfileID(uuid.NewString()),
-		name:      "test",
+		name:      name,
 		networkID: testNetwork.ID(),
 	}
 }
 
-func generateTestNetwork(testDatacenter *datacenterWithClusters) *network {
+func generateTestNetwork(testDatacenter *datacenterWithClusters, name string) *network {
 	return &network{
 		id:   NetworkID(uuid.NewString()),
-		name: "test",
+		name: name,
 		dcID: testDatacenter.ID(),
 	}
 }
@@ -185,10 +335,10 @@ func generateTestDatacenter(testCluster *cluster) *datacenterWithClusters {
 	}
 }
 
-func generateTestStorageDomain() *storageDomain {
+func generateTestStorageDomain(name string) *storageDomain {
 	return &storageDomain{
 		id:             StorageDomainID(uuid.NewString()),
-		name:           "Test storage domain",
+		name:           name,
 		available:      10 * 1024 * 1024 * 1024,
 		status:         StorageDomainStatusActive,
 		externalStatus: StorageDomainExternalStatusNA,
@@ -196,17 +346,41 @@ func generateTestStorageDomain() *storageDomain {
 	}
 }
 
+// generateTestErrata returns the errata of the test host, as if the engine had a Katello provider configured.
+func generateTestErrata() []*erratum {
+	issued := time.Date(2022, 4, 25, 0, 0, 0, 0, time.UTC)
+	return []*erratum{
+		{
+			id:          ErratumID(uuid.NewString()),
+			name:        "RHSA-2022:1537",
+			title:       "Important: gzip security update",
+			erratumType: ErratumTypeSecurity,
+			severity:    "Important",
+			summary:     "An update for gzip is now available.",
+			solution:    "Update the affected packages and restart the host.",
+			issued:      &issued,
+			packages:    []string{"gzip-1.9-13.el8_5"},
+		},
+	}
+}
+
 func generateTestCluster() *cluster {
 	return &cluster{
 		id:   ClusterID(uuid.NewString()),
 		name: "Test cluster",
+		// The test cluster is one level below mockMaxClusterVersion so that compatibility upgrades can be tested.
+		compatibilityVersion:       NewVersion(4, 6),
+		schedulingPolicyID:         mockDefaultSchedulingPolicyID,
+		schedulingPolicyProperties: map[string]string{},
 	}
 }
 
 func generateTestHost(c *cluster) *host {
-	return &host{
+	return (&host{
 		id:        HostID(uuid.NewString()),
+		name:      "test-host",
+		address:   "127.0.0.1",
 		clusterID: c.ID(),
 		status:    HostStatusUp,
-	}
+	}).withMockCapacity()
 }
@@ -30,6 +30,13 @@ func (o *oVirtClient) CreateNIC(
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("creating NIC for VM %s", vmid),
+		mutating(ResourceTypeNIC, "").
+			withParameters(map[string]string{
+				"vm_id":           string(vmid),
+				"vnic_profile_id": string(vnicProfileID),
+				"name":            name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -43,7 +50,15 @@ func (o *oVirtClient) CreateNIC(
 
 			nic := nicBuilder.MustBuild()
 
-			response, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().Add().Nic(nic).Send()
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				Add().
+				Nic(nic).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -67,6 +82,9 @@ func (o *oVirtClient) CreateNIC(
 			return nil
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeNIC, string(result.ID()), string(vmid), MutationTypeCreated)
+	}
 	return result, err
 }
 
@@ -88,7 +106,7 @@ func (m *mockClient) CreateNIC(
 	}
 	for _, n := range m.nics {
 		if n.name == name {
-			return nil, newError(ENotFound, "NIC with name %s is already in use", name)
+			return nil, newError(ENameInUse, "NIC with name %s is already in use", name)
 		}
 	}
 
@@ -110,6 +128,7 @@ func (m *mockClient) CreateNIC(
 	}
 
 	m.nics[id] = nic
+	m.mutationListeners.notify(ResourceTypeNIC, string(id), string(vmid), MutationTypeCreated)
 
 	return nic, nil
 }
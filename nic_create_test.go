@@ -87,3 +87,34 @@ func TestVMNICWithMacCreation(t *testing.T) {
 	assertCanRemoveNIC(t, nic)
 	assertNICCount(t, vm, 0)
 }
+
+func TestVMNICCreationOnSecondaryNetwork(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	secondaryVNICProfileID := helper.GetSecondaryVNICProfileID(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("nic_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	assertCanCreateNIC(
+		t,
+		helper,
+		vm,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateNICParams())
+	nic, err := vm.CreateNIC(
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		secondaryVNICProfileID,
+		ovirtclient.CreateNICParams(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create NIC on the secondary network on VM %s (%v)", vm.ID(), err)
+	}
+	if nic.VNICProfileID() != secondaryVNICProfileID {
+		t.Fatalf("incorrect VNIC profile on NIC (%s != %s)", nic.VNICProfileID(), secondaryVNICProfileID)
+	}
+	assertNICCount(t, vm, 2)
+}
@@ -8,10 +8,19 @@ func (o *oVirtClient) GetNIC(vmid VMID, id NICID, retries ...RetryStrategy) (res
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting NIC %s for VM %s", id, vmid),
+		reading(ResourceTypeNIC, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().NicService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				NicService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -8,10 +8,18 @@ func (o *oVirtClient) ListNICs(vmid VMID, retries ...RetryStrategy) (result []NI
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("listing NICs for VM %s", vmid),
+		reading(ResourceTypeVM, string(vmid)),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -8,15 +8,30 @@ func (o *oVirtClient) RemoveNIC(vmid VMID, id NICID, retries ...RetryStrategy) (
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("removing NIC %s from VM %s", id, vmid),
+		mutating(ResourceTypeNIC, string(id)).
+			withParameters(map[string]string{
+				"vm_id": string(vmid),
+			}),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().NicService(string(id)).Remove().Send()
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				NicService(string(id)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeNIC, string(id), string(vmid), MutationTypeRemoved)
+	}
 	return
 }
 
@@ -30,5 +45,6 @@ func (m *mockClient) RemoveNIC(vmid VMID, id NICID, _ ...RetryStrategy) error {
 		return newError(ENotFound, "NIC with ID %s not found on VM with ID %s", id, vmid)
 	}
 	delete(m.nics, id)
+	m.mutationListeners.notify(ResourceTypeNIC, string(id), string(vmid), MutationTypeRemoved)
 	return nil
 }
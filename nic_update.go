@@ -34,10 +34,14 @@ func (o *oVirtClient) UpdateNIC(
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("updating NIC %s for VM %s", nicID, vmid),
+		mutating(ResourceTypeNIC, string(nicID)).
+			withParameters(map[string]string{
+				"vm_id": string(vmid),
+			}),
 		o.logger,
 		retries,
 		func() error {
-			update, err := req.Send()
+			update, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
 			if err != nil {
 				return wrap(err, EUnidentified, "Failed to update NIC %s", nicID)
 			}
@@ -52,6 +56,9 @@ func (o *oVirtClient) UpdateNIC(
 			result = nic
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeNIC, string(nicID), string(vmid), MutationTypeUpdated)
+	}
 	return result, err
 }
 
@@ -84,6 +91,7 @@ func (m *mockClient) UpdateNIC(vmid VMID, nicID NICID, params UpdateNICParameter
 		nic = nic.withMac(*mac)
 	}
 	m.nics[nicID] = nic
+	m.mutationListeners.notify(ResourceTypeNIC, string(nicID), string(vmid), MutationTypeUpdated)
 
 	return nic, nil
 }
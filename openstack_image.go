@@ -0,0 +1,277 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// OpenStackImageProviderID is the identifier of an external OpenStack Glance image provider.
+type OpenStackImageProviderID string
+
+// OpenStackImageID is the identifier of an image in an OpenStack Glance image provider.
+type OpenStackImageID string
+
+// OpenStackImageClient contains the methods to work with images in external OpenStack Glance providers, such as the
+// ovirt-image-repository provider that ships with oVirt. Importing a prepared cloud image is usually much faster than
+// uploading it from the client.
+type OpenStackImageClient interface {
+	// ListOpenStackImageProviders lists the Glance image providers configured in the oVirt Engine.
+	ListOpenStackImageProviders(retries ...RetryStrategy) ([]OpenStackImageProvider, error)
+	// ListOpenStackImages lists the images available in a Glance image provider.
+	ListOpenStackImages(providerID OpenStackImageProviderID, retries ...RetryStrategy) ([]OpenStackImage, error)
+	// ImportOpenStackImage imports an image from a Glance provider as a disk, or as a template if requested in
+	// params, into the specified storage domain. The import runs in the background, the returned IDs can be used to
+	// wait for the disk or template to become ready. Use OpenStackImageImportParams to obtain a builder for the
+	// params.
+	//
+	// The engine only creates the template once the image has been downloaded, so this function waits for it to
+	// appear. If the template does not appear before the timeout, the result containing the disk ID is returned
+	// together with the error, since the import has already started.
+	ImportOpenStackImage(
+		providerID OpenStackImageProviderID,
+		imageID OpenStackImageID,
+		storageDomainID StorageDomainID,
+		params OpenStackImageImportParameters,
+		retries ...RetryStrategy,
+	) (OpenStackImageImportResult, error)
+}
+
+// OpenStackImageImportResult identifies the objects created by ImportOpenStackImage.
+type OpenStackImageImportResult interface {
+	// DiskID returns the ID of the disk created from the image.
+	DiskID() DiskID
+	// TemplateID returns the ID of the template created from the image, or nil if the image was imported as a disk.
+	TemplateID() *TemplateID
+}
+
+// OpenStackImageProvider is an external OpenStack Glance image provider.
+type OpenStackImageProvider interface {
+	// ID returns the identifier of the provider.
+	ID() OpenStackImageProviderID
+	// Name returns the name of the provider.
+	Name() string
+	// URL returns the URL of the Glance API.
+	URL() string
+
+	// ListImages lists the images in this provider.
+	ListImages(retries ...RetryStrategy) ([]OpenStackImage, error)
+}
+
+// OpenStackImage is an image in an OpenStack Glance image provider.
+type OpenStackImage interface {
+	// ID returns the identifier of the image.
+	ID() OpenStackImageID
+	// ProviderID returns the identifier of the provider the image belongs to.
+	ProviderID() OpenStackImageProviderID
+	// Name returns the name of the image.
+	Name() string
+
+	// Import imports the image into the specified storage domain. See OpenStackImageClient.ImportOpenStackImage for
+	// details.
+	Import(
+		storageDomainID StorageDomainID,
+		params OpenStackImageImportParameters,
+		retries ...RetryStrategy,
+	) (OpenStackImageImportResult, error)
+}
+
+// OpenStackImageImportParameters contains the optional parameters for importing an OpenStack image.
+type OpenStackImageImportParameters interface {
+	// DiskAlias returns the alias of the disk to create. If empty, the image name is used.
+	DiskAlias() string
+	// TemplateName returns the name of the template to create. If set, the image is imported as a template.
+	TemplateName() *string
+	// ClusterID returns the cluster the template should be created in. Required when importing as a template.
+	ClusterID() *ClusterID
+}
+
+// BuildableOpenStackImageImportParameters is a buildable version of OpenStackImageImportParameters.
+type BuildableOpenStackImageImportParameters interface {
+	OpenStackImageImportParameters
+
+	// WithDiskAlias sets the alias of the disk created by the import.
+	WithDiskAlias(alias string) (BuildableOpenStackImageImportParameters, error)
+	// MustWithDiskAlias is identical to WithDiskAlias, but panics instead of returning an error.
+	MustWithDiskAlias(alias string) BuildableOpenStackImageImportParameters
+
+	// WithTemplate imports the image as a template with the specified name in the specified cluster.
+	WithTemplate(name string, clusterID ClusterID) (BuildableOpenStackImageImportParameters, error)
+	// MustWithTemplate is identical to WithTemplate, but panics instead of returning an error.
+	MustWithTemplate(name string, clusterID ClusterID) BuildableOpenStackImageImportParameters
+}
+
+// OpenStackImageImportParams creates a new set of parameters for importing an OpenStack image.
+func OpenStackImageImportParams() BuildableOpenStackImageImportParameters {
+	return &openStackImageImportParams{}
+}
+
+type openStackImageImportParams struct {
+	diskAlias    string
+	templateName *string
+	clusterID    *ClusterID
+}
+
+func (o *openStackImageImportParams) DiskAlias() string {
+	return o.diskAlias
+}
+
+func (o *openStackImageImportParams) TemplateName() *string {
+	return o.templateName
+}
+
+func (o *openStackImageImportParams) ClusterID() *ClusterID {
+	return o.clusterID
+}
+
+func (o *openStackImageImportParams) WithDiskAlias(alias string) (BuildableOpenStackImageImportParameters, error) {
+	o.diskAlias = alias
+	return o, nil
+}
+
+func (o *openStackImageImportParams) MustWithDiskAlias(alias string) BuildableOpenStackImageImportParameters {
+	builder, err := o.WithDiskAlias(alias)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *openStackImageImportParams) WithTemplate(
+	name string,
+	clusterID ClusterID,
+) (BuildableOpenStackImageImportParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the template name must not be empty")
+	}
+	if clusterID == "" {
+		return nil, newError(EBadArgument, "the cluster ID must not be empty when importing as a template")
+	}
+	o.templateName = &name
+	o.clusterID = &clusterID
+	return o, nil
+}
+
+func (o *openStackImageImportParams) MustWithTemplate(
+	name string,
+	clusterID ClusterID,
+) BuildableOpenStackImageImportParameters {
+	builder, err := o.WithTemplate(name, clusterID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type openStackImageProvider struct {
+	client Client
+
+	id   OpenStackImageProviderID
+	name string
+	url  string
+}
+
+func (o *openStackImageProvider) ID() OpenStackImageProviderID {
+	return o.id
+}
+
+func (o *openStackImageProvider) Name() string {
+	return o.name
+}
+
+func (o *openStackImageProvider) URL() string {
+	return o.url
+}
+
+func (o *openStackImageProvider) ListImages(retries ...RetryStrategy) ([]OpenStackImage, error) {
+	return o.client.ListOpenStackImages(o.id, retries...)
+}
+
+type openStackImage struct {
+	client Client
+
+	id         OpenStackImageID
+	providerID OpenStackImageProviderID
+	name       string
+}
+
+func (o *openStackImage) ID() OpenStackImageID {
+	return o.id
+}
+
+func (o *openStackImage) ProviderID() OpenStackImageProviderID {
+	return o.providerID
+}
+
+func (o *openStackImage) Name() string {
+	return o.name
+}
+
+func (o *openStackImage) Import(
+	storageDomainID StorageDomainID,
+	params OpenStackImageImportParameters,
+	retries ...RetryStrategy,
+) (OpenStackImageImportResult, error) {
+	return o.client.ImportOpenStackImage(o.providerID, o.id, storageDomainID, params, retries...)
+}
+
+type openStackImageImportResult struct {
+	diskID     DiskID
+	templateID *TemplateID
+}
+
+func (o *openStackImageImportResult) DiskID() DiskID {
+	return o.diskID
+}
+
+func (o *openStackImageImportResult) TemplateID() *TemplateID {
+	return o.templateID
+}
+
+func convertSDKOpenStackImageProvider(sdkObject *ovirtsdk.OpenStackImageProvider, client Client) (
+	OpenStackImageProvider,
+	error,
+) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("OpenStack image provider", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("OpenStack image provider", "name")
+	}
+	url, _ := sdkObject.Url()
+	return &openStackImageProvider{
+		client: client,
+		id:     OpenStackImageProviderID(id),
+		name:   name,
+		url:    url,
+	}, nil
+}
+
+func convertSDKOpenStackImage(
+	sdkObject *ovirtsdk.OpenStackImage,
+	providerID OpenStackImageProviderID,
+	client Client,
+) (OpenStackImage, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("OpenStack image", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("OpenStack image", "name")
+	}
+	return &openStackImage{
+		client:     client,
+		id:         OpenStackImageID(id),
+		providerID: providerID,
+		name:       name,
+	}, nil
+}
+
+// mockOpenStackImageProvider holds a provider and its images in the mock.
+type mockOpenStackImageProvider struct {
+	provider *openStackImageProvider
+	images   map[OpenStackImageID]*openStackImage
+	// imageSizes contains the virtual size of each image, used for the disks created on import.
+	imageSizes map[OpenStackImageID]uint64
+}
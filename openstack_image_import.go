@@ -0,0 +1,225 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ImportOpenStackImage(
+	providerID OpenStackImageProviderID,
+	imageID OpenStackImageID,
+	storageDomainID StorageDomainID,
+	params OpenStackImageImportParameters,
+	retries ...RetryStrategy,
+) (result OpenStackImageImportResult, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = OpenStackImageImportParams()
+	}
+	// The import response is empty, so the disk is looked up by its alias afterwards. We always set the alias and
+	// record the disks that already have it in order to identify the new one.
+	alias := params.DiskAlias()
+	if alias == "" {
+		alias, err = o.getOpenStackImageName(providerID, imageID, retries)
+		if err != nil {
+			return nil, err
+		}
+	}
+	existingDiskIDs, err := o.listDiskIDsByAlias(alias, retries)
+	if err != nil {
+		return nil, err
+	}
+	sdkDisk, err := ovirtsdk.NewDiskBuilder().Alias(alias).Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build disk for OpenStack image import")
+	}
+	err = retry(
+		fmt.Sprintf("importing OpenStack image %s from provider %s", imageID, providerID),
+		mutating(ResourceTypeDisk, "").
+			withParameters(map[string]string{
+				"provider_id":       string(providerID),
+				"image_id":          string(imageID),
+				"storage_domain_id": string(storageDomainID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.
+				SystemService().
+				OpenstackImageProvidersService().
+				ProviderService(string(providerID)).
+				ImagesService().
+				ImageService(string(imageID)).
+				Import().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild()).
+				Disk(sdkDisk)
+			if templateName := params.TemplateName(); templateName != nil {
+				req.ImportAsTemplate(true)
+				req.Template(ovirtsdk.NewTemplateBuilder().Name(*templateName).MustBuild())
+				req.Cluster(ovirtsdk.NewClusterBuilder().Id(string(*params.ClusterID())).MustBuild())
+			}
+			_, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	disk, err := o.findNewDiskByAlias(alias, storageDomainID, existingDiskIDs, retries)
+	if err != nil {
+		return nil, err
+	}
+	o.mutationListeners.notify(ResourceTypeDisk, string(disk.ID()), "", MutationTypeCreated)
+	importResult := &openStackImageImportResult{
+		diskID: disk.ID(),
+	}
+	if templateName := params.TemplateName(); templateName != nil {
+		// The import has started, so the disk ID is returned even if the template does not show up.
+		templateID, err := o.waitForImportedTemplate(*templateName, retries)
+		if err != nil {
+			return importResult, err
+		}
+		importResult.templateID = &templateID
+		o.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeCreated)
+	}
+	return importResult, nil
+}
+
+// getOpenStackImageName returns the name of an image, which is used as the disk alias if none is set.
+func (o *oVirtClient) getOpenStackImageName(
+	providerID OpenStackImageProviderID,
+	imageID OpenStackImageID,
+	retries []RetryStrategy,
+) (string, error) {
+	images, err := o.ListOpenStackImages(providerID, retries...)
+	if err != nil {
+		return "", err
+	}
+	for _, image := range images {
+		if image.ID() == imageID {
+			return image.Name(), nil
+		}
+	}
+	return "", newError(ENotFound, "image %s not found in OpenStack image provider %s", imageID, providerID)
+}
+
+// waitForImportedTemplate waits for the template created by an import to appear. The engine only creates the
+// template once the image has been downloaded, so a missing template is reported as pending until the timeout.
+func (o *oVirtClient) waitForImportedTemplate(name string, retries []RetryStrategy) (result TemplateID, err error) {
+	err = retry(
+		fmt.Sprintf("waiting for imported template %s", name),
+		reading(ResourceTypeTemplate, "").viaClient(),
+		o.logger,
+		retries,
+		func() error {
+			tpl, err := o.GetTemplateByName(name, retries...)
+			if err != nil {
+				if HasErrorCode(err, ENotFound) {
+					return newError(EPending, "imported template %s does not exist yet", name)
+				}
+				return err
+			}
+			result = tpl.ID()
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ImportOpenStackImage(
+	providerID OpenStackImageProviderID,
+	imageID OpenStackImageID,
+	storageDomainID StorageDomainID,
+	params OpenStackImageImportParameters,
+	_ ...RetryStrategy,
+) (OpenStackImageImportResult, error) {
+	if params == nil {
+		params = OpenStackImageImportParams()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p, ok := m.openStackImageProviders[providerID]
+	if !ok {
+		return nil, newError(ENotFound, "OpenStack image provider with ID %s not found", providerID)
+	}
+	image, ok := p.images[imageID]
+	if !ok {
+		return nil, newError(ENotFound, "image %s not found in OpenStack image provider %s", imageID, providerID)
+	}
+	var tpl *template
+	if templateName := params.TemplateName(); templateName != nil {
+		if _, ok := m.clusters[*params.ClusterID()]; !ok {
+			return nil, newError(ENotFound, "cluster with ID %s not found", *params.ClusterID())
+		}
+		for _, existingTemplate := range m.templates {
+			if existingTemplate.name == *templateName {
+				return nil, newError(ENameInUse, "A template with the name \"%s\" already exists.", *templateName)
+			}
+		}
+		templateID := TemplateID(m.GenerateUUID())
+		tpl = &template{
+			client:         m,
+			id:             templateID,
+			name:           *templateName,
+			status:         TemplateStatusLocked,
+			cpu:            m.templates[DefaultBlankTemplateID].cpu.clone(),
+			creationTime:   time.Now(),
+			versionNumber:  1,
+			baseTemplateID: templateID,
+		}
+	}
+
+	alias := params.DiskAlias()
+	if alias == "" {
+		alias = image.name
+	}
+	disk, err := m.createDisk(
+		storageDomainID,
+		ImageFormatCow,
+		p.imageSizes[imageID],
+		CreateDiskParams().MustWithAlias(alias).MustWithSparse(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutationListeners.notify(ResourceTypeDisk, string(disk.id), "", MutationTypeCreated)
+	importResult := &openStackImageImportResult{
+		diskID: disk.id,
+	}
+	if tpl != nil {
+		attachment := &templateDiskAttachment{
+			client:        m,
+			id:            TemplateDiskAttachmentID(m.GenerateUUID()),
+			templateID:    tpl.id,
+			diskID:        disk.id,
+			diskInterface: DiskInterfaceVirtIO,
+			bootable:      true,
+			active:        true,
+		}
+		m.templates[tpl.id] = tpl
+		m.templateDiskAttachmentsByTemplate[tpl.id] = []*templateDiskAttachment{attachment}
+		m.templateDiskAttachmentsByDisk[disk.id] = attachment
+		m.mutationListeners.notify(ResourceTypeTemplate, string(tpl.id), "", MutationTypeCreated)
+		templateID := tpl.id
+		importResult.templateID = &templateID
+	}
+
+	go m.finishOpenStackImageImport(disk, tpl)
+	return importResult, nil
+}
+
+// finishOpenStackImageImport unlocks the imported disk and template after a delay, like the engine does once the
+// image has been downloaded from Glance.
+func (m *mockClient) finishOpenStackImageImport(disk *diskWithData, tpl *template) {
+	time.Sleep(2 * time.Second)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	disk.Unlock()
+	if tpl != nil && tpl.status == TemplateStatusLocked {
+		tpl.status = TemplateStatusOK
+	}
+}
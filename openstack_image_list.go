@@ -0,0 +1,109 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+)
+
+func (o *oVirtClient) ListOpenStackImageProviders(retries ...RetryStrategy) (result []OpenStackImageProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		"listing OpenStack image providers",
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				OpenstackImageProvidersService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkProviders, ok := response.Providers()
+			if !ok {
+				return nil
+			}
+			result = make([]OpenStackImageProvider, len(sdkProviders.Slice()))
+			for i, sdkProvider := range sdkProviders.Slice() {
+				result[i], err = convertSDKOpenStackImageProvider(sdkProvider, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert OpenStack image provider")
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) ListOpenStackImages(
+	providerID OpenStackImageProviderID,
+	retries ...RetryStrategy,
+) (result []OpenStackImage, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing images in OpenStack image provider %s", providerID),
+		reading("", string(providerID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				OpenstackImageProvidersService().
+				ProviderService(string(providerID)).
+				ImagesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkImages, ok := response.Images()
+			if !ok {
+				return nil
+			}
+			result = make([]OpenStackImage, len(sdkImages.Slice()))
+			for i, sdkImage := range sdkImages.Slice() {
+				result[i], err = convertSDKOpenStackImage(sdkImage, providerID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert OpenStack image")
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListOpenStackImageProviders(_ ...RetryStrategy) ([]OpenStackImageProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]OpenStackImageProvider, 0, len(m.openStackImageProviders))
+	for _, p := range m.openStackImageProviders {
+		result = append(result, p.provider)
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListOpenStackImages(
+	providerID OpenStackImageProviderID,
+	_ ...RetryStrategy,
+) ([]OpenStackImage, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p, ok := m.openStackImageProviders[providerID]
+	if !ok {
+		return nil, newError(ENotFound, "OpenStack image provider with ID %s not found", providerID)
+	}
+	result := make([]OpenStackImage, 0, len(p.images))
+	for _, image := range p.images {
+		result = append(result, image)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestOpenStackImageImport(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	providers, err := client.ListOpenStackImageProviders()
+	if err != nil {
+		t.Fatalf("Failed to list OpenStack image providers (%v)", err)
+	}
+	if len(providers) == 0 {
+		t.Skipf("No OpenStack image providers configured.")
+	}
+	images, err := providers[0].ListImages()
+	if err != nil {
+		t.Fatalf("Failed to list images in provider %s (%v)", providers[0].ID(), err)
+	}
+	if len(images) == 0 {
+		t.Skipf("No images in OpenStack image provider %s.", providers[0].Name())
+	}
+
+	alias := helper.GenerateTestResourceName(t)
+	created := make(chan string, 10)
+	removeListener := client.AddMutationListener(func(event ovirtclient.MutationEvent) {
+		if event.ResourceType() == ovirtclient.ResourceTypeDisk && event.MutationType() == ovirtclient.MutationTypeCreated {
+			created <- event.ResourceID()
+		}
+	})
+	defer removeListener()
+	result, err := images[0].Import(
+		helper.GetStorageDomainID(),
+		ovirtclient.OpenStackImageImportParams().MustWithDiskAlias(alias),
+	)
+	if err != nil {
+		t.Fatalf("Failed to import image %s (%v)", images[0].ID(), err)
+	}
+	if result.TemplateID() != nil {
+		t.Fatalf("Importing image %s as a disk returned template %s.", images[0].ID(), *result.TemplateID())
+	}
+
+	disk, err := client.GetDisk(result.DiskID())
+	if err != nil {
+		t.Fatalf("Failed to get imported disk %s (%v)", result.DiskID(), err)
+	}
+	t.Cleanup(func() {
+		if err := client.RemoveDisk(disk.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove imported disk %s (%v)", disk.ID(), err)
+		}
+	})
+	if disk.Alias() != alias {
+		t.Fatalf("Incorrect alias on imported disk %s: %s", disk.ID(), disk.Alias())
+	}
+	select {
+	case id := <-created:
+		if id != string(disk.ID()) {
+			t.Fatalf("Incorrect disk %s reported as created instead of %s.", id, disk.ID())
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("No mutation event received for imported disk %s.", disk.ID())
+	}
+	if _, err := disk.WaitForOK(); err != nil {
+		t.Fatalf("Imported disk %s did not reach OK status (%v)", disk.ID(), err)
+	}
+}
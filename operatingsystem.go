@@ -0,0 +1,85 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+// OperatingSystemClient lists the guest operating systems the oVirt Engine knows about.
+type OperatingSystemClient interface {
+	// ListOperatingSystems returns the operating systems supported by the engine. The name of each operating system
+	// can be passed to VMOSParameters.WithType when creating or updating a VM. Choosing the correct operating system
+	// matters for Windows guests, since it determines the default devices and drivers.
+	ListOperatingSystems(retries ...RetryStrategy) ([]OperatingSystem, error)
+}
+
+// OperatingSystem describes a guest operating system supported by the engine.
+type OperatingSystem interface {
+	// ID returns the identifier of the operating system in the engine configuration.
+	ID() string
+	// Name returns the operating system type to use in the VM parameters, such as rhel_8x64 or windows_2019x64.
+	Name() string
+	// Description returns the human-readable name of the operating system.
+	Description() string
+	// Architecture returns the CPU architecture of the operating system, such as x86_64. It is empty if the engine did
+	// not report it.
+	Architecture() string
+}
+
+func convertSDKOperatingSystem(object *ovirtsdk.OperatingSystemInfo) (OperatingSystem, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("operating system", "id")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("operating system", "name")
+	}
+	description, _ := object.Description()
+	architecture, _ := object.Architecture()
+	return &operatingSystem{
+		id:           id,
+		name:         name,
+		description:  description,
+		architecture: string(architecture),
+	}, nil
+}
+
+type operatingSystem struct {
+	id           string
+	name         string
+	description  string
+	architecture string
+}
+
+func (o operatingSystem) ID() string {
+	return o.id
+}
+
+func (o operatingSystem) Name() string {
+	return o.name
+}
+
+func (o operatingSystem) Description() string {
+	return o.description
+}
+
+func (o operatingSystem) Architecture() string {
+	return o.architecture
+}
+
+// mockOperatingSystems is the list of operating systems the mock client reports. It is a subset of the operating
+// systems a default engine installation reports. Since the engine can be configured with further operating systems,
+// the mock accepts VMs with operating system types not on this list.
+var mockOperatingSystems = []*operatingSystem{
+	{"0", "other", "Other OS", "x86_64"},
+	{"5", "other_linux", "Linux", "x86_64"},
+	{"1002", "other_linux_ppc64", "Linux", "ppc64"},
+	{"24", "rhel_8x64", "Red Hat Enterprise Linux 8.x x64", "x86_64"},
+	{"34", "rhel_9x64", "Red Hat Enterprise Linux 9.x x64", "x86_64"},
+	{"1006", "rhcos_x64", "Red Hat Enterprise Linux CoreOS", "x86_64"},
+	{"1193", "debian_9", "Debian 9", "x86_64"},
+	{"1256", "ubuntu_18_04", "Ubuntu Bionic Beaver LTS", "x86_64"},
+	{"27", "windows_10x64", "Windows 10 x64", "x86_64"},
+	{"37", "windows_11", "Windows 11", "x86_64"},
+	{"29", "windows_2016x64", "Windows 2016 x64", "x86_64"},
+	{"31", "windows_2019x64", "Windows 2019 x64", "x86_64"},
+	{"36", "windows_2022", "Windows 2022", "x86_64"},
+}
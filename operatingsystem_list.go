@@ -0,0 +1,43 @@
+package ovirtclient
+
+func (o *oVirtClient) ListOperatingSystems(retries ...RetryStrategy) (result []OperatingSystem, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []OperatingSystem{}
+	err = retry(
+		"listing operating systems",
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				OperatingSystemsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.OperatingSystem()
+			if !ok {
+				return nil
+			}
+			result = make([]OperatingSystem, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKOperatingSystem(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert operating system during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListOperatingSystems(_ ...RetryStrategy) ([]OperatingSystem, error) {
+	result := make([]OperatingSystem, len(mockOperatingSystems))
+	for i, item := range mockOperatingSystems {
+		result[i] = item
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListOperatingSystems(t *testing.T) {
+	helper := getHelper(t)
+	operatingSystems, err := helper.GetClient().ListOperatingSystems()
+	if err != nil {
+		t.Fatalf("failed to list operating systems (%v)", err)
+	}
+	for _, os := range operatingSystems {
+		if os.Name() == "other" {
+			return
+		}
+	}
+	t.Fatalf("the operating system list does not contain the \"other\" operating system")
+}
+
+func TestVMCreationWithOSTypeAndTimeZone(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			WithOS(ovirtclient.NewVMOSParameters().MustWithType("windows_2019x64")).
+			MustWithTimeZone("GMT Standard Time"),
+	)
+	if osType := vm.OS().Type(); osType != "windows_2019x64" {
+		t.Fatalf("incorrect operating system type after VM creation (%s)", osType)
+	}
+	if timeZone := vm.TimeZone(); timeZone != "GMT Standard Time" {
+		t.Fatalf("incorrect time zone after VM creation (%s)", timeZone)
+	}
+}
+
+func TestMockVMCreationAcceptsOSTypeNotInList(t *testing.T) {
+	helper := getHelperMock(t)
+	// The engine supports more operating systems than the mock lists, so the mock must not reject them.
+	vm, err := helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithOS(ovirtclient.NewVMOSParameters().MustWithType("sles_12")),
+	)
+	if err != nil {
+		t.Fatalf("creating a VM with an operating system type not in the mock list failed (%v)", err)
+	}
+	if osType := vm.OS().Type(); osType != "sles_12" {
+		t.Fatalf("incorrect operating system type after VM creation (%s)", osType)
+	}
+}
@@ -0,0 +1,173 @@
+package ovirtclient
+
+import (
+	"context"
+)
+
+// OperationHook is called for every call the client makes to the oVirt Engine, for example to create a tracing span
+// for each call. Configure it using ExtraSettingsBuilder.WithOperationHook. The ovirtotel package contains an
+// implementation for OpenTelemetry.
+//
+// Unlike RequestHook, the hook is called once per call, including all retries, and receives the context passed to
+// Client.WithContext. Composite operations, for example creating a VM with its NICs, call the hook for each call they
+// make. Since the mock client does not send any API calls, it does not call the hook.
+type OperationHook interface {
+	// StartOperation is called before the call is started. The returned function is called with the error the call
+	// failed with, or nil, once the call finished.
+	StartOperation(ctx context.Context, operation Operation) func(err error)
+}
+
+// Operation describes a single call passed to an OperationHook.
+type Operation interface {
+	// Description describes the operation and its parameters, for example "getting vm 123".
+	Description() string
+	// Mutating returns true if the operation may change something on the engine. These are the operations recorded
+	// by an AuditSink.
+	Mutating() bool
+	// ResourceType returns the type of the resource the operation works on, or an empty string if it has no
+	// ResourceType, for example for datacenters. Operations on the children of a resource, such as listing the NICs
+	// of a VM, report the parent resource.
+	ResourceType() ResourceType
+	// ResourceID returns the ID of the resource the operation works on, or an empty string if it does not work on a
+	// single existing resource, for example when creating or listing resources.
+	ResourceID() string
+	// CorrelationID returns the correlation ID of the call. Calls without a correlation ID get a generated one, see
+	// CorrelationID.
+	CorrelationID() string
+}
+
+// operationTarget describes a call passed to retry: if it may change something on the engine, and which resource it
+// works on. It is set explicitly at each call site, since the description alone does not tell, for example, an image
+// download from an upload.
+type operationTarget struct {
+	mutating     bool
+	resourceType ResourceType
+	resourceID   string
+	// delegating is true if the call only talks to the engine through other client functions.
+	delegating bool
+	// parameters holds the arguments of the call other than the resource ID, see withParameters.
+	parameters map[string]string
+	// resultID returns the ID of the resource the call created, see withResultID.
+	resultID func() string
+}
+
+// reading returns the target of a call that does not change anything on the engine. The resource type or ID may be
+// empty if the call does not work on a single resource.
+func reading(resourceType ResourceType, resourceID string) operationTarget {
+	return operationTarget{
+		mutating:     false,
+		resourceType: resourceType,
+		resourceID:   resourceID,
+	}
+}
+
+// mutating returns the target of a call that may change something on the engine. The ID is empty if the call creates
+// the resource.
+func mutating(resourceType ResourceType, resourceID string) operationTarget {
+	return operationTarget{
+		mutating:     true,
+		resourceType: resourceType,
+		resourceID:   resourceID,
+	}
+}
+
+// viaClient marks a call that only talks to the engine through other client functions, such as the WaitFor
+// functions. These calls do not take a rate limit token, the functions they call do.
+func (t operationTarget) viaClient() operationTarget {
+	t.delegating = true
+	return t
+}
+
+// withParameters records the arguments of a mutating call other than the resource ID, for example the cluster a VM
+// is created in, for the audit sink. The keys are in snake case, such as cluster_id.
+func (t operationTarget) withParameters(parameters map[string]string) operationTarget {
+	t.parameters = parameters
+	return t
+}
+
+// withResultID records how to get the ID of the resource a call creates for the audit sink. The function is only
+// called after the call succeeded, so it may rely on the result being set.
+func (t operationTarget) withResultID(resultID func() string) operationTarget {
+	t.resultID = resultID
+	return t
+}
+
+// newOperation creates the Operation for a call.
+func newOperation(action string, target operationTarget, correlationID string) operation {
+	return operation{
+		description:   action,
+		target:        target,
+		correlationID: correlationID,
+	}
+}
+
+type operation struct {
+	description   string
+	target        operationTarget
+	correlationID string
+}
+
+func (o operation) Description() string {
+	return o.description
+}
+
+func (o operation) Mutating() bool {
+	return o.target.mutating
+}
+
+func (o operation) ResourceType() ResourceType {
+	return o.target.resourceType
+}
+
+func (o operation) ResourceID() string {
+	return o.target.resourceID
+}
+
+func (o operation) CorrelationID() string {
+	return o.correlationID
+}
+
+// startOperation calls the operation hook passed in the retry strategies, if any, and returns the function to call
+// once the call finished.
+func startOperation(
+	action string,
+	target operationTarget,
+	correlationID string,
+	retries []RetryStrategy,
+) func(err error) {
+	for _, r := range retries {
+		if o, ok := r.(*operationHookStrategy); ok {
+			if finish := o.hook.StartOperation(o.ctx, newOperation(action, target, correlationID)); finish != nil {
+				return finish
+			}
+			break
+		}
+	}
+	return func(error) {}
+}
+
+// operationHookStrategy carries the operation hook and the context of the client to the retry function. It does not
+// take part in the retry decisions itself.
+type operationHookStrategy struct {
+	callOption
+
+	hook OperationHook
+	ctx  context.Context
+}
+
+// withClientOperationHook adds the operation hook of the client to the default retry strategies.
+func withClientOperationHook(client Client, retries []RetryStrategy) []RetryStrategy {
+	c, ok := client.(*oVirtClient)
+	if !ok {
+		return retries
+	}
+	v6, ok := c.extraSettings.(ExtraSettingsV6)
+	if !ok || v6.OperationHook() == nil {
+		return retries
+	}
+	ctx := c.GetContext()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return append(retries, &operationHookStrategy{hook: v6.OperationHook(), ctx: ctx})
+}
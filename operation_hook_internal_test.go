@@ -0,0 +1,65 @@
+// This file contains tests for the internal operation descriptions passed to the operation hook. It is therefore
+// excluded from the testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+)
+
+type testOperationHook struct {
+	operations []Operation
+}
+
+func (t *testOperationHook) StartOperation(_ context.Context, operation Operation) func(err error) {
+	t.operations = append(t.operations, operation)
+	return nil
+}
+
+func TestRetryPassesOperationTarget(t *testing.T) {
+	t.Parallel()
+	const id = "5e9b0a4c-6a2f-4d43-9d4b-1a2f3c4d5e6f"
+	hook := &testOperationHook{}
+	retries := defaultRetries(
+		[]RetryStrategy{
+			&operationHookStrategy{hook: hook, ctx: context.Background()},
+		},
+		[]RetryStrategy{
+			MaxTries(1),
+		},
+	)
+	// The descriptions deliberately do not match the targets to make sure the targets are not derived from them.
+	if err := retry("transferring image", reading(ResourceTypeDisk, id), nil, retries, func() error {
+		return nil
+	}); err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	if err := retry("getting vm", mutating(ResourceTypeVM, ""), nil, retries, func() error {
+		return nil
+	}); err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+
+	if len(hook.operations) != 2 {
+		t.Fatalf("incorrect number of operations: %d instead of 2", len(hook.operations))
+	}
+	first := hook.operations[0]
+	if first.Mutating() || first.ResourceType() != ResourceTypeDisk || first.ResourceID() != id {
+		t.Fatalf(
+			"incorrect first operation: mutating %t, %s %s",
+			first.Mutating(),
+			first.ResourceType(),
+			first.ResourceID(),
+		)
+	}
+	second := hook.operations[1]
+	if !second.Mutating() || second.ResourceType() != ResourceTypeVM || second.ResourceID() != "" {
+		t.Fatalf(
+			"incorrect second operation: mutating %t, %s %s",
+			second.Mutating(),
+			second.ResourceType(),
+			second.ResourceID(),
+		)
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/ovirt/go-ovirt-client/v3/ovirtotel
+
+go 1.16
+
+require (
+	github.com/ovirt/go-ovirt-client-log/v3 v3.0.0
+	// OperationHook was introduced in v3.1.0. This module is tagged separately (ovirtotel/vX.Y.Z) after the client
+	// release it depends on. CI tests it against the client in the same commit using a temporary replace.
+	github.com/ovirt/go-ovirt-client/v3 v3.1.0
+	go.opentelemetry.io/otel v1.3.0
+	go.opentelemetry.io/otel/sdk v1.3.0
+	go.opentelemetry.io/otel/trace v1.3.0
+)
@@ -0,0 +1,15 @@
+package ovirtotel_test
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// The tests in this package run against a local test server, but the flag is accepted so the tests can be run
+	// together with the main package using go test -client=... ./...
+	flag.String("client", "all", "Ignored, the tests in this package do not need an oVirt Engine.")
+	flag.Parse()
+	os.Exit(m.Run())
+}
@@ -0,0 +1,113 @@
+// Package ovirtotel creates OpenTelemetry spans for the calls of the oVirt client. Tracing can be enabled with a single
+// option when creating the client:
+//
+//	client, err := ovirtclient.New(
+//	    url, username, password, tls, logger,
+//	    ovirtotel.WithTracing(ovirtclient.NewExtraSettings(), otel.GetTracerProvider()),
+//	)
+//
+// Each call results in one span, including all its retries. Spans are children of the span in the context passed to
+// Client.WithContext, so calls made by a controller show up in the trace of the reconciliation they belong to. The
+// spans carry the following attributes:
+//
+//	ovirt.operation       the description of the call, for example "getting vm 123"
+//	ovirt.mutating        true if the call may change something on the engine
+//	ovirt.resource.type   the type of the resource the call works on, if it has one
+//	ovirt.resource.id     the ID of the resource the call works on, if the call has one
+//	ovirt.correlation_id  the correlation ID of the call, if it has one
+//	ovirt.error.code      the ovirtclient.ErrorCode the call failed with
+//
+// This package is a separate Go module, so users of the client who do not need tracing do not depend on OpenTelemetry.
+package ovirtotel
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer the spans are created with.
+const TracerName = "github.com/ovirt/go-ovirt-client/v3/ovirtotel"
+
+// Attribute keys set on the spans.
+const (
+	// AttributeOperation contains the description of the call.
+	AttributeOperation = attribute.Key("ovirt.operation")
+	// AttributeMutating is true if the call may change something on the engine.
+	AttributeMutating = attribute.Key("ovirt.mutating")
+	// AttributeResourceType contains the ovirtclient.ResourceType of the resource the call works on.
+	AttributeResourceType = attribute.Key("ovirt.resource.type")
+	// AttributeResourceID contains the ID of the resource the call works on.
+	AttributeResourceID = attribute.Key("ovirt.resource.id")
+	// AttributeCorrelationID contains the correlation ID of the call.
+	AttributeCorrelationID = attribute.Key("ovirt.correlation_id")
+	// AttributeErrorCode contains the ovirtclient.ErrorCode the call failed with.
+	AttributeErrorCode = attribute.Key("ovirt.error.code")
+)
+
+// WithTracing adds an operation hook creating spans using the tracer provider to the extra settings.
+func WithTracing(
+	settings ovirtclient.ExtraSettingsBuilder,
+	tracerProvider trace.TracerProvider,
+) ovirtclient.ExtraSettingsBuilder {
+	return settings.WithOperationHook(NewOperationHook(tracerProvider))
+}
+
+// NewOperationHook creates an ovirtclient.OperationHook creating a span for every call using the tracer provider.
+func NewOperationHook(tracerProvider trace.TracerProvider) ovirtclient.OperationHook {
+	return &operationHook{
+		tracer: tracerProvider.Tracer(TracerName),
+	}
+}
+
+type operationHook struct {
+	tracer trace.Tracer
+}
+
+func (o *operationHook) StartOperation(ctx context.Context, operation ovirtclient.Operation) func(err error) {
+	attributes := []attribute.KeyValue{
+		AttributeOperation.String(operation.Description()),
+		AttributeMutating.Bool(operation.Mutating()),
+	}
+	if resourceType := operation.ResourceType(); resourceType != "" {
+		attributes = append(attributes, AttributeResourceType.String(string(resourceType)))
+	}
+	if resourceID := operation.ResourceID(); resourceID != "" {
+		attributes = append(attributes, AttributeResourceID.String(resourceID))
+	}
+	if correlationID := operation.CorrelationID(); correlationID != "" {
+		attributes = append(attributes, AttributeCorrelationID.String(correlationID))
+	}
+	_, span := o.tracer.Start(
+		ctx,
+		spanName(operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	return func(err error) {
+		if err != nil {
+			var engineErr ovirtclient.EngineError
+			if errors.As(err, &engineErr) {
+				span.SetAttributes(AttributeErrorCode.String(string(engineErr.Code())))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// spanName returns the first two words of the description, for example "getting vm", so the span names do not
+// contain IDs or names. If the second word is the resource ID, only the first word is used.
+func spanName(operation ovirtclient.Operation) string {
+	words := strings.SplitN(operation.Description(), " ", 3)
+	if len(words) > 1 && words[1] != operation.ResourceID() {
+		return "ovirt " + words[0] + " " + words[1]
+	}
+	return "ovirt " + words[0]
+}
@@ -0,0 +1,75 @@
+package ovirtotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+	"github.com/ovirt/go-ovirt-client/v3/ovirtotel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTracing(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/sso/oauth/token") {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<fault><reason>Operation Failed</reason><detail>Entity not found</detail></fault>`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client, err := ovirtclient.NewWithVerify(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtotel.WithTracing(ovirtclient.NewExtraSettings(), tracerProvider),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client (%v)", err)
+	}
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "reconcile")
+	vmID := ovirtclient.VMID("5e9b0a4c-6a2f-4d43-9d4b-1a2f3c4d5e6f")
+	if _, err := client.WithContext(ctx).GetVM(vmID, ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Getting a non-existent VM did not fail.")
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Incorrect number of spans: %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "ovirt getting vm" {
+		t.Fatalf("Incorrect span name: %s", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Fatalf("The span is not a child of the span in the client context.")
+	}
+	attributes := map[string]string{}
+	for _, attribute := range span.Attributes() {
+		attributes[string(attribute.Key)] = attribute.Value.Emit()
+	}
+	if attributes[string(ovirtotel.AttributeResourceType)] != string(ovirtclient.ResourceTypeVM) ||
+		attributes[string(ovirtotel.AttributeResourceID)] != string(vmID) {
+		t.Fatalf("Incorrect resource attributes: %v", attributes)
+	}
+	if attributes[string(ovirtotel.AttributeErrorCode)] != string(ovirtclient.ENotFound) {
+		t.Fatalf("Incorrect error code attribute: %v", attributes)
+	}
+}
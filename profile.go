@@ -0,0 +1,106 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// CreateProfileParameters contains the optional parameters for creating CPU and disk profiles.
+type CreateProfileParameters interface {
+	// Description returns the description of the profile, or nil if it has none.
+	Description() *string
+	// QoSID returns the QoS entry the profile references, or nil if the profile should not limit its users.
+	QoSID() *QoSID
+}
+
+// BuildableCreateProfileParameters is a buildable version of CreateProfileParameters.
+type BuildableCreateProfileParameters interface {
+	CreateProfileParameters
+
+	// WithDescription sets the description of the profile.
+	WithDescription(description string) (BuildableCreateProfileParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateProfileParameters
+
+	// WithQoSID sets the QoS entry the profile references. It must be a QoSTypeCPU entry for CPU profiles and
+	// a QoSTypeStorage entry for disk profiles, in the datacenter the profile belongs to.
+	WithQoSID(id QoSID) (BuildableCreateProfileParameters, error)
+	// MustWithQoSID is identical to WithQoSID, but panics instead of returning an error.
+	MustWithQoSID(id QoSID) BuildableCreateProfileParameters
+}
+
+// NewCreateProfileParams creates a buildable set of parameters for CreateCPUProfile and CreateDiskProfile.
+func NewCreateProfileParams() BuildableCreateProfileParameters {
+	return &createProfileParams{}
+}
+
+type createProfileParams struct {
+	description *string
+	qosID       *QoSID
+}
+
+func (c *createProfileParams) Description() *string {
+	return c.description
+}
+
+func (c *createProfileParams) QoSID() *QoSID {
+	return c.qosID
+}
+
+func (c *createProfileParams) WithDescription(description string) (BuildableCreateProfileParameters, error) {
+	c.description = &description
+	return c, nil
+}
+
+func (c *createProfileParams) MustWithDescription(description string) BuildableCreateProfileParameters {
+	builder, err := c.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createProfileParams) WithQoSID(id QoSID) (BuildableCreateProfileParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the QoS ID cannot be empty")
+	}
+	c.qosID = &id
+	return c, nil
+}
+
+func (c *createProfileParams) MustWithQoSID(id QoSID) BuildableCreateProfileParameters {
+	builder, err := c.WithQoSID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateMockProfileQoS checks that the QoS entry exists and has the type required by the profile. It must be
+// called with the lock held.
+func (m *mockClient) validateMockProfileQoS(qosID *QoSID, qosType QoSType) error {
+	if qosID == nil {
+		return nil
+	}
+	item, ok := m.qos[*qosID]
+	if !ok {
+		return newError(ENotFound, "QoS entry with ID %s not found", *qosID)
+	}
+	if item.qosType != qosType {
+		return newError(EBadArgument, "QoS entry %s is a %s entry instead of %s", *qosID, item.qosType, qosType)
+	}
+	return nil
+}
+
+// convertSDKProfileQoS returns the ID of the QoS entry a profile references, or nil if it has none.
+func convertSDKProfileQoS(getQoS func() (*ovirtsdk.Qos, bool)) *QoSID {
+	sdkQoS, ok := getQoS()
+	if !ok {
+		return nil
+	}
+	id, ok := sdkQoS.Id()
+	if !ok {
+		return nil
+	}
+	qosID := QoSID(id)
+	return &qosID
+}
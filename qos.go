@@ -0,0 +1,612 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// QoSID is the identifier of a QoS entry.
+type QoSID string
+
+// QoSClient lists the methods for working with the QoS entries of a datacenter. A QoS entry holds the limits that are
+// applied to the disks, VMs or VNICs through the profiles referencing it, for example a CPU profile references a CPU
+// QoS entry, and a disk profile a storage QoS entry.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#chap-Quality_of_Service for details.
+type QoSClient interface {
+	// ListDatacenterQoS lists all QoS entries in a datacenter.
+	ListDatacenterQoS(datacenterID DatacenterID, retries ...RetryStrategy) ([]QoS, error)
+	// GetDatacenterQoS returns a single QoS entry of a datacenter.
+	GetDatacenterQoS(datacenterID DatacenterID, id QoSID, retries ...RetryStrategy) (QoS, error)
+	// CreateDatacenterQoS creates a QoS entry in a datacenter. The limits must match the type, for example
+	// a QoSTypeStorage entry can only have IOPS and throughput limits. Create the parameters using NewQoSParams.
+	CreateDatacenterQoS(
+		datacenterID DatacenterID,
+		name string,
+		qosType QoSType,
+		params QoSParameters,
+		retries ...RetryStrategy,
+	) (QoS, error)
+	// UpdateDatacenterQoS changes the description and limits of a QoS entry. Limits not set in the parameters are
+	// left unchanged. The new limits apply to all profiles referencing the entry.
+	UpdateDatacenterQoS(
+		datacenterID DatacenterID,
+		id QoSID,
+		params QoSParameters,
+		retries ...RetryStrategy,
+	) (QoS, error)
+	// RemoveDatacenterQoS removes a QoS entry from a datacenter. The profiles referencing the entry are left without
+	// limits.
+	RemoveDatacenterQoS(datacenterID DatacenterID, id QoSID, retries ...RetryStrategy) error
+}
+
+// QoSType is the kind of resource a QoS entry limits.
+type QoSType string
+
+const (
+	// QoSTypeStorage limits the IOPS and throughput of disks. It is referenced by disk profiles.
+	QoSTypeStorage QoSType = "storage"
+	// QoSTypeCPU limits the CPU usage of VMs. It is referenced by CPU profiles.
+	QoSTypeCPU QoSType = "cpu"
+	// QoSTypeNetwork limits the inbound and outbound traffic of VNICs. It is referenced by VNIC profiles.
+	QoSTypeNetwork QoSType = "network"
+	// QoSTypeHostNetwork limits the traffic of a logical network on the host network interfaces. These entries can
+	// be listed, but not created through this library.
+	QoSTypeHostNetwork QoSType = "hostnetwork"
+)
+
+// QoSTypeList is a list of QoSType values.
+type QoSTypeList []QoSType
+
+// QoSTypeValues returns all possible values for QoSType.
+func QoSTypeValues() QoSTypeList {
+	return []QoSType{
+		QoSTypeStorage,
+		QoSTypeCPU,
+		QoSTypeNetwork,
+		QoSTypeHostNetwork,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l QoSTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, qosType := range l {
+		result[i] = string(qosType)
+	}
+	return result
+}
+
+// Validate returns an error if the QoS type is not valid.
+func (q QoSType) Validate() error {
+	for _, qosType := range QoSTypeValues() {
+		if qosType == q {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid QoS type: %s must be one of: %s",
+		q,
+		QoSTypeValues().Strings(),
+	)
+}
+
+// QoSLimits contains the limits of a QoS entry. Only the limits belonging to the type of the entry are used, a value
+// of 0 means the resource is not limited.
+type QoSLimits interface {
+	// MaxIOPS returns the limit of the total read and write operations per second for QoSTypeStorage.
+	MaxIOPS() uint64
+	// MaxReadIOPS returns the limit of the read operations per second for QoSTypeStorage.
+	MaxReadIOPS() uint64
+	// MaxWriteIOPS returns the limit of the write operations per second for QoSTypeStorage.
+	MaxWriteIOPS() uint64
+	// MaxThroughput returns the limit of the total read and write throughput in MB/s for QoSTypeStorage.
+	MaxThroughput() uint64
+	// MaxReadThroughput returns the limit of the read throughput in MB/s for QoSTypeStorage.
+	MaxReadThroughput() uint64
+	// MaxWriteThroughput returns the limit of the write throughput in MB/s for QoSTypeStorage.
+	MaxWriteThroughput() uint64
+	// CPULimit returns the share of the host CPU a VM may use in percent for QoSTypeCPU.
+	CPULimit() uint64
+	// InboundAverage returns the average inbound rate in Mbps for QoSTypeNetwork.
+	InboundAverage() uint64
+	// InboundPeak returns the peak inbound rate in Mbps for QoSTypeNetwork.
+	InboundPeak() uint64
+	// InboundBurst returns the inbound burst size in MB for QoSTypeNetwork.
+	InboundBurst() uint64
+	// OutboundAverage returns the average outbound rate in Mbps for QoSTypeNetwork.
+	OutboundAverage() uint64
+	// OutboundPeak returns the peak outbound rate in Mbps for QoSTypeNetwork.
+	OutboundPeak() uint64
+	// OutboundBurst returns the outbound burst size in MB for QoSTypeNetwork.
+	OutboundBurst() uint64
+}
+
+// QoSData is the data segment of the QoS type.
+type QoSData interface {
+	QoSLimits
+
+	// ID returns the unique identifier of the QoS entry.
+	ID() QoSID
+	// Name returns the name of the QoS entry.
+	Name() string
+	// Description returns the user-readable description of the QoS entry.
+	Description() string
+	// Type returns the kind of resource the entry limits.
+	Type() QoSType
+	// DatacenterID returns the ID of the datacenter the entry belongs to.
+	DatacenterID() DatacenterID
+}
+
+// QoS is a QoS entry in a datacenter.
+type QoS interface {
+	QoSData
+
+	// Update changes the description and limits of the QoS entry.
+	Update(params QoSParameters, retries ...RetryStrategy) (QoS, error)
+	// Remove removes the QoS entry.
+	Remove(retries ...RetryStrategy) error
+}
+
+// QoSParameters contains the description and limits for creating or updating a QoS entry. Each function returns
+// nil if the value is not set.
+type QoSParameters interface {
+	Description() *string
+	MaxIOPS() *uint64
+	MaxReadIOPS() *uint64
+	MaxWriteIOPS() *uint64
+	MaxThroughput() *uint64
+	MaxReadThroughput() *uint64
+	MaxWriteThroughput() *uint64
+	CPULimit() *uint64
+	InboundAverage() *uint64
+	InboundPeak() *uint64
+	InboundBurst() *uint64
+	OutboundAverage() *uint64
+	OutboundPeak() *uint64
+	OutboundBurst() *uint64
+}
+
+// BuildableQoSParameters is a buildable version of QoSParameters. See QoSLimits for the meaning and unit of each
+// limit. The limits are checked against the type of the entry when creating or updating it.
+type BuildableQoSParameters interface {
+	QoSParameters
+
+	WithDescription(description string) BuildableQoSParameters
+	WithMaxIOPS(iops uint64) BuildableQoSParameters
+	WithMaxReadIOPS(iops uint64) BuildableQoSParameters
+	WithMaxWriteIOPS(iops uint64) BuildableQoSParameters
+	WithMaxThroughput(throughput uint64) BuildableQoSParameters
+	WithMaxReadThroughput(throughput uint64) BuildableQoSParameters
+	WithMaxWriteThroughput(throughput uint64) BuildableQoSParameters
+	WithCPULimit(percent uint64) BuildableQoSParameters
+	WithInboundAverage(rate uint64) BuildableQoSParameters
+	WithInboundPeak(rate uint64) BuildableQoSParameters
+	WithInboundBurst(size uint64) BuildableQoSParameters
+	WithOutboundAverage(rate uint64) BuildableQoSParameters
+	WithOutboundPeak(rate uint64) BuildableQoSParameters
+	WithOutboundBurst(size uint64) BuildableQoSParameters
+}
+
+// NewQoSParams creates a buildable set of parameters for CreateDatacenterQoS and UpdateDatacenterQoS.
+func NewQoSParams() BuildableQoSParameters {
+	return &qosParams{}
+}
+
+type qosParams struct {
+	description        *string
+	maxIOPS            *uint64
+	maxReadIOPS        *uint64
+	maxWriteIOPS       *uint64
+	maxThroughput      *uint64
+	maxReadThroughput  *uint64
+	maxWriteThroughput *uint64
+	cpuLimit           *uint64
+	inboundAverage     *uint64
+	inboundPeak        *uint64
+	inboundBurst       *uint64
+	outboundAverage    *uint64
+	outboundPeak       *uint64
+	outboundBurst      *uint64
+}
+
+func (q *qosParams) Description() *string {
+	return q.description
+}
+
+func (q *qosParams) MaxIOPS() *uint64 {
+	return q.maxIOPS
+}
+
+func (q *qosParams) MaxReadIOPS() *uint64 {
+	return q.maxReadIOPS
+}
+
+func (q *qosParams) MaxWriteIOPS() *uint64 {
+	return q.maxWriteIOPS
+}
+
+func (q *qosParams) MaxThroughput() *uint64 {
+	return q.maxThroughput
+}
+
+func (q *qosParams) MaxReadThroughput() *uint64 {
+	return q.maxReadThroughput
+}
+
+func (q *qosParams) MaxWriteThroughput() *uint64 {
+	return q.maxWriteThroughput
+}
+
+func (q *qosParams) CPULimit() *uint64 {
+	return q.cpuLimit
+}
+
+func (q *qosParams) InboundAverage() *uint64 {
+	return q.inboundAverage
+}
+
+func (q *qosParams) InboundPeak() *uint64 {
+	return q.inboundPeak
+}
+
+func (q *qosParams) InboundBurst() *uint64 {
+	return q.inboundBurst
+}
+
+func (q *qosParams) OutboundAverage() *uint64 {
+	return q.outboundAverage
+}
+
+func (q *qosParams) OutboundPeak() *uint64 {
+	return q.outboundPeak
+}
+
+func (q *qosParams) OutboundBurst() *uint64 {
+	return q.outboundBurst
+}
+
+func (q *qosParams) WithDescription(description string) BuildableQoSParameters {
+	q.description = &description
+	return q
+}
+
+func (q *qosParams) WithMaxIOPS(iops uint64) BuildableQoSParameters {
+	q.maxIOPS = &iops
+	return q
+}
+
+func (q *qosParams) WithMaxReadIOPS(iops uint64) BuildableQoSParameters {
+	q.maxReadIOPS = &iops
+	return q
+}
+
+func (q *qosParams) WithMaxWriteIOPS(iops uint64) BuildableQoSParameters {
+	q.maxWriteIOPS = &iops
+	return q
+}
+
+func (q *qosParams) WithMaxThroughput(throughput uint64) BuildableQoSParameters {
+	q.maxThroughput = &throughput
+	return q
+}
+
+func (q *qosParams) WithMaxReadThroughput(throughput uint64) BuildableQoSParameters {
+	q.maxReadThroughput = &throughput
+	return q
+}
+
+func (q *qosParams) WithMaxWriteThroughput(throughput uint64) BuildableQoSParameters {
+	q.maxWriteThroughput = &throughput
+	return q
+}
+
+func (q *qosParams) WithCPULimit(percent uint64) BuildableQoSParameters {
+	q.cpuLimit = &percent
+	return q
+}
+
+func (q *qosParams) WithInboundAverage(rate uint64) BuildableQoSParameters {
+	q.inboundAverage = &rate
+	return q
+}
+
+func (q *qosParams) WithInboundPeak(rate uint64) BuildableQoSParameters {
+	q.inboundPeak = &rate
+	return q
+}
+
+func (q *qosParams) WithInboundBurst(size uint64) BuildableQoSParameters {
+	q.inboundBurst = &size
+	return q
+}
+
+func (q *qosParams) WithOutboundAverage(rate uint64) BuildableQoSParameters {
+	q.outboundAverage = &rate
+	return q
+}
+
+func (q *qosParams) WithOutboundPeak(rate uint64) BuildableQoSParameters {
+	q.outboundPeak = &rate
+	return q
+}
+
+func (q *qosParams) WithOutboundBurst(size uint64) BuildableQoSParameters {
+	q.outboundBurst = &size
+	return q
+}
+
+// qosLimits holds the limits of a QoS entry, 0 meaning not limited.
+type qosLimits struct {
+	maxIOPS            uint64
+	maxReadIOPS        uint64
+	maxWriteIOPS       uint64
+	maxThroughput      uint64
+	maxReadThroughput  uint64
+	maxWriteThroughput uint64
+	cpuLimit           uint64
+	inboundAverage     uint64
+	inboundPeak        uint64
+	inboundBurst       uint64
+	outboundAverage    uint64
+	outboundPeak       uint64
+	outboundBurst      uint64
+}
+
+func (l qosLimits) MaxIOPS() uint64 {
+	return l.maxIOPS
+}
+
+func (l qosLimits) MaxReadIOPS() uint64 {
+	return l.maxReadIOPS
+}
+
+func (l qosLimits) MaxWriteIOPS() uint64 {
+	return l.maxWriteIOPS
+}
+
+func (l qosLimits) MaxThroughput() uint64 {
+	return l.maxThroughput
+}
+
+func (l qosLimits) MaxReadThroughput() uint64 {
+	return l.maxReadThroughput
+}
+
+func (l qosLimits) MaxWriteThroughput() uint64 {
+	return l.maxWriteThroughput
+}
+
+func (l qosLimits) CPULimit() uint64 {
+	return l.cpuLimit
+}
+
+func (l qosLimits) InboundAverage() uint64 {
+	return l.inboundAverage
+}
+
+func (l qosLimits) InboundPeak() uint64 {
+	return l.inboundPeak
+}
+
+func (l qosLimits) InboundBurst() uint64 {
+	return l.inboundBurst
+}
+
+func (l qosLimits) OutboundAverage() uint64 {
+	return l.outboundAverage
+}
+
+func (l qosLimits) OutboundPeak() uint64 {
+	return l.outboundPeak
+}
+
+func (l qosLimits) OutboundBurst() uint64 {
+	return l.outboundBurst
+}
+
+// withParams returns a copy of the limits with the limits set in the parameters applied.
+func (l qosLimits) withParams(params QoSParameters) qosLimits {
+	targets := []struct {
+		value  *uint64
+		target *uint64
+	}{
+		{params.MaxIOPS(), &l.maxIOPS},
+		{params.MaxReadIOPS(), &l.maxReadIOPS},
+		{params.MaxWriteIOPS(), &l.maxWriteIOPS},
+		{params.MaxThroughput(), &l.maxThroughput},
+		{params.MaxReadThroughput(), &l.maxReadThroughput},
+		{params.MaxWriteThroughput(), &l.maxWriteThroughput},
+		{params.CPULimit(), &l.cpuLimit},
+		{params.InboundAverage(), &l.inboundAverage},
+		{params.InboundPeak(), &l.inboundPeak},
+		{params.InboundBurst(), &l.inboundBurst},
+		{params.OutboundAverage(), &l.outboundAverage},
+		{params.OutboundPeak(), &l.outboundPeak},
+		{params.OutboundBurst(), &l.outboundBurst},
+	}
+	for _, t := range targets {
+		if t.value != nil {
+			*t.target = *t.value
+		}
+	}
+	return l
+}
+
+// validate checks that only the limits belonging to the QoS type are set, and that they can be combined.
+func (l qosLimits) validate(qosType QoSType) error {
+	storage := []uint64{
+		l.maxIOPS, l.maxReadIOPS, l.maxWriteIOPS, l.maxThroughput, l.maxReadThroughput, l.maxWriteThroughput,
+	}
+	network := []uint64{
+		l.inboundAverage, l.inboundPeak, l.inboundBurst, l.outboundAverage, l.outboundPeak, l.outboundBurst,
+	}
+	cpu := []uint64{l.cpuLimit}
+	checks := map[QoSType][]uint64{
+		QoSTypeStorage: storage,
+		QoSTypeNetwork: network,
+		QoSTypeCPU:     cpu,
+	}
+	switch qosType {
+	case QoSTypeStorage, QoSTypeNetwork, QoSTypeCPU:
+	case QoSTypeHostNetwork:
+		return newError(EUnsupported, "creating and updating host network QoS entries is not supported")
+	default:
+		return qosType.Validate()
+	}
+	for otherType, values := range checks {
+		if otherType == qosType {
+			continue
+		}
+		for _, value := range values {
+			if value != 0 {
+				return newError(EBadArgument, "%s limits cannot be set on a %s QoS entry", otherType, qosType)
+			}
+		}
+	}
+	if l.maxIOPS != 0 && (l.maxReadIOPS != 0 || l.maxWriteIOPS != 0) {
+		return newError(EBadArgument, "the total IOPS limit cannot be combined with the read and write IOPS limits")
+	}
+	if l.maxThroughput != 0 && (l.maxReadThroughput != 0 || l.maxWriteThroughput != 0) {
+		return newError(
+			EBadArgument,
+			"the total throughput limit cannot be combined with the read and write throughput limits",
+		)
+	}
+	if l.cpuLimit > 100 {
+		return newError(EBadArgument, "the CPU limit must be between 1 and 100 percent (%d given)", l.cpuLimit)
+	}
+	return nil
+}
+
+func convertSDKQoS(object *ovirtsdk.Qos, client Client) (QoS, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("QoS", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("QoS", "name")
+	}
+	qosType, ok := object.Type()
+	if !ok {
+		return nil, newFieldNotFound("QoS", "type")
+	}
+	sdkDatacenter, ok := object.DataCenter()
+	if !ok {
+		return nil, newFieldNotFound("QoS", "datacenter")
+	}
+	datacenterID, ok := sdkDatacenter.Id()
+	if !ok {
+		return nil, newFieldNotFound("datacenter on QoS", "ID")
+	}
+	description, _ := object.Description()
+	result := &qos{
+		client:       client,
+		id:           QoSID(id),
+		name:         name,
+		description:  description,
+		qosType:      QoSType(qosType),
+		datacenterID: DatacenterID(datacenterID),
+	}
+	limits := []struct {
+		get    func() (int64, bool)
+		target *uint64
+	}{
+		{object.MaxIops, &result.maxIOPS},
+		{object.MaxReadIops, &result.maxReadIOPS},
+		{object.MaxWriteIops, &result.maxWriteIOPS},
+		{object.MaxThroughput, &result.maxThroughput},
+		{object.MaxReadThroughput, &result.maxReadThroughput},
+		{object.MaxWriteThroughput, &result.maxWriteThroughput},
+		{object.CpuLimit, &result.cpuLimit},
+		{object.InboundAverage, &result.inboundAverage},
+		{object.InboundPeak, &result.inboundPeak},
+		{object.InboundBurst, &result.inboundBurst},
+		{object.OutboundAverage, &result.outboundAverage},
+		{object.OutboundPeak, &result.outboundPeak},
+		{object.OutboundBurst, &result.outboundBurst},
+	}
+	for _, limit := range limits {
+		if value, ok := limit.get(); ok && value > 0 {
+			*limit.target = uint64(value)
+		}
+	}
+	return result, nil
+}
+
+// buildSDKQoS creates the SDK object for creating or updating a QoS entry with the specified limits. Limits of 0 are
+// not sent, so the engine leaves the resource unlimited.
+func buildSDKQoS(name string, qosType QoSType, description *string, limits qosLimits) (*ovirtsdk.Qos, error) {
+	builder := ovirtsdk.NewQosBuilder().Type(ovirtsdk.QosType(qosType))
+	if name != "" {
+		builder.Name(name)
+	}
+	if description != nil {
+		builder.Description(*description)
+	}
+	setters := []struct {
+		value uint64
+		set   func(int64) *ovirtsdk.QosBuilder
+	}{
+		{limits.maxIOPS, builder.MaxIops},
+		{limits.maxReadIOPS, builder.MaxReadIops},
+		{limits.maxWriteIOPS, builder.MaxWriteIops},
+		{limits.maxThroughput, builder.MaxThroughput},
+		{limits.maxReadThroughput, builder.MaxReadThroughput},
+		{limits.maxWriteThroughput, builder.MaxWriteThroughput},
+		{limits.cpuLimit, builder.CpuLimit},
+		{limits.inboundAverage, builder.InboundAverage},
+		{limits.inboundPeak, builder.InboundPeak},
+		{limits.inboundBurst, builder.InboundBurst},
+		{limits.outboundAverage, builder.OutboundAverage},
+		{limits.outboundPeak, builder.OutboundPeak},
+		{limits.outboundBurst, builder.OutboundBurst},
+	}
+	for _, setter := range setters {
+		if setter.value != 0 {
+			setter.set(int64(setter.value))
+		}
+	}
+	return builder.Build()
+}
+
+type qos struct {
+	qosLimits
+
+	client Client
+
+	id           QoSID
+	name         string
+	description  string
+	qosType      QoSType
+	datacenterID DatacenterID
+}
+
+func (q *qos) ID() QoSID {
+	return q.id
+}
+
+func (q *qos) Name() string {
+	return q.name
+}
+
+func (q *qos) Description() string {
+	return q.description
+}
+
+func (q *qos) Type() QoSType {
+	return q.qosType
+}
+
+func (q *qos) DatacenterID() DatacenterID {
+	return q.datacenterID
+}
+
+func (q *qos) Update(params QoSParameters, retries ...RetryStrategy) (QoS, error) {
+	return q.client.UpdateDatacenterQoS(q.datacenterID, q.id, params, retries...)
+}
+
+func (q *qos) Remove(retries ...RetryStrategy) error {
+	return q.client.RemoveDatacenterQoS(q.datacenterID, q.id, retries...)
+}
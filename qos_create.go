@@ -0,0 +1,105 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) CreateDatacenterQoS(
+	datacenterID DatacenterID,
+	name string,
+	qosType QoSType,
+	params QoSParameters,
+	retries ...RetryStrategy,
+) (result QoS, err error) {
+	if params == nil {
+		params = NewQoSParams()
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "the QoS entry name cannot be empty")
+	}
+	limits := qosLimits{}.withParams(params)
+	if err := limits.validate(qosType); err != nil {
+		return nil, err
+	}
+	sdkQoS, err := buildSDKQoS(name, qosType, params.Description(), limits)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build QoS entry %s", name)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("creating QoS entry %s in datacenter %s", name, datacenterID),
+		mutating(ResourceTypeQoS, "").
+			withParameters(map[string]string{
+				"datacenter_id": string(datacenterID),
+				"name":          name,
+				"type":          string(qosType),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().DataCentersService().DataCenterService(
+				string(datacenterID),
+			).QossService().Add().Qos(sdkQoS).Query("correlation_id", requestCorrelationID(retries)).Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Qos()
+			if !ok {
+				return newFieldNotFound("QoS creation response", "QoS")
+			}
+			result, e = convertSDKQoS(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert QoS entry %s", name)
+			}
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeQoS, string(result.ID()), string(datacenterID), MutationTypeCreated)
+	}
+	return result, err
+}
+
+func (m *mockClient) CreateDatacenterQoS(
+	datacenterID DatacenterID,
+	name string,
+	qosType QoSType,
+	params QoSParameters,
+	_ ...RetryStrategy,
+) (QoS, error) {
+	if params == nil {
+		params = NewQoSParams()
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "the QoS entry name cannot be empty")
+	}
+	limits := qosLimits{}.withParams(params)
+	if err := limits.validate(qosType); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	for _, item := range m.qos {
+		if item.datacenterID == datacenterID && item.name == name {
+			return nil, newError(ENameInUse, "a QoS entry named %s already exists in datacenter %s", name, datacenterID)
+		}
+	}
+	result := &qos{
+		qosLimits:    limits,
+		client:       m,
+		id:           QoSID(m.GenerateUUID()),
+		name:         name,
+		qosType:      qosType,
+		datacenterID: datacenterID,
+	}
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	m.qos[result.id] = result
+	m.mutationListeners.notify(ResourceTypeQoS, string(result.id), string(datacenterID), MutationTypeCreated)
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetDatacenterQoS(
+	datacenterID DatacenterID,
+	id QoSID,
+	retries ...RetryStrategy,
+) (result QoS, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting QoS entry %s in datacenter %s", id, datacenterID),
+		reading(ResourceTypeQoS, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DataCentersService().DataCenterService(
+				string(datacenterID),
+			).QossService().QosService(string(id)).Get().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Qos()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no QoS entry returned when getting QoS entry ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKQoS(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert QoS entry %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetDatacenterQoS(datacenterID DatacenterID, id QoSID, _ ...RetryStrategy) (QoS, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.getDatacenterQoS(datacenterID, id)
+}
+
+// getDatacenterQoS returns the QoS entry if it exists in the datacenter. It must be called with the lock held.
+func (m *mockClient) getDatacenterQoS(datacenterID DatacenterID, id QoSID) (*qos, error) {
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	item, ok := m.qos[id]
+	if !ok || item.datacenterID != datacenterID {
+		return nil, newError(ENotFound, "QoS entry with ID %s not found in datacenter %s", id, datacenterID)
+	}
+	return item, nil
+}
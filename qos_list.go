@@ -0,0 +1,51 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListDatacenterQoS(datacenterID DatacenterID, retries ...RetryStrategy) (result []QoS, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []QoS{}
+	err = retry(
+		fmt.Sprintf("listing QoS entries in datacenter %s", datacenterID),
+		reading(ResourceTypeQoS, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().DataCentersService().DataCenterService(
+				string(datacenterID),
+			).QossService().List().Query("correlation_id", requestCorrelationID(retries)).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Qoss()
+			if !ok {
+				return nil
+			}
+			result = make([]QoS, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKQoS(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert QoS entry during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListDatacenterQoS(datacenterID DatacenterID, _ ...RetryStrategy) ([]QoS, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	result := []QoS{}
+	for _, item := range m.qos {
+		if item.datacenterID == datacenterID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveDatacenterQoS(datacenterID DatacenterID, id QoSID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err := retry(
+		fmt.Sprintf("removing QoS entry %s from datacenter %s", id, datacenterID),
+		mutating(ResourceTypeQoS, string(id)).
+			withParameters(map[string]string{
+				"datacenter_id": string(datacenterID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DataCentersService().
+				DataCenterService(string(datacenterID)).
+				QossService().
+				QosService(string(id)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeQoS, string(id), string(datacenterID), MutationTypeRemoved)
+	}
+	return err
+}
+
+func (m *mockClient) RemoveDatacenterQoS(datacenterID DatacenterID, id QoSID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.getDatacenterQoS(datacenterID, id); err != nil {
+		return err
+	}
+	// Like the engine, the profiles referencing the entry are detached from it instead of blocking the removal.
+	for _, profile := range m.cpuProfiles {
+		if profile.qosID != nil && *profile.qosID == id {
+			profile.qosID = nil
+		}
+	}
+	for _, profile := range m.diskProfiles {
+		if profile.qosID != nil && *profile.qosID == id {
+			profile.qosID = nil
+		}
+	}
+	delete(m.qos, id)
+	m.mutationListeners.notify(ResourceTypeQoS, string(id), string(datacenterID), MutationTypeRemoved)
+	return nil
+}
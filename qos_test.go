@@ -0,0 +1,147 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiskProfileWithStorageQoS(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	datacenterID := getMockDatacenterID(t, client)
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("Failed to list storage domains (%v)", err)
+	}
+	storageDomainID := storageDomains[0].ID()
+
+	qos, err := client.CreateDatacenterQoS(
+		datacenterID,
+		"test-storage-qos",
+		ovirtclient.QoSTypeStorage,
+		ovirtclient.NewQoSParams().WithMaxReadIOPS(1000).WithMaxWriteIOPS(500),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create storage QoS entry (%v)", err)
+	}
+	if qos.MaxReadIOPS() != 1000 || qos.MaxWriteIOPS() != 500 || qos.MaxIOPS() != 0 {
+		t.Fatalf(
+			"Incorrect limits on QoS entry %s (read: %d, write: %d, total: %d)",
+			qos.ID(),
+			qos.MaxReadIOPS(),
+			qos.MaxWriteIOPS(),
+			qos.MaxIOPS(),
+		)
+	}
+
+	profile, err := client.CreateDiskProfile(
+		storageDomainID,
+		"test-limited",
+		ovirtclient.NewCreateProfileParams().MustWithQoSID(qos.ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create disk profile with QoS entry %s (%v)", qos.ID(), err)
+	}
+	if profile.QoSID() == nil || *profile.QoSID() != qos.ID() {
+		t.Fatalf("Incorrect QoS entry on disk profile %s: %v", profile.ID(), profile.QoSID())
+	}
+	disk, err := client.CreateDisk(
+		storageDomainID,
+		ovirtclient.ImageFormatRaw,
+		1024*1024,
+		ovirtclient.CreateDiskParams().MustWithDiskProfileID(profile.ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create disk with disk profile %s (%v)", profile.ID(), err)
+	}
+	if disk.DiskProfileID() == nil || *disk.DiskProfileID() != profile.ID() {
+		t.Fatalf("Incorrect disk profile on disk %s: %v", disk.ID(), disk.DiskProfileID())
+	}
+	if err := profile.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing a disk profile in use did not fail with EConflict (%v)", err)
+	}
+
+	qos, err = qos.Update(ovirtclient.NewQoSParams().WithMaxWriteIOPS(200))
+	if err != nil {
+		t.Fatalf("Failed to update QoS entry %s (%v)", qos.ID(), err)
+	}
+	if qos.MaxReadIOPS() != 1000 || qos.MaxWriteIOPS() != 200 {
+		t.Fatalf("Incorrect limits after update (read: %d, write: %d)", qos.MaxReadIOPS(), qos.MaxWriteIOPS())
+	}
+
+	if err := qos.Remove(); err != nil {
+		t.Fatalf("Failed to remove QoS entry %s (%v)", qos.ID(), err)
+	}
+	profile, err = client.GetDiskProfile(profile.ID())
+	if err != nil {
+		t.Fatalf("Failed to get disk profile %s (%v)", profile.ID(), err)
+	}
+	if profile.QoSID() != nil {
+		t.Fatalf("Disk profile %s still references removed QoS entry %s.", profile.ID(), *profile.QoSID())
+	}
+}
+
+func TestInvalidQoSLimits(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	datacenterID := getMockDatacenterID(t, client)
+
+	_, err := client.CreateDatacenterQoS(
+		datacenterID,
+		"test-invalid",
+		ovirtclient.QoSTypeStorage,
+		ovirtclient.NewQoSParams().WithMaxIOPS(1000).WithMaxReadIOPS(500),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Combining total and read IOPS limits did not fail with EBadArgument (%v)", err)
+	}
+	_, err = client.CreateDatacenterQoS(
+		datacenterID,
+		"test-invalid",
+		ovirtclient.QoSTypeCPU,
+		ovirtclient.NewQoSParams().WithInboundAverage(100),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting a network limit on a CPU QoS entry did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func TestCPUProfileWithStorageQoSFails(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	datacenterID := getMockDatacenterID(t, client)
+	clusters, err := client.ListDatacenterClusters(datacenterID)
+	if err != nil {
+		t.Fatalf("Failed to list clusters of datacenter %s (%v)", datacenterID, err)
+	}
+	qos, err := client.CreateDatacenterQoS(
+		datacenterID,
+		"test-storage-qos",
+		ovirtclient.QoSTypeStorage,
+		ovirtclient.NewQoSParams().WithMaxIOPS(1000),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create storage QoS entry (%v)", err)
+	}
+
+	_, err = client.CreateCPUProfile(
+		clusters[0].ID(),
+		"test-limited",
+		ovirtclient.NewCreateProfileParams().MustWithQoSID(qos.ID()),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a CPU profile with a storage QoS entry did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func getMockDatacenterID(t *testing.T, client ovirtclient.Client) ovirtclient.DatacenterID {
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		t.Fatalf("Failed to list datacenters (%v)", err)
+	}
+	if len(datacenters) == 0 {
+		t.Fatalf("No datacenters found.")
+	}
+	return datacenters[0].ID()
+}
@@ -0,0 +1,109 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) UpdateDatacenterQoS(
+	datacenterID DatacenterID,
+	id QoSID,
+	params QoSParameters,
+	retries ...RetryStrategy,
+) (result QoS, err error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating QoS entry %s", id)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	// The limits are validated against the type and the current limits of the entry, so it has to be fetched first.
+	existing, err := o.GetDatacenterQoS(datacenterID, id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	limits := qosLimitsOf(existing).withParams(params)
+	if err := limits.validate(existing.Type()); err != nil {
+		return nil, err
+	}
+	sdkQoS, err := buildSDKQoS("", existing.Type(), params.Description(), limits)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build update for QoS entry %s", id)
+	}
+	err = retry(
+		fmt.Sprintf("updating QoS entry %s in datacenter %s", id, datacenterID),
+		mutating(ResourceTypeQoS, string(id)).
+			withParameters(map[string]string{
+				"datacenter_id": string(datacenterID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().DataCentersService().DataCenterService(
+				string(datacenterID),
+			).QossService().QosService(string(id)).Update().Qos(sdkQoS).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Qos()
+			if !ok {
+				return newFieldNotFound("QoS update response", "QoS")
+			}
+			result, e = convertSDKQoS(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert QoS entry %s", id)
+			}
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeQoS, string(id), string(datacenterID), MutationTypeUpdated)
+	}
+	return result, err
+}
+
+func (m *mockClient) UpdateDatacenterQoS(
+	datacenterID DatacenterID,
+	id QoSID,
+	params QoSParameters,
+	_ ...RetryStrategy,
+) (QoS, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "no parameters given for updating QoS entry %s", id)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, err := m.getDatacenterQoS(datacenterID, id)
+	if err != nil {
+		return nil, err
+	}
+	limits := item.qosLimits.withParams(params)
+	if err := limits.validate(item.qosType); err != nil {
+		return nil, err
+	}
+	result := *item
+	result.qosLimits = limits
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	m.qos[id] = &result
+	m.mutationListeners.notify(ResourceTypeQoS, string(id), string(datacenterID), MutationTypeUpdated)
+	return &result, nil
+}
+
+// qosLimitsOf copies the limits of a QoS entry.
+func qosLimitsOf(limits QoSLimits) qosLimits {
+	return qosLimits{
+		maxIOPS:            limits.MaxIOPS(),
+		maxReadIOPS:        limits.MaxReadIOPS(),
+		maxWriteIOPS:       limits.MaxWriteIOPS(),
+		maxThroughput:      limits.MaxThroughput(),
+		maxReadThroughput:  limits.MaxReadThroughput(),
+		maxWriteThroughput: limits.MaxWriteThroughput(),
+		cpuLimit:           limits.CPULimit(),
+		inboundAverage:     limits.InboundAverage(),
+		inboundPeak:        limits.InboundPeak(),
+		inboundBurst:       limits.InboundBurst(),
+		outboundAverage:    limits.OutboundAverage(),
+		outboundPeak:       limits.OutboundPeak(),
+		outboundBurst:      limits.OutboundBurst(),
+	}
+}
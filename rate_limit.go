@@ -0,0 +1,101 @@
+package ovirtclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that throttles the API calls of a client. It is shared between the client and its
+// subclients created using WithContext, so the limit applies to the connection as a whole.
+type rateLimiter struct {
+	lock       *sync.Mutex
+	rate       float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+// newRateLimiter creates a rate limiter that allows requestsPerSecond calls on average and up to burst calls at once.
+// It returns nil if requestsPerSecond is 0, meaning no limit.
+func newRateLimiter(requestsPerSecond float64, burst uint) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst == 0 {
+		burst = 1
+	}
+	return &rateLimiter{
+		lock:       &sync.Mutex{},
+		rate:       requestsPerSecond,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// wait blocks until a request may be sent, or the context is canceled.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		r.lock.Lock()
+		now := time.Now()
+		r.tokens += now.Sub(r.lastRefill).Seconds() * r.rate
+		if r.tokens > r.burst {
+			r.tokens = r.burst
+		}
+		r.lastRefill = now
+		if r.tokens >= 1 {
+			r.tokens--
+			r.lock.Unlock()
+			return nil
+		}
+		waitTime := time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+		r.lock.Unlock()
+
+		timer := time.NewTimer(waitTime)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// rateLimitStrategy carries the rate limiter and the context of the client to the retry function, which waits for
+// the limiter before each attempt.
+type rateLimitStrategy struct {
+	callOption
+
+	limiter *rateLimiter
+	ctx     context.Context
+}
+
+// withClientRateLimit adds the rate limiter of the client to the default retry strategies.
+func withClientRateLimit(client Client, retries []RetryStrategy) []RetryStrategy {
+	c, ok := client.(*oVirtClient)
+	if !ok || c.rateLimiter == nil {
+		return retries
+	}
+	return append(retries, &rateLimitStrategy{limiter: c.rateLimiter, ctx: c.ctx})
+}
+
+// waitForRateLimit waits for the rate limiters among the retry strategies before an attempt of the call. Calls that
+// only talk to the engine through other client functions, such as WaitForVMStatus, do not wait, since the functions
+// they call take their own tokens.
+func waitForRateLimit(target operationTarget, retries []RetryStrategy) error {
+	if target.delegating {
+		return nil
+	}
+	for _, r := range retries {
+		if s, ok := r.(*rateLimitStrategy); ok {
+			if err := s.limiter.wait(s.ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
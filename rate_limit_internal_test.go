@@ -0,0 +1,82 @@
+// This file contains tests for the internal rate limiter. It is therefore excluded from the testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterDelaysCallsAboveTheLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(10, 2)
+	startTime := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatalf("waiting for the rate limiter failed (%v)", err)
+		}
+	}
+	// The first two calls use the burst, the other two have to wait 100ms each.
+	if elapsed := time.Since(startTime); elapsed < 150*time.Millisecond {
+		t.Fatalf("the rate limiter did not delay the calls above the burst (elapsed: %s)", elapsed)
+	}
+}
+
+func TestRateLimiterHonorsContext(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(0.1, 1)
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("the first call was delayed (%v)", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx); err == nil {
+		t.Fatalf("waiting for the rate limiter did not stop when the context expired")
+	}
+}
+
+func TestRetryWaitsForRateLimit(t *testing.T) {
+	t.Parallel()
+
+	retries := []RetryStrategy{
+		MaxTries(3),
+		&rateLimitStrategy{limiter: newRateLimiter(10, 1), ctx: context.Background()},
+	}
+	startTime := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := retry("reading", reading("", ""), nil, retries, func() error { return nil }); err != nil {
+			t.Fatalf("retry failed (%v)", err)
+		}
+	}
+	if elapsed := time.Since(startTime); elapsed < 150*time.Millisecond {
+		t.Fatalf("retry did not wait for the rate limit (elapsed: %s)", elapsed)
+	}
+}
+
+func TestRetryViaClientDoesNotTakeRateLimitTokens(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(0.1, 1)
+	retries := []RetryStrategy{
+		MaxTries(3),
+		&rateLimitStrategy{limiter: limiter, ctx: context.Background()},
+	}
+	// The outer call only talks to the engine through the inner one, so only the inner call may take a token,
+	// otherwise it would block for 10 seconds.
+	err := retry("outer", reading("", "").viaClient(), nil, retries, func() error {
+		return retry("inner", reading("", ""), nil, retries, func() error {
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx); err == nil {
+		t.Fatalf("the inner call did not take a token from the rate limiter")
+	}
+}
@@ -0,0 +1,346 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// recordingVersion is the version of the recording format. It must be increased when the format changes in an
+// incompatible way.
+const recordingVersion = 1
+
+// redactedToken replaces the access tokens issued by the engine in recordings.
+const redactedToken = "redacted"
+
+// RecordingProxy is a local HTTP server forwarding requests to a live oVirt Engine and recording the request and
+// response pairs. Pass the URL returned by URL() to New instead of the engine URL to record a session, then write
+// the recording out using Save. The recording can be served back using NewReplayServer.
+//
+// The credentials sent to the engine and the access tokens it issues are not recorded. Image transfers go directly
+// to the image proxy of the engine and are not recorded.
+type RecordingProxy interface {
+	// URL returns the API URL to connect the client to.
+	URL() string
+	// Save writes the requests recorded so far to the specified writer in JSON format.
+	Save(w io.Writer) error
+	// Stop shuts down the proxy.
+	Stop() error
+}
+
+// ReplayServer is a local HTTP server serving the responses captured by a RecordingProxy. Pass the URL returned by
+// URL() to New instead of the engine URL. Requests are matched by method, path, query string and body. Identical
+// requests receive the recorded responses in order, and the last one once they run out, so polling loops finish as
+// they did during the recording. Requests containing random values, such as generated resource names, only match if
+// the test generates the same values when replaying.
+type ReplayServer interface {
+	// URL returns the API URL to connect the client to.
+	URL() string
+	// Unmatched returns the requests that had no recorded response.
+	Unmatched() []string
+	// Stop shuts down the server.
+	Stop() error
+}
+
+// NewRecordingProxy starts a RecordingProxy on a random local port forwarding to the specified oVirt Engine API URL.
+func NewRecordingProxy(engineURL string, tlsProvider TLSProvider, logger Logger) (RecordingProxy, error) {
+	target, err := url.Parse(engineURL)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid engine URL: %s", engineURL)
+	}
+	tlsConfig, err := tlsProvider.CreateTLSConfig()
+	if err != nil {
+		return nil, wrap(err, ETLSError, "failed to create TLS configuration")
+	}
+	proxy := &recordingProxy{
+		target: target,
+		logger: logger,
+		client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		lock: &sync.Mutex{},
+	}
+	proxy.server, err = startLocalServer(target.Path, proxy)
+	if err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+// NewReplayServer starts a ReplayServer on a random local port serving the recording written by RecordingProxy.Save.
+func NewReplayServer(r io.Reader, logger Logger) (ReplayServer, error) {
+	rec := &recording{}
+	if err := json.NewDecoder(r).Decode(rec); err != nil {
+		return nil, wrap(err, EBadArgument, "failed to decode recording")
+	}
+	if rec.Version != recordingVersion {
+		return nil, newError(
+			EBadArgument,
+			"unsupported recording version %d, expected %d",
+			rec.Version,
+			recordingVersion,
+		)
+	}
+	replay := &replayServer{
+		logger:    logger,
+		responses: map[string][]recordedExchange{},
+		lock:      &sync.Mutex{},
+	}
+	for _, exchange := range rec.Exchanges {
+		key := exchange.key()
+		replay.responses[key] = append(replay.responses[key], exchange)
+	}
+	var err error
+	replay.server, err = startLocalServer(rec.APIPath, replay)
+	if err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
+
+type recording struct {
+	Version   int                `json:"version"`
+	APIPath   string             `json:"api_path"`
+	Exchanges []recordedExchange `json:"exchanges"`
+}
+
+type recordedExchange struct {
+	Method       string `json:"method"`
+	URI          string `json:"uri"`
+	RequestBody  string `json:"request_body,omitempty"`
+	Status       int    `json:"status"`
+	ContentType  string `json:"content_type,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+}
+
+// key returns the string used to match requests on replay. The correlation_id query parameter is ignored since the
+// client generates it randomly for some operations.
+func (e recordedExchange) key() string {
+	uri := e.URI
+	if parsed, err := url.ParseRequestURI(e.URI); err == nil {
+		query := parsed.Query()
+		query.Del("correlation_id")
+		parsed.RawQuery = query.Encode()
+		uri = parsed.RequestURI()
+	}
+	return fmt.Sprintf("%s %s\n%s", e.Method, uri, e.RequestBody)
+}
+
+type localServer struct {
+	server *http.Server
+	url    string
+}
+
+func startLocalServer(apiPath string, handler http.Handler) (*localServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, wrap(err, EConnection, "failed to listen on a local port")
+	}
+	server := &http.Server{Handler: handler} //nolint:gosec
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return &localServer{
+		server: server,
+		url:    fmt.Sprintf("http://%s%s", listener.Addr().String(), apiPath),
+	}, nil
+}
+
+func (l *localServer) stop() error {
+	if err := l.server.Close(); err != nil {
+		return wrap(err, EUnidentified, "failed to stop local server")
+	}
+	return nil
+}
+
+// isSSORequest returns true for requests to the SSO endpoints, which carry the credentials and tokens.
+func isSSORequest(uri string) bool {
+	return strings.HasPrefix(uri, "/ovirt-engine/sso/")
+}
+
+type recordingProxy struct {
+	target    *url.URL
+	logger    Logger
+	client    *http.Client
+	server    *localServer
+	lock      *sync.Mutex
+	exchanges []recordedExchange
+}
+
+func (r *recordingProxy) URL() string {
+	return r.server.url
+}
+
+func (r *recordingProxy) Stop() error {
+	return r.server.stop()
+}
+
+func (r *recordingProxy) Save(w io.Writer) error {
+	r.lock.Lock()
+	rec := &recording{
+		Version:   recordingVersion,
+		APIPath:   r.target.Path,
+		Exchanges: append([]recordedExchange{}, r.exchanges...),
+	}
+	r.lock.Unlock()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(rec); err != nil {
+		return wrap(err, EUnidentified, "failed to encode recording")
+	}
+	return nil
+}
+
+func (r *recordingProxy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	requestBody, err := io.ReadAll(request.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	outgoingURL := *r.target
+	outgoingURL.Path = request.URL.Path
+	outgoingURL.RawQuery = request.URL.RawQuery
+	outgoing, err := http.NewRequestWithContext(
+		request.Context(),
+		request.Method,
+		outgoingURL.String(),
+		bytes.NewReader(requestBody),
+	)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	outgoing.Header = request.Header.Clone()
+	// Leave the compression to the transport so the recorded bodies are readable.
+	outgoing.Header.Del("Accept-Encoding")
+
+	response, err := r.client.Do(outgoing)
+	if err != nil {
+		r.logger.Warningf("Failed to forward %s %s to the engine (%v)", request.Method, request.URL.RequestURI(), err)
+		http.Error(writer, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	exchange := recordedExchange{
+		Method:       request.Method,
+		URI:          request.URL.RequestURI(),
+		RequestBody:  string(requestBody),
+		Status:       response.StatusCode,
+		ContentType:  response.Header.Get("Content-Type"),
+		ResponseBody: string(responseBody),
+	}
+	if isSSORequest(exchange.URI) {
+		exchange.RequestBody = ""
+		exchange.ResponseBody = redactAccessToken(responseBody)
+	}
+	r.lock.Lock()
+	r.exchanges = append(r.exchanges, exchange)
+	r.lock.Unlock()
+
+	for name, values := range response.Header {
+		for _, value := range values {
+			writer.Header().Add(name, value)
+		}
+	}
+	writer.Header().Del("Content-Length")
+	writer.WriteHeader(response.StatusCode)
+	_, _ = writer.Write(responseBody)
+}
+
+// redactAccessToken replaces the access token in an SSO response. Responses that are not JSON, such as error pages,
+// are recorded as they are.
+func redactAccessToken(body []byte) string {
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+	if _, ok := data["access_token"]; ok {
+		data["access_token"] = redactedToken
+	}
+	redacted, err := json.Marshal(data)
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+type replayServer struct {
+	logger    Logger
+	server    *localServer
+	lock      *sync.Mutex
+	responses map[string][]recordedExchange
+	unmatched []string
+}
+
+func (r *replayServer) URL() string {
+	return r.server.url
+}
+
+func (r *replayServer) Stop() error {
+	return r.server.stop()
+}
+
+func (r *replayServer) Unmatched() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string{}, r.unmatched...)
+}
+
+func (r *replayServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	requestBody, err := io.ReadAll(request.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	exchange := recordedExchange{
+		Method:      request.Method,
+		URI:         request.URL.RequestURI(),
+		RequestBody: string(requestBody),
+	}
+	if isSSORequest(exchange.URI) {
+		exchange.RequestBody = ""
+	}
+
+	r.lock.Lock()
+	key := exchange.key()
+	responses := r.responses[key]
+	if len(responses) == 0 {
+		r.unmatched = append(r.unmatched, fmt.Sprintf("%s %s", exchange.Method, exchange.URI))
+		r.lock.Unlock()
+		r.logger.Warningf("No recorded response for %s %s", exchange.Method, exchange.URI)
+		http.Error(writer, "no recorded response for this request", http.StatusNotImplemented)
+		return
+	}
+	response := responses[0]
+	if len(responses) > 1 {
+		r.responses[key] = responses[1:]
+	}
+	r.lock.Unlock()
+
+	if response.ContentType != "" {
+		writer.Header().Set("Content-Type", response.ContentType)
+	}
+	writer.WriteHeader(response.Status)
+	_, _ = writer.Write([]byte(response.ResponseBody))
+}
@@ -0,0 +1,112 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	logger := ovirtclientlog.NewTestLogger(t)
+	lock := &sync.Mutex{}
+	polls := 0
+	engine := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/ovirt-engine/sso/oauth/token":
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"secret-token"}`))
+		case "/ovirt-engine/api/vms/1":
+			lock.Lock()
+			polls++
+			status := "down"
+			if polls > 1 {
+				status = "up"
+			}
+			lock.Unlock()
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = fmt.Fprintf(writer, "<vm><status>%s</status></vm>", status)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(engine.Close)
+
+	proxy, err := ovirtclient.NewRecordingProxy(engine.URL+"/ovirt-engine/api", ovirtclient.TLS().Insecure(), logger)
+	if err != nil {
+		t.Fatalf("Failed to start recording proxy (%v)", err)
+	}
+	baseURL := strings.TrimSuffix(proxy.URL(), "/ovirt-engine/api")
+	tokenURL := baseURL + "/ovirt-engine/sso/oauth/token"
+	assertHTTPResponse(t, http.MethodPost, tokenURL, "password=secret-password", "secret-token")
+	assertHTTPResponse(t, http.MethodGet, proxy.URL()+"/vms/1", "", "down")
+	assertHTTPResponse(t, http.MethodGet, proxy.URL()+"/vms/1", "", "up")
+	recorded := &bytes.Buffer{}
+	if err := proxy.Save(recorded); err != nil {
+		t.Fatalf("Failed to save recording (%v)", err)
+	}
+	if err := proxy.Stop(); err != nil {
+		t.Fatalf("Failed to stop recording proxy (%v)", err)
+	}
+	if strings.Contains(recorded.String(), "secret") {
+		t.Fatalf("The recording contains credentials or tokens:\n%s", recorded.String())
+	}
+
+	replay, err := ovirtclient.NewReplayServer(recorded, logger)
+	if err != nil {
+		t.Fatalf("Failed to start replay server (%v)", err)
+	}
+	t.Cleanup(func() {
+		_ = replay.Stop()
+	})
+	baseURL = strings.TrimSuffix(replay.URL(), "/ovirt-engine/api")
+	assertHTTPResponse(t, http.MethodPost, baseURL+"/ovirt-engine/sso/oauth/token", "password=other", "redacted")
+	assertHTTPResponse(t, http.MethodGet, replay.URL()+"/vms/1", "", "down")
+	assertHTTPResponse(t, http.MethodGet, replay.URL()+"/vms/1", "", "up")
+	assertHTTPResponse(t, http.MethodGet, replay.URL()+"/vms/1", "", "up")
+	if len(replay.Unmatched()) != 0 {
+		t.Fatalf("Recorded requests were reported as unmatched: %v", replay.Unmatched())
+	}
+
+	response, err := http.Get(replay.URL() + "/vms/2") //nolint:noctx
+	if err != nil {
+		t.Fatalf("Failed to send unrecorded request (%v)", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("Incorrect status code for unrecorded request: %d", response.StatusCode)
+	}
+	if unmatched := replay.Unmatched(); len(unmatched) != 1 || unmatched[0] != "GET /ovirt-engine/api/vms/2" {
+		t.Fatalf("Incorrect unmatched requests: %v", unmatched)
+	}
+}
+
+func assertHTTPResponse(t *testing.T, method string, requestURL string, body string, expected string) {
+	t.Helper()
+	request, err := http.NewRequest(method, requestURL, strings.NewReader(body)) //nolint:noctx
+	if err != nil {
+		t.Fatalf("Failed to create request (%v)", err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Failed to send %s %s (%v)", method, requestURL, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response body (%v)", err)
+	}
+	if !strings.Contains(string(responseBody), expected) {
+		t.Fatalf("The response to %s %s does not contain %s: %s", method, requestURL, expected, responseBody)
+	}
+}
@@ -0,0 +1,277 @@
+package ovirtclient
+
+import (
+	"sync"
+	"time"
+)
+
+// ReferenceCache keeps slow-changing reference data, such as clusters, templates, VNIC profiles and storage domains,
+// in memory for a configurable time. Controllers looking up the same objects in every reconciliation can use the
+// cache to avoid sending the same API calls over and over.
+//
+// The cache listens to the mutations made through the client it was created with and drops the affected entries.
+// Since mutation listeners are called asynchronously, a read directly after a change may still return the old data;
+// call Invalidate or InvalidateResource after the change if this matters. Changes made outside the client are only
+// picked up when the entries expire.
+//
+// Errors are not cached, and the objects returned from the cache are shared between callers, so they must not be
+// modified.
+type ReferenceCache interface {
+	// ListClusters returns all clusters. See ClusterClient.ListClusters for details.
+	ListClusters(retries ...RetryStrategy) ([]Cluster, error)
+	// GetCluster returns a single cluster. See ClusterClient.GetCluster for details.
+	GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error)
+	// ListTemplates returns all templates. See TemplateClient.ListTemplates for details.
+	ListTemplates(retries ...RetryStrategy) ([]Template, error)
+	// GetTemplate returns a single template. See TemplateClient.GetTemplate for details.
+	GetTemplate(id TemplateID, retries ...RetryStrategy) (Template, error)
+	// ListVNICProfiles returns all VNIC profiles. See VNICProfileClient.ListVNICProfiles for details.
+	ListVNICProfiles(retries ...RetryStrategy) ([]VNICProfile, error)
+	// GetVNICProfile returns a single VNIC profile. See VNICProfileClient.GetVNICProfile for details.
+	GetVNICProfile(id VNICProfileID, retries ...RetryStrategy) (VNICProfile, error)
+	// ListStorageDomains returns all storage domains. See StorageDomainClient.ListStorageDomains for details.
+	ListStorageDomains(retries ...RetryStrategy) (StorageDomainList, error)
+	// GetStorageDomain returns a single storage domain. See StorageDomainClient.GetStorageDomain for details.
+	GetStorageDomain(id StorageDomainID, retries ...RetryStrategy) (StorageDomain, error)
+
+	// Invalidate drops all cached entries of the resource type, for example ResourceTypeCluster.
+	Invalidate(resourceType ResourceType)
+	// InvalidateResource drops the cached entry of a single resource, as well as the cached list of its type.
+	InvalidateResource(resourceType ResourceType, id string)
+	// InvalidateAll drops all cached entries.
+	InvalidateAll()
+	// Close stops listening to the mutations of the client. The cache remains usable, but entries are only dropped
+	// when they expire or are invalidated.
+	Close()
+}
+
+// ReferenceCacheParameters are the optional parameters for NewReferenceCache.
+type ReferenceCacheParameters interface {
+	// TTL returns the time an entry is kept in the cache.
+	TTL() time.Duration
+}
+
+// BuildableReferenceCacheParameters is a buildable version of ReferenceCacheParameters.
+type BuildableReferenceCacheParameters interface {
+	ReferenceCacheParameters
+
+	// WithTTL sets the time an entry is kept in the cache.
+	WithTTL(ttl time.Duration) (BuildableReferenceCacheParameters, error)
+	// MustWithTTL is identical to WithTTL, but panics instead of returning an error.
+	MustWithTTL(ttl time.Duration) BuildableReferenceCacheParameters
+}
+
+// ReferenceCacheParams creates a buildable set of ReferenceCacheParameters for NewReferenceCache. The default TTL is
+// 5 minutes.
+func ReferenceCacheParams() BuildableReferenceCacheParameters {
+	return &referenceCacheParams{
+		ttl: 5 * time.Minute,
+	}
+}
+
+type referenceCacheParams struct {
+	ttl time.Duration
+}
+
+func (r *referenceCacheParams) TTL() time.Duration {
+	return r.ttl
+}
+
+func (r *referenceCacheParams) WithTTL(ttl time.Duration) (BuildableReferenceCacheParameters, error) {
+	if ttl <= 0 {
+		return r, newError(EBadArgument, "the TTL must be positive (%s given)", ttl)
+	}
+	r.ttl = ttl
+	return r, nil
+}
+
+func (r *referenceCacheParams) MustWithTTL(ttl time.Duration) BuildableReferenceCacheParameters {
+	builder, err := r.WithTTL(ttl)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// NewReferenceCache creates a new, empty ReferenceCache backed by the specified client. If params is nil, the
+// defaults of ReferenceCacheParams() are used.
+func NewReferenceCache(client Client, params ReferenceCacheParameters) (ReferenceCache, error) {
+	if client == nil {
+		return nil, newError(EBadArgument, "the client must not be nil")
+	}
+	if params == nil {
+		params = ReferenceCacheParams()
+	}
+	cache := &referenceCache{
+		client:  client,
+		ttl:     params.TTL(),
+		lock:    &sync.Mutex{},
+		entries: map[referenceCacheKey]referenceCacheEntry{},
+	}
+	cache.removeListener = client.AddMutationListener(cache.onMutation)
+	return cache, nil
+}
+
+// referenceCacheListID is the ID used in the cache keys for the list of all resources of a type.
+const referenceCacheListID = ""
+
+type referenceCacheKey struct {
+	resourceType ResourceType
+	id           string
+}
+
+type referenceCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+type referenceCache struct {
+	client         Client
+	ttl            time.Duration
+	lock           *sync.Mutex
+	entries        map[referenceCacheKey]referenceCacheEntry
+	removeListener func()
+}
+
+func (r *referenceCache) ListClusters(retries ...RetryStrategy) ([]Cluster, error) {
+	result, err := r.get(ResourceTypeCluster, referenceCacheListID, func() (interface{}, error) {
+		return r.client.ListClusters(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]Cluster), nil
+}
+
+func (r *referenceCache) GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error) {
+	result, err := r.get(ResourceTypeCluster, string(id), func() (interface{}, error) {
+		return r.client.GetCluster(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(Cluster), nil
+}
+
+func (r *referenceCache) ListTemplates(retries ...RetryStrategy) ([]Template, error) {
+	result, err := r.get(ResourceTypeTemplate, referenceCacheListID, func() (interface{}, error) {
+		return r.client.ListTemplates(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]Template), nil
+}
+
+func (r *referenceCache) GetTemplate(id TemplateID, retries ...RetryStrategy) (Template, error) {
+	result, err := r.get(ResourceTypeTemplate, string(id), func() (interface{}, error) {
+		return r.client.GetTemplate(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(Template), nil
+}
+
+func (r *referenceCache) ListVNICProfiles(retries ...RetryStrategy) ([]VNICProfile, error) {
+	result, err := r.get(ResourceTypeVNICProfile, referenceCacheListID, func() (interface{}, error) {
+		return r.client.ListVNICProfiles(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]VNICProfile), nil
+}
+
+func (r *referenceCache) GetVNICProfile(id VNICProfileID, retries ...RetryStrategy) (VNICProfile, error) {
+	result, err := r.get(ResourceTypeVNICProfile, string(id), func() (interface{}, error) {
+		return r.client.GetVNICProfile(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(VNICProfile), nil
+}
+
+func (r *referenceCache) ListStorageDomains(retries ...RetryStrategy) (StorageDomainList, error) {
+	result, err := r.get(ResourceTypeStorageDomain, referenceCacheListID, func() (interface{}, error) {
+		return r.client.ListStorageDomains(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(StorageDomainList), nil
+}
+
+func (r *referenceCache) GetStorageDomain(id StorageDomainID, retries ...RetryStrategy) (StorageDomain, error) {
+	result, err := r.get(ResourceTypeStorageDomain, string(id), func() (interface{}, error) {
+		return r.client.GetStorageDomain(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(StorageDomain), nil
+}
+
+func (r *referenceCache) Invalidate(resourceType ResourceType) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for key := range r.entries {
+		if key.resourceType == resourceType {
+			delete(r.entries, key)
+		}
+	}
+}
+
+func (r *referenceCache) InvalidateResource(resourceType ResourceType, id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.entries, referenceCacheKey{resourceType, id})
+	delete(r.entries, referenceCacheKey{resourceType, referenceCacheListID})
+}
+
+func (r *referenceCache) InvalidateAll() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.entries = map[referenceCacheKey]referenceCacheEntry{}
+}
+
+func (r *referenceCache) Close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.removeListener != nil {
+		r.removeListener()
+		r.removeListener = nil
+	}
+}
+
+func (r *referenceCache) onMutation(event MutationEvent) {
+	r.InvalidateResource(event.ResourceType(), event.ResourceID())
+}
+
+// get returns the cached value for the key, or fetches and caches it if it is missing or expired. The lock is not
+// held while fetching, so concurrent misses on the same key fetch the value in parallel.
+func (r *referenceCache) get(
+	resourceType ResourceType,
+	id string,
+	fetch func() (interface{}, error),
+) (interface{}, error) {
+	key := referenceCacheKey{resourceType, id}
+	r.lock.Lock()
+	entry, ok := r.entries[key]
+	r.lock.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value, nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.entries[key] = referenceCacheEntry{
+		value:   value,
+		expires: time.Now().Add(r.ttl),
+	}
+	return value, nil
+}
@@ -0,0 +1,82 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// countingClient counts the cluster lookups reaching the underlying client.
+type countingClient struct {
+	ovirtclient.Client
+
+	clusterLists int
+}
+
+func (c *countingClient) ListClusters(retries ...ovirtclient.RetryStrategy) ([]ovirtclient.Cluster, error) {
+	c.clusterLists++
+	return c.Client.ListClusters(retries...)
+}
+
+func TestReferenceCacheCachesUntilInvalidated(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := &countingClient{Client: helper.GetClient()}
+
+	cache, err := ovirtclient.NewReferenceCache(client, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reference cache (%v)", err)
+	}
+	defer cache.Close()
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.ListClusters(); err != nil {
+			t.Fatalf("Failed to list clusters (%v)", err)
+		}
+	}
+	if client.clusterLists != 1 {
+		t.Fatalf("Expected 1 cluster list call, got %d.", client.clusterLists)
+	}
+
+	cache.Invalidate(ovirtclient.ResourceTypeCluster)
+	if _, err := cache.ListClusters(); err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	if client.clusterLists != 2 {
+		t.Fatalf("Expected 2 cluster list calls after invalidation, got %d.", client.clusterLists)
+	}
+}
+
+func TestReferenceCacheExpiresEntries(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := &countingClient{Client: helper.GetClient()}
+
+	cache, err := ovirtclient.NewReferenceCache(
+		client,
+		ovirtclient.ReferenceCacheParams().MustWithTTL(10*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create reference cache (%v)", err)
+	}
+	defer cache.Close()
+
+	if _, err := cache.ListClusters(); err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, err := cache.ListClusters(); err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	if client.clusterLists != 2 {
+		t.Fatalf("Expected 2 cluster list calls after expiry, got %d.", client.clusterLists)
+	}
+}
+
+func TestReferenceCacheRejectsInvalidTTL(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.ReferenceCacheParams().WithTTL(0); err == nil {
+		t.Fatalf("Setting a zero TTL did not result in an error.")
+	}
+}
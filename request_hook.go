@@ -0,0 +1,176 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redactedValue replaces secrets in the URLs passed to request hooks.
+const redactedValue = "redacted"
+
+// RequestHook is called only for the HTTP requests the client sends using its own HTTP client, which is also returned
+// by GetHTTPClient. These are the image transfers, extent queries and the health check. Configure it using
+// ExtraSettingsBuilder.WithRequestHook. It can be used to create tracing spans or metrics for these requests.
+//
+// Requests sent by the underlying SDK are not covered. These include most API calls and the authentication, since the
+// SDK does not allow passing an HTTP client or transport. Use an OperationHook to trace those calls.
+//
+// Hooks are called synchronously when the response headers arrive, or the request fails, so they should return
+// quickly. Retries of a call result in a separate request each. The mock client does not send any requests and does
+// not call hooks.
+type RequestHook interface {
+	// OnRequest is called with the details of a finished request.
+	OnRequest(record RequestRecord)
+}
+
+// RequestHookFunc is a function implementing RequestHook.
+type RequestHookFunc func(record RequestRecord)
+
+// OnRequest calls the function.
+func (f RequestHookFunc) OnRequest(record RequestRecord) {
+	f(record)
+}
+
+// RequestRecord describes a single HTTP request sent to the oVirt Engine.
+type RequestRecord interface {
+	// Method returns the HTTP method of the request, for example GET.
+	Method() string
+	// URL returns the URL of the request. Credentials in the URL, password and token query parameters, and image
+	// transfer tickets are replaced with "redacted".
+	URL() string
+	// StatusCode returns the HTTP status code of the response, or 0 if no response was received.
+	StatusCode() int
+	// CorrelationID returns the correlation ID sent with the request, or an empty string if it has none. Calls without
+	// a correlation ID send a generated one.
+	CorrelationID() string
+	// StartTime returns the time the request was sent.
+	StartTime() time.Time
+	// Duration returns the time until the response headers arrived or the request failed. Reading the response body
+	// is not included.
+	Duration() time.Duration
+	// Err returns the error the request failed with, or nil if a response was received. Error responses from the
+	// engine, such as 404, are responses and have no error.
+	Err() error
+}
+
+// getRequestHooks returns the request hooks from the extra settings, or nil if none are set.
+func getRequestHooks(extraSettings ExtraSettings) []RequestHook {
+	v5, ok := extraSettings.(ExtraSettingsV5)
+	if !ok {
+		return nil
+	}
+	return v5.RequestHooks()
+}
+
+// newRequestHookTransport wraps the transport so each request is reported to the hooks. It returns the transport
+// unchanged if there are no hooks.
+func newRequestHookTransport(transport http.RoundTripper, hooks []RequestHook) http.RoundTripper {
+	if len(hooks) == 0 {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &requestHookTransport{
+		backend: transport,
+		hooks:   hooks,
+	}
+}
+
+type requestHookTransport struct {
+	backend http.RoundTripper
+	hooks   []RequestHook
+}
+
+func (r *requestHookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	record := requestRecord{
+		method:        req.Method,
+		url:           redactURL(req.URL),
+		correlationID: req.URL.Query().Get("correlation_id"),
+		startTime:     time.Now(),
+	}
+	resp, err := r.backend.RoundTrip(req)
+	record.duration = time.Since(record.startTime)
+	record.err = err
+	if resp != nil {
+		record.statusCode = resp.StatusCode
+	}
+	for _, hook := range r.hooks {
+		hook.OnRequest(record)
+	}
+	return resp, err
+}
+
+// sensitiveQueryParameters are the query parameters redacted from the URLs passed to request hooks.
+var sensitiveQueryParameters = []string{ //nolint:gochecknoglobals
+	"password",
+	"token",
+	"access_token",
+	"refresh_token",
+	"ticket",
+}
+
+// redactURL returns the URL as a string with the credentials and secret query parameters replaced. Image transfer
+// URLs consist of /images/ and the transfer ticket, which grants access to the disk, so the ticket is replaced too.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	if _, hasPassword := u.User.Password(); hasPassword {
+		redacted.User = url.UserPassword(u.User.Username(), redactedValue)
+	}
+	if redacted.RawQuery != "" {
+		query := redacted.Query()
+		for key := range query {
+			for _, sensitive := range sensitiveQueryParameters {
+				if strings.EqualFold(key, sensitive) {
+					query.Set(key, redactedValue)
+				}
+			}
+		}
+		redacted.RawQuery = query.Encode()
+	}
+	if strings.HasPrefix(redacted.Path, "/images/") {
+		redacted.Path = "/images/" + redactedValue
+		redacted.RawPath = ""
+	}
+	return redacted.String()
+}
+
+type requestRecord struct {
+	method        string
+	url           string
+	statusCode    int
+	correlationID string
+	startTime     time.Time
+	duration      time.Duration
+	err           error
+}
+
+func (r requestRecord) Method() string {
+	return r.method
+}
+
+func (r requestRecord) URL() string {
+	return r.url
+}
+
+func (r requestRecord) StatusCode() int {
+	return r.statusCode
+}
+
+func (r requestRecord) CorrelationID() string {
+	return r.correlationID
+}
+
+func (r requestRecord) StartTime() time.Time {
+	return r.startTime
+}
+
+func (r requestRecord) Duration() time.Duration {
+	return r.duration
+}
+
+func (r requestRecord) Err() error {
+	return r.err
+}
@@ -0,0 +1,152 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+)
+
+// defaultResolveConcurrency is the number of parallel requests used when the client resolves the IDs of linked
+// objects, for example the tags of a VM.
+const defaultResolveConcurrency uint = 4
+
+// ResolveFunc fetches a single object by its ID for ResolveIDs.
+type ResolveFunc func(id string) (interface{}, error)
+
+// ResolveIDs fetches the objects with the specified IDs using fetch, running at most concurrency fetches at the same
+// time. This is much faster than fetching the objects one after the other, for example when scanning the objects
+// referenced by a list of VMs. The typed variants, such as Client.GetVMs, should be preferred where available.
+//
+// The returned objects are in the same order as the IDs, and IDs passed more than once are only fetched once. If some
+// objects cannot be fetched, a BulkError is returned with the failures keyed by ID, and the slice contains nil for
+// the failed objects.
+func ResolveIDs(ids []string, concurrency uint, fetch ResolveFunc) ([]interface{}, error) {
+	if concurrency == 0 {
+		return nil, newError(EBadArgument, "the concurrency for resolving IDs must be at least 1")
+	}
+	if fetch == nil {
+		return nil, newError(EBadArgument, "the fetch function must not be nil")
+	}
+
+	objects := make(map[string]interface{}, len(ids))
+	lock := &sync.Mutex{}
+	failures := map[string]error{}
+	semaphore := make(chan struct{}, concurrency)
+	wg := &sync.WaitGroup{}
+	started := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := started[id]; ok {
+			continue
+		}
+		started[id] = struct{}{}
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(id string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			object, err := fetch(id)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				failures[id] = err
+				return
+			}
+			objects[id] = object
+		}(id)
+	}
+	wg.Wait()
+
+	result := make([]interface{}, len(ids))
+	for i, id := range ids {
+		result[i] = objects[id]
+	}
+	if len(failures) != 0 {
+		return result, newBulkError(fmt.Sprintf("fetching %d object(s)", len(started)), failures)
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) GetVMs(ids []VMID, concurrency uint, retries ...RetryStrategy) ([]VM, error) {
+	return getVMs(o, ids, concurrency, retries)
+}
+
+func (m *mockClient) GetVMs(ids []VMID, concurrency uint, retries ...RetryStrategy) ([]VM, error) {
+	return getVMs(m, ids, concurrency, retries)
+}
+
+func (o *oVirtClient) GetDisks(ids []DiskID, concurrency uint, retries ...RetryStrategy) ([]Disk, error) {
+	return getDisks(o, ids, concurrency, retries)
+}
+
+func (m *mockClient) GetDisks(ids []DiskID, concurrency uint, retries ...RetryStrategy) ([]Disk, error) {
+	return getDisks(m, ids, concurrency, retries)
+}
+
+func (o *oVirtClient) GetHosts(ids []HostID, concurrency uint, retries ...RetryStrategy) ([]Host, error) {
+	return getHosts(o, ids, concurrency, retries)
+}
+
+func (m *mockClient) GetHosts(ids []HostID, concurrency uint, retries ...RetryStrategy) ([]Host, error) {
+	return getHosts(m, ids, concurrency, retries)
+}
+
+func getVMs(client Client, ids []VMID, concurrency uint, retries []RetryStrategy) ([]VM, error) {
+	stringIDs := make([]string, len(ids))
+	for i, id := range ids {
+		stringIDs[i] = string(id)
+	}
+	objects, err := ResolveIDs(stringIDs, concurrency, func(id string) (interface{}, error) {
+		return client.GetVM(VMID(id), retries...)
+	})
+	if objects == nil {
+		return nil, err
+	}
+	result := make([]VM, len(objects))
+	for i, object := range objects {
+		if object != nil {
+			result[i] = object.(VM)
+		}
+	}
+	return result, err
+}
+
+func getDisks(client Client, ids []DiskID, concurrency uint, retries []RetryStrategy) ([]Disk, error) {
+	stringIDs := make([]string, len(ids))
+	for i, id := range ids {
+		stringIDs[i] = string(id)
+	}
+	objects, err := ResolveIDs(stringIDs, concurrency, func(id string) (interface{}, error) {
+		return client.GetDisk(DiskID(id), retries...)
+	})
+	if objects == nil {
+		return nil, err
+	}
+	result := make([]Disk, len(objects))
+	for i, object := range objects {
+		if object != nil {
+			result[i] = object.(Disk)
+		}
+	}
+	return result, err
+}
+
+func getHosts(client Client, ids []HostID, concurrency uint, retries []RetryStrategy) ([]Host, error) {
+	stringIDs := make([]string, len(ids))
+	for i, id := range ids {
+		stringIDs[i] = string(id)
+	}
+	objects, err := ResolveIDs(stringIDs, concurrency, func(id string) (interface{}, error) {
+		return client.GetHost(HostID(id), retries...)
+	})
+	if objects == nil {
+		return nil, err
+	}
+	result := make([]Host, len(objects))
+	for i, object := range objects {
+		if object != nil {
+			result[i] = object.(Host)
+		}
+	}
+	return result, err
+}
@@ -0,0 +1,88 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestResolveIDsBoundsConcurrency(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	running := 0
+	maxRunning := 0
+	calls := map[string]int{}
+	ids := []string{"a", "b", "c", "d", "e", "a"}
+
+	objects, err := ovirtclient.ResolveIDs(ids, 2, func(id string) (interface{}, error) {
+		lock.Lock()
+		running++
+		calls[id]++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		lock.Lock()
+		running--
+		lock.Unlock()
+		return "object-" + id, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to resolve IDs (%v)", err)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("More than 2 fetches ran at the same time (%d).", maxRunning)
+	}
+	if calls["a"] != 1 {
+		t.Fatalf("Duplicate ID fetched %d times.", calls["a"])
+	}
+	for i, id := range ids {
+		if objects[i] != "object-"+id {
+			t.Fatalf("Incorrect object at index %d (expected: object-%s, got: %v)", i, id, objects[i])
+		}
+	}
+}
+
+func TestResolveIDsReportsPartialErrors(t *testing.T) {
+	t.Parallel()
+	objects, err := ovirtclient.ResolveIDs([]string{"a", "b"}, 2, func(id string) (interface{}, error) {
+		if id == "b" {
+			return nil, ovirtclient.WrapError(errors.New("not found"), ovirtclient.ENotFound, "object %s not found", id)
+		}
+		return id, nil
+	})
+	var bulkErr ovirtclient.BulkError
+	if !errors.As(err, &bulkErr) || !bulkErr.HasCode(ovirtclient.ENotFound) {
+		t.Fatalf("The returned error is not a BulkError with the ENotFound code (%v)", err)
+	}
+	if _, ok := bulkErr.Failures()["b"]; !ok || len(bulkErr.Failures()) != 1 {
+		t.Fatalf("Incorrect failures in bulk error: %v", bulkErr.Failures())
+	}
+	if objects[0] != "a" || objects[1] != nil {
+		t.Fatalf("Incorrect objects returned with a partial error: %v", objects)
+	}
+}
+
+func TestGetVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	missingID := ovirtclient.VMID(helper.GenerateRandomID(10))
+
+	vms, err := helper.GetClient().GetVMs([]ovirtclient.VMID{vm.ID(), missingID}, 2)
+	var bulkErr ovirtclient.BulkError
+	if !errors.As(err, &bulkErr) || !bulkErr.HasCode(ovirtclient.ENotFound) {
+		t.Fatalf("The returned error is not a BulkError with the ENotFound code (%v)", err)
+	}
+	if _, ok := bulkErr.Failures()[string(missingID)]; !ok {
+		t.Fatalf("The missing VM is not reported in the bulk error: %v", bulkErr.Failures())
+	}
+	if len(vms) != 2 || vms[0] == nil || vms[0].ID() != vm.ID() || vms[1] != nil {
+		t.Fatalf("Incorrect VMs returned: %v", vms)
+	}
+}
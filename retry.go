@@ -11,16 +11,96 @@ import (
 	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
 )
 
+// Retry calls the function in what until it succeeds, using the same backoff and error classification as the client
+// uses for its own API calls. This is useful for composite operations built from multiple client calls, which would
+// otherwise have to reimplement the retry logic.
+//
+// - ctx is an optional context. If it is passed, the loop is aborted when the context is canceled.
+// - action is the action being performed in the "ing" form, for example "resizing VM disks". It is used for logging
+// and error messages.
+// - logger is an optional logger that receives the retry messages.
+// - what is the function that should be called repeatedly. Errors returned from the client are classified as usual,
+// other errors are treated as permanent unless they are wrapped using WrapError with a retryable code.
+// - retries are optional retry strategies. Any missing capability (waiting, timeout, error classification) is filled
+// in with the same defaults the client uses for read calls.
+//
+// The loop itself does not count against the rate limit of a client, only the client calls made in what do.
+func Retry(
+	ctx context.Context,
+	action string,
+	logger Logger,
+	what func() error,
+	retries ...RetryStrategy,
+) error {
+	if action == "" {
+		return newError(EBadArgument, "the action for retry must not be empty")
+	}
+	if what == nil {
+		return newError(EBadArgument, "the function to retry must not be nil")
+	}
+	timeouts := []RetryStrategy{
+		MaxTries(3),
+		CallTimeout(time.Minute),
+		Timeout(5 * time.Minute),
+	}
+	if ctx != nil {
+		timeouts = []RetryStrategy{
+			MaxTries(10),
+			ContextStrategy(ctx),
+		}
+		// The context must be honored even if the caller passed their own timeouts.
+		for _, r := range retries {
+			if r.CanTimeout() {
+				retries = append(retries, ContextStrategy(ctx))
+				break
+			}
+		}
+	}
+	return retry(action, reading("", "").viaClient(), logger, defaultRetries(retries, timeouts), what)
+}
+
 // retry is a function that will automatically retry calling the function specified in the what parameter until the
 // timeouts specified in the howLong parameter are reached. It attempts to identify permanent failures and abort if
 // they are encountered.
 //
 // - action is the action that is being performed in the "ing" form, for example "creating disk".
+// - target tells if the call may change something and which resource it works on, see reading and mutating.
 // - what is the function that should be called repeatedly.
 // - logger is an optional logger that can be passed to log retry actions.
 // - howLong is the retry configuration that should be used.
+//
+// If howLong contains a CorrelationIDStrategy, the correlation ID is added to the log messages and the returned error.
+// defaultRetries adds a generated one, so the ID the requests send is the same as the one reported.
 func retry(
 	action string,
+	target operationTarget,
+	logger ovirtclientlog.Logger,
+	howLong []RetryStrategy,
+	what func() error,
+) error {
+	if logger == nil {
+		logger = &noopLogger{}
+	}
+	correlationID := findCorrelationID(howLong)
+	if _, ok := logger.(StructuredLogger); ok {
+		logger = withLogFields(logger, operationLogFields(action, target, correlationID))
+	} else if correlationID != "" {
+		logger = &correlatedLogger{
+			backend:       logger,
+			correlationID: correlationID,
+		}
+	}
+	audit := startAudit(action, target, correlationID, logger, howLong)
+	finishOperation := startOperation(action, target, correlationID, howLong)
+	err := correlateError(retryCall(action, target, logger, howLong, what), correlationID)
+	finishOperation(err)
+	audit.finish(err)
+	return err
+}
+
+func retryCall(
+	action string,
+	target operationTarget,
 	logger ovirtclientlog.Logger,
 	howLong []RetryStrategy,
 	what func() error,
@@ -30,25 +110,37 @@ func retry(
 		retries[i] = factory.Get()
 	}
 
-	if logger == nil {
-		logger = &noopLogger{}
-	}
 	logger.Infof("%s%s...", strings.ToUpper(action[:1]), action[1:])
-	for {
+	failures := 0
+	for attempt := 1; ; attempt++ {
+		attemptLogger := withLogFields(logger, LogFields{LogFieldAttempt: attempt})
+		if err := waitForRateLimit(target, howLong); err != nil {
+			return wrap(err, ETimeout, "timeout while waiting for the rate limit before %s", action)
+		}
 		err := what()
+		if err != nil {
+			err = identifyCustomError(howLong, err)
+		}
 		if err == nil {
-			logger.Infof("Completed %s.", action)
+			attemptLogger.Infof("Completed %s.", action)
+			if failures > 0 {
+				warn(attemptLogger, howLong, WRetried, "completed %s after %d failed attempt(s)", action, failures)
+			}
 			return nil
 		}
+		if !isWaitingError(err) {
+			failures++
+		}
+		attemptLogger = withLogFields(attemptLogger, errorLogFields(err))
 		for _, r := range retries {
 			if err := r.Continue(err, action); err != nil {
-				logger.Infof("Giving up %s (%v)", action, err)
+				attemptLogger.Infof("Giving up %s (%v)", action, err)
 				return err
 			}
 		}
 
-		if !recoverFailure(action, retries, err, logger) {
-			logRetry(action, logger, err)
+		if !recoverFailure(action, retries, err, attemptLogger) {
+			logRetry(action, attemptLogger, err)
 		}
 		// Here we create a select statement with a dynamic number of cases. We use this because a) select{} only
 		// supports fixed cases and b) the channel types are different. Context returns a <-chan struct{}, while
@@ -68,7 +160,7 @@ func retry(
 			}
 		}
 		if len(chans) == 0 {
-			logger.Errorf(
+			attemptLogger.Errorf(
 				"No retry strategies with waiting function specified for %s.",
 				action,
 			)
@@ -76,7 +168,7 @@ func retry(
 		}
 		chosen, _, _ := reflect.Select(chans)
 		if err := retries[chosen].OnWaitExpired(err, action); err != nil {
-			logger.Infof("Giving up %s (%v)", action, err)
+			attemptLogger.Infof("Giving up %s (%v)", action, err)
 			return err
 		}
 	}
@@ -109,15 +201,18 @@ func recoverFailure(action string, retries []RetryInstance, err error, logger ov
 	return false
 }
 
-func logRetry(action string, logger ovirtclientlog.Logger, err error) {
+// isWaitingError returns true if the error indicates that the retry loop is waiting for something to happen, such as a
+// resource to unlock, rather than an actual failure.
+func isWaitingError(err error) bool {
 	var e EngineError
-	isPending := false
-	isConflict := false
-	if errors.As(err, &e) {
-		isPending = e.HasCode(EPending)
-		isConflict = e.HasCode(EConflict) || e.HasCode(EDiskLocked) || e.HasCode(EVMLocked)
+	if !errors.As(err, &e) {
+		return false
 	}
-	if isPending || isConflict {
+	return e.HasCode(EPending) || e.HasCode(EConflict) || e.HasCode(EDiskLocked) || e.HasCode(EVMLocked)
+}
+
+func logRetry(action string, logger ovirtclientlog.Logger, err error) {
+	if isWaitingError(err) {
 		logger.Debugf("Still %s, retrying... (%s)", action, err.Error())
 	} else {
 		logger.Debugf("Failed %s, retrying... (%s)", action, err.Error())
@@ -188,6 +283,50 @@ func (r retryStrategyContainer) CanTimeout() bool {
 	return r.canTimeout
 }
 
+// callOption is embedded in the strategies that only carry a setting to the call they are passed to, such as
+// CorrelationID or AllowHostedEngine. These strategies are looked up by type and do not take part in the retry
+// decisions.
+type callOption struct{}
+
+func (c callOption) Get() RetryInstance {
+	return callOptionInstance{}
+}
+
+func (c callOption) CanClassifyErrors() bool {
+	return false
+}
+
+func (c callOption) CanWait() bool {
+	return false
+}
+
+func (c callOption) CanTimeout() bool {
+	return false
+}
+
+func (c callOption) CanRecover() bool {
+	return false
+}
+
+// callOptionInstance is the no-op RetryInstance of callOption.
+type callOptionInstance struct{}
+
+func (c callOptionInstance) Continue(_ error, _ string) error {
+	return nil
+}
+
+func (c callOptionInstance) Recover(err error) error {
+	return err
+}
+
+func (c callOptionInstance) Wait(_ error) interface{} {
+	return nil
+}
+
+func (c callOptionInstance) OnWaitExpired(_ error, _ string) error {
+	return nil
+}
+
 // RetryInstance is an instance created by the RetryStrategy for a single use. It may have internal state
 // and should not be reused.
 type RetryInstance interface {
@@ -467,21 +606,30 @@ func (t *timeoutStrategy) OnWaitExpired(_ error, _ string) error {
 	return nil
 }
 
-// ReconnectStrategy triggers the client to reconnect if an EInvalidGrant error is encountered.
+// ReconnectStrategy triggers the client to reconnect if an EInvalidGrant error is encountered. This happens when the
+// SSO token expires, for example in long-running controllers. The client adds this strategy to every call, even if
+// custom timeouts are passed.
 func ReconnectStrategy(client Client) RetryStrategy {
-	return &retryStrategyContainer{
-		func() RetryInstance {
-			return &reconnectStrategy{
-				client: client,
-			}
+	return &reconnectRetryStrategy{
+		retryStrategyContainer{
+			func() RetryInstance {
+				return &reconnectStrategy{
+					client: client,
+				}
+			},
+			false,
+			false,
+			false,
+			true,
 		},
-		false,
-		false,
-		false,
-		true,
 	}
 }
 
+// reconnectRetryStrategy is a separate type for the ReconnectStrategy so that defaultRetries can recognize it.
+type reconnectRetryStrategy struct {
+	retryStrategyContainer
+}
+
 type reconnectStrategy struct {
 	client Client
 }
@@ -509,6 +657,8 @@ func defaultRetries(retries []RetryStrategy, timeout []RetryStrategy) []RetryStr
 	foundWait := false
 	foundTimeout := false
 	foundClassifier := false
+	foundRecovery := false
+	foundCorrelationID := findCorrelationID(retries) != ""
 	for _, r := range retries {
 		if r.CanWait() {
 			foundWait = true
@@ -519,69 +669,116 @@ func defaultRetries(retries []RetryStrategy, timeout []RetryStrategy) []RetryStr
 		if r.CanClassifyErrors() {
 			foundClassifier = true
 		}
+		if r.CanRecover() {
+			foundRecovery = true
+		}
 	}
 	if !foundWait {
 		retries = append(retries, ExponentialBackoff(2))
 	}
 	if !foundTimeout {
 		retries = append(retries, timeout...)
+	} else {
+		// The client-level correlation ID, error identifiers, audit sink and reconnect handling are part of the
+		// defaults, but they should apply even if the caller passed their own timeouts.
+		for _, r := range timeout {
+			if _, ok := r.(CorrelationIDStrategy); ok && !foundCorrelationID {
+				retries = append(retries, r)
+			}
+			if _, ok := r.(*reconnectRetryStrategy); ok && !foundRecovery {
+				retries = append(retries, r)
+			}
+			switch r.(type) {
+			case *errorIdentifierStrategy, *rateLimitStrategy, *auditStrategy, *operationHookStrategy:
+				// Composite operations pass their already defaulted retries on to the calls they make, so the
+				// strategies may already be present.
+				if !containsStrategyType(retries, r) {
+					retries = append(retries, r)
+				}
+			}
+		}
 	}
 	if !foundClassifier {
 		retries = append(retries, AutoRetry())
 	}
-	return retries
+	// The ID is generated here rather than per request, so all attempts of the call and the calls a composite
+	// operation makes send the same ID, which also ends up in the logs, the error and the audit record.
+	return withGeneratedCorrelationID(retries)
+}
+
+// containsStrategyType returns true if retries contains a strategy of the same type as strategy.
+func containsStrategyType(retries []RetryStrategy, strategy RetryStrategy) bool {
+	for _, r := range retries {
+		if reflect.TypeOf(r) == reflect.TypeOf(strategy) {
+			return true
+		}
+	}
+	return false
+}
+
+// withClientStrategies adds the client-level settings that are carried in the retry strategies, such as the
+// correlation ID, the error identifiers, the rate limit, the audit sink and the operation hook, to the default retry
+// strategies.
+func withClientStrategies(client Client, retries []RetryStrategy) []RetryStrategy {
+	return withClientOperationHook(
+		client,
+		withClientAuditSink(
+			client,
+			withClientRateLimit(client, withClientErrorIdentifiers(client, withClientCorrelationID(client, retries))),
+		),
+	)
 }
 
 // defaultReadTimeouts returns a list of retry strategies suitable for read calls. There are view retries and
 // individual calls with retries shouldn't last longer than a minute, otherwise something went wrong.
 func defaultReadTimeouts(client Client) []RetryStrategy {
 	if ctx := client.GetContext(); ctx != nil {
-		return []RetryStrategy{
+		return withClientStrategies(client, []RetryStrategy{
 			MaxTries(10),
 			ContextStrategy(ctx),
 			ReconnectStrategy(client),
-		}
+		})
 	}
-	return []RetryStrategy{
+	return withClientStrategies(client, []RetryStrategy{
 		MaxTries(3),
 		CallTimeout(time.Minute),
 		Timeout(5 * time.Minute),
 		ReconnectStrategy(client),
-	}
+	})
 }
 
 // defaultWriteTimeouts has slightly higher tolerances for write API calls, as they may need longer waiting
 // times.
 func defaultWriteTimeouts(client Client) []RetryStrategy {
 	if ctx := client.GetContext(); ctx != nil {
-		return []RetryStrategy{
+		return withClientStrategies(client, []RetryStrategy{
 			MaxTries(10),
 			ContextStrategy(ctx),
 			ReconnectStrategy(client),
-		}
+		})
 	}
-	return []RetryStrategy{
+	return withClientStrategies(client, []RetryStrategy{
 		MaxTries(10),
 		CallTimeout(5 * time.Minute),
 		Timeout(10 * time.Minute),
 		ReconnectStrategy(client),
-	}
+	})
 }
 
 // defaultLongTimeouts contains a strategy to wait for calls that typically take longer, for example waiting for a
 // disk to become ready.
 func defaultLongTimeouts(client Client) []RetryStrategy {
 	if ctx := client.GetContext(); ctx != nil {
-		return []RetryStrategy{
+		return withClientStrategies(client, []RetryStrategy{
 			MaxTries(10),
 			ContextStrategy(ctx),
 			ReconnectStrategy(client),
-		}
+		})
 	}
-	return []RetryStrategy{
+	return withClientStrategies(client, []RetryStrategy{
 		MaxTries(30),
 		CallTimeout(15 * time.Minute),
 		Timeout(30 * time.Minute),
 		ReconnectStrategy(client),
-	}
+	})
 }
@@ -4,7 +4,9 @@ package ovirtclient //nolint:testpackage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -24,6 +26,7 @@ func TestTimeoutStrategy(t *testing.T) {
 	startTime := time.Now()
 	err := retry(
 		"test",
+		reading("", ""),
 		nil,
 		[]RetryStrategy{
 			ExponentialBackoff(1),
@@ -62,6 +65,7 @@ func TestContextStrategy(t *testing.T) {
 		startTime = time.Now()
 		err = retry(
 			"test",
+			reading("", ""),
 			nil,
 			[]RetryStrategy{
 				ExponentialBackoff(1),
@@ -93,3 +97,192 @@ func TestContextStrategy(t *testing.T) {
 		t.Fatalf("retry didn't run for enough time")
 	}
 }
+
+func TestRetryReportsWarningAfterFailures(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	warnings := CollectWarnings()
+	err := retry(
+		"test",
+		reading("", ""),
+		nil,
+		[]RetryStrategy{
+			ExponentialBackoff(1),
+			MaxTries(3),
+			warnings,
+		},
+		func() error {
+			tries++
+			if tries < 2 {
+				return newError(EConnection, "test failure")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("retry returned an error (%v)", err)
+	}
+	collected := warnings.Warnings()
+	if len(collected) != 1 {
+		t.Fatalf("incorrect number of warnings (expected: 1, got: %d)", len(collected))
+	}
+	if collected[0].Code() != WRetried {
+		t.Fatalf("incorrect warning code (expected: %s, got: %s)", WRetried, collected[0].Code())
+	}
+}
+
+func TestRetryAddsCorrelationIDToError(t *testing.T) {
+	t.Parallel()
+
+	err := retry(
+		"test",
+		reading("", ""),
+		nil,
+		defaultRetries(
+			[]RetryStrategy{
+				CorrelationID("test-correlation-id"),
+			},
+			[]RetryStrategy{
+				MaxTries(1),
+			},
+		),
+		func() error {
+			return newError(ENotFound, "test failure")
+		},
+	)
+	if err == nil {
+		t.Fatalf("retry on a failing call did not return with an error")
+	}
+	var correlatedErr CorrelatedError
+	if !errors.As(err, &correlatedErr) {
+		t.Fatalf("the returned error is not a correlated error (%v)", err)
+	}
+	if id := correlatedErr.CorrelationID(); id != "test-correlation-id" {
+		t.Fatalf("incorrect correlation ID on error (expected: %s, got: %s)", "test-correlation-id", id)
+	}
+	if !HasErrorCode(err, ENotFound) {
+		t.Fatalf("the correlated error lost its error code (%v)", err)
+	}
+	if !strings.Contains(err.Error(), "test-correlation-id") {
+		t.Fatalf("the error message does not contain the correlation ID (%s)", err.Error())
+	}
+}
+
+func TestRetryUsesCustomErrorIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	identifiers := newErrorIdentifiers()
+	remove := identifiers.add(func(err error) EngineError {
+		if strings.Contains(err.Error(), "Kontingent überschritten") {
+			return WrapError(err, EQuotaExceeded, "the quota has been exceeded")
+		}
+		return nil
+	})
+	calls := 0
+	call := func() error {
+		calls++
+		return fmt.Errorf("Kontingent überschritten")
+	}
+	retries := defaultRetries(
+		[]RetryStrategy{
+			&errorIdentifierStrategy{identifiers: identifiers},
+		},
+		[]RetryStrategy{
+			MaxTries(3),
+		},
+	)
+
+	err := retry("test", reading("", ""), nil, retries, call)
+	if !HasErrorCode(err, EQuotaExceeded) {
+		t.Fatalf("the custom error identifier was not used (%v)", err)
+	}
+	if calls != 1 {
+		t.Fatalf("a non-retryable custom error was retried (calls: %d)", calls)
+	}
+
+	remove()
+	err = retry("test", reading("", ""), nil, retries, call)
+	if HasErrorCode(err, EQuotaExceeded) {
+		t.Fatalf("the custom error identifier was used after it was removed (%v)", err)
+	}
+}
+
+func TestRetryIsUsableForCompositeOperations(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	err := Retry(
+		context.Background(),
+		"running composite operation",
+		nil,
+		func() error {
+			calls++
+			if calls < 2 {
+				return newError(EPending, "not ready yet")
+			}
+			return nil
+		},
+		ExponentialBackoff(1),
+	)
+	if err != nil {
+		t.Fatalf("retry of a composite operation failed (%v)", err)
+	}
+	if calls != 2 {
+		t.Fatalf("incorrect number of calls (expected: 2, got: %d)", calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = Retry(
+		ctx,
+		"running canceled operation",
+		nil,
+		func() error {
+			return newError(EPending, "not ready yet")
+		},
+		Timeout(time.Minute),
+	)
+	if !HasErrorCode(err, ETimeout) {
+		t.Fatalf("retry did not honor the canceled context (%v)", err)
+	}
+}
+
+type reconnectCountingClient struct {
+	Client
+
+	reconnects int
+}
+
+func (r *reconnectCountingClient) Reconnect() error {
+	r.reconnects++
+	return nil
+}
+
+func TestRetryReconnectsOnExpiredTokenWithCustomTimeouts(t *testing.T) {
+	t.Parallel()
+
+	client := &reconnectCountingClient{Client: NewMock()}
+	calls := 0
+	call := func() error {
+		calls++
+		if calls == 1 {
+			return newError(EInvalidGrant, "the access token is no longer valid")
+		}
+		return nil
+	}
+	retries := defaultRetries(
+		[]RetryStrategy{
+			MaxTries(3),
+			ExponentialBackoff(1),
+		},
+		defaultReadTimeouts(client),
+	)
+
+	if err := retry("test", reading("", ""), nil, retries, call); err != nil {
+		t.Fatalf("the call failed after an expired token (%v)", err)
+	}
+	if client.reconnects != 1 {
+		t.Fatalf("the client did not reconnect exactly once (reconnects: %d)", client.reconnects)
+	}
+}
@@ -0,0 +1,130 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+// SchedulingPolicyID is the identifier of a scheduling policy.
+type SchedulingPolicyID string
+
+// SchedulingPolicyClient lists the methods for working with scheduling policies. A scheduling policy decides which
+// host a VM is started on and when VMs are migrated between the hosts of a cluster. The policy of a cluster can be
+// changed using UpdateCluster.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#sect-Scheduling_Policies for details.
+type SchedulingPolicyClient interface {
+	// ListSchedulingPolicies returns all scheduling policies in the oVirt Engine, including the built-in ones.
+	ListSchedulingPolicies(retries ...RetryStrategy) ([]SchedulingPolicy, error)
+	// GetSchedulingPolicy returns a single scheduling policy by its ID.
+	GetSchedulingPolicy(id SchedulingPolicyID, retries ...RetryStrategy) (SchedulingPolicy, error)
+}
+
+// SchedulingPolicyData is the data segment of the SchedulingPolicy type.
+type SchedulingPolicyData interface {
+	// ID returns the unique identifier of the scheduling policy.
+	ID() SchedulingPolicyID
+	// Name returns the name of the scheduling policy, for example evenly_distributed.
+	Name() string
+	// Description returns the user-readable description of the scheduling policy.
+	Description() string
+	// Default returns true if new clusters use this policy unless told otherwise.
+	Default() bool
+	// Locked returns true for the built-in policies, which cannot be changed.
+	Locked() bool
+	// Properties returns the properties the policy accepts along with their default values. For example,
+	// evenly_distributed accepts HighUtilization, the CPU load in percent above which VMs are migrated away from
+	// a host. The values for a specific cluster can be set using UpdateCluster.
+	Properties() map[string]string
+}
+
+// SchedulingPolicy is a policy that decides the placement of VMs on the hosts of a cluster.
+type SchedulingPolicy interface {
+	SchedulingPolicyData
+}
+
+func convertSDKSchedulingPolicy(object *ovirtsdk.SchedulingPolicy, client Client) (SchedulingPolicy, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("scheduling policy", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("scheduling policy", "name")
+	}
+	description, _ := object.Description()
+	defaultPolicy, _ := object.DefaultPolicy()
+	locked, _ := object.Locked()
+	properties := map[string]string{}
+	if sdkProperties, ok := object.Properties(); ok {
+		properties = convertSDKProperties(sdkProperties)
+	}
+	return &schedulingPolicy{
+		client:        client,
+		id:            SchedulingPolicyID(id),
+		name:          name,
+		description:   description,
+		defaultPolicy: defaultPolicy,
+		locked:        locked,
+		properties:    properties,
+	}, nil
+}
+
+// convertSDKProperties converts a list of name-value pairs into a map.
+func convertSDKProperties(sdkProperties *ovirtsdk.PropertySlice) map[string]string {
+	result := map[string]string{}
+	for _, property := range sdkProperties.Slice() {
+		name, ok := property.Name()
+		if !ok {
+			continue
+		}
+		value, _ := property.Value()
+		result[name] = value
+	}
+	return result
+}
+
+// buildSDKProperties converts a map into a list of name-value pairs for the SDK.
+func buildSDKProperties(properties map[string]string) *ovirtsdk.PropertySlice {
+	result := &ovirtsdk.PropertySlice{}
+	for name, value := range properties {
+		result.SetSlice(append(result.Slice(), ovirtsdk.NewPropertyBuilder().Name(name).Value(value).MustBuild()))
+	}
+	return result
+}
+
+type schedulingPolicy struct {
+	client Client
+
+	id            SchedulingPolicyID
+	name          string
+	description   string
+	defaultPolicy bool
+	locked        bool
+	properties    map[string]string
+}
+
+func (s schedulingPolicy) ID() SchedulingPolicyID {
+	return s.id
+}
+
+func (s schedulingPolicy) Name() string {
+	return s.name
+}
+
+func (s schedulingPolicy) Description() string {
+	return s.description
+}
+
+func (s schedulingPolicy) Default() bool {
+	return s.defaultPolicy
+}
+
+func (s schedulingPolicy) Locked() bool {
+	return s.locked
+}
+
+func (s schedulingPolicy) Properties() map[string]string {
+	result := make(map[string]string, len(s.properties))
+	for name, value := range s.properties {
+		result[name] = value
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package ovirtclient //nolint:dupl
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetSchedulingPolicy(
+	id SchedulingPolicyID,
+	retries ...RetryStrategy,
+) (result SchedulingPolicy, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting scheduling policy %s", id),
+		reading("", string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				SchedulingPoliciesService().
+				PolicyService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Policy()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no scheduling policy returned when getting scheduling policy ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKSchedulingPolicy(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert scheduling policy %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetSchedulingPolicy(id SchedulingPolicyID, _ ...RetryStrategy) (SchedulingPolicy, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.schedulingPolicies[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "scheduling policy with ID %s not found", id)
+}
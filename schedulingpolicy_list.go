@@ -0,0 +1,47 @@
+package ovirtclient //nolint:dupl
+
+func (o *oVirtClient) ListSchedulingPolicies(retries ...RetryStrategy) (result []SchedulingPolicy, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []SchedulingPolicy{}
+	err = retry(
+		"listing scheduling policies",
+		reading("", ""),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				SchedulingPoliciesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Policies()
+			if !ok {
+				return nil
+			}
+			result = make([]SchedulingPolicy, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKSchedulingPolicy(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert scheduling policy during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListSchedulingPolicies(_ ...RetryStrategy) ([]SchedulingPolicy, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]SchedulingPolicy, len(m.schedulingPolicies))
+	i := 0
+	for _, item := range m.schedulingPolicies {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
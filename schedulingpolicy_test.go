@@ -0,0 +1,80 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListSchedulingPolicies(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	policies, err := client.ListSchedulingPolicies()
+	if err != nil {
+		t.Fatalf("Failed to list scheduling policies (%v)", err)
+	}
+	policy := findSchedulingPolicy(t, policies, "evenly_distributed")
+	fetchedPolicy, err := client.GetSchedulingPolicy(policy.ID())
+	if err != nil {
+		t.Fatalf("Failed to get scheduling policy %s (%v)", policy.ID(), err)
+	}
+	if fetchedPolicy.Name() != policy.Name() {
+		t.Fatalf("Incorrect scheduling policy name: %s instead of %s", fetchedPolicy.Name(), policy.Name())
+	}
+	if _, ok := fetchedPolicy.Properties()["HighUtilization"]; !ok {
+		t.Fatalf("The HighUtilization property is missing from scheduling policy %s.", policy.Name())
+	}
+}
+
+func TestUpdateClusterSchedulingPolicy(t *testing.T) {
+	t.Parallel()
+	// Changing the scheduling policy affects every VM in the cluster, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+	clusterID := clusters[0].ID()
+	policies, err := client.ListSchedulingPolicies()
+	if err != nil {
+		t.Fatalf("Failed to list scheduling policies (%v)", err)
+	}
+	policy := findSchedulingPolicy(t, policies, "evenly_distributed")
+
+	cluster, err := client.UpdateCluster(
+		clusterID,
+		ovirtclient.UpdateClusterParams().
+			MustWithSchedulingPolicyID(policy.ID()).
+			MustWithSchedulingPolicyProperties(map[string]string{"HighUtilization": "70"}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to set the scheduling policy of cluster %s (%v)", clusterID, err)
+	}
+	if cluster.SchedulingPolicyID() != policy.ID() {
+		t.Fatalf("Incorrect scheduling policy after update: %s instead of %s", cluster.SchedulingPolicyID(), policy.ID())
+	}
+	if value := cluster.SchedulingPolicyProperties()["HighUtilization"]; value != "70" {
+		t.Fatalf("Incorrect HighUtilization value after update: %s instead of 70", value)
+	}
+
+	if _, err := client.UpdateCluster(
+		clusterID,
+		ovirtclient.UpdateClusterParams().MustWithSchedulingPolicyProperties(map[string]string{"NoSuchProperty": "1"}),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an unknown scheduling policy property did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func findSchedulingPolicy(
+	t *testing.T,
+	policies []ovirtclient.SchedulingPolicy,
+	name string,
+) ovirtclient.SchedulingPolicy {
+	for _, policy := range policies {
+		if policy.Name() == name {
+			return policy
+		}
+	}
+	t.Fatalf("Scheduling policy %s not found.", name)
+	return nil
+}
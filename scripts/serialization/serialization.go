@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// serializationParams is the data structure passed to the code generation template.
+type serializationParams struct {
+	// IDs is the list of string-based ID types that should be serializable without validation.
+	IDs []string
+	// Enums is the list of string-based enum types that should be validated against their XValues() function when
+	// deserializing.
+	Enums []string
+}
+
+const fileTemplate = `// Code generated automatically using go:generate. DO NOT EDIT.
+
+package ovirtclient
+
+import (
+	"database/sql/driver"
+)
+{{ range .IDs }}
+// MarshalText implements encoding.TextMarshaler.
+func (i {{ . }}) MarshalText() ([]byte, error) {
+	return []byte(i), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *{{ . }}) UnmarshalText(text []byte) error {
+	*i = {{ . }}(text)
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (i {{ . }}) Value() (driver.Value, error) {
+	return string(i), nil
+}
+
+// Scan implements sql.Scanner.
+func (i *{{ . }}) Scan(src interface{}) error {
+	value, err := scanString("{{ . }}", src)
+	if err != nil {
+		return err
+	}
+	*i = {{ . }}(value)
+	return nil
+}
+{{ end }}{{ range .Enums }}
+// MarshalText implements encoding.TextMarshaler.
+func (e {{ . }}) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It returns an EBadArgument error if the value is not one of
+// {{ . }}Values().
+func (e *{{ . }}) UnmarshalText(text []byte) error {
+	value := {{ . }}(text)
+	for _, v := range {{ . }}Values() {
+		if v == value {
+			*e = value
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid value for {{ . }}: %s", value)
+}
+
+// Value implements driver.Valuer.
+func (e {{ . }}) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Scan implements sql.Scanner. NULL values are converted to an empty {{ . }}.
+func (e *{{ . }}) Scan(src interface{}) error {
+	if src == nil {
+		*e = ""
+		return nil
+	}
+	value, err := scanString("{{ . }}", src)
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(value))
+}
+{{ end }}`
+
+func main() {
+	ids := ""
+	enums := ""
+	output := ""
+	flag.StringVar(&ids, "i", "", "Comma-separated list of ID types to generate serialization functions for.")
+	flag.StringVar(&enums, "e", "", "Comma-separated list of enum types to generate serialization functions for.")
+	flag.StringVar(&output, "o", "", "Output file. Required.")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(
+			os.Stderr,
+			"Usage: go run serialization.go OPTIONS\n\n"+
+				"This file generates text and SQL serialization functions for ID and enum types.\n",
+		)
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	flag.Parse()
+	if output == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "The -o parameter is required.\n\n")
+		flag.Usage()
+	}
+
+	params := serializationParams{
+		IDs:   splitList(ids),
+		Enums: splitList(enums),
+	}
+	tpl := template.Must(template.New("serialization").Parse(fileTemplate))
+	buf := &bytes.Buffer{}
+	if err := tpl.Execute(buf, params); err != nil {
+		log.Fatalf("Failed to render template (%v)", err)
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format generated code (%v)", err)
+	}
+	if err := os.WriteFile(output, formatted, 0600); err != nil {
+		log.Fatalf("Failed to write %s (%v)", output, err)
+	}
+}
+
+func splitList(list string) []string {
+	var result []string
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
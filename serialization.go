@@ -0,0 +1,21 @@
+package ovirtclient
+
+//go:generate go run scripts/serialization/serialization.go -o serialization_generated.go -i AffinityGroupID,BackupID,CheckpointID,ClusterID,CPUProfileID,DatacenterID,DiskAttachmentID,DiskID,DiskProfileID,ErratumID,HostFenceAgentID,HostID,HostNetworkAttachmentID,HostNICID,InstanceTypeID,MigrationPolicyID,NetworkID,NICID,OpenStackImageID,OpenStackImageProviderID,QoSID,SchedulingPolicyID,StorageDomainID,TagID,TemplateDiskAttachmentID,TemplateID,VMGraphicsConsoleID,VMID,VMReportedDeviceID,VMWatchdogID,VNICProfileID -e BackupPhase,CheckpointState,CPUMode,DiskBackup,DiskContentType,DiskInterface,DiskStatus,DiskStorageType,ErratumType,ExternalVMImportStatus,ExternalVMProviderType,FenceType,HostBondLACPRate,HostBondMode,HostBondXmitHashPolicy,HostNetworkBootProtocol,HostStatus,HostUpgradeStatus,ImageFormat,MigrationBandwidthMethod,PowerManagementStatus,QoSType,StorageDomainExternalStatus,StorageDomainStatus,StorageDomainType,TemplateStatus,VMAffinity,VMBIOSType,VMBootDevice,VMReportedDeviceType,VMStatus,VMType,WatchdogAction,WatchdogModel
+
+// The ID and status types in this package implement encoding.TextMarshaler, encoding.TextUnmarshaler, sql.Scanner
+// and driver.Valuer so they can be stored in configuration files and databases directly. Enum types are validated
+// when deserialized, IDs are not. The implementations are in serialization_generated.go.
+
+// scanString converts a value received from a database driver into a string.
+func scanString(typeName string, src interface{}) (string, error) {
+	switch value := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case []byte:
+		return string(value), nil
+	default:
+		return "", newError(EBadArgument, "cannot scan %T into %s", src, typeName)
+	}
+}
@@ -0,0 +1,67 @@
+package ovirtclient_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+type serializationTestConfig struct {
+	VMID   ovirtclient.VMID     `json:"vm_id"`
+	Status ovirtclient.VMStatus `json:"status"`
+}
+
+func TestIDAndStatusJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+	original := serializationTestConfig{
+		VMID:   "e5e5a2cb-2e8e-4d4d-8f0c-ef5f4b4c7f31",
+		Status: ovirtclient.VMStatusUp,
+	}
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Failed to marshal config (%v)", err)
+	}
+	var decoded serializationTestConfig
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal config (%v)", err)
+	}
+	if decoded != original {
+		t.Fatalf("Decoded config does not match the original (%v != %v)", decoded, original)
+	}
+}
+
+func TestStatusUnmarshalRejectsInvalidValue(t *testing.T) {
+	t.Parallel()
+	var decoded serializationTestConfig
+	err := json.Unmarshal([]byte(`{"vm_id":"foo","status":"nonexistent"}`), &decoded)
+	if err == nil {
+		t.Fatalf("Unmarshalling an invalid VM status did not return an error.")
+	}
+}
+
+func TestIDAndStatusSQLRoundTrip(t *testing.T) {
+	t.Parallel()
+	value, err := ovirtclient.DiskStatusOK.Value()
+	if err != nil {
+		t.Fatalf("Failed to convert disk status to a database value (%v)", err)
+	}
+	var status ovirtclient.DiskStatus
+	if err := status.Scan([]byte(value.(string))); err != nil {
+		t.Fatalf("Failed to scan disk status (%v)", err)
+	}
+	if status != ovirtclient.DiskStatusOK {
+		t.Fatalf("Scanned disk status is %s instead of %s.", status, ovirtclient.DiskStatusOK)
+	}
+
+	var diskID ovirtclient.DiskID
+	if err := diskID.Scan(nil); err != nil {
+		t.Fatalf("Failed to scan NULL disk ID (%v)", err)
+	}
+	if diskID != "" {
+		t.Fatalf("Scanning NULL resulted in a non-empty disk ID: %s", diskID)
+	}
+	if err := diskID.Scan(42); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Scanning an integer into a disk ID did not return an EBadArgument error (%v)", err)
+	}
+}
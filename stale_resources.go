@@ -0,0 +1,346 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StaleResourceParameters describes which resources RemoveStaleResources should remove.
+type StaleResourceParameters interface {
+	// NamePrefix returns the prefix the name of a resource must start with to be removed. If empty, the name is not
+	// checked.
+	NamePrefix() string
+	// TagID returns the tag a VM must have to be removed. If nil, the tags are not checked.
+	TagID() *TagID
+	// MaxAge returns the age a resource must exceed to be removed.
+	MaxAge() time.Duration
+	// DiskCreationTime returns the function determining when a disk was created from its alias, since the oVirt
+	// Engine does not report the creation time of disks. The function returns the zero time if the alias contains no
+	// creation time. If nil, floating disks are not removed.
+	DiskCreationTime() func(alias string) time.Time
+}
+
+// BuildableStaleResourceParameters is a buildable version of StaleResourceParameters.
+type BuildableStaleResourceParameters interface {
+	StaleResourceParameters
+
+	// WithNamePrefix sets the prefix the name of a resource must start with to be removed.
+	WithNamePrefix(prefix string) (BuildableStaleResourceParameters, error)
+	// MustWithNamePrefix is identical to WithNamePrefix, but panics instead of returning an error.
+	MustWithNamePrefix(prefix string) BuildableStaleResourceParameters
+
+	// WithTagID sets the tag a VM must have to be removed.
+	WithTagID(tagID TagID) (BuildableStaleResourceParameters, error)
+	// MustWithTagID is identical to WithTagID, but panics instead of returning an error.
+	MustWithTagID(tagID TagID) BuildableStaleResourceParameters
+
+	// WithMaxAge sets the age a resource must exceed to be removed.
+	WithMaxAge(maxAge time.Duration) (BuildableStaleResourceParameters, error)
+	// MustWithMaxAge is identical to WithMaxAge, but panics instead of returning an error.
+	MustWithMaxAge(maxAge time.Duration) BuildableStaleResourceParameters
+
+	// WithDiskCreationTime enables removing floating disks, using the specified function to determine when a disk
+	// was created from its alias.
+	WithDiskCreationTime(creationTime func(alias string) time.Time) (BuildableStaleResourceParameters, error)
+	// MustWithDiskCreationTime is identical to WithDiskCreationTime, but panics instead of returning an error.
+	MustWithDiskCreationTime(creationTime func(alias string) time.Time) BuildableStaleResourceParameters
+}
+
+// NewStaleResourceParams creates a builder for the parameters of RemoveStaleResources.
+func NewStaleResourceParams() BuildableStaleResourceParameters {
+	return &staleResourceParameters{}
+}
+
+type staleResourceParameters struct {
+	namePrefix       string
+	tagID            *TagID
+	maxAge           time.Duration
+	diskCreationTime func(alias string) time.Time
+}
+
+func (s *staleResourceParameters) NamePrefix() string {
+	return s.namePrefix
+}
+
+func (s *staleResourceParameters) TagID() *TagID {
+	return s.tagID
+}
+
+func (s *staleResourceParameters) MaxAge() time.Duration {
+	return s.maxAge
+}
+
+func (s *staleResourceParameters) DiskCreationTime() func(alias string) time.Time {
+	return s.diskCreationTime
+}
+
+func (s *staleResourceParameters) WithNamePrefix(prefix string) (BuildableStaleResourceParameters, error) {
+	if prefix == "" {
+		return s, newError(EBadArgument, "the name prefix must not be empty")
+	}
+	s.namePrefix = prefix
+	return s, nil
+}
+
+func (s *staleResourceParameters) MustWithNamePrefix(prefix string) BuildableStaleResourceParameters {
+	builder, err := s.WithNamePrefix(prefix)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *staleResourceParameters) WithTagID(tagID TagID) (BuildableStaleResourceParameters, error) {
+	if tagID == "" {
+		return s, newError(EBadArgument, "the tag ID must not be empty")
+	}
+	s.tagID = &tagID
+	return s, nil
+}
+
+func (s *staleResourceParameters) MustWithTagID(tagID TagID) BuildableStaleResourceParameters {
+	builder, err := s.WithTagID(tagID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *staleResourceParameters) WithMaxAge(maxAge time.Duration) (BuildableStaleResourceParameters, error) {
+	if maxAge < 0 {
+		return s, newError(EBadArgument, "the maximum age must not be negative")
+	}
+	s.maxAge = maxAge
+	return s, nil
+}
+
+func (s *staleResourceParameters) MustWithMaxAge(maxAge time.Duration) BuildableStaleResourceParameters {
+	builder, err := s.WithMaxAge(maxAge)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *staleResourceParameters) WithDiskCreationTime(
+	creationTime func(alias string) time.Time,
+) (BuildableStaleResourceParameters, error) {
+	if creationTime == nil {
+		return s, newError(EBadArgument, "the disk creation time function must not be nil")
+	}
+	s.diskCreationTime = creationTime
+	return s, nil
+}
+
+func (s *staleResourceParameters) MustWithDiskCreationTime(
+	creationTime func(alias string) time.Time,
+) BuildableStaleResourceParameters {
+	builder, err := s.WithDiskCreationTime(creationTime)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) RemoveStaleResources(
+	params StaleResourceParameters,
+	retries ...RetryStrategy,
+) ([]TeardownResult, error) {
+	return removeStaleResources(o, params, retries)
+}
+
+func (m *mockClient) RemoveStaleResources(
+	params StaleResourceParameters,
+	retries ...RetryStrategy,
+) ([]TeardownResult, error) {
+	return removeStaleResources(m, params, retries)
+}
+
+// removeStaleResources contains the garbage collection logic shared between the live and mock clients. VMs are
+// removed first since templates cannot be removed while VMs still use them. Floating disks are removed last, so the
+// disks of the removed VMs and templates are not counted twice.
+func removeStaleResources(
+	client Client,
+	params StaleResourceParameters,
+	retries []RetryStrategy,
+) ([]TeardownResult, error) {
+	if params == nil || (params.NamePrefix() == "" && params.TagID() == nil) {
+		return nil, newError(
+			EBadArgument,
+			"a name prefix or a tag is required for removing stale resources to avoid removing everything",
+		)
+	}
+	cutoff := time.Now().Add(-params.MaxAge())
+	isStale := func(name string, creationTime time.Time) bool {
+		// Resources without a creation time can't be proven to be old enough, so they are never removed.
+		if creationTime.IsZero() || !creationTime.Before(cutoff) {
+			return false
+		}
+		return strings.HasPrefix(name, params.NamePrefix())
+	}
+
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list VMs for removing stale resources")
+	}
+	var vmIDs []VMID
+	for _, vm := range vms {
+		if !isStale(vm.Name(), vm.CreationTime()) {
+			continue
+		}
+		if params.TagID() != nil {
+			// The VM list doesn't contain the tags on a live engine, so they are fetched separately.
+			tagged, err := vmHasTag(client, vm.ID(), *params.TagID(), retries)
+			if err != nil {
+				return nil, err
+			}
+			if !tagged {
+				continue
+			}
+		}
+		vmIDs = append(vmIDs, vm.ID())
+	}
+	results, err := teardownVMs(client, vmIDs, retries)
+	failures := map[string]error{}
+	if err != nil {
+		for _, result := range results {
+			if result.Err() != nil {
+				failures[fmt.Sprintf("%s %s", result.ResourceType(), result.ID())] = result.Err()
+			}
+		}
+	}
+
+	// Templates can't be tagged through this library, so they are only collected by name.
+	if params.TagID() == nil {
+		templates, err := client.ListTemplates(retries...)
+		if err != nil {
+			return results, wrap(err, EUnidentified, "failed to list templates for removing stale resources")
+		}
+		for _, tpl := range templates {
+			if IsBlankTemplateID(tpl.ID()) || !isStale(tpl.Name(), tpl.CreationTime()) {
+				continue
+			}
+			err := client.RemoveTemplate(tpl.ID(), retries...)
+			if err != nil && HasErrorCode(err, ENotFound) {
+				err = nil
+			}
+			results = append(results, &teardownResult{ResourceTypeTemplate, string(tpl.ID()), err})
+			if err != nil {
+				failures[fmt.Sprintf("%s %s", ResourceTypeTemplate, tpl.ID())] = err
+			}
+		}
+	}
+
+	// Disks can't be tagged either, and their creation time has to be determined by the caller.
+	if params.TagID() == nil && params.DiskCreationTime() != nil {
+		diskResults, err := removeStaleDisks(client, params.DiskCreationTime(), isStale, retries)
+		results = append(results, diskResults...)
+		if err != nil {
+			return results, err
+		}
+		for _, result := range diskResults {
+			if result.Err() != nil {
+				failures[fmt.Sprintf("%s %s", result.ResourceType(), result.ID())] = result.Err()
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return results, newBulkError("removing stale resources", failures)
+	}
+	return results, nil
+}
+
+// removeStaleDisks removes the stale disks that are not attached to a VM or a template.
+func removeStaleDisks(
+	client Client,
+	creationTime func(alias string) time.Time,
+	isStale func(name string, creationTime time.Time) bool,
+	retries []RetryStrategy,
+) ([]TeardownResult, error) {
+	disks, err := client.ListDisks(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list disks for removing stale resources")
+	}
+	var candidates []Disk
+	for _, disk := range disks {
+		if isStale(disk.Alias(), creationTime(disk.Alias())) {
+			candidates = append(candidates, disk)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	attached, err := listAttachedDiskIDs(client, retries)
+	if err != nil {
+		return nil, err
+	}
+	var results []TeardownResult
+	for _, disk := range candidates {
+		if _, ok := attached[disk.ID()]; ok {
+			continue
+		}
+		err := client.RemoveDisk(disk.ID(), retries...)
+		if err != nil && HasErrorCode(err, ENotFound) {
+			err = nil
+		}
+		results = append(results, &teardownResult{ResourceTypeDisk, string(disk.ID()), err})
+	}
+	return results, nil
+}
+
+// listAttachedDiskIDs returns the IDs of all disks attached to a VM or a template. The engine does not tell if a disk
+// is attached when listing disks, so the attachments of every VM and template are listed.
+func listAttachedDiskIDs(client Client, retries []RetryStrategy) (map[DiskID]struct{}, error) {
+	attached := map[DiskID]struct{}{}
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list VMs for removing stale disks")
+	}
+	for _, vm := range vms {
+		attachments, err := client.ListDiskAttachments(vm.ID(), retries...)
+		if err != nil {
+			if HasErrorCode(err, ENotFound) {
+				continue
+			}
+			return nil, wrap(err, EUnidentified, "failed to list disk attachments of VM %s", vm.ID())
+		}
+		for _, attachment := range attachments {
+			attached[attachment.DiskID()] = struct{}{}
+		}
+	}
+	templates, err := client.ListTemplates(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list templates for removing stale disks")
+	}
+	for _, tpl := range templates {
+		attachments, err := client.ListTemplateDiskAttachments(tpl.ID(), retries...)
+		if err != nil {
+			if HasErrorCode(err, ENotFound) {
+				continue
+			}
+			return nil, wrap(err, EUnidentified, "failed to list disk attachments of template %s", tpl.ID())
+		}
+		for _, attachment := range attachments {
+			attached[attachment.DiskID()] = struct{}{}
+		}
+	}
+	return attached, nil
+}
+
+func vmHasTag(client Client, vmID VMID, tagID TagID, retries []RetryStrategy) (bool, error) {
+	tags, err := client.ListVMTags(vmID, retries...)
+	if err != nil {
+		if HasErrorCode(err, ENotFound) {
+			return false, nil
+		}
+		return false, wrap(err, EUnidentified, "failed to list tags of VM %s", vmID)
+	}
+	for _, tag := range tags {
+		if tag.ID() == tagID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
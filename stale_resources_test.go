@@ -0,0 +1,106 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestRemoveStaleResources(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	results, err := client.RemoveStaleResources(
+		ovirtclient.NewStaleResourceParams().MustWithNamePrefix(vm.Name()).MustWithMaxAge(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("Failed to remove stale resources (%v)", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("Resources newer than the maximum age were removed: %d results", len(results))
+	}
+
+	time.Sleep(time.Millisecond)
+	results, err = client.RemoveStaleResources(
+		ovirtclient.NewStaleResourceParams().MustWithNamePrefix(vm.Name()).MustWithMaxAge(0),
+	)
+	if err != nil {
+		t.Fatalf("Failed to remove stale resources (%v)", err)
+	}
+	removed := map[ovirtclient.ResourceType]string{}
+	for _, result := range results {
+		removed[result.ResourceType()] = result.ID()
+	}
+	if removed[ovirtclient.ResourceTypeVM] != string(vm.ID()) ||
+		removed[ovirtclient.ResourceTypeDisk] != string(disk.ID()) {
+		t.Fatalf("The stale VM and its disk were not removed: %v", removed)
+	}
+	if _, err := client.GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The stale VM still exists (%v)", err)
+	}
+}
+
+func TestRemoveStaleResourcesRequiresFilter(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().RemoveStaleResources(ovirtclient.NewStaleResourceParams())
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Removing stale resources without a filter did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func TestRemoveStaleTestResourcesOnlyRemovesGeneratedNames(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	if _, ok := helper.GetClient().(ovirtclient.MockClient); !ok {
+		t.Skipf("Removing all test resources on a live engine interferes with other tests, only running against the mock.")
+	}
+	generated := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	other := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	floatingDisk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithAlias(helper.GenerateTestResourceName(t)),
+	)
+	attachedDisk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithAlias(helper.GenerateTestResourceName(t)),
+	)
+	assertCanAttachDisk(t, other, attachedDisk)
+	otherDisk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithAlias(fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5))),
+	)
+
+	time.Sleep(time.Millisecond)
+	if _, err := helper.RemoveStaleTestResources(0); err != nil {
+		t.Fatalf("Failed to remove stale test resources (%v)", err)
+	}
+	if _, err := helper.GetClient().GetVM(generated.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The stale test VM %s still exists (%v)", generated.ID(), err)
+	}
+	if _, err := helper.GetClient().GetVM(other.ID()); err != nil {
+		t.Fatalf("VM %s not named by the test helper was removed (%v)", other.ID(), err)
+	}
+	if _, err := helper.GetClient().GetDisk(floatingDisk.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The stale floating test disk %s still exists (%v)", floatingDisk.ID(), err)
+	}
+	if _, err := helper.GetClient().GetDisk(attachedDisk.ID()); err != nil {
+		t.Fatalf("Test disk %s attached to VM %s was removed (%v)", attachedDisk.ID(), other.ID(), err)
+	}
+	if _, err := helper.GetClient().GetDisk(otherDisk.ID()); err != nil {
+		t.Fatalf("Disk %s not named by the test helper was removed (%v)", otherDisk.ID(), err)
+	}
+}
@@ -1,8 +1,10 @@
 package ovirtclient
 
 import (
+	"context"
 	"strings"
 	"sync"
+	"time"
 
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
@@ -16,11 +18,71 @@ type StorageDomainClient interface {
 	ListStorageDomains(retries ...RetryStrategy) (StorageDomainList, error)
 	// GetStorageDomain returns a single storage domain, or an error if the storage domain could not be found.
 	GetStorageDomain(id StorageDomainID, retries ...RetryStrategy) (StorageDomain, error)
+	// GetStorageDomainByName returns a storage domain by its name. An ENotFound error is returned if no storage domain
+	// has the name, and an EMultipleResults error if more than one does.
+	GetStorageDomainByName(name string, retries ...RetryStrategy) (StorageDomain, error)
 	// GetDiskFromStorageDomain returns a single disk from a specific storage domain, or an error if no disk can be found.
 	GetDiskFromStorageDomain(id StorageDomainID, diskID DiskID, retries ...RetryStrategy) (result Disk, err error)
 	// RemoveDiskFromStorageDomain removes a disk from a specific storage domain, but leaves the disk on other storage
 	// domains if any. If the disk is not present on any more storage domains, the entire disk will be removed.
 	RemoveDiskFromStorageDomain(id StorageDomainID, diskID DiskID, retries ...RetryStrategy) error
+	// WaitForStorageDomainCapacity waits until the used or available space of a storage domain crosses the
+	// threshold, for example to resume provisioning once space has been freed up.
+	WaitForStorageDomainCapacity(
+		id StorageDomainID,
+		threshold StorageDomainCapacityThreshold,
+		retries ...RetryStrategy,
+	) (StorageDomain, error)
+	// WatchStorageDomainCapacity checks the space of a storage domain every interval and calls the callback with
+	// the storage domain when the threshold is crossed in either direction, including the first check. It returns
+	// when the context is cancelled, or with an error if fetching the storage domain fails.
+	WatchStorageDomainCapacity(
+		ctx context.Context,
+		id StorageDomainID,
+		threshold StorageDomainCapacityThreshold,
+		interval time.Duration,
+		callback func(storageDomain StorageDomain, crossed bool),
+		retries ...RetryStrategy,
+	) error
+	// ImportStorageDomain imports an existing data storage domain, for example one replicated from another engine,
+	// using the specified host to connect to the storage. The storage domain is attached to the datacenter in the
+	// parameters, if any. Its VMs, templates and disks can be registered afterwards, see ListUnregisteredVMs.
+	//
+	// If the import succeeds but attaching the storage domain fails, the imported storage domain is returned along
+	// with the error. The storage domain stays unattached and does not need to be imported again.
+	ImportStorageDomain(
+		name string,
+		hostID HostID,
+		connection StorageDomainConnection,
+		params OptionalImportStorageDomainParameters,
+		retries ...RetryStrategy,
+	) (StorageDomain, error)
+	// ListUnregisteredVMs lists the VMs in the OVF store of a storage domain that are not registered in the engine,
+	// typically after importing the storage domain during a disaster recovery failover.
+	ListUnregisteredVMs(storageDomainID StorageDomainID, retries ...RetryStrategy) ([]UnregisteredVM, error)
+	// ListUnregisteredTemplates lists the templates in the OVF store of a storage domain that are not registered in
+	// the engine.
+	ListUnregisteredTemplates(storageDomainID StorageDomainID, retries ...RetryStrategy) ([]UnregisteredTemplate, error)
+	// ListUnregisteredDisks lists the disks on a storage domain that are not registered in the engine.
+	ListUnregisteredDisks(storageDomainID StorageDomainID, retries ...RetryStrategy) ([]UnregisteredDisk, error)
+	// RegisterVM registers an unregistered VM of a storage domain in the engine, keeping its ID. The parameters
+	// map the cluster and VNIC profiles of the original engine to the resources of this engine. The disks of the VM
+	// are registered along with it.
+	RegisterVM(
+		storageDomainID StorageDomainID,
+		vmID VMID,
+		params RegistrationParameters,
+		retries ...RetryStrategy,
+	) (VM, error)
+	// RegisterTemplate registers an unregistered template of a storage domain in the engine, keeping its ID.
+	RegisterTemplate(
+		storageDomainID StorageDomainID,
+		templateID TemplateID,
+		params RegistrationParameters,
+		retries ...RetryStrategy,
+	) (Template, error)
+	// RegisterDisk registers a floating unregistered disk of a storage domain in the engine, keeping its ID.
+	RegisterDisk(storageDomainID StorageDomainID, diskID DiskID, retries ...RetryStrategy) (Disk, error)
 }
 
 // StorageDomainData is the core of StorageDomain, providing only data access functions.
@@ -31,6 +93,8 @@ type StorageDomainData interface {
 	Name() string
 	// Available returns the number of available bytes on the storage domain
 	Available() uint64
+	// Used returns the number of used bytes on the storage domain.
+	Used() uint64
 	// StorageType returns the type of the storage domain
 	StorageType() StorageDomainType
 	// Status returns the status of the storage domain. This status may be unknown if the storage domain is external.
@@ -259,6 +323,11 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 	if available < 0 {
 		return nil, newError(EBug, "invalid available bytes returned from storage domain: %d", available)
 	}
+	// Like the available space, the used space is not reported for some statuses, in which case it is 0.
+	used, _ := sdkStorageDomain.Used()
+	if used < 0 {
+		return nil, newError(EBug, "invalid used bytes returned from storage domain: %d", used)
+	}
 	storage, ok := sdkStorageDomain.Storage()
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch hostStorage of storage domain")
@@ -281,6 +350,7 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 		id:             StorageDomainID(id),
 		name:           name,
 		available:      uint64(available),
+		used:           uint64(used),
 		storageType:    StorageDomainType(storageType),
 		status:         StorageDomainStatus(status),
 		externalStatus: StorageDomainExternalStatus(externalStatus),
@@ -293,6 +363,7 @@ type storageDomain struct {
 	id             StorageDomainID
 	name           string
 	available      uint64
+	used           uint64
 	storageType    StorageDomainType
 	status         StorageDomainStatus
 	externalStatus StorageDomainExternalStatus
@@ -310,6 +381,10 @@ func (s storageDomain) Available() uint64 {
 	return s.available
 }
 
+func (s storageDomain) Used() uint64 {
+	return s.used
+}
+
 func (s storageDomain) StorageType() StorageDomainType {
 	return s.storageType
 }
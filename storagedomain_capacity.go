@@ -0,0 +1,201 @@
+package ovirtclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StorageDomainCapacityThreshold describes a limit on the space of a storage domain for
+// WaitForStorageDomainCapacity and WatchStorageDomainCapacity.
+type StorageDomainCapacityThreshold interface {
+	// Crossed returns true if the storage domain is past the threshold.
+	Crossed(storageDomain StorageDomainData) bool
+	// String returns a human-readable description of the threshold for log and error messages.
+	String() string
+}
+
+// StorageDomainAvailableBelow returns a threshold that is crossed when the available space of the storage domain
+// drops below the specified number of bytes.
+func StorageDomainAvailableBelow(bytes uint64) StorageDomainCapacityThreshold {
+	return &storageDomainAvailableThreshold{bytes: bytes, below: true}
+}
+
+// StorageDomainAvailableAbove returns a threshold that is crossed when the available space of the storage domain
+// reaches at least the specified number of bytes.
+func StorageDomainAvailableAbove(bytes uint64) StorageDomainCapacityThreshold {
+	return &storageDomainAvailableThreshold{bytes: bytes, below: false}
+}
+
+// StorageDomainUsedPercentAbove returns a threshold that is crossed when the used space reaches the specified
+// percentage of the total space of the storage domain. The percentage must be between 1 and 100.
+func StorageDomainUsedPercentAbove(percent uint) (StorageDomainCapacityThreshold, error) {
+	if percent == 0 || percent > 100 {
+		return nil, newError(EBadArgument, "the used percentage must be between 1 and 100, %d given", percent)
+	}
+	return &storageDomainUsedPercentThreshold{percent: percent}, nil
+}
+
+// MustStorageDomainUsedPercentAbove is identical to StorageDomainUsedPercentAbove, but panics instead of returning
+// an error.
+func MustStorageDomainUsedPercentAbove(percent uint) StorageDomainCapacityThreshold {
+	threshold, err := StorageDomainUsedPercentAbove(percent)
+	if err != nil {
+		panic(err)
+	}
+	return threshold
+}
+
+type storageDomainAvailableThreshold struct {
+	bytes uint64
+	below bool
+}
+
+func (s *storageDomainAvailableThreshold) Crossed(storageDomain StorageDomainData) bool {
+	if s.below {
+		return storageDomain.Available() < s.bytes
+	}
+	return storageDomain.Available() >= s.bytes
+}
+
+func (s *storageDomainAvailableThreshold) String() string {
+	if s.below {
+		return fmt.Sprintf("less than %d bytes available", s.bytes)
+	}
+	return fmt.Sprintf("at least %d bytes available", s.bytes)
+}
+
+type storageDomainUsedPercentThreshold struct {
+	percent uint
+}
+
+func (s *storageDomainUsedPercentThreshold) Crossed(storageDomain StorageDomainData) bool {
+	total := storageDomain.Used() + storageDomain.Available()
+	if total == 0 {
+		// Storage domains that do not report their space, for example because they are not attached, are never
+		// considered full.
+		return false
+	}
+	return storageDomain.Used()*100 >= uint64(s.percent)*total
+}
+
+func (s *storageDomainUsedPercentThreshold) String() string {
+	return fmt.Sprintf("at least %d%% used", s.percent)
+}
+
+func (o *oVirtClient) WaitForStorageDomainCapacity(
+	id StorageDomainID,
+	threshold StorageDomainCapacityThreshold,
+	retries ...RetryStrategy,
+) (StorageDomain, error) {
+	return waitForStorageDomainCapacity(o, o.logger, id, threshold, defaultRetries(retries, defaultLongTimeouts(o)))
+}
+
+func (m *mockClient) WaitForStorageDomainCapacity(
+	id StorageDomainID,
+	threshold StorageDomainCapacityThreshold,
+	retries ...RetryStrategy,
+) (StorageDomain, error) {
+	return waitForStorageDomainCapacity(m, m.logger, id, threshold, defaultRetries(retries, defaultLongTimeouts(m)))
+}
+
+func (o *oVirtClient) WatchStorageDomainCapacity(
+	ctx context.Context,
+	id StorageDomainID,
+	threshold StorageDomainCapacityThreshold,
+	interval time.Duration,
+	callback func(storageDomain StorageDomain, crossed bool),
+	retries ...RetryStrategy,
+) error {
+	return watchStorageDomainCapacity(ctx, o, id, threshold, interval, callback, retries)
+}
+
+func (m *mockClient) WatchStorageDomainCapacity(
+	ctx context.Context,
+	id StorageDomainID,
+	threshold StorageDomainCapacityThreshold,
+	interval time.Duration,
+	callback func(storageDomain StorageDomain, crossed bool),
+	retries ...RetryStrategy,
+) error {
+	return watchStorageDomainCapacity(ctx, m, id, threshold, interval, callback, retries)
+}
+
+// waitForStorageDomainCapacity contains the logic shared between the live and mock clients.
+func waitForStorageDomainCapacity(
+	client Client,
+	logger Logger,
+	id StorageDomainID,
+	threshold StorageDomainCapacityThreshold,
+	retries []RetryStrategy,
+) (result StorageDomain, err error) {
+	if threshold == nil {
+		return nil, newError(EBadArgument, "the capacity threshold must be specified")
+	}
+	err = retry(
+		fmt.Sprintf("waiting for storage domain %s to reach %s", id, threshold),
+		reading(ResourceTypeStorageDomain, string(id)),
+		logger,
+		retries,
+		func() error {
+			result, err = client.GetStorageDomain(id, retries...)
+			if err != nil {
+				return err
+			}
+			if !threshold.Crossed(result) {
+				return newError(
+					EPending,
+					"storage domain %s has %d bytes used and %d bytes available",
+					id,
+					result.Used(),
+					result.Available(),
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+// watchStorageDomainCapacity contains the logic shared between the live and mock clients.
+func watchStorageDomainCapacity(
+	ctx context.Context,
+	client Client,
+	id StorageDomainID,
+	threshold StorageDomainCapacityThreshold,
+	interval time.Duration,
+	callback func(storageDomain StorageDomain, crossed bool),
+	retries []RetryStrategy,
+) error {
+	if threshold == nil {
+		return newError(EBadArgument, "the capacity threshold must be specified")
+	}
+	if callback == nil {
+		return newError(EBadArgument, "the callback must be specified")
+	}
+	if interval <= 0 {
+		return newError(EBadArgument, "the interval must be positive")
+	}
+	client = client.WithContext(ctx)
+	var lastCrossed *bool
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		storageDomain, err := client.GetStorageDomain(id, retries...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		crossed := threshold.Crossed(storageDomain)
+		if lastCrossed == nil || *lastCrossed != crossed {
+			callback(storageDomain, crossed)
+			lastCrossed = &crossed
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestWatchStorageDomainCapacity(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("Failed to list storage domains (%v)", err)
+	}
+	storageDomain := storageDomains[0]
+	diskSize := uint64(1024 * 1024 * 1024)
+	threshold := ovirtclient.StorageDomainAvailableBelow(storageDomain.Available() - diskSize/2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	events := make(chan bool, 2)
+	done := make(chan error, 1)
+	go func() {
+		done <- client.WatchStorageDomainCapacity(
+			ctx,
+			storageDomain.ID(),
+			threshold,
+			10*time.Millisecond,
+			func(_ ovirtclient.StorageDomain, crossed bool) {
+				events <- crossed
+			},
+		)
+	}()
+	if crossed := <-events; crossed {
+		t.Fatalf("Threshold reported as crossed before creating a disk.")
+	}
+
+	if _, err := client.CreateDisk(storageDomain.ID(), ovirtclient.ImageFormatRaw, diskSize, nil); err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+	select {
+	case crossed := <-events:
+		if !crossed {
+			t.Fatalf("Threshold not reported as crossed after creating a disk.")
+		}
+	case <-ctx.Done():
+		t.Fatalf("Timeout while waiting for the threshold to be crossed.")
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Watching the storage domain capacity failed (%v)", err)
+	}
+
+	sd, err := client.WaitForStorageDomainCapacity(storageDomain.ID(), threshold)
+	if err != nil {
+		t.Fatalf("Failed to wait for the storage domain capacity (%v)", err)
+	}
+	if sd.Used() < diskSize {
+		t.Fatalf("Incorrect used space on storage domain %s: %d bytes", sd.ID(), sd.Used())
+	}
+}
+
+func TestInvalidStorageDomainUsedPercent(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.StorageDomainUsedPercentAbove(101)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a threshold above 100%% did not fail with EBadArgument (%v)", err)
+	}
+}
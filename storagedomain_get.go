@@ -10,10 +10,17 @@ func (o *oVirtClient) GetStorageDomain(id StorageDomainID, retries ...RetryStrat
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting storage domain %s", id),
+		reading(ResourceTypeStorageDomain, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().StorageDomainsService().StorageDomainService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -43,7 +50,7 @@ func (m *mockClient) GetStorageDomain(id StorageDomainID, _ ...RetryStrategy) (S
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.storageDomains[id]; ok {
-		return item, nil
+		return m.storageDomainSnapshot(item), nil
 	}
 	return nil, newError(ENotFound, "storage domain with ID %s not found", id)
 }
@@ -0,0 +1,62 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetStorageDomainByName(name string, retries ...RetryStrategy) (result StorageDomain, err error) {
+	quotedName, err := quoteSearchString(name)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting storage domain by name %s", name),
+		reading(ResourceTypeStorageDomain, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				List().
+				Search("name="+quotedName).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			var results []StorageDomain
+			for _, sdkObject := range response.MustStorageDomains().Slice() {
+				// The search may return other storage domains too, so we check the name again.
+				if sdkName, ok := sdkObject.Name(); ok && sdkName == name {
+					item, err := convertSDKStorageDomain(sdkObject, o)
+					if err != nil {
+						return wrap(err, EBug, "failed to convert storage domain %s", name)
+					}
+					results = append(results, item)
+				}
+			}
+			if err := checkNameMatches(len(results), "storage domain", name); err != nil {
+				return err
+			}
+			result = results[0]
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetStorageDomainByName(name string, _ ...RetryStrategy) (StorageDomain, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var results []StorageDomain
+	for _, item := range m.storageDomains {
+		if item.name == name {
+			results = append(results, m.storageDomainSnapshot(item))
+		}
+	}
+	if err := checkNameMatches(len(results), "storage domain", name); err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
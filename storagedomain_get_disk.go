@@ -8,11 +8,17 @@ func (o *oVirtClient) GetDiskFromStorageDomain(id StorageDomainID, diskID DiskID
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting disk %s from storage domain %s", diskID, id),
+		reading(ResourceTypeDisk, string(diskID)),
 		o.logger,
 		retries,
 		func() error {
 			response, err := o.conn.SystemService().StorageDomainsService().
-				StorageDomainService(string(id)).DisksService().DiskService(string(diskID)).Get().Send()
+				StorageDomainService(string(id)).
+				DisksService().
+				DiskService(string(diskID)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,393 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// StorageDomainConnection describes how the hosts reach the storage of an existing storage domain. Use
+// NewNFSStorageDomainConnection, NewISCSIStorageDomainConnection or NewFCPStorageDomainConnection to create one.
+type StorageDomainConnection interface {
+	// StorageType returns the type of the storage. It is one of StorageDomainTypeNFS, StorageDomainTypeISCSI or
+	// StorageDomainTypeFCP.
+	StorageType() StorageDomainType
+	// Address returns the address of the NFS server or the iSCSI portal. It is empty for FC.
+	Address() string
+	// Path returns the exported path on the NFS server. It is empty for block storage.
+	Path() string
+	// Port returns the port of the iSCSI portal. It is 0 for other storage types.
+	Port() uint
+	// Target returns the IQN of the iSCSI target. It is empty for other storage types.
+	Target() string
+	// LogicalUnitIDs returns the IDs of the LUNs the storage domain resides on. It is empty for NFS.
+	LogicalUnitIDs() []string
+}
+
+// NewNFSStorageDomainConnection creates a connection to a storage domain exported by an NFS server.
+func NewNFSStorageDomainConnection(address string, path string) (StorageDomainConnection, error) {
+	if address == "" {
+		return nil, newError(EBadArgument, "the NFS server address must be specified")
+	}
+	if path == "" {
+		return nil, newError(EBadArgument, "the NFS export path must be specified")
+	}
+	return &storageDomainConnection{
+		storageType: StorageDomainTypeNFS,
+		address:     address,
+		path:        path,
+	}, nil
+}
+
+// MustNewNFSStorageDomainConnection is identical to NewNFSStorageDomainConnection, but panics instead of returning an
+// error.
+func MustNewNFSStorageDomainConnection(address string, path string) StorageDomainConnection {
+	connection, err := NewNFSStorageDomainConnection(address, path)
+	if err != nil {
+		panic(err)
+	}
+	return connection
+}
+
+// NewISCSIStorageDomainConnection creates a connection to a storage domain on the specified LUNs of an iSCSI target.
+// The host must be logged in to the target first, see HostClient.LoginISCSITarget.
+func NewISCSIStorageDomainConnection(
+	address string,
+	port uint,
+	target string,
+	logicalUnitIDs []string,
+) (StorageDomainConnection, error) {
+	if address == "" {
+		return nil, newError(EBadArgument, "the iSCSI portal address must be specified")
+	}
+	if port == 0 || port > 65535 {
+		return nil, newError(EBadArgument, "invalid iSCSI port: %d", port)
+	}
+	if target == "" {
+		return nil, newError(EBadArgument, "the iSCSI target must be specified")
+	}
+	if err := validateStorageDomainLogicalUnitIDs(logicalUnitIDs); err != nil {
+		return nil, err
+	}
+	return &storageDomainConnection{
+		storageType:    StorageDomainTypeISCSI,
+		address:        address,
+		port:           port,
+		target:         target,
+		logicalUnitIDs: logicalUnitIDs,
+	}, nil
+}
+
+// MustNewISCSIStorageDomainConnection is identical to NewISCSIStorageDomainConnection, but panics instead of
+// returning an error.
+func MustNewISCSIStorageDomainConnection(
+	address string,
+	port uint,
+	target string,
+	logicalUnitIDs []string,
+) StorageDomainConnection {
+	connection, err := NewISCSIStorageDomainConnection(address, port, target, logicalUnitIDs)
+	if err != nil {
+		panic(err)
+	}
+	return connection
+}
+
+// NewFCPStorageDomainConnection creates a connection to a storage domain on the specified Fibre Channel LUNs.
+func NewFCPStorageDomainConnection(logicalUnitIDs []string) (StorageDomainConnection, error) {
+	if err := validateStorageDomainLogicalUnitIDs(logicalUnitIDs); err != nil {
+		return nil, err
+	}
+	return &storageDomainConnection{
+		storageType:    StorageDomainTypeFCP,
+		logicalUnitIDs: logicalUnitIDs,
+	}, nil
+}
+
+// MustNewFCPStorageDomainConnection is identical to NewFCPStorageDomainConnection, but panics instead of returning an
+// error.
+func MustNewFCPStorageDomainConnection(logicalUnitIDs []string) StorageDomainConnection {
+	connection, err := NewFCPStorageDomainConnection(logicalUnitIDs)
+	if err != nil {
+		panic(err)
+	}
+	return connection
+}
+
+func validateStorageDomainLogicalUnitIDs(logicalUnitIDs []string) error {
+	if len(logicalUnitIDs) == 0 {
+		return newError(EBadArgument, "at least one LUN ID must be specified")
+	}
+	for _, id := range logicalUnitIDs {
+		if id == "" {
+			return newError(EBadArgument, "LUN IDs must not be empty")
+		}
+	}
+	return nil
+}
+
+type storageDomainConnection struct {
+	storageType    StorageDomainType
+	address        string
+	path           string
+	port           uint
+	target         string
+	logicalUnitIDs []string
+}
+
+func (s *storageDomainConnection) StorageType() StorageDomainType {
+	return s.storageType
+}
+
+func (s *storageDomainConnection) Address() string {
+	return s.address
+}
+
+func (s *storageDomainConnection) Path() string {
+	return s.path
+}
+
+func (s *storageDomainConnection) Port() uint {
+	return s.port
+}
+
+func (s *storageDomainConnection) Target() string {
+	return s.target
+}
+
+func (s *storageDomainConnection) LogicalUnitIDs() []string {
+	return s.logicalUnitIDs
+}
+
+// OptionalImportStorageDomainParameters are the optional parameters of ImportStorageDomain.
+type OptionalImportStorageDomainParameters interface {
+	// DatacenterID returns the datacenter to attach the imported storage domain to. If nil, the storage domain is
+	// left unattached.
+	DatacenterID() *DatacenterID
+}
+
+// BuildableImportStorageDomainParameters is a buildable version of OptionalImportStorageDomainParameters.
+type BuildableImportStorageDomainParameters interface {
+	OptionalImportStorageDomainParameters
+
+	// WithDatacenterID sets the datacenter to attach the imported storage domain to.
+	WithDatacenterID(datacenterID DatacenterID) (BuildableImportStorageDomainParameters, error)
+	// MustWithDatacenterID is identical to WithDatacenterID, but panics instead of returning an error.
+	MustWithDatacenterID(datacenterID DatacenterID) BuildableImportStorageDomainParameters
+}
+
+// ImportStorageDomainParams creates a buildable set of optional parameters for ImportStorageDomain.
+func ImportStorageDomainParams() BuildableImportStorageDomainParameters {
+	return &importStorageDomainParams{}
+}
+
+type importStorageDomainParams struct {
+	datacenterID *DatacenterID
+}
+
+func (i *importStorageDomainParams) DatacenterID() *DatacenterID {
+	return i.datacenterID
+}
+
+func (i *importStorageDomainParams) WithDatacenterID(
+	datacenterID DatacenterID,
+) (BuildableImportStorageDomainParameters, error) {
+	if datacenterID == "" {
+		return nil, newError(EBadArgument, "the datacenter ID must not be empty")
+	}
+	i.datacenterID = &datacenterID
+	return i, nil
+}
+
+func (i *importStorageDomainParams) MustWithDatacenterID(
+	datacenterID DatacenterID,
+) BuildableImportStorageDomainParameters {
+	builder, err := i.WithDatacenterID(datacenterID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateImportStorageDomainParameters(name string, hostID HostID, connection StorageDomainConnection) error {
+	if name == "" {
+		return newError(EBadArgument, "the name of the imported storage domain must be specified")
+	}
+	if hostID == "" {
+		return newError(EBadArgument, "the host used to connect to the storage must be specified")
+	}
+	if connection == nil {
+		return newError(EBadArgument, "the storage connection must be specified")
+	}
+	return nil
+}
+
+func convertStorageDomainConnection(connection StorageDomainConnection) *ovirtsdk4.HostStorageBuilder {
+	builder := ovirtsdk4.NewHostStorageBuilder().Type(ovirtsdk4.StorageType(connection.StorageType()))
+	switch connection.StorageType() {
+	case StorageDomainTypeNFS:
+		builder.Address(connection.Address()).Path(connection.Path())
+	default:
+		for _, id := range connection.LogicalUnitIDs() {
+			lun := ovirtsdk4.NewLogicalUnitBuilder().Id(id)
+			if connection.StorageType() == StorageDomainTypeISCSI {
+				lun.Address(connection.Address()).Port(int64(connection.Port())).Target(connection.Target())
+			}
+			builder.LogicalUnitsBuilderOfAny(*lun)
+		}
+	}
+	return builder
+}
+
+func (o *oVirtClient) ImportStorageDomain(
+	name string,
+	hostID HostID,
+	connection StorageDomainConnection,
+	params OptionalImportStorageDomainParameters,
+	retries ...RetryStrategy,
+) (result StorageDomain, err error) {
+	if err := validateImportStorageDomainParameters(name, hostID, connection); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &importStorageDomainParams{}
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("importing storage domain %s", name),
+		mutating(ResourceTypeStorageDomain, "").
+			withParameters(map[string]string{
+				"name":    name,
+				"host_id": string(hostID),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			sdkStorageDomain, err := ovirtsdk4.NewStorageDomainBuilder().
+				Name(name).
+				Type(ovirtsdk4.STORAGEDOMAINTYPE_DATA).
+				Import(true).
+				HostBuilder(ovirtsdk4.NewHostBuilder().Id(string(hostID))).
+				StorageBuilder(convertStorageDomainConnection(connection)).
+				Build()
+			if err != nil {
+				return wrap(err, EBug, "failed to build storage domain")
+			}
+			response, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				Add().
+				StorageDomain(sdkStorageDomain).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.StorageDomain()
+			if !ok {
+				return newFieldNotFound("storage domain import response", "storage domain")
+			}
+			result, err = convertSDKStorageDomain(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert storage domain %s", name)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	o.mutationListeners.notify(ResourceTypeStorageDomain, string(result.ID()), "", MutationTypeCreated)
+	if datacenterID := params.DatacenterID(); datacenterID != nil {
+		attached, err := o.attachStorageDomain(result.ID(), *datacenterID, retries)
+		if err != nil {
+			// The import itself succeeded, so the storage domain is returned to keep the caller from importing it again.
+			return result, wrap(
+				err,
+				EUnidentified,
+				"storage domain %s has been imported, but could not be attached to datacenter %s",
+				result.ID(),
+				*datacenterID,
+			)
+		}
+		return attached, nil
+	}
+	return result, nil
+}
+
+// attachStorageDomain attaches the storage domain to the datacenter, which also activates it.
+func (o *oVirtClient) attachStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries []RetryStrategy,
+) (StorageDomain, error) {
+	err := retry(
+		fmt.Sprintf("attaching storage domain %s to datacenter %s", id, datacenterID),
+		mutating(ResourceTypeStorageDomain, string(id)).
+			withParameters(map[string]string{
+				"datacenter_id": string(datacenterID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().DataCentersService().DataCenterService(string(datacenterID)).
+				StorageDomainsService().Add().
+				StorageDomain(ovirtsdk4.NewStorageDomainBuilder().Id(string(id)).MustBuild()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	o.mutationListeners.notify(ResourceTypeStorageDomain, string(id), "", MutationTypeUpdated)
+	return o.GetStorageDomain(id, retries...)
+}
+
+func (m *mockClient) ImportStorageDomain(
+	name string,
+	hostID HostID,
+	connection StorageDomainConnection,
+	params OptionalImportStorageDomainParameters,
+	_ ...RetryStrategy,
+) (StorageDomain, error) {
+	if err := validateImportStorageDomainParameters(name, hostID, connection); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &importStorageDomainParams{}
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if connection.StorageType() == StorageDomainTypeISCSI {
+		if err := m.validateMockISCSIConnection(hostID, connection); err != nil {
+			return nil, err
+		}
+	}
+	datacenterID := params.DatacenterID()
+	if datacenterID != nil {
+		if _, ok := m.dataCenters[*datacenterID]; !ok {
+			return nil, newError(ENotFound, "datacenter with ID %s not found", *datacenterID)
+		}
+	}
+	for _, sd := range m.storageDomains {
+		if sd.name == name {
+			return nil, newError(ENameInUse, "a storage domain with the name %s already exists", name)
+		}
+	}
+	sd := generateTestStorageDomain(name)
+	sd.client = m
+	sd.storageType = connection.StorageType()
+	sd.status = StorageDomainStatusUnattached
+	if datacenterID != nil {
+		sd.status = StorageDomainStatusActive
+	}
+	m.storageDomains[sd.id] = sd
+	m.unregisteredByStorageDomain[sd.id] = newUnregisteredEntities()
+	m.addDefaultDiskProfile(sd)
+	m.mutationListeners.notify(ResourceTypeStorageDomain, string(sd.id), "", MutationTypeCreated)
+	return m.storageDomainSnapshot(sd), nil
+}
@@ -0,0 +1,58 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestImportStorageDomain(t *testing.T) {
+	t.Parallel()
+	// Importing changes the storage domain inventory, so this test only runs against its own mock.
+	client := ovirtclient.NewMock()
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		t.Fatalf("Failed to list datacenters (%v)", err)
+	}
+
+	storageDomain, err := client.ImportStorageDomain(
+		"dr-storage",
+		hosts[0].ID(),
+		ovirtclient.MustNewNFSStorageDomainConnection("nfs.example.com", "/exports/dr"),
+		ovirtclient.ImportStorageDomainParams().MustWithDatacenterID(datacenters[0].ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to import storage domain (%v)", err)
+	}
+	if storageDomain.StorageType() != ovirtclient.StorageDomainTypeNFS {
+		t.Fatalf("Incorrect storage type of imported storage domain: %s", storageDomain.StorageType())
+	}
+	if storageDomain.Status() != ovirtclient.StorageDomainStatusActive {
+		t.Fatalf("Imported storage domain is not active after attaching it (%s)", storageDomain.Status())
+	}
+	if _, err := client.ListUnregisteredVMs(storageDomain.ID()); err != nil {
+		t.Fatalf("Failed to list unregistered VMs on imported storage domain (%v)", err)
+	}
+
+	_, err = client.ImportStorageDomain(
+		"dr-storage",
+		hosts[0].ID(),
+		ovirtclient.MustNewFCPStorageDomainConnection([]string{"360014051b2ca4d1a2f24d1b8e4c6b2d1"}),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENameInUse) {
+		t.Fatalf("Importing a storage domain with a name in use did not fail with ENameInUse (%v)", err)
+	}
+}
+
+func TestNewISCSIStorageDomainConnectionValidation(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.NewISCSIStorageDomainConnection("iscsi.example.com", 3260, "iqn.2022-01.com.example:dr", nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating an iSCSI connection without LUNs did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -7,10 +7,16 @@ func (o *oVirtClient) ListStorageDomains(retries ...RetryStrategy) (result Stora
 	result = []StorageDomain{}
 	err = retry(
 		"listing storage domains",
+		reading(ResourceTypeStorageDomain, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().StorageDomainsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				StorageDomainsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -36,7 +42,7 @@ func (m *mockClient) ListStorageDomains(_ ...RetryStrategy) (StorageDomainList,
 	result := make([]StorageDomain, len(m.storageDomains))
 	i := 0
 	for _, item := range m.storageDomains {
-		result[i] = item
+		result[i] = m.storageDomainSnapshot(item)
 		i++
 	}
 	return result, nil
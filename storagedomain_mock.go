@@ -0,0 +1,69 @@
+package ovirtclient
+
+// The mock keeps the capacity of each storage domain in its available field and calculates the free space from the
+// disks residing on it. This way removing a disk frees up space without any further bookkeeping.
+
+// storageDomainUsage returns the number of bytes used by the disks on the specified storage domain. It must be
+// called with the lock held.
+func (m *mockClient) storageDomainUsage(id StorageDomainID) uint64 {
+	var used uint64
+	for _, disk := range m.disks {
+		for _, diskStorageDomainID := range disk.storageDomainIDs {
+			if diskStorageDomainID == id {
+				used += disk.provisionedSize
+			}
+		}
+	}
+	return used
+}
+
+// storageDomainAvailable returns the number of free bytes on the specified storage domain. It must be called with
+// the lock held.
+func (m *mockClient) storageDomainAvailable(sd *storageDomain) uint64 {
+	used := m.storageDomainUsage(sd.id)
+	if used > sd.available {
+		return 0
+	}
+	return sd.available - used
+}
+
+// storageDomainSnapshot returns a copy of the storage domain with the current free and used space filled in. It must
+// be called with the lock held.
+func (m *mockClient) storageDomainSnapshot(sd *storageDomain) *storageDomain {
+	result := *sd
+	result.available = m.storageDomainAvailable(sd)
+	result.used = m.storageDomainUsage(sd.id)
+	return &result
+}
+
+// ensureStorageAvailable checks if the storage domains have enough free space for the specified number of bytes
+// each. It must be called with the lock held.
+func (m *mockClient) ensureStorageAvailable(needed map[StorageDomainID]uint64) error {
+	for id, size := range needed {
+		sd, ok := m.storageDomains[id]
+		if !ok {
+			return newError(ENotFound, "storage domain with ID %s not found", id)
+		}
+		if available := m.storageDomainAvailable(sd); size > available {
+			return newError(
+				EInsufficientStorage,
+				"not enough free space on storage domain %s (%d bytes requested, %d bytes available)",
+				id,
+				size,
+				available,
+			)
+		}
+	}
+	return nil
+}
+
+// diskStorageNeeds returns the space needed on each storage domain for copying the specified disks.
+func diskStorageNeeds(disks []*diskWithData) map[StorageDomainID]uint64 {
+	needed := map[StorageDomainID]uint64{}
+	for _, disk := range disks {
+		for _, id := range disk.storageDomainIDs {
+			needed[id] += disk.provisionedSize
+		}
+	}
+	return needed
+}
@@ -0,0 +1,54 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestMockStorageDomainAccounting(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("Failed to list storage domains (%v)", err)
+	}
+	storageDomainID := storageDomains[0].ID()
+	capacity := storageDomains[0].Available()
+
+	assertStorageDomainAvailable := func(expected uint64) {
+		t.Helper()
+		sd, err := client.GetStorageDomain(storageDomainID)
+		if err != nil {
+			t.Fatalf("Failed to fetch storage domain %s (%v)", storageDomainID, err)
+		}
+		if sd.Available() != expected {
+			t.Fatalf("Incorrect available space on storage domain (%d instead of %d).", sd.Available(), expected)
+		}
+	}
+
+	_, err = client.CreateDisk(storageDomainID, ovirtclient.ImageFormatRaw, capacity+1, nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EInsufficientStorage) {
+		t.Fatalf("Creating a disk larger than the storage domain did not fail with EInsufficientStorage (%v)", err)
+	}
+
+	disk, err := client.CreateDisk(storageDomainID, ovirtclient.ImageFormatRaw, capacity/2, nil)
+	if err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+	assertStorageDomainAvailable(capacity - capacity/2)
+
+	_, err = client.UpdateDisk(disk.ID(), ovirtclient.UpdateDiskParams().MustWithProvisionedSize(capacity+1))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EInsufficientStorage) {
+		t.Fatalf("Growing a disk beyond the storage domain did not fail with EInsufficientStorage (%v)", err)
+	}
+	if _, err := client.CopyDisk(disk.ID(), storageDomainID, nil); err != nil {
+		t.Fatalf("Failed to copy disk (%v)", err)
+	}
+	assertStorageDomainAvailable(capacity - 2*(capacity/2))
+
+	if err := client.RemoveDisk(disk.ID()); err != nil {
+		t.Fatalf("Failed to remove disk (%v)", err)
+	}
+	assertStorageDomainAvailable(capacity - capacity/2)
+}
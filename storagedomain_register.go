@@ -0,0 +1,1057 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// UnregisteredVM is a VM found in the OVF store of a storage domain that is not registered in the engine. This is
+// typically the case after importing a storage domain from another engine during a disaster recovery failover.
+type UnregisteredVM interface {
+	// ID returns the ID of the VM. The ID is kept when the VM is registered.
+	ID() VMID
+	// Name returns the name of the VM.
+	Name() string
+	// StorageDomainID returns the ID of the storage domain the VM was found on.
+	StorageDomainID() StorageDomainID
+	// Memory returns the memory of the VM in bytes.
+	Memory() int64
+	// Register registers the VM in the engine, see StorageDomainClient.RegisterVM for details.
+	Register(params RegistrationParameters, retries ...RetryStrategy) (VM, error)
+}
+
+// UnregisteredTemplate is a template found in the OVF store of a storage domain that is not registered in the engine.
+type UnregisteredTemplate interface {
+	// ID returns the ID of the template. The ID is kept when the template is registered.
+	ID() TemplateID
+	// Name returns the name of the template.
+	Name() string
+	// StorageDomainID returns the ID of the storage domain the template was found on.
+	StorageDomainID() StorageDomainID
+	// Register registers the template in the engine, see StorageDomainClient.RegisterTemplate for details.
+	Register(params RegistrationParameters, retries ...RetryStrategy) (Template, error)
+}
+
+// UnregisteredDisk is a disk on a storage domain that is not registered in the engine.
+type UnregisteredDisk interface {
+	// ID returns the ID of the disk. The ID is kept when the disk is registered.
+	ID() DiskID
+	// Alias returns the alias of the disk.
+	Alias() string
+	// ProvisionedSize returns the size of the disk as seen by the VM in bytes.
+	ProvisionedSize() uint64
+	// Format returns the image format of the disk.
+	Format() ImageFormat
+	// StorageDomainID returns the ID of the storage domain the disk resides on.
+	StorageDomainID() StorageDomainID
+	// Register registers the disk in the engine, see StorageDomainClient.RegisterDisk for details.
+	Register(retries ...RetryStrategy) (Disk, error)
+}
+
+// RegistrationParameters describes how the VMs and templates of an imported storage domain are mapped to the
+// resources of the engine they are registered in.
+type RegistrationParameters interface {
+	// ClusterID returns the cluster to register the VM or template in if none of the cluster mappings match. If
+	// empty, the cluster mappings must cover the original cluster.
+	ClusterID() ClusterID
+	// ClusterMappings returns the mappings from the clusters of the original engine to the clusters of this engine.
+	ClusterMappings() []RegistrationClusterMapping
+	// VNICProfileMappings returns the mappings from the VNIC profiles of the original engine to the VNIC profiles
+	// of this engine. NICs without a mapping are connected to the VNIC profile with the same name on the network
+	// with the same name, if one exists.
+	VNICProfileMappings() []RegistrationVNICProfileMapping
+	// AllowPartialImport returns true if the VM or template should be registered even if some of its disks or
+	// networks are missing.
+	AllowPartialImport() bool
+	// ReassignBadMACs returns true if the NICs whose MAC address is invalid or already in use should get a new MAC
+	// address. The engine refuses the registration for such NICs otherwise.
+	ReassignBadMACs() bool
+}
+
+// RegistrationClusterMapping maps a cluster of the original engine to a cluster of this engine.
+type RegistrationClusterMapping interface {
+	// SourceClusterName returns the name of the cluster on the original engine.
+	SourceClusterName() string
+	// TargetClusterID returns the cluster to use instead.
+	TargetClusterID() ClusterID
+}
+
+// RegistrationVNICProfileMapping maps a VNIC profile of the original engine to a VNIC profile of this engine.
+type RegistrationVNICProfileMapping interface {
+	// SourceNetworkName returns the name of the network on the original engine.
+	SourceNetworkName() string
+	// SourceVNICProfileName returns the name of the VNIC profile on the original engine.
+	SourceVNICProfileName() string
+	// TargetVNICProfileID returns the VNIC profile to use instead.
+	TargetVNICProfileID() VNICProfileID
+}
+
+// BuildableRegistrationParameters is a buildable version of RegistrationParameters.
+type BuildableRegistrationParameters interface {
+	RegistrationParameters
+
+	// WithClusterID sets the cluster to register the VM or template in if none of the cluster mappings match.
+	WithClusterID(clusterID ClusterID) BuildableRegistrationParameters
+	// WithClusterMapping adds a mapping from a cluster of the original engine to a cluster of this engine.
+	WithClusterMapping(sourceClusterName string, targetClusterID ClusterID) BuildableRegistrationParameters
+	// WithVNICProfileMapping adds a mapping from a VNIC profile of the original engine to a VNIC profile of this
+	// engine.
+	WithVNICProfileMapping(
+		sourceNetworkName string,
+		sourceVNICProfileName string,
+		targetVNICProfileID VNICProfileID,
+	) BuildableRegistrationParameters
+	// WithAllowPartialImport sets whether the VM or template should be registered even if some of its disks or
+	// networks are missing.
+	WithAllowPartialImport(allowPartialImport bool) BuildableRegistrationParameters
+	// WithReassignBadMACs sets whether NICs with an invalid or duplicate MAC address should get a new MAC address.
+	WithReassignBadMACs(reassignBadMACs bool) BuildableRegistrationParameters
+}
+
+// RegistrationParams creates a buildable set of registration parameters for easier use.
+func RegistrationParams() BuildableRegistrationParameters {
+	return &registrationParams{
+		lock: &sync.Mutex{},
+	}
+}
+
+type registrationParams struct {
+	lock *sync.Mutex
+
+	clusterID           ClusterID
+	clusterMappings     []RegistrationClusterMapping
+	vnicProfileMappings []RegistrationVNICProfileMapping
+	allowPartialImport  bool
+	reassignBadMACs     bool
+}
+
+func (r *registrationParams) ClusterID() ClusterID {
+	return r.clusterID
+}
+
+func (r *registrationParams) ClusterMappings() []RegistrationClusterMapping {
+	return r.clusterMappings
+}
+
+func (r *registrationParams) VNICProfileMappings() []RegistrationVNICProfileMapping {
+	return r.vnicProfileMappings
+}
+
+func (r *registrationParams) AllowPartialImport() bool {
+	return r.allowPartialImport
+}
+
+func (r *registrationParams) ReassignBadMACs() bool {
+	return r.reassignBadMACs
+}
+
+func (r *registrationParams) WithClusterID(clusterID ClusterID) BuildableRegistrationParameters {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.clusterID = clusterID
+	return r
+}
+
+func (r *registrationParams) WithClusterMapping(
+	sourceClusterName string,
+	targetClusterID ClusterID,
+) BuildableRegistrationParameters {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.clusterMappings = append(r.clusterMappings, &registrationClusterMapping{
+		sourceClusterName: sourceClusterName,
+		targetClusterID:   targetClusterID,
+	})
+	return r
+}
+
+func (r *registrationParams) WithVNICProfileMapping(
+	sourceNetworkName string,
+	sourceVNICProfileName string,
+	targetVNICProfileID VNICProfileID,
+) BuildableRegistrationParameters {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.vnicProfileMappings = append(r.vnicProfileMappings, &registrationVNICProfileMapping{
+		sourceNetworkName:     sourceNetworkName,
+		sourceVNICProfileName: sourceVNICProfileName,
+		targetVNICProfileID:   targetVNICProfileID,
+	})
+	return r
+}
+
+func (r *registrationParams) WithAllowPartialImport(allowPartialImport bool) BuildableRegistrationParameters {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.allowPartialImport = allowPartialImport
+	return r
+}
+
+func (r *registrationParams) WithReassignBadMACs(reassignBadMACs bool) BuildableRegistrationParameters {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reassignBadMACs = reassignBadMACs
+	return r
+}
+
+type registrationClusterMapping struct {
+	sourceClusterName string
+	targetClusterID   ClusterID
+}
+
+func (r *registrationClusterMapping) SourceClusterName() string {
+	return r.sourceClusterName
+}
+
+func (r *registrationClusterMapping) TargetClusterID() ClusterID {
+	return r.targetClusterID
+}
+
+type registrationVNICProfileMapping struct {
+	sourceNetworkName     string
+	sourceVNICProfileName string
+	targetVNICProfileID   VNICProfileID
+}
+
+func (r *registrationVNICProfileMapping) SourceNetworkName() string {
+	return r.sourceNetworkName
+}
+
+func (r *registrationVNICProfileMapping) SourceVNICProfileName() string {
+	return r.sourceVNICProfileName
+}
+
+func (r *registrationVNICProfileMapping) TargetVNICProfileID() VNICProfileID {
+	return r.targetVNICProfileID
+}
+
+func validateRegistrationParameters(params RegistrationParameters) error {
+	if params == nil {
+		return newError(EBadArgument, "the registration parameters must be specified")
+	}
+	if params.ClusterID() == "" && len(params.ClusterMappings()) == 0 {
+		return newError(EBadArgument, "either a cluster ID or cluster mappings must be specified for the registration")
+	}
+	for _, mapping := range params.ClusterMappings() {
+		if mapping.SourceClusterName() == "" || mapping.TargetClusterID() == "" {
+			return newError(EBadArgument, "cluster mappings must have both a source cluster name and a target cluster ID")
+		}
+	}
+	for _, mapping := range params.VNICProfileMappings() {
+		if mapping.SourceNetworkName() == "" || mapping.SourceVNICProfileName() == "" {
+			return newError(EBadArgument, "VNIC profile mappings must have a source network and VNIC profile name")
+		}
+		if mapping.TargetVNICProfileID() == "" {
+			return newError(EBadArgument, "VNIC profile mappings must have a target VNIC profile ID")
+		}
+	}
+	return nil
+}
+
+func convertRegistrationConfiguration(params RegistrationParameters) (*ovirtsdk4.RegistrationConfiguration, error) {
+	builder := ovirtsdk4.NewRegistrationConfigurationBuilder()
+	for _, mapping := range params.ClusterMappings() {
+		builder.ClusterMappingsBuilderOfAny(*ovirtsdk4.NewRegistrationClusterMappingBuilder().
+			FromBuilder(ovirtsdk4.NewClusterBuilder().Name(mapping.SourceClusterName())).
+			ToBuilder(ovirtsdk4.NewClusterBuilder().Id(string(mapping.TargetClusterID()))))
+	}
+	for _, mapping := range params.VNICProfileMappings() {
+		builder.VnicProfileMappingsBuilderOfAny(*ovirtsdk4.NewRegistrationVnicProfileMappingBuilder().
+			FromBuilder(
+				ovirtsdk4.NewVnicProfileBuilder().
+					Name(mapping.SourceVNICProfileName()).
+					NetworkBuilder(ovirtsdk4.NewNetworkBuilder().Name(mapping.SourceNetworkName())),
+			).
+			ToBuilder(ovirtsdk4.NewVnicProfileBuilder().Id(string(mapping.TargetVNICProfileID()))))
+	}
+	configuration, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build registration configuration")
+	}
+	return configuration, nil
+}
+
+func (o *oVirtClient) ListUnregisteredVMs(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []UnregisteredVM, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []UnregisteredVM{}
+	err = retry(
+		fmt.Sprintf("listing unregistered VMs on storage domain %s", storageDomainID),
+		reading(ResourceTypeStorageDomain, string(storageDomainID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				VmsService().
+				List().
+				Unregistered(true).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Vm()
+			if !ok {
+				return nil
+			}
+			result = make([]UnregisteredVM, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				item := &unregisteredVM{
+					client:          o,
+					storageDomainID: storageDomainID,
+				}
+				id, ok := sdkObject.Id()
+				if !ok {
+					return newFieldNotFound("unregistered VM", "ID")
+				}
+				item.id = VMID(id)
+				item.name, _ = sdkObject.Name()
+				item.memory, _ = sdkObject.Memory()
+				result[i] = item
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) ListUnregisteredTemplates(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []UnregisteredTemplate, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []UnregisteredTemplate{}
+	err = retry(
+		fmt.Sprintf("listing unregistered templates on storage domain %s", storageDomainID),
+		reading(ResourceTypeStorageDomain, string(storageDomainID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				TemplatesService().
+				List().
+				Unregistered(true).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Templates()
+			if !ok {
+				return nil
+			}
+			result = make([]UnregisteredTemplate, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				item := &unregisteredTemplate{
+					client:          o,
+					storageDomainID: storageDomainID,
+				}
+				id, ok := sdkObject.Id()
+				if !ok {
+					return newFieldNotFound("unregistered template", "ID")
+				}
+				item.id = TemplateID(id)
+				item.name, _ = sdkObject.Name()
+				result[i] = item
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) ListUnregisteredDisks(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []UnregisteredDisk, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []UnregisteredDisk{}
+	err = retry(
+		fmt.Sprintf("listing unregistered disks on storage domain %s", storageDomainID),
+		reading(ResourceTypeStorageDomain, string(storageDomainID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				DisksService().
+				List().
+				Unregistered(true).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Disks()
+			if !ok {
+				return nil
+			}
+			result = make([]UnregisteredDisk, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				item := &unregisteredDisk{
+					client:          o,
+					storageDomainID: storageDomainID,
+				}
+				id, ok := sdkObject.Id()
+				if !ok {
+					return newFieldNotFound("unregistered disk", "ID")
+				}
+				item.id = DiskID(id)
+				item.alias, _ = sdkObject.Alias()
+				if provisionedSize, ok := sdkObject.ProvisionedSize(); ok {
+					item.provisionedSize = uint64(provisionedSize)
+				}
+				if format, ok := sdkObject.Format(); ok {
+					item.format = ImageFormat(format)
+				}
+				result[i] = item
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) RegisterVM(
+	storageDomainID StorageDomainID,
+	vmID VMID,
+	params RegistrationParameters,
+	retries ...RetryStrategy,
+) (result VM, err error) {
+	if err := validateRegistrationParameters(params); err != nil {
+		return nil, err
+	}
+	configuration, err := convertRegistrationConfiguration(params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("registering VM %s from storage domain %s", vmID, storageDomainID),
+		mutating(ResourceTypeVM, string(vmID)).
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.SystemService().StorageDomainsService().StorageDomainService(string(storageDomainID)).
+				VmsService().VmService(string(vmID)).Register().
+				RegistrationConfiguration(configuration).
+				AllowPartialImport(params.AllowPartialImport()).
+				ReassignBadMacs(params.ReassignBadMACs())
+			if clusterID := params.ClusterID(); clusterID != "" {
+				req.Cluster(ovirtsdk4.NewClusterBuilder().Id(string(clusterID)).MustBuild())
+			}
+			_, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	result, err = o.GetVM(vmID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch VM %s after registration", vmID)
+	}
+	o.mutationListeners.notify(ResourceTypeVM, string(vmID), "", MutationTypeCreated)
+	return result, nil
+}
+
+func (o *oVirtClient) RegisterTemplate(
+	storageDomainID StorageDomainID,
+	templateID TemplateID,
+	params RegistrationParameters,
+	retries ...RetryStrategy,
+) (result Template, err error) {
+	if err := validateRegistrationParameters(params); err != nil {
+		return nil, err
+	}
+	configuration, err := convertRegistrationConfiguration(params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("registering template %s from storage domain %s", templateID, storageDomainID),
+		mutating(ResourceTypeTemplate, string(templateID)).
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.SystemService().StorageDomainsService().StorageDomainService(string(storageDomainID)).
+				TemplatesService().TemplateService(string(templateID)).Register().
+				RegistrationConfiguration(configuration).
+				AllowPartialImport(params.AllowPartialImport())
+			if clusterID := params.ClusterID(); clusterID != "" {
+				req.Cluster(ovirtsdk4.NewClusterBuilder().Id(string(clusterID)).MustBuild())
+			}
+			_, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	result, err = o.GetTemplate(templateID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch template %s after registration", templateID)
+	}
+	o.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeCreated)
+	return result, nil
+}
+
+func (o *oVirtClient) RegisterDisk(
+	storageDomainID StorageDomainID,
+	diskID DiskID,
+	retries ...RetryStrategy,
+) (result Disk, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("registering disk %s from storage domain %s", diskID, storageDomainID),
+		mutating(ResourceTypeDisk, string(diskID)).
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			// The engine registers disks by adding them to the storage domain with the unregistered flag set.
+			response, err := o.conn.SystemService().StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).DisksService().Add().
+				Disk(ovirtsdk4.NewDiskBuilder().Id(string(diskID)).MustBuild()).
+				Unregistered(true).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkDisk, ok := response.Disk()
+			if !ok {
+				return newFieldNotFound("disk registration response", "disk")
+			}
+			result, err = convertSDKDisk(sdkDisk, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert disk")
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	o.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeCreated)
+	return result, nil
+}
+
+func (m *mockClient) ListUnregisteredVMs(
+	storageDomainID StorageDomainID,
+	_ ...RetryStrategy,
+) ([]UnregisteredVM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entities, err := m.unregisteredEntitiesOf(storageDomainID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]UnregisteredVM, 0, len(entities.vms))
+	for _, item := range entities.vms {
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListUnregisteredTemplates(
+	storageDomainID StorageDomainID,
+	_ ...RetryStrategy,
+) ([]UnregisteredTemplate, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entities, err := m.unregisteredEntitiesOf(storageDomainID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]UnregisteredTemplate, 0, len(entities.templates))
+	for _, item := range entities.templates {
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListUnregisteredDisks(
+	storageDomainID StorageDomainID,
+	_ ...RetryStrategy,
+) ([]UnregisteredDisk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entities, err := m.unregisteredEntitiesOf(storageDomainID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]UnregisteredDisk, 0, len(entities.disks))
+	for _, item := range entities.disks {
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (m *mockClient) RegisterVM(
+	storageDomainID StorageDomainID,
+	vmID VMID,
+	params RegistrationParameters,
+	_ ...RetryStrategy,
+) (VM, error) {
+	if err := validateRegistrationParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entities, err := m.unregisteredEntitiesOf(storageDomainID)
+	if err != nil {
+		return nil, err
+	}
+	source, ok := entities.vms[vmID]
+	if !ok {
+		return nil, newError(ENotFound, "no unregistered VM with ID %s on storage domain %s", vmID, storageDomainID)
+	}
+	if _, ok := m.vms[vmID]; ok {
+		return nil, newError(EConflict, "VM %s is already registered", vmID)
+	}
+	for _, vm := range m.vms {
+		if vm.name == source.name {
+			return nil, newError(ENameInUse, "A VM with the name \"%s\" already exists.", source.name)
+		}
+	}
+	clusterID, err := m.registrationCluster(source.clusterName, params)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := m.registrationDiskAttachments(entities, source.diskAttachments, params)
+	if err != nil {
+		return nil, err
+	}
+	nics, err := m.registrationNICs(clusterID, source.nics, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creating the VM through the regular path fills in the defaults for the parts that are not in the OVF.
+	item := m.createVM(source.name, &vmParams{}, clusterID, DefaultBlankTemplateID, source.cpu.clone())
+	delete(m.vms, item.id)
+	item.id = source.id
+	item.memory = source.memory
+	m.vms[item.id] = item
+	m.vmDiskAttachmentsByVM[item.id] = map[DiskAttachmentID]*diskAttachment{}
+	m.vmIPs[item.id] = map[string][]net.IP{}
+	m.addGraphicsConsoles(item)
+	for _, a := range attachments {
+		m.registerMockDisk(entities, a.diskID)
+		attachment := &diskAttachment{
+			client:        m,
+			id:            DiskAttachmentID(m.GenerateUUID()),
+			vmid:          item.id,
+			diskID:        a.diskID,
+			diskInterface: a.diskInterface,
+			bootable:      a.bootable,
+			active:        true,
+		}
+		m.vmDiskAttachmentsByVM[item.id][attachment.id] = attachment
+		m.addDiskAttachmentByDisk(attachment)
+	}
+	for _, n := range nics {
+		n.vmid = item.id
+		m.nics[n.id] = n
+	}
+	delete(entities.vms, vmID)
+	m.mutationListeners.notify(ResourceTypeVM, string(item.id), "", MutationTypeCreated)
+	return item, nil
+}
+
+func (m *mockClient) RegisterTemplate(
+	storageDomainID StorageDomainID,
+	templateID TemplateID,
+	params RegistrationParameters,
+	_ ...RetryStrategy,
+) (Template, error) {
+	if err := validateRegistrationParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entities, err := m.unregisteredEntitiesOf(storageDomainID)
+	if err != nil {
+		return nil, err
+	}
+	source, ok := entities.templates[templateID]
+	if !ok {
+		return nil, newError(
+			ENotFound,
+			"no unregistered template with ID %s on storage domain %s",
+			templateID,
+			storageDomainID,
+		)
+	}
+	if _, ok := m.templates[templateID]; ok {
+		return nil, newError(EConflict, "template %s is already registered", templateID)
+	}
+	if _, err := m.registrationCluster(source.clusterName, params); err != nil {
+		return nil, err
+	}
+	attachments, err := m.registrationDiskAttachments(entities, source.diskAttachments, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl := &template{
+		client:         m,
+		id:             source.id,
+		name:           source.name,
+		status:         TemplateStatusOK,
+		cpu:            source.cpu.clone(),
+		creationTime:   time.Now(),
+		versionNumber:  1,
+		baseTemplateID: source.id,
+	}
+	m.templates[tpl.id] = tpl
+	m.templateDiskAttachmentsByTemplate[tpl.id] = []*templateDiskAttachment{}
+	for _, a := range attachments {
+		m.registerMockDisk(entities, a.diskID)
+		attachment := &templateDiskAttachment{
+			client:        m,
+			id:            TemplateDiskAttachmentID(m.GenerateUUID()),
+			templateID:    tpl.id,
+			diskID:        a.diskID,
+			diskInterface: a.diskInterface,
+			bootable:      a.bootable,
+			active:        true,
+		}
+		m.templateDiskAttachmentsByTemplate[tpl.id] = append(m.templateDiskAttachmentsByTemplate[tpl.id], attachment)
+		m.templateDiskAttachmentsByDisk[a.diskID] = attachment
+	}
+	delete(entities.templates, templateID)
+	m.mutationListeners.notify(ResourceTypeTemplate, string(tpl.id), "", MutationTypeCreated)
+	return tpl, nil
+}
+
+func (m *mockClient) RegisterDisk(storageDomainID StorageDomainID, diskID DiskID, _ ...RetryStrategy) (Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entities, err := m.unregisteredEntitiesOf(storageDomainID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := entities.disks[diskID]; !ok {
+		return nil, newError(ENotFound, "no unregistered disk with ID %s on storage domain %s", diskID, storageDomainID)
+	}
+	result := m.registerMockDisk(entities, diskID)
+	m.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeCreated)
+	return result, nil
+}
+
+// unregisteredEntitiesOf returns the unregistered entities of a storage domain. It must be called with the lock held.
+func (m *mockClient) unregisteredEntitiesOf(storageDomainID StorageDomainID) (*unregisteredEntities, error) {
+	if _, ok := m.storageDomains[storageDomainID]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	entities, ok := m.unregisteredByStorageDomain[storageDomainID]
+	if !ok {
+		entities = newUnregisteredEntities()
+		m.unregisteredByStorageDomain[storageDomainID] = entities
+	}
+	return entities, nil
+}
+
+// registrationCluster returns the cluster to register a VM or template from the specified original cluster in. It
+// must be called with the lock held.
+func (m *mockClient) registrationCluster(sourceClusterName string, params RegistrationParameters) (ClusterID, error) {
+	clusterID := params.ClusterID()
+	for _, mapping := range params.ClusterMappings() {
+		if mapping.SourceClusterName() == sourceClusterName {
+			clusterID = mapping.TargetClusterID()
+			break
+		}
+	}
+	if clusterID == "" {
+		return "", newError(
+			EBadArgument,
+			"no cluster mapping for the original cluster %s and no cluster ID specified",
+			sourceClusterName,
+		)
+	}
+	if _, ok := m.clusters[clusterID]; !ok {
+		return "", newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	return clusterID, nil
+}
+
+// registrationDiskAttachments returns the disk attachments of a VM or template that can be registered, leaving out
+// the missing disks if the parameters allow a partial import. It must be called with the lock held.
+func (m *mockClient) registrationDiskAttachments(
+	entities *unregisteredEntities,
+	attachments []*unregisteredDiskAttachment,
+	params RegistrationParameters,
+) ([]*unregisteredDiskAttachment, error) {
+	result := make([]*unregisteredDiskAttachment, 0, len(attachments))
+	for _, a := range attachments {
+		if _, ok := entities.disks[a.diskID]; !ok {
+			if params.AllowPartialImport() {
+				continue
+			}
+			return nil, newError(
+				EBadArgument,
+				"disk %s is missing from the storage domain, allow a partial import to register without it",
+				a.diskID,
+			)
+		}
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+// registrationNICs creates the NICs of a VM being registered in the specified cluster, without adding them to the
+// inventory. It must be called with the lock held.
+func (m *mockClient) registrationNICs(
+	clusterID ClusterID,
+	nics []*unregisteredNIC,
+	params RegistrationParameters,
+) ([]*nic, error) {
+	result := make([]*nic, 0, len(nics))
+	usedMACs := map[string]bool{}
+	for _, n := range m.nics {
+		usedMACs[n.mac] = true
+	}
+	for _, n := range nics {
+		vnicProfileID, ok := m.registrationVNICProfile(clusterID, n, params)
+		if !ok {
+			if params.AllowPartialImport() {
+				continue
+			}
+			return nil, newError(
+				EBadArgument,
+				"no VNIC profile found for NIC %s (network %s, profile %s), add a VNIC profile mapping",
+				n.name,
+				n.networkName,
+				n.vnicProfileName,
+			)
+		}
+		mac := n.mac
+		if _, err := net.ParseMAC(mac); err != nil || usedMACs[mac] {
+			if !params.ReassignBadMACs() {
+				return nil, newError(
+					EConflict,
+					"NIC %s has an invalid or duplicate MAC address %s, enable reassigning bad MACs",
+					n.name,
+					mac,
+				)
+			}
+			mac = m.generateMockMAC(usedMACs)
+		}
+		usedMACs[mac] = true
+		result = append(result, &nic{
+			client:        m,
+			id:            NICID(m.GenerateUUID()),
+			name:          n.name,
+			vnicProfileID: vnicProfileID,
+			mac:           mac,
+		})
+	}
+	return result, nil
+}
+
+// registrationVNICProfile finds the VNIC profile for a NIC of a VM being registered in the specified cluster. It must
+// be called with the lock held.
+func (m *mockClient) registrationVNICProfile(
+	clusterID ClusterID,
+	n *unregisteredNIC,
+	params RegistrationParameters,
+) (VNICProfileID, bool) {
+	for _, mapping := range params.VNICProfileMappings() {
+		if mapping.SourceNetworkName() == n.networkName && mapping.SourceVNICProfileName() == n.vnicProfileName {
+			_, ok := m.vnicProfiles[mapping.TargetVNICProfileID()]
+			return mapping.TargetVNICProfileID(), ok
+		}
+	}
+	for _, profile := range m.vnicProfiles {
+		if profile.name != n.vnicProfileName {
+			continue
+		}
+		network, ok := m.networks[profile.networkID]
+		if ok && network.name == n.networkName && m.clusterInDatacenter(clusterID, network.dcID) {
+			return profile.id, true
+		}
+	}
+	return "", false
+}
+
+// generateMockMAC generates a MAC address from the oVirt MAC pool range that is not in use yet. It must be called
+// with the lock held.
+func (m *mockClient) generateMockMAC(usedMACs map[string]bool) string {
+	for {
+		mac := fmt.Sprintf(
+			"56:6f:%02x:%02x:%02x:%02x",
+			m.nonSecureRandom.Intn(256),
+			m.nonSecureRandom.Intn(256),
+			m.nonSecureRandom.Intn(256),
+			m.nonSecureRandom.Intn(256),
+		)
+		if !usedMACs[mac] {
+			return mac
+		}
+	}
+}
+
+// registerMockDisk moves an unregistered disk into the inventory. It must be called with the lock held.
+func (m *mockClient) registerMockDisk(entities *unregisteredEntities, diskID DiskID) *diskWithData {
+	source := entities.disks[diskID]
+	delete(entities.disks, diskID)
+	result := &diskWithData{
+		disk: disk{
+			client:           m,
+			id:               source.id,
+			alias:            source.alias,
+			provisionedSize:  source.provisionedSize,
+			totalSize:        source.provisionedSize,
+			format:           source.format,
+			storageDomainIDs: []StorageDomainID{source.storageDomainID},
+			status:           DiskStatusOK,
+			sparse:           source.format == ImageFormatCow,
+			contentType:      DiskContentTypeData,
+			backup:           DiskBackupNone,
+			storageType:      diskStorageTypeForStorageDomain(m.storageDomains[source.storageDomainID]),
+			diskProfileID:    m.defaultDiskProfileID(source.storageDomainID),
+		},
+		lock: &sync.Mutex{},
+	}
+	m.disks[result.id] = result
+	return result
+}
+
+// unregisteredEntities holds the VMs, templates and disks of a storage domain in the mock that are not registered.
+type unregisteredEntities struct {
+	vms       map[VMID]*unregisteredVM
+	templates map[TemplateID]*unregisteredTemplate
+	disks     map[DiskID]*unregisteredDisk
+}
+
+func newUnregisteredEntities() *unregisteredEntities {
+	return &unregisteredEntities{
+		vms:       map[VMID]*unregisteredVM{},
+		templates: map[TemplateID]*unregisteredTemplate{},
+		disks:     map[DiskID]*unregisteredDisk{},
+	}
+}
+
+type unregisteredVM struct {
+	client Client
+
+	id              VMID
+	name            string
+	storageDomainID StorageDomainID
+	memory          int64
+
+	// The fields below are only filled in the mock.
+	clusterName     string
+	cpu             *vmCPU
+	diskAttachments []*unregisteredDiskAttachment
+	nics            []*unregisteredNIC
+}
+
+func (u *unregisteredVM) ID() VMID {
+	return u.id
+}
+
+func (u *unregisteredVM) Name() string {
+	return u.name
+}
+
+func (u *unregisteredVM) StorageDomainID() StorageDomainID {
+	return u.storageDomainID
+}
+
+func (u *unregisteredVM) Memory() int64 {
+	return u.memory
+}
+
+func (u *unregisteredVM) Register(params RegistrationParameters, retries ...RetryStrategy) (VM, error) {
+	return u.client.RegisterVM(u.storageDomainID, u.id, params, retries...)
+}
+
+type unregisteredTemplate struct {
+	client Client
+
+	id              TemplateID
+	name            string
+	storageDomainID StorageDomainID
+
+	// The fields below are only filled in the mock.
+	clusterName     string
+	cpu             *vmCPU
+	diskAttachments []*unregisteredDiskAttachment
+}
+
+func (u *unregisteredTemplate) ID() TemplateID {
+	return u.id
+}
+
+func (u *unregisteredTemplate) Name() string {
+	return u.name
+}
+
+func (u *unregisteredTemplate) StorageDomainID() StorageDomainID {
+	return u.storageDomainID
+}
+
+func (u *unregisteredTemplate) Register(params RegistrationParameters, retries ...RetryStrategy) (Template, error) {
+	return u.client.RegisterTemplate(u.storageDomainID, u.id, params, retries...)
+}
+
+type unregisteredDisk struct {
+	client Client
+
+	id              DiskID
+	alias           string
+	provisionedSize uint64
+	format          ImageFormat
+	storageDomainID StorageDomainID
+}
+
+func (u *unregisteredDisk) ID() DiskID {
+	return u.id
+}
+
+func (u *unregisteredDisk) Alias() string {
+	return u.alias
+}
+
+func (u *unregisteredDisk) ProvisionedSize() uint64 {
+	return u.provisionedSize
+}
+
+func (u *unregisteredDisk) Format() ImageFormat {
+	return u.format
+}
+
+func (u *unregisteredDisk) StorageDomainID() StorageDomainID {
+	return u.storageDomainID
+}
+
+func (u *unregisteredDisk) Register(retries ...RetryStrategy) (Disk, error) {
+	return u.client.RegisterDisk(u.storageDomainID, u.id, retries...)
+}
+
+type unregisteredDiskAttachment struct {
+	diskID        DiskID
+	diskInterface DiskInterface
+	bootable      bool
+}
+
+type unregisteredNIC struct {
+	name            string
+	mac             string
+	networkName     string
+	vnicProfileName string
+}
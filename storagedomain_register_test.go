@@ -0,0 +1,157 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+const (
+	unregisteredVMID   = "45a2a7a6-7c1f-4a52-a1e0-9a8d1b1e7f01"
+	unregisteredDiskID = "45a2a7a6-7c1f-4a52-a1e0-9a8d1b1e7f02"
+	floatingDiskID     = "45a2a7a6-7c1f-4a52-a1e0-9a8d1b1e7f03"
+)
+
+func TestRegisterVM(t *testing.T) {
+	t.Parallel()
+	client, storageDomainID := newMockWithUnregisteredVM(t)
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters (%v)", err)
+	}
+
+	vms, err := client.ListUnregisteredVMs(storageDomainID)
+	if err != nil {
+		t.Fatalf("Failed to list unregistered VMs on storage domain %s (%v)", storageDomainID, err)
+	}
+	if len(vms) != 1 || vms[0].ID() != unregisteredVMID {
+		t.Fatalf("Incorrect unregistered VMs on storage domain %s: %v", storageDomainID, vms)
+	}
+
+	vm, err := vms[0].Register(
+		ovirtclient.RegistrationParams().WithClusterMapping("dr-cluster", clusters[0].ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to register VM %s (%v)", unregisteredVMID, err)
+	}
+	if vm.ID() != unregisteredVMID || vm.ClusterID() != clusters[0].ID() {
+		t.Fatalf("Incorrect registered VM: ID %s in cluster %s", vm.ID(), vm.ClusterID())
+	}
+	attachments, err := vm.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of VM %s (%v)", vm.ID(), err)
+	}
+	if len(attachments) != 1 || attachments[0].DiskID() != unregisteredDiskID {
+		t.Fatalf("Incorrect disk attachments after registration: %v", attachments)
+	}
+	nics, err := vm.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of VM %s (%v)", vm.ID(), err)
+	}
+	if len(nics) != 1 || nics[0].Mac() != "56:6f:00:00:00:01" {
+		t.Fatalf("Incorrect NICs after registration: %v", nics)
+	}
+
+	vms, err = client.ListUnregisteredVMs(storageDomainID)
+	if err != nil {
+		t.Fatalf("Failed to list unregistered VMs on storage domain %s (%v)", storageDomainID, err)
+	}
+	if len(vms) != 0 {
+		t.Fatalf("The VM is still listed as unregistered after registration.")
+	}
+}
+
+func TestRegisterVMWithoutClusterMapping(t *testing.T) {
+	t.Parallel()
+	client, storageDomainID := newMockWithUnregisteredVM(t)
+
+	_, err := client.RegisterVM(
+		storageDomainID,
+		unregisteredVMID,
+		ovirtclient.RegistrationParams().WithClusterMapping("other-cluster", "does-not-exist"),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Registering a VM without a matching cluster mapping did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func TestRegisterDisk(t *testing.T) {
+	t.Parallel()
+	client, storageDomainID := newMockWithUnregisteredVM(t)
+
+	disk, err := client.RegisterDisk(storageDomainID, floatingDiskID)
+	if err != nil {
+		t.Fatalf("Failed to register disk %s (%v)", floatingDiskID, err)
+	}
+	if disk.ID() != floatingDiskID || disk.Alias() != "floating" {
+		t.Fatalf("Incorrect registered disk: ID %s with alias %s", disk.ID(), disk.Alias())
+	}
+	if _, err := client.RegisterDisk(storageDomainID, floatingDiskID); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Registering a disk twice did not fail with ENotFound (%v)", err)
+	}
+}
+
+// newMockWithUnregisteredVM creates a mock client with an unregistered VM and a floating unregistered disk on a
+// storage domain by editing a saved state, as if the storage domain had been imported from another engine.
+func newMockWithUnregisteredVM(t *testing.T) (ovirtclient.MockClient, ovirtclient.StorageDomainID) {
+	buf := &bytes.Buffer{}
+	if err := ovirtclient.NewMock().SaveState(buf); err != nil {
+		t.Fatalf("Failed to save mock state (%v)", err)
+	}
+	state := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &state); err != nil {
+		t.Fatalf("Failed to decode mock state (%v)", err)
+	}
+	storageDomain := state["storage_domains"].([]interface{})[0].(map[string]interface{})
+	storageDomain["unregistered"] = map[string]interface{}{
+		"vms": []interface{}{
+			map[string]interface{}{
+				"id":           unregisteredVMID,
+				"name":         "dr-vm",
+				"cluster_name": "dr-cluster",
+				"cpu":          map[string]interface{}{"sockets": 1, "cores": 2, "threads": 1},
+				"memory":       1024 * 1024 * 1024,
+				"disks": []interface{}{
+					map[string]interface{}{"disk_id": unregisteredDiskID, "bootable": true},
+				},
+				"nics": []interface{}{
+					map[string]interface{}{
+						"name":              "nic1",
+						"mac":               "56:6f:00:00:00:01",
+						"network_name":      "test",
+						"vnic_profile_name": "test",
+					},
+				},
+			},
+		},
+		"disks": []interface{}{
+			map[string]interface{}{
+				"id":               unregisteredDiskID,
+				"alias":            "dr-vm-disk",
+				"provisioned_size": 1024 * 1024,
+				"format":           "cow",
+			},
+			map[string]interface{}{
+				"id":               floatingDiskID,
+				"alias":            "floating",
+				"provisioned_size": 1024 * 1024,
+				"format":           "raw",
+			},
+		},
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("Failed to encode mock state (%v)", err)
+	}
+	client, err := ovirtclient.NewMockFromState(bytes.NewReader(data), ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to load mock state (%v)", err)
+	}
+	return client, ovirtclient.StorageDomainID(storageDomain["id"].(string))
+}
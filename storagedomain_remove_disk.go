@@ -8,11 +8,20 @@ func (o *oVirtClient) RemoveDiskFromStorageDomain(id StorageDomainID, diskID Dis
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("removing disk %s from storage domain %s", diskID, id),
+		mutating(ResourceTypeDisk, string(diskID)).
+			withParameters(map[string]string{
+				"storage_domain_id": string(id),
+			}),
 		o.logger,
 		retries,
 		func() error {
 			_, err := o.conn.SystemService().StorageDomainsService().
-				StorageDomainService(string(id)).DisksService().DiskService(string(diskID)).Remove().Send()
+				StorageDomainService(string(id)).
+				DisksService().
+				DiskService(string(diskID)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				o.logger.Infof("error removing disk..")
 				return err
@@ -20,6 +29,10 @@ func (o *oVirtClient) RemoveDiskFromStorageDomain(id StorageDomainID, diskID Dis
 
 			return nil
 		})
+	if err == nil {
+		// The disk may still be present on other storage domains, so we report it as updated.
+		o.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeUpdated)
+	}
 	return
 }
 
@@ -36,6 +49,7 @@ func (m *mockClient) RemoveDiskFromStorageDomain(id StorageDomainID, diskID Disk
 	// if there is only 1 domain just delete the disk
 	if len(domains) == 1 {
 		delete(m.disks, diskID)
+		m.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeRemoved)
 		return nil
 	}
 
@@ -44,6 +58,7 @@ func (m *mockClient) RemoveDiskFromStorageDomain(id StorageDomainID, diskID Disk
 		if sdomain == id {
 			// gocritic will complain on the following line due to appendAssign, but that's legit here
 			m.disks[diskID].storageDomainIDs = append(domains[:i], domains[i+1:]...) //nolint:gocritic
+			m.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeUpdated)
 			return nil
 		}
 	}
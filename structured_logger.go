@@ -0,0 +1,260 @@
+package ovirtclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// LogFields are the details of a log message passed to a StructuredLogger.
+type LogFields map[string]interface{}
+
+// These are the fields the client passes to StructuredLogger.WithFields.
+const (
+	// LogFieldOperation contains the description of the call, for example "getting vm 123".
+	LogFieldOperation = "operation"
+	// LogFieldResourceType contains the ResourceType of the resource the call works on, if it could be determined.
+	// See Operation.ResourceType for details.
+	LogFieldResourceType = "resource_type"
+	// LogFieldResourceID contains the ID of the resource the call works on, if the call has one.
+	LogFieldResourceID = "resource_id"
+	// LogFieldCorrelationID contains the correlation ID of the call, if it has one.
+	LogFieldCorrelationID = "correlation_id"
+	// LogFieldAttempt contains the number of the attempt of the call, starting with 1.
+	LogFieldAttempt = "attempt"
+	// LogFieldErrorCode contains the ErrorCode of the error an attempt failed with.
+	LogFieldErrorCode = "error_code"
+)
+
+// StructuredLogger is a Logger that receives the details of the calls as fields instead of only as part of the
+// message, so the logs can be searched and aggregated by operation, resource or error code. If the logger passed to
+// the client implements this interface, the client adds the LogField fields to all messages it logs for a call. The
+// correlation ID is then passed in a field instead of prefixing the messages.
+//
+// Use NewJSONLogger or NewKeyValueLogger to create a StructuredLogger writing to a file, or implement this interface
+// to pass the fields to the logging library of your choice.
+type StructuredLogger interface {
+	Logger
+
+	// WithFields returns a logger adding the fields to every message, in addition to the fields already added.
+	WithFields(fields LogFields) StructuredLogger
+}
+
+// LogLevel is the severity of a log message.
+type LogLevel string
+
+const (
+	// LogLevelDebug is used for messages about the individual attempts of a call.
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelInfo is used for messages about starting and finishing calls.
+	LogLevelInfo LogLevel = "info"
+	// LogLevelWarning is used for messages about unexpected situations the client handled, see Warning.
+	LogLevelWarning LogLevel = "warning"
+	// LogLevelError is used for messages about failures the client cannot handle.
+	LogLevelError LogLevel = "error"
+)
+
+// LogLevelValues returns all possible LogLevel values, from the least to the most severe.
+func LogLevelValues() []LogLevel {
+	return []LogLevel{
+		LogLevelDebug,
+		LogLevelInfo,
+		LogLevelWarning,
+		LogLevelError,
+	}
+}
+
+// Validate returns an error if the log level is not valid.
+func (l LogLevel) Validate() error {
+	if l.severity() < 0 {
+		return newError(EBadArgument, "invalid log level: %s", l)
+	}
+	return nil
+}
+
+// severity returns the index of the level in LogLevelValues, or -1 if the level is invalid.
+func (l LogLevel) severity() int {
+	for i, level := range LogLevelValues() {
+		if level == l {
+			return i
+		}
+	}
+	return -1
+}
+
+// NewJSONLogger creates a StructuredLogger writing each message with at least the specified level to w as a single
+// line of JSON, for example:
+//
+//	{"attempt":1,"level":"info","message":"Completed getting vm 123.","operation":"getting vm 123","time":"..."}
+func NewJSONLogger(w io.Writer, minLevel LogLevel) (StructuredLogger, error) {
+	return newStructuredLogger(w, minLevel, encodeJSONLogEntry)
+}
+
+// NewKeyValueLogger creates a StructuredLogger writing each message with at least the specified level to w as a
+// single line of key=value pairs, for example:
+//
+//	time=... level=info message="Completed getting vm 123." attempt=1 operation="getting vm 123"
+func NewKeyValueLogger(w io.Writer, minLevel LogLevel) (StructuredLogger, error) {
+	return newStructuredLogger(w, minLevel, encodeKeyValueLogEntry)
+}
+
+func newStructuredLogger(
+	w io.Writer,
+	minLevel LogLevel,
+	encode func(time.Time, LogLevel, string, LogFields) ([]byte, error),
+) (StructuredLogger, error) {
+	if err := minLevel.Validate(); err != nil {
+		return nil, err
+	}
+	return &structuredLogger{
+		lock:     &sync.Mutex{},
+		writer:   w,
+		minLevel: minLevel.severity(),
+		encode:   encode,
+		fields:   LogFields{},
+	}, nil
+}
+
+type structuredLogger struct {
+	// lock is shared between all loggers created using WithFields, so lines written to w do not mix.
+	lock     *sync.Mutex
+	writer   io.Writer
+	minLevel int
+	encode   func(time.Time, LogLevel, string, LogFields) ([]byte, error)
+	fields   LogFields
+}
+
+func (s *structuredLogger) WithFields(fields LogFields) StructuredLogger {
+	newFields := make(LogFields, len(s.fields)+len(fields))
+	for key, value := range s.fields {
+		newFields[key] = value
+	}
+	for key, value := range fields {
+		newFields[key] = value
+	}
+	return &structuredLogger{
+		lock:     s.lock,
+		writer:   s.writer,
+		minLevel: s.minLevel,
+		encode:   s.encode,
+		fields:   newFields,
+	}
+}
+
+func (s *structuredLogger) WithContext(_ context.Context) ovirtclientlog.Logger {
+	return s
+}
+
+func (s *structuredLogger) Debugf(format string, args ...interface{}) {
+	s.write(LogLevelDebug, format, args...)
+}
+
+func (s *structuredLogger) Infof(format string, args ...interface{}) {
+	s.write(LogLevelInfo, format, args...)
+}
+
+func (s *structuredLogger) Warningf(format string, args ...interface{}) {
+	s.write(LogLevelWarning, format, args...)
+}
+
+func (s *structuredLogger) Errorf(format string, args ...interface{}) {
+	s.write(LogLevelError, format, args...)
+}
+
+func (s *structuredLogger) write(level LogLevel, format string, args ...interface{}) {
+	if level.severity() < s.minLevel {
+		return
+	}
+	now := time.Now()
+	message := fmt.Sprintf(format, args...)
+	line, err := s.encode(now, level, message, s.fields)
+	if err != nil {
+		// The logger has no way to report errors, so the message is written without its fields instead.
+		line, _ = s.encode(now, level, fmt.Sprintf("%s (failed to encode log fields: %v)", message, err), LogFields{})
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, _ = s.writer.Write(append(line, '\n'))
+}
+
+func encodeJSONLogEntry(t time.Time, level LogLevel, message string, fields LogFields) ([]byte, error) {
+	entry := make(map[string]interface{}, len(fields)+3)
+	for key, value := range fields {
+		entry[key] = value
+	}
+	entry["time"] = t.Format(time.RFC3339Nano)
+	entry["level"] = level
+	entry["message"] = message
+	return json.Marshal(entry)
+}
+
+func encodeKeyValueLogEntry(t time.Time, level LogLevel, message string, fields LogFields) ([]byte, error) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := []string{
+		"time=" + t.Format(time.RFC3339Nano),
+		"level=" + string(level),
+		"message=" + quoteLogValue(message),
+	}
+	for _, key := range keys {
+		parts = append(parts, key+"="+quoteLogValue(fmt.Sprintf("%v", fields[key])))
+	}
+	return []byte(strings.Join(parts, " ")), nil
+}
+
+// quoteLogValue quotes a value for the key=value format if it contains spaces, quotes or equal signs.
+func quoteLogValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// withLogFields adds the fields to the logger if it is a StructuredLogger, and returns it unchanged otherwise.
+func withLogFields(logger ovirtclientlog.Logger, fields LogFields) ovirtclientlog.Logger {
+	if structured, ok := logger.(StructuredLogger); ok {
+		return structured.WithFields(fields)
+	}
+	return logger
+}
+
+// operationLogFields returns the fields describing a call for a StructuredLogger.
+func operationLogFields(action string, target operationTarget, correlationID string) LogFields {
+	op := newOperation(action, target, correlationID)
+	fields := LogFields{
+		LogFieldOperation: op.Description(),
+	}
+	if op.ResourceType() != "" {
+		fields[LogFieldResourceType] = op.ResourceType()
+	}
+	if op.ResourceID() != "" {
+		fields[LogFieldResourceID] = op.ResourceID()
+	}
+	if correlationID != "" {
+		fields[LogFieldCorrelationID] = correlationID
+	}
+	return fields
+}
+
+// errorLogFields returns the error code of the error as fields for a StructuredLogger.
+func errorLogFields(err error) LogFields {
+	var engineErr EngineError
+	if errors.As(err, &engineErr) {
+		return LogFields{
+			LogFieldErrorCode: engineErr.Code(),
+		}
+	}
+	return LogFields{}
+}
@@ -0,0 +1,81 @@
+// This file contains tests for the fields the retry function passes to structured loggers. It is therefore excluded
+// from the testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRetryLogsStructuredFields(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	logger, err := NewJSONLogger(buf, LogLevelDebug)
+	if err != nil {
+		t.Fatalf("failed to create JSON logger (%v)", err)
+	}
+	const id = "5e9b0a4c-6a2f-4d43-9d4b-1a2f3c4d5e6f"
+	attempts := 0
+	if err := retry(
+		"getting vm "+id,
+		reading(ResourceTypeVM, id),
+		logger,
+		[]RetryStrategy{MaxTries(2), ExponentialBackoff(1), AutoRetry(), CorrelationID("test-1")},
+		func() error {
+			attempts++
+			if attempts == 1 {
+				return newError(EConnection, "connection refused")
+			}
+			return nil
+		},
+	); err != nil {
+		t.Fatalf("the call failed (%v)", err)
+	}
+
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("failed to decode log line %s (%v)", line, err)
+		}
+		if entry[LogFieldOperation] != "getting vm "+id || entry[LogFieldResourceID] != id ||
+			entry[LogFieldResourceType] != string(ResourceTypeVM) || entry[LogFieldCorrelationID] != "test-1" {
+			t.Fatalf("the log line is missing the operation fields: %s", line)
+		}
+		entries = append(entries, entry)
+	}
+	foundError := false
+	foundSecondAttempt := false
+	for _, entry := range entries {
+		if entry[LogFieldErrorCode] == string(EConnection) && entry[LogFieldAttempt] == float64(1) {
+			foundError = true
+		}
+		if entry[LogFieldAttempt] == float64(2) {
+			foundSecondAttempt = true
+		}
+	}
+	if !foundError || !foundSecondAttempt {
+		t.Fatalf("the attempt and error code fields are missing from the log: %s", buf.String())
+	}
+}
+
+func TestKeyValueLoggerQuotesValues(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	logger, err := NewKeyValueLogger(buf, LogLevelInfo)
+	if err != nil {
+		t.Fatalf("failed to create key/value logger (%v)", err)
+	}
+	logger.Debugf("not logged")
+	logger.WithFields(LogFields{LogFieldOperation: "getting vm 123", LogFieldAttempt: 1}).Infof("Completed.")
+	line := strings.TrimSpace(buf.String())
+	if !strings.HasSuffix(line, `level=info message=Completed. attempt=1 operation="getting vm 123"`) {
+		t.Fatalf("incorrect log line: %s", line)
+	}
+	if _, err := NewKeyValueLogger(buf, "verbose"); err == nil {
+		t.Fatalf("an invalid log level was accepted")
+	}
+}
@@ -12,6 +12,11 @@ func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...
 
 	err = retry(
 		"creating tag",
+		mutating(ResourceTypeTag, "").
+			withParameters(map[string]string{
+				"name": name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -19,7 +24,13 @@ func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...
 			if description := params.Description(); description != nil {
 				tagBuilder.Description(*description)
 			}
-			response, e := o.conn.SystemService().TagsService().Add().Tag(tagBuilder.MustBuild()).Send()
+			response, e := o.conn.
+				SystemService().
+				TagsService().
+				Add().
+				Tag(tagBuilder.MustBuild()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -39,6 +50,9 @@ func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeTag, string(result.ID()), "", MutationTypeCreated)
+	}
 	return result, err
 }
 
@@ -56,6 +70,7 @@ func (m *mockClient) CreateTag(name string, params CreateTagParams, _ ...RetrySt
 		description: params.Description(),
 	}
 	m.tags[id] = tag
+	m.mutationListeners.notify(ResourceTypeTag, string(id), "", MutationTypeCreated)
 
 	result = tag
 	return
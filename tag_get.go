@@ -10,10 +10,17 @@ func (o *oVirtClient) GetTag(id TagID, retries ...RetryStrategy) (result Tag, er
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting tag %s", id),
+		reading(ResourceTypeTag, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().TagsService().TagService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				TagsService().
+				TagService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -7,10 +7,16 @@ func (o *oVirtClient) ListTags(retries ...RetryStrategy) (result []Tag, err erro
 	result = []Tag{}
 	err = retry(
 		"listing tags",
+		reading(ResourceTypeTag, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().TagsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				TagsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -8,12 +8,22 @@ func (o *oVirtClient) RemoveTag(tagID TagID, retries ...RetryStrategy) (err erro
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("removing tag %s", tagID),
+		mutating(ResourceTypeTag, string(tagID)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().TagsService().TagService(string(tagID)).Remove().Send()
+			_, err := o.conn.
+				SystemService().
+				TagsService().
+				TagService(string(tagID)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeTag, string(tagID), "", MutationTypeRemoved)
+	}
 	return
 }
 
@@ -37,6 +47,7 @@ func (m *mockClient) RemoveTag(id TagID, _ ...RetryStrategy) (err error) {
 	}
 
 	delete(m.tags, id)
+	m.mutationListeners.notify(ResourceTypeTag, string(id), "", MutationTypeRemoved)
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package ovirtclient
 
 import (
+	"time"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
@@ -15,19 +17,55 @@ type TemplateClient interface {
 	)
 	// ListTemplates returns all templates stored in the oVirt engine.
 	ListTemplates(retries ...RetryStrategy) ([]Template, error)
-	// GetTemplateByName returns a template by its Name.
+	// GetTemplateByName returns a template by its Name. An ENotFound error is returned if no template has the name,
+	// and an EMultipleResults error if more than one does, for example because of template versions.
 	GetTemplateByName(templateName string, retries ...RetryStrategy) (Template, error)
+	// GetTemplateByNameAndVersion returns the template with the specified name and version number. Base templates
+	// have the version number 1. An ENotFound error is returned if no such template exists.
+	GetTemplateByNameAndVersion(templateName string, versionNumber uint, retries ...RetryStrategy) (Template, error)
 	// GetTemplate returns a template by its ID.
 	GetTemplate(id TemplateID, retries ...RetryStrategy) (Template, error)
 	// GetBlankTemplate finds a blank template in the oVirt engine and returns it. If no blank template is present,
 	// this function will return an error.
 	GetBlankTemplate(retries ...RetryStrategy) (Template, error)
-	// RemoveTemplate removes the template with the specified ID.
+	// UpdateTemplate updates the name and description of the template with the specified ID. Parameters can be created
+	// using UpdateTemplateParams().
+	UpdateTemplate(templateID TemplateID, params UpdateTemplateParameters, retries ...RetryStrategy) (Template, error)
+	// RemoveTemplate removes the template with the specified ID. The default blank template cannot be removed, an
+	// EBadArgument error is returned when attempting to do so.
 	RemoveTemplate(templateID TemplateID, retries ...RetryStrategy) error
 	// WaitForTemplateStatus waits for a template to enter a specific status.
 	WaitForTemplateStatus(templateID TemplateID, status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// CopyTemplateDiskToStorageDomain copies template disk to the specified storage domain.
 	CopyTemplateDiskToStorageDomain(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (Disk, error)
+	// CopyTemplateToStorageDomain copies all disks of the template to another data domain, so VMs can be created from
+	// the template on that storage domain. Disks already present on the storage domain are skipped. It returns the
+	// template once all disks have been copied.
+	CopyTemplateToStorageDomain(
+		templateID TemplateID,
+		storageDomainID StorageDomainID,
+		retries ...RetryStrategy,
+	) (Template, error)
+	// ExportTemplate exports the template to an export domain, from which it can be imported in another
+	// environment. The engine locks the template during the export, use WaitForTemplateStatus to wait for
+	// TemplateStatusOK. Use TemplateExportParams to obtain a builder for the optional params.
+	ExportTemplate(
+		templateID TemplateID,
+		exportDomainID StorageDomainID,
+		params OptionalTemplateExportParameters,
+		retries ...RetryStrategy,
+	) error
+	// ExportTemplateToOVA exports the template as an OVA file to the absolute directory path on the host. The
+	// directory must exist and be writable by the vdsm user. The engine locks the template during the export, use
+	// WaitForTemplateStatus to wait for TemplateStatusOK. Use TemplateExportParams to obtain a builder for the optional
+	// params.
+	ExportTemplateToOVA(
+		templateID TemplateID,
+		hostID HostID,
+		directory string,
+		params OptionalTemplateExportParameters,
+		retries ...RetryStrategy,
+	) error
 }
 
 // TemplateID is an identifier for a template. It has a special type so the compiler
@@ -46,6 +84,15 @@ type TemplateData interface {
 	Status() TemplateStatus
 	// CPU returns the CPU configuration of the template if any.
 	CPU() VMCPU
+	// CreationTime returns the time the template was created. It is the zero time if the engine did not report it.
+	CreationTime() time.Time
+	// VersionNumber returns the version of the template within its family. Base templates have the version number 1.
+	VersionNumber() uint
+	// VersionName returns the user-given name of the template version, if any.
+	VersionName() string
+	// BaseTemplateID returns the ID of the base template of the family. For base templates this is the ID of the
+	// template itself.
+	BaseTemplateID() TemplateID
 
 	// IsBlank returns true, if the template either has the ID of all zeroes, or if the template has no settings, disks,
 	// or other settings. This function only checks the details supported by go-ovirt-client.
@@ -62,6 +109,10 @@ type Template interface {
 	WaitForStatus(status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// ListDiskAttachments lists all disk attachments for the current template.
 	ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error)
+	// ListNICs lists the network interfaces VMs created from the current template will receive.
+	ListNICs(retries ...RetryStrategy) ([]TemplateNIC, error)
+	// Update updates the name and description of the current template. It returns the updated template.
+	Update(params UpdateTemplateParameters, retries ...RetryStrategy) (Template, error)
 	// Remove removes the specified template.
 	Remove(retries ...RetryStrategy) error
 }
@@ -79,9 +130,26 @@ const (
 	TemplateStatusIllegal TemplateStatus = "illegal"
 )
 
+// TemplateStatusValues returns all possible TemplateStatus values.
+func TemplateStatusValues() []TemplateStatus {
+	return []TemplateStatus{
+		TemplateStatusOK,
+		TemplateStatusLocked,
+		TemplateStatusIllegal,
+	}
+}
+
 // OptionalTemplateCreateParameters contains the optional parameters for creating a template.
 type OptionalTemplateCreateParameters interface {
 	Description() *string
+	// VersionName returns the name of the template version to create.
+	VersionName() *string
+	// BaseTemplateID returns the ID of the base template if the new template should be created as a sub-version of
+	// it. Sub-versions always carry the name of the base template.
+	BaseTemplateID() *TemplateID
+	// Seal returns true if machine-specific data, such as SSH host keys, the machine ID and log files, should be
+	// removed from the template disks using virt-sysprep. Sealing is only supported for Linux VMs.
+	Seal() *bool
 }
 
 // BuildableTemplateCreateParameters is a buildable version of OptionalTemplateCreateParameters.
@@ -92,10 +160,29 @@ type BuildableTemplateCreateParameters interface {
 	WithDescription(description string) (BuildableTemplateCreateParameters, error)
 	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
 	MustWithDescription(description string) BuildableTemplateCreateParameters
+
+	// WithVersionName sets the name of the template version.
+	WithVersionName(versionName string) (BuildableTemplateCreateParameters, error)
+	// MustWithVersionName is identical to WithVersionName, but panics instead of returning an error.
+	MustWithVersionName(versionName string) BuildableTemplateCreateParameters
+
+	// WithBaseTemplateID creates the template as a new sub-version of the specified base template.
+	WithBaseTemplateID(baseTemplateID TemplateID) (BuildableTemplateCreateParameters, error)
+	// MustWithBaseTemplateID is identical to WithBaseTemplateID, but panics instead of returning an error.
+	MustWithBaseTemplateID(baseTemplateID TemplateID) BuildableTemplateCreateParameters
+
+	// WithSeal sets if the template should be sealed. Sealing removes machine-specific data from the template disks,
+	// so VMs created from the template don't share the machine ID or SSH host keys.
+	WithSeal(seal bool) (BuildableTemplateCreateParameters, error)
+	// MustWithSeal is identical to WithSeal, but panics instead of returning an error.
+	MustWithSeal(seal bool) BuildableTemplateCreateParameters
 }
 
 type templateCreateParameters struct {
-	description *string
+	description    *string
+	versionName    *string
+	baseTemplateID *TemplateID
+	seal           *bool
 }
 
 func (t templateCreateParameters) Description() *string {
@@ -115,11 +202,138 @@ func (t templateCreateParameters) MustWithDescription(description string) Builda
 	return builder
 }
 
+func (t templateCreateParameters) VersionName() *string {
+	return t.versionName
+}
+
+func (t templateCreateParameters) WithVersionName(versionName string) (BuildableTemplateCreateParameters, error) {
+	t.versionName = &versionName
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithVersionName(versionName string) BuildableTemplateCreateParameters {
+	builder, err := t.WithVersionName(versionName)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t templateCreateParameters) BaseTemplateID() *TemplateID {
+	return t.baseTemplateID
+}
+
+func (t templateCreateParameters) WithBaseTemplateID(
+	baseTemplateID TemplateID,
+) (BuildableTemplateCreateParameters, error) {
+	if baseTemplateID == "" {
+		return t, newError(EBadArgument, "the base template ID must not be empty")
+	}
+	t.baseTemplateID = &baseTemplateID
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithBaseTemplateID(baseTemplateID TemplateID) BuildableTemplateCreateParameters {
+	builder, err := t.WithBaseTemplateID(baseTemplateID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t templateCreateParameters) Seal() *bool {
+	return t.seal
+}
+
+func (t templateCreateParameters) WithSeal(seal bool) (BuildableTemplateCreateParameters, error) {
+	t.seal = &seal
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithSeal(seal bool) BuildableTemplateCreateParameters {
+	builder, err := t.WithSeal(seal)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // TemplateCreateParams creates a builder for the parameters of the template creation.
 func TemplateCreateParams() BuildableTemplateCreateParameters {
 	return &templateCreateParameters{}
 }
 
+// UpdateTemplateParameters contains the changes for a template. Each method can return nil to leave the attribute
+// unchanged.
+type UpdateTemplateParameters interface {
+	// Name returns the new name of the template.
+	Name() *string
+	// Description returns the new description of the template.
+	Description() *string
+}
+
+// BuildableUpdateTemplateParameters is a buildable version of UpdateTemplateParameters.
+type BuildableUpdateTemplateParameters interface {
+	UpdateTemplateParameters
+
+	// WithName sets the new name of the template.
+	WithName(name string) (BuildableUpdateTemplateParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateTemplateParameters
+
+	// WithDescription sets the new description of the template.
+	WithDescription(description string) (BuildableUpdateTemplateParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateTemplateParameters
+}
+
+// UpdateTemplateParams creates a builder for the parameters of the template update.
+func UpdateTemplateParams() BuildableUpdateTemplateParameters {
+	return &templateUpdateParameters{}
+}
+
+type templateUpdateParameters struct {
+	name        *string
+	description *string
+}
+
+func (t *templateUpdateParameters) Name() *string {
+	return t.name
+}
+
+func (t *templateUpdateParameters) Description() *string {
+	return t.description
+}
+
+func (t *templateUpdateParameters) WithName(name string) (BuildableUpdateTemplateParameters, error) {
+	if name == "" {
+		return t, newError(EBadArgument, "the template name must not be empty")
+	}
+	t.name = &name
+	return t, nil
+}
+
+func (t *templateUpdateParameters) MustWithName(name string) BuildableUpdateTemplateParameters {
+	builder, err := t.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t *templateUpdateParameters) WithDescription(description string) (BuildableUpdateTemplateParameters, error) {
+	t.description = &description
+	return t, nil
+}
+
+func (t *templateUpdateParameters) MustWithDescription(description string) BuildableUpdateTemplateParameters {
+	builder, err := t.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template, error) {
 	id, ok := sdkTemplate.Id()
 	if !ok {
@@ -138,16 +352,44 @@ func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template
 	if err != nil {
 		return nil, err
 	}
+	creationTime, _ := sdkTemplate.CreationTime()
+	versionNumber, versionName, baseTemplateID := convertSDKTemplateVersion(sdkTemplate, TemplateID(id))
 	return &template{
-		client:      client,
-		id:          TemplateID(id),
-		name:        name,
-		status:      TemplateStatus(status),
-		description: description,
-		cpu:         cpu,
+		client:         client,
+		id:             TemplateID(id),
+		name:           name,
+		status:         TemplateStatus(status),
+		description:    description,
+		cpu:            cpu,
+		creationTime:   creationTime,
+		versionNumber:  versionNumber,
+		versionName:    versionName,
+		baseTemplateID: baseTemplateID,
 	}, nil
 }
 
+// convertSDKTemplateVersion returns the version details of the template. Templates not reporting a version are
+// treated as base templates.
+func convertSDKTemplateVersion(sdkTemplate *ovirtsdk.Template, id TemplateID) (uint, string, TemplateID) {
+	versionNumber := uint(1)
+	versionName := ""
+	baseTemplateID := id
+	sdkVersion, ok := sdkTemplate.Version()
+	if !ok {
+		return versionNumber, versionName, baseTemplateID
+	}
+	if number, ok := sdkVersion.VersionNumber(); ok {
+		versionNumber = uint(number)
+	}
+	versionName, _ = sdkVersion.VersionName()
+	if baseTemplate, ok := sdkVersion.BaseTemplate(); ok {
+		if baseID, ok := baseTemplate.Id(); ok {
+			baseTemplateID = TemplateID(baseID)
+		}
+	}
+	return versionNumber, versionName, baseTemplateID
+}
+
 func convertSDKTemplateCPU(sdkObject *ovirtsdk.Template) (*vmCPU, error) {
 	sdkCPU, ok := sdkObject.Cpu()
 	if !ok {
@@ -180,18 +422,47 @@ func convertSDKTemplateCPU(sdkObject *ovirtsdk.Template) (*vmCPU, error) {
 }
 
 type template struct {
-	client      Client
-	id          TemplateID
-	name        string
-	description string
-	status      TemplateStatus
-	cpu         *vmCPU
+	client       Client
+	id           TemplateID
+	name         string
+	description  string
+	status       TemplateStatus
+	cpu          *vmCPU
+	creationTime time.Time
+
+	versionNumber  uint
+	versionName    string
+	baseTemplateID TemplateID
+}
+
+func (t template) CreationTime() time.Time {
+	return t.creationTime
+}
+
+func (t template) VersionNumber() uint {
+	return t.versionNumber
+}
+
+func (t template) VersionName() string {
+	return t.versionName
+}
+
+func (t template) BaseTemplateID() TemplateID {
+	return t.baseTemplateID
 }
 
 func (t template) ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error) {
 	return t.client.ListTemplateDiskAttachments(t.id, retries...)
 }
 
+func (t template) ListNICs(retries ...RetryStrategy) ([]TemplateNIC, error) {
+	return t.client.ListTemplateNICs(t.id, retries...)
+}
+
+func (t template) Update(params UpdateTemplateParameters, retries ...RetryStrategy) (Template, error) {
+	return t.client.UpdateTemplate(t.id, params, retries...)
+}
+
 func (t template) CPU() VMCPU {
 	return t.cpu
 }
@@ -26,7 +26,7 @@ func (o *oVirtClient) StartCopyTemplateDiskToStorageDomain(
 	storageDomainID StorageDomainID,
 	retries ...RetryStrategy) (DiskUpdate, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	correlationID := fmt.Sprintf("template_disk_copy_%s", generateRandomID(5, o.nonSecureRandom))
+	correlationID := o.jobCorrelationID("template_disk_copy", retries)
 	sdkStorageDomain := ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID))
 	sdkDisk := ovirtsdk.NewDiskBuilder().Id(string(diskID))
 	storageDomain, _ := o.GetStorageDomain(storageDomainID)
@@ -34,6 +34,10 @@ func (o *oVirtClient) StartCopyTemplateDiskToStorageDomain(
 
 	err := retry(
 		fmt.Sprintf("copying disk %s to storage domain %s", diskID, storageDomainID),
+		mutating(ResourceTypeDisk, string(diskID)).
+			withParameters(map[string]string{
+				"storage_domain_id": string(storageDomainID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -57,6 +61,7 @@ func (o *oVirtClient) StartCopyTemplateDiskToStorageDomain(
 	if err != nil {
 		return nil, err
 	}
+	o.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeUpdated)
 	return &storageDomainDiskWait{
 		client:        o,
 		disk:          disk,
@@ -77,6 +82,14 @@ func (m *mockClient) CopyTemplateDiskToStorageDomain(
 	if !ok {
 		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
 	}
+	if err := validateImageDiskOperation(disk, "copying"); err != nil {
+		return nil, err
+	}
+	if err := m.ensureStorageAvailable(
+		map[StorageDomainID]uint64{storageDomainID: disk.provisionedSize},
+	); err != nil {
+		return nil, err
+	}
 	if err := disk.Lock(); err != nil {
 		return nil, err
 	}
@@ -86,6 +99,7 @@ func (m *mockClient) CopyTemplateDiskToStorageDomain(
 		storageDomainID: storageDomainID,
 		done:            make(chan struct{}),
 	}
+	m.mutationListeners.notify(ResourceTypeDisk, string(diskID), "", MutationTypeUpdated)
 	defer update.do()
 	return disk, nil
 }
@@ -115,5 +129,6 @@ func (c *mockDiskCopy) do() {
 	time.Sleep(time.Second)
 	c.client.disks[c.disk.ID()] = c.disk
 	c.client.disks[c.disk.ID()].storageDomainIDs = append(c.client.disks[c.disk.ID()].storageDomainIDs, c.storageDomainID)
+	c.disk.Unlock()
 	close(c.done)
 }
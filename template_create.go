@@ -2,6 +2,8 @@ package ovirtclient
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
@@ -19,6 +21,12 @@ func (o *oVirtClient) CreateTemplate(
 	}
 	err = retry(
 		fmt.Sprintf("creating template from VM %s", vmID),
+		mutating(ResourceTypeTemplate, "").
+			withParameters(map[string]string{
+				"vm_id": string(vmID),
+				"name":  name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -28,7 +36,14 @@ func (o *oVirtClient) CreateTemplate(
 			if desc := params.Description(); desc != nil {
 				tpl.Description(*desc)
 			}
-			response, err := o.conn.SystemService().TemplatesService().Add().Template(tpl.MustBuild()).Send()
+			if version := buildSDKTemplateVersion(params); version != nil {
+				tpl.VersionBuilder(version)
+			}
+			request := o.conn.SystemService().TemplatesService().Add().Template(tpl.MustBuild())
+			if seal := params.Seal(); seal != nil {
+				request.Seal(*seal)
+			}
+			response, err := request.Query("correlation_id", requestCorrelationID(retries)).Send()
 			if err != nil {
 				return err
 			}
@@ -51,9 +66,28 @@ func (o *oVirtClient) CreateTemplate(
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeTemplate, string(result.ID()), "", MutationTypeCreated)
+	}
 	return result, err
 }
 
+func buildSDKTemplateVersion(params OptionalTemplateCreateParameters) *ovirtsdk.TemplateVersionBuilder {
+	baseTemplateID := params.BaseTemplateID()
+	versionName := params.VersionName()
+	if baseTemplateID == nil && versionName == nil {
+		return nil
+	}
+	version := ovirtsdk.NewTemplateVersionBuilder()
+	if baseTemplateID != nil {
+		version.BaseTemplateBuilder(ovirtsdk.NewTemplateBuilder().Id(string(*baseTemplateID)))
+	}
+	if versionName != nil {
+		version.VersionName(*versionName)
+	}
+	return version
+}
+
 func (m *mockClient) CreateTemplate(
 	vmID VMID,
 	name string,
@@ -68,27 +102,66 @@ func (m *mockClient) CreateTemplate(
 		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
 	}
 
-	for _, tpl := range m.templates {
-		if tpl.name == name {
-			return nil, newError(EConflict, "A template with the name \"%s\" already exists.", name)
+	if params == nil {
+		params = &templateCreateParameters{}
+	}
+
+	if seal := params.Seal(); seal != nil && *seal && vm.os != nil && strings.HasPrefix(vm.os.t, "windows") {
+		return nil, newError(
+			EUnsupported,
+			"VM %s has the operating system %s, sealing is only supported for Linux VMs",
+			vmID,
+			vm.os.t,
+		)
+	}
+
+	id := TemplateID(m.GenerateUUID())
+	versionNumber := uint(1)
+	baseTemplateID := id
+	if baseID := params.BaseTemplateID(); baseID != nil {
+		baseTemplate, err := m.getBaseTemplate(*baseID)
+		if err != nil {
+			return nil, err
+		}
+		// Sub-versions share the name of the base template.
+		name = baseTemplate.name
+		versionNumber = m.latestTemplateVersion(baseTemplate.id).versionNumber + 1
+		baseTemplateID = baseTemplate.id
+	} else {
+		for _, tpl := range m.templates {
+			if tpl.name == name {
+				return nil, newError(ENameInUse, "A template with the name \"%s\" already exists.", name)
+			}
 		}
 	}
 
-	if params == nil {
-		params = &templateCreateParameters{}
+	var disks []*diskWithData
+	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
+		disks = append(disks, m.disks[attachment.diskID])
+	}
+	if err := m.ensureStorageAvailable(diskStorageNeeds(disks)); err != nil {
+		return nil, err
 	}
 
 	description := ""
 	if desc := params.Description(); desc != nil {
 		description = *desc
 	}
+	versionName := ""
+	if vName := params.VersionName(); vName != nil {
+		versionName = *vName
+	}
 	tpl := &template{
-		client:      m,
-		id:          TemplateID(m.GenerateUUID()),
-		name:        name,
-		description: description,
-		status:      TemplateStatusLocked,
-		cpu:         vm.cpu.clone(),
+		client:         m,
+		id:             id,
+		name:           name,
+		description:    description,
+		status:         TemplateStatusLocked,
+		cpu:            vm.cpu.clone(),
+		creationTime:   time.Now(),
+		versionNumber:  versionNumber,
+		versionName:    versionName,
+		baseTemplateID: baseTemplateID,
 	}
 	m.templates[tpl.ID()] = tpl
 	m.templateDiskAttachmentsByTemplate[tpl.ID()] = make(
@@ -96,11 +169,44 @@ func (m *mockClient) CreateTemplate(
 		len(m.vmDiskAttachmentsByVM[vmID]),
 	)
 	m.attachTemplateDisks(vmID, tpl)
+	m.copyTemplateNICs(vmID, tpl)
+	m.mutationListeners.notify(ResourceTypeTemplate, string(tpl.id), "", MutationTypeCreated)
 
 	go m.handlePostTemplateCreation(tpl)
 	return tpl, nil
 }
 
+// getBaseTemplate returns the template with the specified ID if it is a base template. It must be called with the
+// lock held.
+func (m *mockClient) getBaseTemplate(id TemplateID) (*template, error) {
+	baseTemplate, ok := m.templates[id]
+	if !ok {
+		return nil, newError(ENotFound, "base template with ID %s not found", id)
+	}
+	if baseTemplate.baseTemplateID != baseTemplate.id {
+		return nil, newError(
+			EBadArgument,
+			"template %s is version %d of template %s, not a base template",
+			id,
+			baseTemplate.versionNumber,
+			baseTemplate.baseTemplateID,
+		)
+	}
+	return baseTemplate, nil
+}
+
+// latestTemplateVersion returns the template with the highest version number in the family of the specified base
+// template. It must be called with the lock held.
+func (m *mockClient) latestTemplateVersion(baseTemplateID TemplateID) *template {
+	latest := m.templates[baseTemplateID]
+	for _, tpl := range m.templates {
+		if tpl.baseTemplateID == baseTemplateID && tpl.versionNumber > latest.versionNumber {
+			latest = tpl
+		}
+	}
+	return latest
+}
+
 func (m *mockClient) handlePostTemplateCreation(tpl *template) {
 	func() {
 		time.Sleep(2 * time.Second)
@@ -117,6 +223,25 @@ func (m *mockClient) handlePostTemplateCreation(tpl *template) {
 	}()
 }
 
+// copyTemplateNICs records the NICs of the VM on the template. It must be called with the lock held.
+func (m *mockClient) copyTemplateNICs(vmID VMID, tpl *template) {
+	var nics []*templateNIC
+	for _, item := range m.nics {
+		if item.vmid != vmID {
+			continue
+		}
+		nics = append(nics, &templateNIC{
+			client:        m,
+			id:            NICID(m.GenerateUUID()),
+			name:          item.name,
+			templateID:    tpl.id,
+			vnicProfileID: item.vnicProfileID,
+		})
+	}
+	sort.Slice(nics, func(i, j int) bool { return nics[i].name < nics[j].name })
+	m.templateNICsByTemplate[tpl.id] = nics
+}
+
 func (m *mockClient) attachTemplateDisks(vmID VMID, tpl *template) {
 	i := 0
 	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
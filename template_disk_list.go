@@ -11,6 +11,7 @@ func (o *oVirtClient) ListTemplateDiskAttachments(
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("listing disk attachments for template %s", templateID),
+		reading(ResourceTypeTemplate, string(templateID)),
 		o.logger,
 		retries,
 		func() error {
@@ -20,6 +21,7 @@ func (o *oVirtClient) ListTemplateDiskAttachments(
 				TemplateService(string(templateID)).
 				DiskAttachmentsService().
 				List().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			if err != nil {
 				return err
@@ -32,7 +34,7 @@ func (o *oVirtClient) ListTemplateDiskAttachments(
 			for i, attachment := range attachments.Slice() {
 				result[i], err = convertSDKTemplateDiskAttachment(attachment, o)
 				if err != nil {
-					return wrap(err, EBug, "failed to convert template disk attachment %d for template %s (%v)")
+					return wrap(err, EBug, "failed to convert template disk attachment %d for template %s", i, templateID)
 				}
 			}
 			return nil
@@ -0,0 +1,314 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// mockTemplateExportDelay is the time the mock keeps a template locked while it is exported.
+const mockTemplateExportDelay = time.Second
+
+// OptionalTemplateExportParameters contains the optional parameters for ExportTemplate and ExportTemplateToOVA.
+type OptionalTemplateExportParameters interface {
+	// Overwrite returns true if an existing export of the template at the destination should be replaced. If false,
+	// the export fails if the destination already contains the template.
+	Overwrite() bool
+	// FileName returns the name of the OVA file to create. It is only used by ExportTemplateToOVA. If empty, the
+	// name of the template with the .ova extension is used.
+	FileName() string
+}
+
+// BuildableTemplateExportParameters is a buildable version of OptionalTemplateExportParameters.
+type BuildableTemplateExportParameters interface {
+	OptionalTemplateExportParameters
+
+	// WithOverwrite sets if an existing export of the template at the destination should be replaced.
+	WithOverwrite(overwrite bool) (BuildableTemplateExportParameters, error)
+	// MustWithOverwrite is identical to WithOverwrite, but panics instead of returning an error.
+	MustWithOverwrite(overwrite bool) BuildableTemplateExportParameters
+
+	// WithFileName sets the name of the OVA file to create.
+	WithFileName(fileName string) (BuildableTemplateExportParameters, error)
+	// MustWithFileName is identical to WithFileName, but panics instead of returning an error.
+	MustWithFileName(fileName string) BuildableTemplateExportParameters
+}
+
+// TemplateExportParams creates a new set of optional parameters for exporting a template.
+func TemplateExportParams() BuildableTemplateExportParameters {
+	return &templateExportParams{}
+}
+
+type templateExportParams struct {
+	overwrite bool
+	fileName  string
+}
+
+func (t *templateExportParams) Overwrite() bool {
+	return t.overwrite
+}
+
+func (t *templateExportParams) FileName() string {
+	return t.fileName
+}
+
+func (t *templateExportParams) WithOverwrite(overwrite bool) (BuildableTemplateExportParameters, error) {
+	t.overwrite = overwrite
+	return t, nil
+}
+
+func (t *templateExportParams) MustWithOverwrite(overwrite bool) BuildableTemplateExportParameters {
+	builder, err := t.WithOverwrite(overwrite)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t *templateExportParams) WithFileName(fileName string) (BuildableTemplateExportParameters, error) {
+	if fileName == "" || path.Base(fileName) != fileName {
+		return nil, newError(EBadArgument, "invalid OVA file name: %s", fileName)
+	}
+	t.fileName = fileName
+	return t, nil
+}
+
+func (t *templateExportParams) MustWithFileName(fileName string) BuildableTemplateExportParameters {
+	builder, err := t.WithFileName(fileName)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) ExportTemplate(
+	templateID TemplateID,
+	exportDomainID StorageDomainID,
+	params OptionalTemplateExportParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = TemplateExportParams()
+	}
+	err := retry(
+		fmt.Sprintf("exporting template %s to export domain %s", templateID, exportDomainID),
+		mutating(ResourceTypeTemplate, string(templateID)).
+			withParameters(map[string]string{
+				"export_domain_id": string(exportDomainID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(templateID)).
+				Export().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(exportDomainID)).MustBuild()).
+				Exclusive(params.Overwrite()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	o.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeUpdated)
+	return nil
+}
+
+func (m *mockClient) ExportTemplate(
+	templateID TemplateID,
+	exportDomainID StorageDomainID,
+	params OptionalTemplateExportParameters,
+	_ ...RetryStrategy,
+) error {
+	if params == nil {
+		params = TemplateExportParams()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.storageDomains[exportDomainID]; !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", exportDomainID)
+	}
+	return m.exportTemplate(templateID, fmt.Sprintf("storage domain %s", exportDomainID), params)
+}
+
+func (o *oVirtClient) ExportTemplateToOVA(
+	templateID TemplateID,
+	hostID HostID,
+	directory string,
+	params OptionalTemplateExportParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = TemplateExportParams()
+	}
+	if err := validateTemplateOVADirectory(directory); err != nil {
+		return err
+	}
+	err := retry(
+		fmt.Sprintf("exporting template %s as OVA to %s on host %s", templateID, directory, hostID),
+		mutating(ResourceTypeTemplate, string(templateID)).
+			withParameters(map[string]string{
+				"host_id":   string(hostID),
+				"directory": directory,
+			}),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(templateID)).
+				ExportToPathOnHost().
+				Host(ovirtsdk.NewHostBuilder().Id(string(hostID)).MustBuild()).
+				Directory(directory).
+				Exclusive(params.Overwrite())
+			if fileName := params.FileName(); fileName != "" {
+				req.Filename(fileName)
+			}
+			_, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	o.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeUpdated)
+	return nil
+}
+
+func (m *mockClient) ExportTemplateToOVA(
+	templateID TemplateID,
+	hostID HostID,
+	directory string,
+	params OptionalTemplateExportParameters,
+	_ ...RetryStrategy,
+) error {
+	if params == nil {
+		params = TemplateExportParams()
+	}
+	if err := validateTemplateOVADirectory(directory); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	tpl, ok := m.templates[templateID]
+	if !ok {
+		return newError(ENotFound, "template with ID %s not found", templateID)
+	}
+	fileName := params.FileName()
+	if fileName == "" {
+		fileName = tpl.name + ".ova"
+	}
+	return m.exportTemplate(templateID, fmt.Sprintf("host %s:%s", hostID, path.Join(directory, fileName)), params)
+}
+
+// exportTemplate locks the template for the duration of a simulated export and records the destination, so a
+// second export to the same destination fails without overwrite. It must be called with the lock held.
+func (m *mockClient) exportTemplate(
+	templateID TemplateID,
+	destination string,
+	params OptionalTemplateExportParameters,
+) error {
+	tpl, ok := m.templates[templateID]
+	if !ok {
+		return newError(ENotFound, "template with ID %s not found", templateID)
+	}
+	if templateID == DefaultBlankTemplateID {
+		return newError(EBadArgument, "the blank template cannot be exported")
+	}
+	if tpl.status != TemplateStatusOK {
+		return newError(EConflict, "template %s is in status \"%s\"", templateID, tpl.status)
+	}
+	if _, ok := m.templateExports[destination]; ok && !params.Overwrite() {
+		return newError(EConflict, "template %s has already been exported to %s", templateID, destination)
+	}
+	m.templateExports[destination] = templateID
+	tpl.status = TemplateStatusLocked
+	m.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeUpdated)
+	go func() {
+		time.Sleep(mockTemplateExportDelay)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if tpl.status == TemplateStatusLocked {
+			tpl.status = TemplateStatusOK
+		}
+	}()
+	return nil
+}
+
+// validateTemplateOVADirectory checks that the OVA export directory is an absolute path, since the engine resolves it
+// on the host.
+func validateTemplateOVADirectory(directory string) error {
+	if !path.IsAbs(directory) {
+		return newError(EBadArgument, "the OVA export directory must be an absolute path (%s given)", directory)
+	}
+	return nil
+}
+
+func (o *oVirtClient) CopyTemplateToStorageDomain(
+	templateID TemplateID,
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (Template, error) {
+	return copyTemplateToStorageDomain(o, templateID, storageDomainID, retries)
+}
+
+func (m *mockClient) CopyTemplateToStorageDomain(
+	templateID TemplateID,
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (Template, error) {
+	return copyTemplateToStorageDomain(m, templateID, storageDomainID, retries)
+}
+
+// copyTemplateToStorageDomain copies all disks of the template that are not yet present on the storage domain one
+// after the other, since the engine locks the template while a disk is copied.
+func copyTemplateToStorageDomain(
+	client Client,
+	templateID TemplateID,
+	storageDomainID StorageDomainID,
+	retries []RetryStrategy,
+) (Template, error) {
+	attachments, err := client.ListTemplateDiskAttachments(templateID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		disk, err := client.GetDisk(attachment.DiskID(), retries...)
+		if err != nil {
+			return nil, err
+		}
+		if diskOnStorageDomain(disk, storageDomainID) {
+			continue
+		}
+		if _, err := client.CopyTemplateDiskToStorageDomain(disk.ID(), storageDomainID, retries...); err != nil {
+			return nil, wrap(
+				err,
+				EUnidentified,
+				"failed to copy disk %s of template %s to storage domain %s",
+				disk.ID(),
+				templateID,
+				storageDomainID,
+			)
+		}
+	}
+	return client.GetTemplate(templateID, retries...)
+}
+
+func diskOnStorageDomain(disk Disk, storageDomainID StorageDomainID) bool {
+	for _, id := range disk.StorageDomainIDs() {
+		if id == storageDomainID {
+			return true
+		}
+	}
+	return false
+}
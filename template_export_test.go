@@ -0,0 +1,72 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestCopyTemplateToStorageDomain(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	assertCanAttachDisk(t, vm, disk)
+	template := assertCanCreateTemplate(t, helper, vm)
+	tpl := assertCanGetTemplateOK(t, helper, template.ID())
+	secondarySD := helper.GetSecondaryStorageDomainID(t)
+
+	if _, err := helper.GetClient().CopyTemplateToStorageDomain(tpl.ID(), secondarySD); err != nil {
+		t.Fatalf("Failed to copy template %s to storage domain %s (%v)", tpl.ID(), secondarySD, err)
+	}
+
+	for _, attachment := range assertCanListTemplateDiskAttachments(t, tpl) {
+		templateDisk := assertCanGetDiskFromTemplateAttachment(t, helper, attachment)
+		assertCanGetDiskFromStorageDomain(t, helper, secondarySD, templateDisk)
+	}
+}
+
+func TestExportTemplateToOVA(t *testing.T) {
+	helper := getHelper(t)
+	client, ok := helper.GetClient().(ovirtclient.MockClient)
+	if !ok {
+		t.Skipf("Exporting an OVA requires a writable directory on a host, only running this test against the mock.")
+	}
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	template := assertCanCreateTemplate(t, helper, vm)
+	tpl := assertCanGetTemplateOK(t, helper, template.ID())
+
+	if err := client.ExportTemplateToOVA(tpl.ID(), hosts[0].ID(), "relative/path", nil); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Exporting to a relative path did not fail with EBadArgument (%v)", err)
+	}
+	if err := client.ExportTemplateToOVA(tpl.ID(), hosts[0].ID(), "/var/tmp", nil); err != nil {
+		t.Fatalf("Failed to export template %s as OVA (%v)", tpl.ID(), err)
+	}
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+
+	if err := client.ExportTemplateToOVA(tpl.ID(), hosts[0].ID(), "/var/tmp", nil); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EConflict,
+	) {
+		t.Fatalf("Exporting over an existing OVA without overwrite did not fail with EConflict (%v)", err)
+	}
+	if err := client.ExportTemplateToOVA(
+		tpl.ID(),
+		hosts[0].ID(),
+		"/var/tmp",
+		ovirtclient.TemplateExportParams().MustWithOverwrite(true),
+	); err != nil {
+		t.Fatalf("Failed to overwrite exported OVA of template %s (%v)", tpl.ID(), err)
+	}
+	assertCanGetTemplateOK(t, helper, tpl.ID())
+}
@@ -10,10 +10,17 @@ func (o *oVirtClient) GetTemplate(id TemplateID, retries ...RetryStrategy) (resu
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting template %s", id),
+		reading(ResourceTypeTemplate, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().TemplatesService().TemplateService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -6,7 +6,7 @@ func (o *oVirtClient) GetBlankTemplate(retries ...RetryStrategy) (result Templat
 		return nil, err
 	}
 	for _, tpl := range templateList {
-		if tpl.ID() == DefaultBlankTemplateID {
+		if IsBlankTemplateID(tpl.ID()) {
 			return tpl, nil
 		}
 	}
@@ -32,7 +32,7 @@ func (m *mockClient) GetBlankTemplate(retries ...RetryStrategy) (result Template
 		return nil, err
 	}
 	for _, tpl := range templateList {
-		if tpl.ID() == DefaultBlankTemplateID {
+		if IsBlankTemplateID(tpl.ID()) {
 			return tpl, nil
 		}
 	}
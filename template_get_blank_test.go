@@ -25,3 +25,16 @@ func TestTemplateBlank(t *testing.T) {
 		t.Fatalf("Factory-default blank template is not considered a blank template.")
 	}
 }
+
+func TestBlankTemplateCannotBeRemoved(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	if !ovirtclient.IsBlankTemplateID(ovirtclient.DefaultBlankTemplateID) {
+		t.Fatalf("The default blank template ID is not recognized as blank.")
+	}
+	err := helper.GetClient().RemoveTemplate(ovirtclient.DefaultBlankTemplateID)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Removing the default blank template did not fail with EBadArgument (%v)", err)
+	}
+}
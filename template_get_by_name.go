@@ -5,34 +5,47 @@ import (
 )
 
 func (o *oVirtClient) GetTemplateByName(templateName string, retries ...RetryStrategy) (result Template, err error) {
+	quotedName, err := quoteSearchString(templateName)
+	if err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting template by Name %s", templateName),
+		reading(ResourceTypeTemplate, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().TemplatesService().List().Search("name=" + templateName).Send()
+			response, err := o.conn.
+				SystemService().
+				TemplatesService().
+				List().
+				Search("name="+quotedName).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
+			var results []Template
 			for _, sdkObject := range response.MustTemplates().Slice() {
-				if mTemplate, ok := sdkObject.Name(); ok {
-					if templateName == mTemplate {
-						result, err = convertSDKTemplate(sdkObject, o)
-						if err != nil {
-							return wrap(
-								err,
-								EBug,
-								"failed to convert Template %s",
-								templateName,
-							)
-						}
-						return nil
-
+				if mTemplate, ok := sdkObject.Name(); ok && templateName == mTemplate {
+					item, err := convertSDKTemplate(sdkObject, o)
+					if err != nil {
+						return wrap(
+							err,
+							EBug,
+							"failed to convert Template %s",
+							templateName,
+						)
 					}
+					results = append(results, item)
 				}
 			}
-			return newError(ENotFound, "template with Name %s not found", templateName)
+			if err := checkNameMatches(len(results), "template", templateName); err != nil {
+				return err
+			}
+			result = results[0]
+			return nil
 		})
 	return result, err
 }
@@ -40,10 +53,14 @@ func (o *oVirtClient) GetTemplateByName(templateName string, retries ...RetryStr
 func (m *mockClient) GetTemplateByName(templateName string, _ ...RetryStrategy) (result Template, err error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+	var results []Template
 	for _, template := range m.templates {
 		if template.name == templateName {
-			return template, nil
+			results = append(results, template)
 		}
 	}
-	return nil, newError(ENotFound, "template with Name %s not found", templateName)
+	if err := checkNameMatches(len(results), "template", templateName); err != nil {
+		return nil, err
+	}
+	return results[0], nil
 }
@@ -0,0 +1,81 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetTemplateByNameAndVersion(
+	templateName string,
+	versionNumber uint,
+	retries ...RetryStrategy,
+) (result Template, err error) {
+	quotedName, err := quoteSearchString(templateName)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting template by name %s and version %d", templateName, versionNumber),
+		reading(ResourceTypeTemplate, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				TemplatesService().
+				List().
+				Search("name="+quotedName).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			var results []Template
+			for _, sdkObject := range response.MustTemplates().Slice() {
+				if mTemplate, ok := sdkObject.Name(); !ok || templateName != mTemplate {
+					continue
+				}
+				item, err := convertSDKTemplate(sdkObject, o)
+				if err != nil {
+					return wrap(
+						err,
+						EBug,
+						"failed to convert template %s",
+						templateName,
+					)
+				}
+				if item.VersionNumber() == versionNumber {
+					results = append(results, item)
+				}
+			}
+			if err := checkNameMatches(len(results), "template", templateVersionName(templateName, versionNumber)); err != nil {
+				return err
+			}
+			result = results[0]
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetTemplateByNameAndVersion(
+	templateName string,
+	versionNumber uint,
+	_ ...RetryStrategy,
+) (result Template, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var results []Template
+	for _, template := range m.templates {
+		if template.name == templateName && template.versionNumber == versionNumber {
+			results = append(results, template)
+		}
+	}
+	if err := checkNameMatches(len(results), "template", templateVersionName(templateName, versionNumber)); err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func templateVersionName(templateName string, versionNumber uint) string {
+	return fmt.Sprintf("%s and version %d", templateName, versionNumber)
+}
@@ -7,10 +7,16 @@ func (o *oVirtClient) ListTemplates(retries ...RetryStrategy) (result []Template
 	result = []Template{}
 	err = retry(
 		"listing templates",
+		reading(ResourceTypeTemplate, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().TemplatesService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				TemplatesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -0,0 +1,94 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// TemplateNICClient contains the methods to inspect the network interfaces of templates.
+type TemplateNICClient interface {
+	// ListTemplateNICs lists the network interfaces VMs created from the template will receive.
+	ListTemplateNICs(templateID TemplateID, retries ...RetryStrategy) ([]TemplateNIC, error)
+}
+
+// TemplateNICData contains the details of a network interface on a template.
+type TemplateNICData interface {
+	// ID is the identifier for this network interface.
+	ID() NICID
+	// Name is the user-given name of the network interface.
+	Name() string
+	// TemplateID returns the ID of the template the network interface belongs to.
+	TemplateID() TemplateID
+	// VNICProfileID returns the ID of the VNIC profile in use by the network interface.
+	VNICProfileID() VNICProfileID
+}
+
+// TemplateNIC contains all methods from TemplateNICData and also convenience functions to fetch related objects.
+type TemplateNIC interface {
+	TemplateNICData
+
+	// Template fetches the template this network interface belongs to.
+	Template(retries ...RetryStrategy) (Template, error)
+	// VNICProfile fetches the VNIC profile used by this network interface.
+	VNICProfile(retries ...RetryStrategy) (VNICProfile, error)
+}
+
+type templateNIC struct {
+	client Client
+
+	id            NICID
+	name          string
+	templateID    TemplateID
+	vnicProfileID VNICProfileID
+}
+
+func (t templateNIC) ID() NICID {
+	return t.id
+}
+
+func (t templateNIC) Name() string {
+	return t.name
+}
+
+func (t templateNIC) TemplateID() TemplateID {
+	return t.templateID
+}
+
+func (t templateNIC) VNICProfileID() VNICProfileID {
+	return t.vnicProfileID
+}
+
+func (t templateNIC) Template(retries ...RetryStrategy) (Template, error) {
+	return t.client.GetTemplate(t.templateID, retries...)
+}
+
+func (t templateNIC) VNICProfile(retries ...RetryStrategy) (VNICProfile, error) {
+	return t.client.GetVNICProfile(t.vnicProfileID, retries...)
+}
+
+func convertSDKTemplateNIC(sdkObject *ovirtsdk.Nic, templateID TemplateID, client Client) (TemplateNIC, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("template NIC", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound(fmt.Sprintf("template NIC %s", id), "name")
+	}
+	vnicProfile, ok := sdkObject.VnicProfile()
+	if !ok {
+		return nil, newFieldNotFound(fmt.Sprintf("template NIC %s", id), "vNIC profile")
+	}
+	vnicProfileID, ok := vnicProfile.Id()
+	if !ok {
+		return nil, newFieldNotFound(fmt.Sprintf("vNIC profile on template NIC %s", id), "ID")
+	}
+	return &templateNIC{
+		client:        client,
+		id:            NICID(id),
+		name:          name,
+		templateID:    templateID,
+		vnicProfileID: VNICProfileID(vnicProfileID),
+	}, nil
+}
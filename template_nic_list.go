@@ -0,0 +1,55 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListTemplateNICs(templateID TemplateID, retries ...RetryStrategy) (result []TemplateNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing NICs for template %s", templateID),
+		reading(ResourceTypeTemplate, string(templateID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(templateID)).
+				NicsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Nics()
+			if !ok {
+				return nil
+			}
+			result = make([]TemplateNIC, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKTemplateNIC(sdkObject, templateID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert NIC #%d of template %s", i, templateID)
+				}
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListTemplateNICs(templateID TemplateID, _ ...RetryStrategy) ([]TemplateNIC, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.templates[templateID]; !ok {
+		return nil, newError(ENotFound, "template with ID %s not found", templateID)
+	}
+	result := make([]TemplateNIC, len(m.templateNICsByTemplate[templateID]))
+	for i, item := range m.templateNICsByTemplate[templateID] {
+		result[i] = item
+	}
+	return result, nil
+}
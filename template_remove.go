@@ -9,21 +9,38 @@ func (o *oVirtClient) RemoveTemplate(
 	retries ...RetryStrategy,
 ) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateTemplateRemoval(templateID); err != nil {
+		return err
+	}
 	err = retry(
 		fmt.Sprintf("removing template %s", templateID),
+		mutating(ResourceTypeTemplate, string(templateID)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().TemplatesService().TemplateService(string(templateID)).Remove().Send()
+			_, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(templateID)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeRemoved)
+	}
 	return
 }
 
 func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	if err := validateTemplateRemoval(id); err != nil {
+		return err
+	}
 	err = retry(
 		fmt.Sprintf("removing template %s", id),
+		mutating(ResourceTypeTemplate, string(id)),
 		m.logger,
 		retries,
 		func() error {
@@ -45,12 +62,34 @@ func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (er
 				}
 			}
 
+			for _, version := range m.templates {
+				if version.id != id && version.baseTemplateID == id {
+					return newError(
+						EConflict,
+						"Template %s cannot be removed because it has sub-version %s.",
+						id,
+						version.id,
+					)
+				}
+			}
+
 			if tpl.status == TemplateStatusLocked {
 				return newError(EConflict, "Template %s is in status %s.", id, tpl.status)
 			}
 
 			delete(m.templates, id)
+			delete(m.templateNICsByTemplate, id)
 			return nil
 		})
+	if err == nil {
+		m.mutationListeners.notify(ResourceTypeTemplate, string(id), "", MutationTypeRemoved)
+	}
 	return err
 }
+
+func validateTemplateRemoval(templateID TemplateID) error {
+	if IsBlankTemplateID(templateID) {
+		return newError(EBadArgument, "the default blank template %s cannot be removed", templateID)
+	}
+	return nil
+}
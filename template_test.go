@@ -274,3 +274,49 @@ func assertCannotRemoveTemplate(t *testing.T, helper ovirtclient.TestHelper, tem
 		t.Fatalf("Successfully removed template %s despite assumption.", templateID)
 	}
 }
+
+func TestSealedTemplateCreation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithOS(ovirtclient.NewVMOSParameters().MustWithType("rhel_8x64")),
+	)
+	template, err := helper.GetClient().CreateTemplate(
+		vm.ID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.TemplateCreateParams().MustWithSeal(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create sealed template from VM %s (%v)", vm.ID(), err)
+	}
+	t.Cleanup(func() {
+		if err := template.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to clean up template %s after test. (%v)", template.ID(), err)
+		}
+	})
+	assertCanGetTemplateOK(t, helper, template.ID())
+}
+
+func TestSealedTemplateCreationFailsForWindowsVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelperMock(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithOS(ovirtclient.NewVMOSParameters().MustWithType("windows_2019x64")),
+	)
+	_, err := helper.GetClient().CreateTemplate(
+		vm.ID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.TemplateCreateParams().MustWithSeal(true),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EUnsupported) {
+		t.Fatalf("Sealing a template from a Windows VM did not fail with EUnsupported (%v)", err)
+	}
+}
@@ -0,0 +1,94 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateTemplate(
+	templateID TemplateID,
+	params UpdateTemplateParameters,
+	retries ...RetryStrategy,
+) (result Template, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		return nil, newError(EBadArgument, "the template update parameters must not be nil")
+	}
+	tpl := ovirtsdk.NewTemplateBuilder().Id(string(templateID))
+	if name := params.Name(); name != nil {
+		tpl.Name(*name)
+	}
+	if description := params.Description(); description != nil {
+		tpl.Description(*description)
+	}
+	err = retry(
+		fmt.Sprintf("updating template %s", templateID),
+		mutating(ResourceTypeTemplate, string(templateID)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(templateID)).
+				Update().
+				Template(tpl.MustBuild()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to update template %s", templateID)
+			}
+			sdkObject, ok := response.Template()
+			if !ok {
+				return newFieldNotFound("template update response", "template")
+			}
+			result, err = convertSDKTemplate(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert template %s", templateID)
+			}
+			return nil
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeUpdated)
+	}
+	return result, err
+}
+
+func (m *mockClient) UpdateTemplate(
+	templateID TemplateID,
+	params UpdateTemplateParameters,
+	_ ...RetryStrategy,
+) (Template, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if params == nil {
+		return nil, newError(EBadArgument, "the template update parameters must not be nil")
+	}
+	tpl, ok := m.templates[templateID]
+	if !ok {
+		return nil, newError(ENotFound, "template with ID %s not found", templateID)
+	}
+	if tpl.status == TemplateStatusLocked {
+		return nil, newError(EConflict, "Template %s is in status %s.", templateID, tpl.status)
+	}
+	if name := params.Name(); name != nil {
+		for _, other := range m.templates {
+			if other.id != templateID && other.name == *name {
+				return nil, newError(ENameInUse, "A template with the name \"%s\" already exists.", *name)
+			}
+		}
+	}
+
+	result := *tpl
+	if name := params.Name(); name != nil {
+		result.name = *name
+	}
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	m.templates[templateID] = &result
+	m.mutationListeners.notify(ResourceTypeTemplate, string(templateID), "", MutationTypeUpdated)
+	return &result, nil
+}
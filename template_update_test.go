@@ -0,0 +1,65 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestTemplateUpdate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	template := assertCanCreateTemplate(t, helper, vm)
+	template = assertCanGetTemplateOK(t, helper, template.ID())
+
+	newName := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	updatedTemplate, err := template.Update(
+		ovirtclient.UpdateTemplateParams().
+			MustWithName(newName).
+			MustWithDescription("Updated description"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update template %s (%v)", template.ID(), err)
+	}
+	if updatedTemplate.Name() != newName {
+		t.Fatalf("Incorrect template name after update: %s instead of %s", updatedTemplate.Name(), newName)
+	}
+
+	fetchedTemplate := assertCanGetTemplateOK(t, helper, template.ID())
+	if fetchedTemplate.Name() != newName {
+		t.Fatalf("Incorrect template name after fetching: %s instead of %s", fetchedTemplate.Name(), newName)
+	}
+	if fetchedTemplate.Description() != "Updated description" {
+		t.Fatalf("Incorrect template description after fetching: %s", fetchedTemplate.Description())
+	}
+}
+
+func TestTemplateNICs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	nic := assertCanCreateNIC(t, helper, vm, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	template := assertCanCreateTemplate(t, helper, vm)
+	template = assertCanGetTemplateOK(t, helper, template.ID())
+
+	nics, err := template.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of template %s (%v)", template.ID(), err)
+	}
+	if len(nics) != 1 {
+		t.Fatalf("Incorrect number of NICs on template %s: %d instead of 1", template.ID(), len(nics))
+	}
+	if nics[0].Name() != nic.Name() {
+		t.Fatalf("Incorrect template NIC name: %s instead of %s", nics[0].Name(), nic.Name())
+	}
+	if nics[0].VNICProfileID() != nic.VNICProfileID() {
+		t.Fatalf("Incorrect template NIC VNIC profile: %s instead of %s", nics[0].VNICProfileID(), nic.VNICProfileID())
+	}
+	if nics[0].TemplateID() != template.ID() {
+		t.Fatalf("Incorrect template ID on template NIC: %s instead of %s", nics[0].TemplateID(), template.ID())
+	}
+}
@@ -0,0 +1,119 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestTemplateSubVersion(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	baseTemplate := assertCanCreateTemplate(t, helper, vm)
+	baseTemplate = assertCanGetTemplateOK(t, helper, baseTemplate.ID())
+	if baseTemplate.VersionNumber() != 1 {
+		t.Fatalf("Incorrect version number of base template: %d instead of 1", baseTemplate.VersionNumber())
+	}
+	if baseTemplate.BaseTemplateID() != baseTemplate.ID() {
+		t.Fatalf("Incorrect base template ID of base template: %s", baseTemplate.BaseTemplateID())
+	}
+
+	subVersion := assertCanCreateTemplateVersion(t, helper, vm, baseTemplate.ID(), "v2")
+	if subVersion.VersionNumber() != 2 {
+		t.Fatalf("Incorrect version number of sub-version: %d instead of 2", subVersion.VersionNumber())
+	}
+	if subVersion.VersionName() != "v2" {
+		t.Fatalf("Incorrect version name of sub-version: %s instead of v2", subVersion.VersionName())
+	}
+	if subVersion.BaseTemplateID() != baseTemplate.ID() {
+		t.Fatalf(
+			"Incorrect base template ID of sub-version: %s instead of %s",
+			subVersion.BaseTemplateID(),
+			baseTemplate.ID(),
+		)
+	}
+	if subVersion.Name() != baseTemplate.Name() {
+		t.Fatalf("Incorrect name of sub-version: %s instead of %s", subVersion.Name(), baseTemplate.Name())
+	}
+}
+
+func TestVMCreationFromLatestTemplateVersion(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	baseTemplate := assertCanCreateTemplate(t, helper, vm)
+	baseTemplate = assertCanGetTemplateOK(t, helper, baseTemplate.ID())
+	subVersion := assertCanCreateTemplateVersion(t, helper, vm, baseTemplate.ID(), "latest")
+
+	newVM := assertCanCreateVMFromTemplate(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		baseTemplate.ID(),
+		ovirtclient.NewCreateVMParams().WithUseLatestTemplateVersion(true),
+	)
+	if newVM.TemplateID() != subVersion.ID() {
+		t.Fatalf("VM was created from template %s instead of the latest version %s", newVM.TemplateID(), subVersion.ID())
+	}
+}
+
+func TestGetTemplateByNameAndVersion(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	baseTemplate := assertCanCreateTemplate(t, helper, vm)
+	baseTemplate = assertCanGetTemplateOK(t, helper, baseTemplate.ID())
+	subVersion := assertCanCreateTemplateVersion(t, helper, vm, baseTemplate.ID(), "v2")
+
+	for _, expected := range []ovirtclient.Template{baseTemplate, subVersion} {
+		tpl, err := helper.GetClient().GetTemplateByNameAndVersion(expected.Name(), expected.VersionNumber())
+		if err != nil {
+			t.Fatalf(
+				"Failed to get template %s by name and version %d (%v)",
+				expected.Name(),
+				expected.VersionNumber(),
+				err,
+			)
+		}
+		if tpl.ID() != expected.ID() {
+			t.Fatalf("Incorrect template returned: %s instead of %s", tpl.ID(), expected.ID())
+		}
+	}
+
+	_, err := helper.GetClient().GetTemplateByNameAndVersion(baseTemplate.Name(), 3)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting a non-existent template version did not return an ENotFound error (%v)", err)
+	}
+}
+
+func assertCanCreateTemplateVersion(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	vm ovirtclient.VM,
+	baseTemplateID ovirtclient.TemplateID,
+	versionName string,
+) ovirtclient.Template {
+	t.Logf("Creating version %s of template %s from VM %s...", versionName, baseTemplateID, vm.Name())
+	template, err := helper.GetClient().CreateTemplate(
+		vm.ID(),
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.TemplateCreateParams().
+			MustWithBaseTemplateID(baseTemplateID).
+			MustWithVersionName(versionName),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create version %s of template %s (%v)", versionName, baseTemplateID, err)
+	}
+	t.Cleanup(func() {
+		t.Logf("Cleaning up template %s...", template.ID())
+		if err := template.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to clean up template %s after test. (%v)", template.ID(), err)
+		}
+	})
+	return assertCanGetTemplateOK(t, helper, template.ID())
+}
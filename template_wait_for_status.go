@@ -12,6 +12,7 @@ func (o *oVirtClient) WaitForTemplateStatus(
 	retries = defaultRetries(retries, defaultLongTimeouts(o))
 	err = retry(
 		fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status),
+		reading(ResourceTypeTemplate, string(id)).viaClient(),
 		o.logger,
 		retries,
 		func() error {
@@ -35,6 +36,7 @@ func (m *mockClient) WaitForTemplateStatus(
 	retries = defaultRetries(retries, defaultLongTimeouts(m))
 	err = retry(
 		fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status),
+		reading(ResourceTypeTemplate, string(id)),
 		nil,
 		retries,
 		func() error {
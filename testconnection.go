@@ -4,12 +4,22 @@ package ovirtclient
 type TestConnectionClient interface {
 	// Test tests if the connection is alive or not.
 	Test(retries ...RetryStrategy) error
+	// Healthcheck runs a preflight check of the connection in stages and returns the version of the oVirt Engine. The
+	// stages can be told apart by the error code of the returned error:
+	//
+	// - EConnection: the engine URL is not reachable.
+	// - ETLSError: the TLS certificate of the engine could not be verified.
+	// - ENotAnOVirtEngine: the URL does not point to the oVirt Engine API.
+	// - EAccessDenied or EUserAccountLocked: the credentials were rejected.
+	// - EUnsupported: the engine API version is not supported by this library.
+	Healthcheck(retries ...RetryStrategy) (EngineVersion, error)
 }
 
 func (o *oVirtClient) Test(retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	return retry(
 		"testing oVirt engine connection",
+		reading("", ""),
 		o.logger,
 		retries,
 		func() error {
@@ -22,6 +32,7 @@ func (m *mockClient) Test(retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultReadTimeouts(m))
 	return retry(
 		"testing oVirt engine connection",
+		reading("", ""),
 		nil,
 		retries,
 		func() error {
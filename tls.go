@@ -1,11 +1,16 @@
 package ovirtclient
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 )
 
@@ -45,6 +50,36 @@ type BuildableTLSProvider interface {
 	// CACertsFromCertPool sets a certificate pool to use as a source for certificates. This is incompatible with  the
 	// CACertsFromSystem call as both create a certificate pool. This function must not be called twice.
 	CACertsFromCertPool(*x509.CertPool) BuildableTLSProvider
+
+	// ClientCertificate adds a PEM-encoded client certificate and private key to present to the oVirt Engine, for
+	// example when the engine sits behind a proxy requiring mutual TLS. This function can be called multiple times
+	// to add multiple certificates. Client certificates are also sent if Insecure is called afterwards.
+	ClientCertificate(certPEM []byte, keyPEM []byte) BuildableTLSProvider
+
+	// ClientCertificateFromFile adds a client certificate and private key from PEM-encoded files.
+	ClientCertificateFromFile(certFile string, keyFile string) BuildableTLSProvider
+
+	// PinCertificateFingerprint trusts the engine certificate if its SHA-256 fingerprint matches, as printed by
+	// "openssl x509 -noout -fingerprint -sha256". Colons are optional and the case does not matter. This function can
+	// be called multiple times to accept several certificates, for example while the engine certificate is replaced.
+	// If no CA certificates are added, only the fingerprint is checked. Otherwise, the certificate must also be signed
+	// by one of the CAs. See GetEngineCertificate and CertificateFingerprint for fetching the fingerprint.
+	PinCertificateFingerprint(fingerprint string) BuildableTLSProvider
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of a certificate as colon-separated uppercase hex, in the
+// same format as OpenSSL prints it. It can be passed to BuildableTLSProvider.PinCertificateFingerprint.
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return formatCertificateFingerprint(sum[:])
+}
+
+func formatCertificateFingerprint(sum []byte) string {
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
 }
 
 // TLS creates a BuildableTLSProvider that can be used to easily add trusted CA certificates and generally follows best
@@ -56,14 +91,23 @@ func TLS() BuildableTLSProvider {
 }
 
 type standardTLSProvider struct {
-	lock        *sync.Mutex
-	insecure    bool
-	caCerts     [][]byte
-	files       []string
-	directories []standardTLSProviderDirectory
-	certPool    *x509.CertPool
-	system      bool
-	configured  bool
+	lock         *sync.Mutex
+	insecure     bool
+	caCerts      [][]byte
+	files        []string
+	directories  []standardTLSProviderDirectory
+	certPool     *x509.CertPool
+	system       bool
+	configured   bool
+	clientCerts  []standardTLSProviderClientCert
+	fingerprints []string
+}
+
+type standardTLSProviderClientCert struct {
+	certPEM  []byte
+	keyPEM   []byte
+	certFile string
+	keyFile  string
 }
 
 type standardTLSProviderDirectory struct {
@@ -90,6 +134,34 @@ func (s *standardTLSProvider) CACertsFromCertPool(certPool *x509.CertPool) Build
 	return s
 }
 
+func (s *standardTLSProvider) ClientCertificate(certPEM []byte, keyPEM []byte) BuildableTLSProvider {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clientCerts = append(s.clientCerts, standardTLSProviderClientCert{
+		certPEM: certPEM,
+		keyPEM:  keyPEM,
+	})
+	return s
+}
+
+func (s *standardTLSProvider) ClientCertificateFromFile(certFile string, keyFile string) BuildableTLSProvider {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clientCerts = append(s.clientCerts, standardTLSProviderClientCert{
+		certFile: certFile,
+		keyFile:  keyFile,
+	})
+	return s
+}
+
+func (s *standardTLSProvider) PinCertificateFingerprint(fingerprint string) BuildableTLSProvider {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.configured = true
+	s.fingerprints = append(s.fingerprints, fingerprint)
+	return s
+}
+
 func (s *standardTLSProvider) CACertsFromMemory(caCert []byte) BuildableTLSProvider {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -134,9 +206,14 @@ func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 			"TLS not configured (Did you forget to call certificate configuration options on the TLS provider?)",
 		)
 	}
+	certificates, err := s.loadClientCerts()
+	if err != nil {
+		return nil, err
+	}
 	if s.insecure {
 		return &tls.Config{
 			InsecureSkipVerify: true, //nolint:gosec
+			Certificates:       certificates,
 		}, nil
 	}
 	tlsConfig := &tls.Config{
@@ -162,6 +239,20 @@ func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 		DynamicRecordSizingDisabled: false,
 		Renegotiation:               0,
 		KeyLogWriter:                nil,
+		Certificates:                certificates,
+	}
+
+	if len(s.fingerprints) > 0 {
+		pins, err := parseCertificateFingerprints(s.fingerprints)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.VerifyPeerCertificate = verifyCertificateFingerprint(pins)
+		if !s.hasCACerts() {
+			// The certificate is verified by its fingerprint in VerifyPeerCertificate instead of the CA chain.
+			tlsConfig.InsecureSkipVerify = true //nolint:gosec
+			return tlsConfig, nil
+		}
 	}
 
 	certPool := s.certPool
@@ -184,6 +275,86 @@ func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
+// hasCACerts returns true if any CA certificate source has been configured. It must be called with the lock held.
+func (s *standardTLSProvider) hasCACerts() bool {
+	return len(s.caCerts) > 0 || len(s.files) > 0 || len(s.directories) > 0 || s.certPool != nil || s.system
+}
+
+// parseCertificateFingerprints decodes the hex-encoded SHA-256 fingerprints passed to PinCertificateFingerprint.
+func parseCertificateFingerprints(fingerprints []string) ([][]byte, error) {
+	result := make([][]byte, len(fingerprints))
+	for i, fingerprint := range fingerprints {
+		pin, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+		if err != nil {
+			return nil, wrap(err, ETLSError, "the pinned certificate fingerprint %s is not hex-encoded", fingerprint)
+		}
+		if len(pin) != sha256.Size {
+			return nil, newError(
+				ETLSError,
+				"the pinned certificate fingerprint %s is not a SHA-256 fingerprint (%d bytes instead of %d)",
+				fingerprint,
+				len(pin),
+				sha256.Size,
+			)
+		}
+		result[i] = pin
+	}
+	return result, nil
+}
+
+// verifyCertificateFingerprint returns a function for tls.Config.VerifyPeerCertificate that accepts the server
+// certificate only if its fingerprint matches one of the pins. Only the leaf certificate is checked because the
+// engine CA is typically self-signed and not what the user copied the fingerprint from.
+func verifyCertificateFingerprint(pins [][]byte) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return newError(ETLSError, "the server did not present a certificate")
+		}
+		sum := sha256.Sum256(rawCerts[0])
+		for _, pin := range pins {
+			if bytes.Equal(pin, sum[:]) {
+				return nil
+			}
+		}
+		return newError(
+			ETLSError,
+			"the server certificate fingerprint %s does not match any pinned fingerprint",
+			formatCertificateFingerprint(sum[:]),
+		)
+	}
+}
+
+func (s *standardTLSProvider) loadClientCerts() ([]tls.Certificate, error) {
+	var certificates []tls.Certificate
+	for i, clientCert := range s.clientCerts {
+		if clientCert.certFile == "" {
+			certificate, err := tls.X509KeyPair(clientCert.certPEM, clientCert.keyPEM)
+			if err != nil {
+				return nil, wrap(
+					err,
+					ETLSError,
+					"the provided client certificate number #%d or its private key is not valid in PEM format",
+					i,
+				)
+			}
+			certificates = append(certificates, certificate)
+			continue
+		}
+		certificate, err := tls.LoadX509KeyPair(clientCert.certFile, clientCert.keyFile)
+		if err != nil {
+			return nil, wrap(
+				err,
+				ETLSError,
+				"failed to load client certificate from %s with private key %s",
+				clientCert.certFile,
+				clientCert.keyFile,
+			)
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates, nil
+}
+
 func (s *standardTLSProvider) addCertsFromDir(certPool *x509.CertPool) error {
 	for _, dir := range s.directories {
 		files, err := os.ReadDir(dir.dir)
@@ -217,7 +388,7 @@ func (s *standardTLSProvider) addCertsFromDir(certPool *x509.CertPool) error {
 				return wrap(
 					err,
 					EFileReadFailed,
-					"failed to read certificate file: %s (%w)",
+					"failed to read certificate file: %s",
 					fullPath,
 				)
 			}
@@ -254,7 +425,7 @@ func (s *standardTLSProvider) addCertsFromMemory(certPool *x509.CertPool) error
 	for i, caCert := range s.caCerts {
 		if ok := certPool.AppendCertsFromPEM(caCert); !ok {
 			return newError(
-				EBadArgument,
+				ETLSError,
 				"the provided CA certificate number #%d is not a valid certificate in PEM format",
 				i,
 			)
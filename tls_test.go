@@ -3,7 +3,12 @@ package ovirtclient_test
 import (
 	"crypto/x509"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
+	"testing"
+	"time"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
@@ -61,3 +66,92 @@ func ExampleBuildableTLSProvider_CACertsFromCertPool() {
 	}
 	// Output: Certificate verification is enabled.
 }
+
+func TestTLSClientCertificate(t *testing.T) {
+	t.Parallel()
+	caPrivKey, caCert, caBytes, err := createCA()
+	if err != nil {
+		t.Fatalf("failed to create CA (%v)", err)
+	}
+	clientKey, clientCert, err := createSignedCert(
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		caPrivKey,
+		caCert,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client certificate (%v)", err)
+	}
+
+	tlsConfig, err := ovirtclient.TLS().
+		CACertsFromMemory(caBytes).
+		ClientCertificate(clientCert, clientKey).
+		CreateTLSConfig()
+	if err != nil {
+		t.Fatalf("failed to create TLS config with a client certificate (%v)", err)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("incorrect number of client certificates: %d", len(tlsConfig.Certificates))
+	}
+
+	_, err = ovirtclient.TLS().
+		CACertsFromMemory(caBytes).
+		ClientCertificate(clientCert, []byte("not a key")).
+		CreateTLSConfig()
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETLSError) {
+		t.Fatalf("an invalid client key did not result in an ETLSError (%v)", err)
+	}
+
+	_, err = ovirtclient.TLS().
+		CACertsFromMemory(caBytes).
+		ClientCertificateFromFile("/nonexistent/client.pem", "/nonexistent/client.key").
+		CreateTLSConfig()
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETLSError) {
+		t.Fatalf("a missing client certificate file did not result in an ETLSError (%v)", err)
+	}
+}
+
+func TestTLSCertificatePinning(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cert, err := ovirtclient.GetEngineCertificate(server.URL, 10*time.Second)
+	if err != nil {
+		t.Fatalf("failed to fetch the server certificate (%v)", err)
+	}
+	fingerprint := ovirtclient.CertificateFingerprint(cert)
+	if fingerprint != ovirtclient.CertificateFingerprint(server.Certificate()) {
+		t.Fatalf("the fetched certificate does not match the server certificate")
+	}
+
+	request := func(tls ovirtclient.TLSProvider) error {
+		tlsConfig, err := tls.CreateTLSConfig()
+		if err != nil {
+			return err
+		}
+		client := &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		}
+		response, err := client.Get(server.URL)
+		if err != nil {
+			return err
+		}
+		return response.Body.Close()
+	}
+	if err := request(ovirtclient.TLS().PinCertificateFingerprint(
+		strings.ToLower(strings.ReplaceAll(fingerprint, ":", "")),
+	)); err != nil {
+		t.Fatalf("the request failed with the pinned certificate fingerprint (%v)", err)
+	}
+	otherFingerprint := strings.Repeat("00:", 31) + "00"
+	if err := request(ovirtclient.TLS().PinCertificateFingerprint(otherFingerprint)); err == nil {
+		t.Fatalf("the request succeeded with a non-matching certificate fingerprint")
+	}
+
+	_, err = ovirtclient.TLS().PinCertificateFingerprint("not a fingerprint").CreateTLSConfig()
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETLSError) {
+		t.Fatalf("an invalid certificate fingerprint did not result in an ETLSError (%v)", err)
+	}
+}
@@ -0,0 +1,60 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// listDiskIDsByAlias returns the IDs of the disks that currently have the specified alias. Actions such as copying a
+// disk or importing an image do not return the disk they create, so the IDs are recorded before sending the action
+// and passed to findNewDiskByAlias to identify the new disk afterwards.
+func (o *oVirtClient) listDiskIDsByAlias(alias string, retries []RetryStrategy) (map[DiskID]struct{}, error) {
+	disks, err := o.ListDisksByAlias(alias, retries...)
+	if err != nil {
+		return nil, err
+	}
+	diskIDs := make(map[DiskID]struct{}, len(disks))
+	for _, disk := range disks {
+		diskIDs[disk.ID()] = struct{}{}
+	}
+	return diskIDs, nil
+}
+
+// findNewDiskByAlias waits for a disk with the specified alias to appear on the storage domain, skipping the disks in
+// existingDiskIDs, which had the alias before the action creating the disk was sent.
+func (o *oVirtClient) findNewDiskByAlias(
+	alias string,
+	storageDomainID StorageDomainID,
+	existingDiskIDs map[DiskID]struct{},
+	retries []RetryStrategy,
+) (result Disk, err error) {
+	err = retry(
+		fmt.Sprintf("looking up new disk %s on storage domain %s", alias, storageDomainID),
+		reading(ResourceTypeStorageDomain, string(storageDomainID)).viaClient(),
+		o.logger,
+		retries,
+		func() error {
+			disks, err := o.ListDisksByAlias(alias, retries...)
+			if err != nil {
+				return err
+			}
+			for _, disk := range disks {
+				if _, ok := existingDiskIDs[disk.ID()]; ok {
+					continue
+				}
+				for _, sdID := range disk.StorageDomainIDs() {
+					if sdID == storageDomainID {
+						result = disk
+						return nil
+					}
+				}
+			}
+			return newError(
+				EPending,
+				"new disk %s not yet visible on storage domain %s",
+				alias,
+				storageDomainID,
+			)
+		},
+	)
+	return result, err
+}
@@ -0,0 +1,14 @@
+package ovirtclient
+
+// checkNameMatches returns an ENotFound error if no resource matched a name lookup and an EMultipleResults error if
+// more than one did, since names are not guaranteed to be unique for all resource types.
+func checkNameMatches(count int, kind string, name string) error {
+	switch {
+	case count == 0:
+		return newError(ENotFound, "no %s found with name %s", kind, name)
+	case count > 1:
+		return newError(EMultipleResults, "%d %ss found with name %s", count, kind, name)
+	default:
+		return nil
+	}
+}
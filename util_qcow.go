@@ -5,7 +5,45 @@ import (
 	"io"
 )
 
-func extractQCOWParameters(fileSize uint64, reader io.ReadSeekCloser) (
+// ImageInfo describes an image file as detected from its header.
+type ImageInfo interface {
+	// Format returns the detected format of the image.
+	Format() ImageFormat
+	// VirtualSize returns the size of the disk the image represents. For raw images this is the file size, for
+	// QCOW2 images it is read from the header.
+	VirtualSize() uint64
+}
+
+// DetectImageFormat reads the header of an image and returns the format and virtual size of the image. The size
+// parameter is the size of the image file in bytes. The reader is rewound to the start of the file afterwards.
+//
+// QCOW2 images that reference a backing file are rejected with an EBadArgument error since the backing file would
+// not be uploaded alongside the image.
+func DetectImageFormat(size uint64, reader io.ReadSeeker) (ImageInfo, error) {
+	format, virtualSize, err := extractQCOWParameters(size, reader)
+	if err != nil {
+		return nil, err
+	}
+	return &imageInfo{
+		format:      format,
+		virtualSize: virtualSize,
+	}, nil
+}
+
+type imageInfo struct {
+	format      ImageFormat
+	virtualSize uint64
+}
+
+func (i imageInfo) Format() ImageFormat {
+	return i.format
+}
+
+func (i imageInfo) VirtualSize() uint64 {
+	return i.virtualSize
+}
+
+func extractQCOWParameters(fileSize uint64, reader io.ReadSeeker) (
 	ImageFormat,
 	uint64,
 	error,
@@ -18,12 +56,28 @@ func extractQCOWParameters(fileSize uint64, reader io.ReadSeekCloser) (
 	if err != nil {
 		return "", 0, wrap(err, EBadArgument, "failed to read QCOW header")
 	}
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return "", 0, wrap(err, EBadArgument, "failed to seek to the start of the image after reading the header")
+	}
 
 	isQCOW := string(header[0:len(qcowMagicBytes)]) == qcowMagicBytes
 	if !isQCOW {
 		format = ImageFormatRaw
 	} else {
 		// See https://people.gnome.org/~markmc/qcow-image-format.html
+		version := binary.BigEndian.Uint32(header[qcowVersionStartByte : qcowVersionStartByte+4])
+		if version != 2 && version != 3 {
+			return format, 0, newError(EBadArgument, "unsupported QCOW version %d, only QCOW2 images are supported", version)
+		}
+		backingFileOffset := binary.BigEndian.Uint64(
+			header[qcowBackingFileOffsetStartByte : qcowBackingFileOffsetStartByte+8],
+		)
+		if backingFileOffset != 0 {
+			return format, 0, newError(
+				EBadArgument,
+				"the QCOW2 image references a backing file, please flatten it using qemu-img convert before uploading",
+			)
+		}
 		qcowSize = binary.BigEndian.Uint64(header[qcowSizeStartByte : qcowSizeStartByte+8])
 	}
 	if qcowSize <= 0 {
@@ -31,3 +85,18 @@ func extractQCOWParameters(fileSize uint64, reader io.ReadSeekCloser) (
 	}
 	return format, qcowSize, err
 }
+
+// validateUploadFormat checks if an image in the source format can be uploaded to a disk in the target format. The
+// oVirt Engine can write raw data into a QCOW2 disk, but cannot convert QCOW2 images to raw.
+func validateUploadFormat(sourceFormat ImageFormat, targetFormat ImageFormat) error {
+	if sourceFormat == ImageFormatCow && targetFormat == ImageFormatRaw {
+		return newError(
+			EBadArgument,
+			"the image is in %s format, but the target disk is %s; please convert the image using "+
+				"qemu-img convert -O raw before uploading",
+			sourceFormat,
+			targetFormat,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDetectImageFormat(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name          string
+		image         []byte
+		expectFormat  ovirtclient.ImageFormat
+		expectSize    uint64
+		expectErrCode ovirtclient.ErrorCode
+	}{
+		{
+			name:         "raw",
+			image:        make([]byte, 1024),
+			expectFormat: ovirtclient.ImageFormatRaw,
+			expectSize:   1024,
+		},
+		{
+			name:         "qcow2",
+			image:        buildTestQCOWHeader(3, 0, 1048576),
+			expectFormat: ovirtclient.ImageFormatCow,
+			expectSize:   1048576,
+		},
+		{
+			name:          "qcow2-backing-file",
+			image:         buildTestQCOWHeader(3, 512, 1048576),
+			expectErrCode: ovirtclient.EBadArgument,
+		},
+		{
+			name:          "qcow1",
+			image:         buildTestQCOWHeader(1, 0, 1048576),
+			expectErrCode: ovirtclient.EBadArgument,
+		},
+	}
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			reader := bytes.NewReader(tc.image)
+			info, err := ovirtclient.DetectImageFormat(uint64(len(tc.image)), reader)
+			if tc.expectErrCode != "" {
+				if !ovirtclient.HasErrorCode(err, tc.expectErrCode) {
+					t.Fatalf("Expected %s error, got %v", tc.expectErrCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to detect image format (%v)", err)
+			}
+			if info.Format() != tc.expectFormat {
+				t.Fatalf("Detected format %s instead of %s.", info.Format(), tc.expectFormat)
+			}
+			if info.VirtualSize() != tc.expectSize {
+				t.Fatalf("Detected virtual size %d instead of %d.", info.VirtualSize(), tc.expectSize)
+			}
+			if reader.Len() != len(tc.image) {
+				t.Fatalf("The reader was not rewound after detecting the image format.")
+			}
+		})
+	}
+}
+
+func buildTestQCOWHeader(version uint32, backingFileOffset uint64, size uint64) []byte {
+	header := make([]byte, 512)
+	copy(header, "QFI\xfb")
+	binary.BigEndian.PutUint32(header[4:8], version)
+	binary.BigEndian.PutUint64(header[8:16], backingFileOffset)
+	binary.BigEndian.PutUint64(header[24:32], size)
+	return header
+}
@@ -6,6 +6,8 @@ import (
 	"math/rand"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,6 +39,10 @@ type TestHelper interface {
 	// GetVNICProfileID returns a VNIC profile ID for testing.
 	GetVNICProfileID() VNICProfileID
 
+	// GetSecondaryVNICProfileID returns the ID of a VNIC profile for testing that is on a different network than the
+	// one returned by GetVNICProfileID. If no secondary network is available, the test will be skipped.
+	GetSecondaryVNICProfileID(t *testing.T) VNICProfileID
+
 	// GetTLS returns the TLS provider used for this test helper.
 	GetTLS() TLSProvider
 
@@ -46,8 +52,22 @@ type TestHelper interface {
 	// GetPassword returns the oVirt password.
 	GetPassword() string
 
-	// GenerateTestResourceName generates a test resource name from the test name.
+	// GenerateTestResourceName generates a test resource name in the format goovirtclient-<unix>-<name>-<random>,
+	// where <unix> is the creation time in Unix seconds, <name> is the test name and <random> is a random ID.
+	// Characters not allowed in resource names are replaced with an underscore. The prefix is specific to this
+	// library, so RemoveStaleTestResources does not touch resources created by other test suites. The engine does not
+	// report when a disk was created, so RemoveStaleResources parses the age of floating disks from the embedded
+	// creation time.
 	GenerateTestResourceName(t *testing.T) string
+
+	// EnsureVM creates a VM from the blank template with a generated test resource name. The VM, along with the disks
+	// and NICs attached to it later, is removed using TeardownVMs when the test finishes. The test fails if the VM
+	// cannot be created.
+	EnsureVM(t *testing.T, params OptionalVMParameters) VM
+
+	// RemoveStaleTestResources removes the VMs, templates and floating disks named by GenerateTestResourceName that
+	// are older than maxAge. This cleans up after test runs that crashed before their cleanup could run.
+	RemoveStaleTestResources(maxAge time.Duration) ([]TeardownResult, error)
 }
 
 // MustNewTestHelper is identical to NewTestHelper, but panics instead of returning an error.
@@ -123,6 +143,16 @@ func NewTestHelper(
 		return nil, err
 	}
 
+	secondaryVNICProfileID, err := setupSecondaryVNICProfileID(
+		params.SecondaryVNICProfileID(),
+		vnicProfileID,
+		clusterID,
+		client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &testHelper{
 		username:                 username,
 		password:                 password,
@@ -133,6 +163,7 @@ func NewTestHelper(
 		secondaryStorageDomainID: secondaryStorageDomainID,
 		blankTemplateID:          blankTemplateID,
 		vnicProfileID:            vnicProfileID,
+		secondaryVNICProfileID:   secondaryVNICProfileID,
 		// We are suppressing gosec linting here since rand is not used in a security-relevant context,
 		// only to generate random ID's for testing.
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
@@ -166,6 +197,10 @@ type TestHelperParameters interface {
 	// VNICProfileID returns an ID to a VNIC profile designated for testing. It may return
 	// an empty string, in which case an arbitrary VNIC profile is selected.
 	VNICProfileID() VNICProfileID
+	// SecondaryVNICProfileID returns an ID to a VNIC profile designated for testing that is on a different network
+	// than VNICProfileID. It may return an empty string if no VNIC profile is designated, in which case a VNIC
+	// profile is selected if available.
+	SecondaryVNICProfileID() VNICProfileID
 }
 
 // BuildableTestHelperParameters is a buildable version of the TestHelperParameters.
@@ -183,6 +218,9 @@ type BuildableTestHelperParameters interface {
 	WithBlankTemplateID(TemplateID) BuildableTestHelperParameters
 	// WithVNICProfileID sets the ID of the VNIC profile that can be used for testing.
 	WithVNICProfileID(VNICProfileID) BuildableTestHelperParameters
+	// WithSecondaryVNICProfileID sets the ID of the VNIC profile that can be used for testing, which must be on a
+	// different network than the primary VNIC profile.
+	WithSecondaryVNICProfileID(VNICProfileID) BuildableTestHelperParameters
 }
 
 type testHelperParameters struct {
@@ -191,6 +229,7 @@ type testHelperParameters struct {
 	secondaryStorageDomainID StorageDomainID
 	blankTemplateID          TemplateID
 	vnicProfileID            VNICProfileID
+	secondaryVNICProfileID   VNICProfileID
 }
 
 func (t *testHelperParameters) WithSecondaryStorageDomainID(s StorageDomainID) BuildableTestHelperParameters {
@@ -238,6 +277,15 @@ func (t *testHelperParameters) WithVNICProfileID(s VNICProfileID) BuildableTestH
 	return t
 }
 
+func (t *testHelperParameters) SecondaryVNICProfileID() VNICProfileID {
+	return t.secondaryVNICProfileID
+}
+
+func (t *testHelperParameters) WithSecondaryVNICProfileID(s VNICProfileID) BuildableTestHelperParameters {
+	t.secondaryVNICProfileID = s
+	return t
+}
+
 func setupVNICProfileID(vnicProfileID VNICProfileID, clusterID ClusterID, client Client) (VNICProfileID, error) {
 	if vnicProfileID != "" {
 		_, err := client.GetVNICProfile(vnicProfileID)
@@ -246,11 +294,55 @@ func setupVNICProfileID(vnicProfileID VNICProfileID, clusterID ClusterID, client
 		}
 		return vnicProfileID, nil
 	}
+	vnicProfileID, err := findTestVNICProfileID("", clusterID, client)
+	if err != nil {
+		return "", err
+	}
+	return vnicProfileID, nil
+}
+
+func setupSecondaryVNICProfileID(
+	vnicProfileID VNICProfileID,
+	primaryVNICProfileID VNICProfileID,
+	clusterID ClusterID,
+	client Client,
+) (VNICProfileID, error) {
+	primaryVNICProfile, err := client.GetVNICProfile(primaryVNICProfileID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch primary VNIC profile %s (%w)", primaryVNICProfileID, err)
+	}
+	if vnicProfileID != "" {
+		vnicProfile, err := client.GetVNICProfile(vnicProfileID)
+		if err != nil {
+			return "", fmt.Errorf("failed to verify secondary VNIC profile ID %s", vnicProfileID)
+		}
+		if vnicProfile.NetworkID() == primaryVNICProfile.NetworkID() {
+			return "", fmt.Errorf(
+				"secondary VNIC profile %s is on the same network as the primary VNIC profile %s",
+				vnicProfileID,
+				primaryVNICProfileID,
+			)
+		}
+		return vnicProfileID, nil
+	}
+	vnicProfileID, err = findTestVNICProfileID(primaryVNICProfile.NetworkID(), clusterID, client)
+	if err != nil && !errors.Is(err, errNoTestVNICProfileFound) {
+		return "", err
+	}
+	return vnicProfileID, nil
+}
+
+var errNoTestVNICProfileFound = fmt.Errorf("failed to find a valid VNIC profile ID for testing")
+
+func findTestVNICProfileID(skipNetworkID NetworkID, clusterID ClusterID, client Client) (VNICProfileID, error) {
 	vnicProfiles, err := client.ListVNICProfiles()
 	if err != nil {
 		return "", fmt.Errorf("failed to list VNIC profiles (%w)", err)
 	}
 	for _, vnicProfile := range vnicProfiles {
+		if skipNetworkID != "" && vnicProfile.NetworkID() == skipNetworkID {
+			continue
+		}
 		network, err := vnicProfile.Network()
 		if err != nil {
 			return "", fmt.Errorf("failed to fetch network %s (%w)", vnicProfile.NetworkID(), err)
@@ -267,7 +359,7 @@ func setupVNICProfileID(vnicProfileID VNICProfileID, clusterID ClusterID, client
 			return vnicProfile.ID(), nil
 		}
 	}
-	return "", fmt.Errorf("failed to find a valid VNIC profile ID for testing")
+	return "", errNoTestVNICProfileFound
 }
 
 func setupBlankTemplateID(blankTemplateID TemplateID, client Client) (id TemplateID, err error) {
@@ -432,6 +524,7 @@ type testHelper struct {
 	blankTemplateID          TemplateID
 	vnicProfileID            VNICProfileID
 	secondaryStorageDomainID StorageDomainID
+	secondaryVNICProfileID   VNICProfileID
 	password                 string
 	username                 string
 }
@@ -439,7 +532,54 @@ type testHelper struct {
 var resourceNameRe = regexp.MustCompile(`[^a-zA-Z\d_.-]`)
 
 func (t *testHelper) GenerateTestResourceName(test *testing.T) string {
-	return resourceNameRe.ReplaceAllString(fmt.Sprintf("%s-%s", test.Name(), t.GenerateRandomID(5)), "_")
+	return resourceNameRe.ReplaceAllString(
+		fmt.Sprintf("%s%d-%s-%s", testResourceNamePrefix, time.Now().Unix(), test.Name(), t.GenerateRandomID(5)),
+		"_",
+	)
+}
+
+func (t *testHelper) EnsureVM(test *testing.T, params OptionalVMParameters) VM {
+	test.Helper()
+	name := t.GenerateTestResourceName(test)
+	vm, err := t.client.CreateVM(t.clusterID, t.blankTemplateID, name, params)
+	if err != nil {
+		test.Fatalf("Failed to create test VM %s (%v)", name, err)
+	}
+	test.Cleanup(func() {
+		if _, err := t.client.TeardownVMs([]VMID{vm.ID()}); err != nil {
+			test.Fatalf("Failed to tear down test VM %s (%v)", vm.ID(), err)
+		}
+	})
+	return vm
+}
+
+// testResourceNamePrefix is the prefix of all names generated by GenerateTestResourceName. It is specific to this
+// library so stale resources of other projects testing against the same engine are left alone.
+const testResourceNamePrefix = "goovirtclient-"
+
+func (t *testHelper) RemoveStaleTestResources(maxAge time.Duration) ([]TeardownResult, error) {
+	params, err := NewStaleResourceParams().
+		MustWithNamePrefix(testResourceNamePrefix).
+		MustWithDiskCreationTime(testResourceCreationTime).
+		WithMaxAge(maxAge)
+	if err != nil {
+		return nil, err
+	}
+	return t.client.RemoveStaleResources(params)
+}
+
+// testResourceCreationTime returns the creation time GenerateTestResourceName put in a name, or the zero time if the
+// name was not generated by it.
+func testResourceCreationTime(name string) time.Time {
+	if !strings.HasPrefix(name, testResourceNamePrefix) {
+		return time.Time{}
+	}
+	parts := strings.SplitN(strings.TrimPrefix(name, testResourceNamePrefix), "-", 2)
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
 }
 
 func (t *testHelper) GetUsername() string {
@@ -465,6 +605,13 @@ func (t *testHelper) GetVNICProfileID() VNICProfileID {
 	return t.vnicProfileID
 }
 
+func (t *testHelper) GetSecondaryVNICProfileID(te *testing.T) VNICProfileID {
+	if t.secondaryVNICProfileID == "" {
+		te.Skipf("No secondary VNIC profile available, skipping test.")
+	}
+	return t.secondaryVNICProfileID
+}
+
 func (t *testHelper) GetClient() Client {
 	return t.client
 }
@@ -513,6 +660,10 @@ func NewMockTestHelper(logger ovirtclientlog.Logger) (TestHelper, error) {
 //
 // The username for the oVirt engine. Mandatory.
 //
+//	OVIRT_PROFILE
+//
+// The authentication profile, such as internal. If set, OVIRT_USERNAME must not contain the profile.
+//
 //	OVIRT_PASSWORD
 //
 // The password for the oVirt engine. Mandatory.
@@ -541,9 +692,19 @@ func NewMockTestHelper(logger ovirtclientlog.Logger) (TestHelper, error) {
 //
 // Storage domain to use for testing. Will be automatically chosen if not provided.
 //
+//	OVIRT_SECONDARY_STORAGE_DOMAIN_ID
+//
+// A second storage domain to use for testing, for example for moving disks. Will be automatically chosen if not
+// provided. Tests requiring it are skipped if none is available.
+//
 //	OVIRT_VNIC_PROFILE_ID
 //
 // VNIC profile to use for testing. Will be automatically chosen if not provided.
+//
+//	OVIRT_SECONDARY_VNIC_PROFILE_ID
+//
+// A VNIC profile on a different network than OVIRT_VNIC_PROFILE_ID. Will be automatically chosen if not provided.
+// Tests requiring it are skipped if none is available.
 func NewLiveTestHelperFromEnv(logger ovirtclientlog.Logger) (TestHelper, error) {
 	// Note: if this function changes please update the documentation above and also doc.go.
 	url, tls, err := getConnectionParametersForLiveTesting()
@@ -554,6 +715,12 @@ func NewLiveTestHelperFromEnv(logger ovirtclientlog.Logger) (TestHelper, error)
 	if user == "" {
 		return nil, fmt.Errorf("the OVIRT_USER environment variable must not be empty")
 	}
+	if profile := os.Getenv("OVIRT_PROFILE"); profile != "" {
+		user, err = joinUsernameProfile(user, profile)
+		if err != nil {
+			return nil, wrap(err, EBadArgument, "invalid OVIRT_PROFILE environment variable")
+		}
+	}
 	password := os.Getenv("OVIRT_PASSWORD")
 	if password == "" {
 		return nil, fmt.Errorf("the OVIRT_PASSWORD environment variable must not be empty")
@@ -565,6 +732,7 @@ func NewLiveTestHelperFromEnv(logger ovirtclientlog.Logger) (TestHelper, error)
 	params.WithStorageDomainID(StorageDomainID(os.Getenv("OVIRT_STORAGE_DOMAIN_ID")))
 	params.WithSecondaryStorageDomainID(StorageDomainID(os.Getenv("OVIRT_SECONDARY_STORAGE_DOMAIN_ID")))
 	params.WithVNICProfileID(VNICProfileID(os.Getenv("OVIRT_VNIC_PROFILE_ID")))
+	params.WithSecondaryVNICProfileID(VNICProfileID(os.Getenv("OVIRT_SECONDARY_VNIC_PROFILE_ID")))
 
 	helper, err := NewTestHelper(
 		url,
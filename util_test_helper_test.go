@@ -0,0 +1,29 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHelperEnsureVMCleansUp(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	// The disk is created outside the subtest so its own cleanup doesn't run before the VM teardown.
+	disk := assertCanCreateDisk(t, helper)
+	var vm ovirtclient.VM
+	t.Run("fixture", func(t *testing.T) {
+		vm = helper.EnsureVM(t, nil)
+		assertCanAttachDisk(t, vm, disk)
+		assertCanCreateNIC(t, helper, vm, "eth0", nil)
+	})
+
+	if _, err := client.GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The fixture VM %s was not removed after the test (%v)", vm.ID(), err)
+	}
+	if _, err := client.GetDisk(disk.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The disk %s of the fixture VM was not removed after the test (%v)", disk.ID(), err)
+	}
+}
@@ -0,0 +1,119 @@
+package ovirtclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ParityScenario is a single scenario executed by CheckMockParity against both the mock and the live client.
+type ParityScenario interface {
+	// Name returns the name of the scenario used when reporting differences.
+	Name() string
+	// Run executes the scenario using the specified test helper. The returned error is compared between the mock
+	// and the live run.
+	Run(helper TestHelper) error
+}
+
+// NewParityScenario creates a ParityScenario from a function. The function receives the test helper, so it can
+// use the infrastructure IDs, which differ between the mock and the live engine.
+func NewParityScenario(name string, run func(helper TestHelper) error) ParityScenario {
+	return &parityScenario{
+		name: name,
+		run:  run,
+	}
+}
+
+type parityScenario struct {
+	name string
+	run  func(helper TestHelper) error
+}
+
+func (p *parityScenario) Name() string {
+	return p.name
+}
+
+func (p *parityScenario) Run(helper TestHelper) error {
+	return p.run(helper)
+}
+
+// ParityDifference describes a scenario where the mock and the live client behaved differently.
+type ParityDifference interface {
+	// Scenario returns the name of the scenario.
+	Scenario() string
+	// MockErr returns the error returned by the scenario against the mock client, or nil if it succeeded.
+	MockErr() error
+	// LiveErr returns the error returned by the scenario against the live client, or nil if it succeeded.
+	LiveErr() error
+	// String returns a human-readable description of the difference.
+	String() string
+}
+
+type parityDifference struct {
+	scenario string
+	mockErr  error
+	liveErr  error
+}
+
+func (p *parityDifference) Scenario() string {
+	return p.scenario
+}
+
+func (p *parityDifference) MockErr() error {
+	return p.mockErr
+}
+
+func (p *parityDifference) LiveErr() error {
+	return p.liveErr
+}
+
+func (p *parityDifference) String() string {
+	return fmt.Sprintf(
+		"scenario %s: mock returned %s, live returned %s",
+		p.scenario,
+		describeParityResult(p.mockErr),
+		describeParityResult(p.liveErr),
+	)
+}
+
+// CheckMockParity runs each scenario against the mock and the live test helper and returns the scenarios where the
+// two behaved differently. Two runs behave the same if both succeed, or if both fail with the same error code. The
+// error messages are not compared since they are not part of the API. The scenarios are responsible for cleaning up
+// the resources they create.
+func CheckMockParity(mock TestHelper, live TestHelper, scenarios []ParityScenario) []ParityDifference {
+	var differences []ParityDifference
+	for _, scenario := range scenarios {
+		mockErr := scenario.Run(mock)
+		liveErr := scenario.Run(live)
+		if parityResultsMatch(mockErr, liveErr) {
+			continue
+		}
+		differences = append(differences, &parityDifference{
+			scenario: scenario.Name(),
+			mockErr:  mockErr,
+			liveErr:  liveErr,
+		})
+	}
+	return differences
+}
+
+func parityResultsMatch(mockErr error, liveErr error) bool {
+	if mockErr == nil || liveErr == nil {
+		return mockErr == nil && liveErr == nil
+	}
+	return parityErrorCode(mockErr) == parityErrorCode(liveErr)
+}
+
+func parityErrorCode(err error) ErrorCode {
+	var engineErr EngineError
+	if errors.As(err, &engineErr) {
+		return engineErr.Code()
+	}
+	return EUnidentified
+}
+
+func describeParityResult(err error) string {
+	if err == nil {
+		return "no error"
+	}
+	return fmt.Sprintf("%s (%v)", parityErrorCode(err), err)
+}
@@ -0,0 +1,75 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+const nonExistentID = "d8e2b5c0-0000-4000-8000-000000000000"
+
+var mockParityScenarios = []ovirtclient.ParityScenario{
+	ovirtclient.NewParityScenario("get non-existent VM", func(helper ovirtclient.TestHelper) error {
+		_, err := helper.GetClient().GetVM(nonExistentID)
+		return err
+	}),
+	ovirtclient.NewParityScenario("get non-existent disk", func(helper ovirtclient.TestHelper) error {
+		_, err := helper.GetClient().GetDisk(nonExistentID)
+		return err
+	}),
+	ovirtclient.NewParityScenario("create VM with duplicate name", func(helper ovirtclient.TestHelper) error {
+		client := helper.GetClient()
+		name := fmt.Sprintf("parity-%s", helper.GenerateRandomID(5))
+		vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = vm.Remove()
+		}()
+		duplicate, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, nil)
+		if err == nil {
+			_ = duplicate.Remove()
+		}
+		return err
+	}),
+}
+
+func TestMockParity(t *testing.T) {
+	if os.Getenv("OVIRT_URL") == "" {
+		t.Skipf("OVIRT_URL is not set, skipping mock parity test.")
+	}
+	differences := ovirtclient.CheckMockParity(getHelperMock(t), getHelperLive(t), mockParityScenarios)
+	for _, difference := range differences {
+		t.Errorf("The mock differs from the live engine in %s", difference)
+	}
+}
+
+func TestMockParityReportsDifferences(t *testing.T) {
+	t.Parallel()
+	first := getHelperMock(t)
+	second := getHelperMock(t)
+	if differences := ovirtclient.CheckMockParity(first, second, mockParityScenarios); len(differences) != 0 {
+		t.Fatalf("Differences were reported between two identical mocks: %v", differences)
+	}
+
+	vm := assertCanCreateVM(t, first, first.GenerateTestResourceName(t), nil)
+	differences := ovirtclient.CheckMockParity(
+		first,
+		second,
+		[]ovirtclient.ParityScenario{
+			ovirtclient.NewParityScenario("get VM by name", func(helper ovirtclient.TestHelper) error {
+				_, err := helper.GetClient().GetVMByName(vm.Name())
+				return err
+			}),
+		},
+	)
+	if len(differences) != 1 {
+		t.Fatalf("Incorrect number of differences reported: %v", differences)
+	}
+	if differences[0].MockErr() != nil || !ovirtclient.HasErrorCode(differences[0].LiveErr(), ovirtclient.ENotFound) {
+		t.Fatalf("Incorrect difference reported: %s", differences[0])
+	}
+}
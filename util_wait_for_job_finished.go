@@ -24,10 +24,17 @@ import (
 func (o *oVirtClient) waitForJobFinished(correlationID string, retries []RetryStrategy) error {
 	return retry(
 		fmt.Sprintf("waiting for job with correlation ID %s to finish", correlationID),
+		reading("", ""),
 		o.logger,
 		retries,
 		func() error {
-			jobResp, err := o.conn.SystemService().JobsService().List().Search(fmt.Sprintf("correlation_id=%s", correlationID)).Send()
+			jobResp, err := o.conn.
+				SystemService().
+				JobsService().
+				List().
+				Search(fmt.Sprintf("correlation_id=%s", correlationID)).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
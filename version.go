@@ -0,0 +1,70 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// Version is a major.minor version number, such as the compatibility level of a cluster.
+type Version interface {
+	// Major returns the major version number.
+	Major() uint
+	// Minor returns the minor version number.
+	Minor() uint
+	// AtLeast returns true if this version is the same as or newer than major.minor.
+	AtLeast(major uint, minor uint) bool
+	// String returns the version in the major.minor format.
+	String() string
+}
+
+// NewVersion creates a new Version from the specified numbers.
+func NewVersion(major uint, minor uint) Version {
+	return &version{
+		major: major,
+		minor: minor,
+	}
+}
+
+type version struct {
+	major uint
+	minor uint
+}
+
+func (v *version) Major() uint {
+	return v.major
+}
+
+func (v *version) Minor() uint {
+	return v.minor
+}
+
+func (v *version) AtLeast(major uint, minor uint) bool {
+	if v.major != major {
+		return v.major > major
+	}
+	return v.minor >= minor
+}
+
+func (v *version) String() string {
+	return fmt.Sprintf("%d.%d", v.major, v.minor)
+}
+
+// buildSDKVersion converts a version into an SDK builder.
+func buildSDKVersion(version Version) *ovirtsdk.VersionBuilder {
+	return ovirtsdk.NewVersionBuilder().
+		Major(int64(version.Major())).
+		Minor(int64(version.Minor()))
+}
+
+func convertSDKVersion(sdkObject *ovirtsdk.Version) (Version, error) {
+	major, ok := sdkObject.Major()
+	if !ok {
+		return nil, newFieldNotFound("version", "major")
+	}
+	minor, ok := sdkObject.Minor()
+	if !ok {
+		return nil, newFieldNotFound("version", "minor")
+	}
+	return NewVersion(uint(major), uint(minor)), nil
+}
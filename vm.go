@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
@@ -16,7 +17,8 @@ type VMID string
 
 // VMClient includes the methods required to deal with virtual machines.
 type VMClient interface {
-	// CreateVM creates a virtual machine.
+	// CreateVM creates a virtual machine. The memory, CPU topology and disk parameters are applied in the same
+	// engine call as the creation, so the VM never exists with the settings of the template.
 	CreateVM(
 		clusterID ClusterID,
 		templateID TemplateID,
@@ -24,18 +26,67 @@ type VMClient interface {
 		optional OptionalVMParameters,
 		retries ...RetryStrategy,
 	) (VM, error)
+	// CreateVMs creates multiple VMs in parallel, running at most concurrency creations at the same time. The
+	// returned VMs are in the same order as the specs. If some VMs fail to create, a BulkError is returned with the
+	// failures keyed by VM name, and the slice contains nil for the failed VMs. If rollback is true, the VMs that were
+	// created successfully are removed again in case of a failure and no VMs are returned.
+	CreateVMs(
+		specs []CreateVMSpec,
+		concurrency uint,
+		rollback bool,
+		retries ...RetryStrategy,
+	) ([]VM, error)
+	// CreateVMWithNICs creates a VM same as CreateVM, waits for its disks to be copied from the template and then
+	// creates the specified NICs. If any of these steps fail after the VM has been created and rollback is true, the
+	// VM is removed along with its NICs and disks using TeardownVMs, and a BulkError is returned containing the
+	// original failure as well as the objects that could not be removed. Without rollback the partially created VM
+	// is returned along with the error.
+	CreateVMWithNICs(
+		clusterID ClusterID,
+		templateID TemplateID,
+		name string,
+		optional OptionalVMParameters,
+		nics []CreateVMNICSpec,
+		rollback bool,
+		retries ...RetryStrategy,
+	) (VM, error)
+	// EnsureVM makes sure a VM with the specified name exists in the cluster. If it doesn't exist, it is created
+	// from the template with the optional parameters, same as CreateVM. If it exists, the comment, description,
+	// memory, memory policy, CPU, BIOS type and OS type set in the optional parameters are compared with the VM and
+	// updated if they drifted. Parameters that are not set are left alone. The update fails with EObjectChanged if
+	// the VM is changed concurrently. If the VM exists in a different cluster, EConflict is returned.
+	EnsureVM(
+		clusterID ClusterID,
+		templateID TemplateID,
+		name string,
+		optional OptionalVMParameters,
+		retries ...RetryStrategy,
+	) (EnsureVMResult, error)
 	// GetVM returns a single virtual machine based on an ID.
 	GetVM(id VMID, retries ...RetryStrategy) (VM, error)
-	// GetVMByName returns a single virtual machine based on a Name.
+	// GetVMs fetches multiple VMs by their IDs, running at most concurrency requests at the same time. The returned
+	// VMs are in the same order as the IDs. If some VMs cannot be fetched, a BulkError is returned with the failures
+	// keyed by VM ID, and the slice contains nil for the failed VMs. See ResolveIDs for details.
+	GetVMs(ids []VMID, concurrency uint, retries ...RetryStrategy) ([]VM, error)
+	// GetVMByName returns a single virtual machine based on a Name. An ENotFound error is returned if no VM has the
+	// name, and an EMultipleResults error if more than one does.
 	GetVMByName(name string, retries ...RetryStrategy) (VM, error)
 	// UpdateVM updates the virtual machine with the given parameters.
 	// Use UpdateVMParams to obtain a builder for the params.
 	UpdateVM(id VMID, params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
+	// RenameVM changes the name of a VM. It checks for VMs with the same name in the datacenter of the VM first and
+	// returns an ENameInUse error listing the conflicting VM, since the error returned by the engine in this case
+	// does not identify it.
+	RenameVM(id VMID, name string, retries ...RetryStrategy) (VM, error)
 	// AutoOptimizeVMCPUPinningSettings sets the CPU settings to optimized.
 	AutoOptimizeVMCPUPinningSettings(id VMID, optimize bool, retries ...RetryStrategy) error
 	// StartVM triggers a VM start. The actual VM startup will take time and should be waited for via the
 	// WaitForVMStatus call.
 	StartVM(id VMID, retries ...RetryStrategy) error
+	// StartVMOnce starts a VM with one-time settings, such as a different boot order, a directly booted kernel, a
+	// cloud-init payload or stateless mode. The settings are not stored on the VM. The VM must be down. Use
+	// RunOnceParams to obtain a builder for the params.
+	StartVMOnce(id VMID, params RunOnceParameters, retries ...RetryStrategy) error
 	// StopVM triggers a VM power-off. The actual VM stop will take time and should be waited for via the
 	// WaitForVMStatus call. The force parameter will cause the shutdown to proceed even if a backup is currently
 	// running.
@@ -44,14 +95,70 @@ type VMClient interface {
 	// WaitForVMStatus call. The force parameter will cause the shutdown to proceed even if a backup is currently
 	// running.
 	ShutdownVM(id VMID, force bool, retries ...RetryStrategy) error
+	// ApplyNextRunConfiguration restarts a running VM that has a next run configuration, so the pending changes take
+	// effect. A reboot from within the guest is not enough for this, the VM is shut down and started again. If
+	// powerOff is true, the VM is powered off instead of shut down gracefully. VMs that are down or have no pending
+	// changes are left alone. The returned VM reflects the state after the VM has been started again, use
+	// WaitForVMStatus to wait for it to come up.
+	ApplyNextRunConfiguration(id VMID, powerOff bool, retries ...RetryStrategy) (VM, error)
 	// WaitForVMStatus waits for the VM to reach the desired status.
 	WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (VM, error)
+	// WatchVM reports the changes of a VM on the returned channel, so controllers can react to status changes
+	// without implementing their own polling loop. Use WatchParams to create the parameters, or pass nil for the
+	// defaults.
+	//
+	// The VM is fetched before WatchVM returns, and an error is returned if this fails. The first event contains this
+	// state with MutationTypeCreated. After that, the VM is checked every poll interval, as well as immediately when
+	// the VM or one of its sub-resources is changed through the same client, and an event with MutationTypeUpdated
+	// is sent if it changed. Changes between two checks are merged into one event. When the VM is removed, an event
+	// with MutationTypeRemoved and the last known state is sent and the channel is closed. Failed checks are logged
+	// and retried at the next interval.
+	//
+	// The watch runs until the stop function is called, which closes the channel. The channel is not buffered, so
+	// the checks pause while the caller does not read from it.
+	WatchVM(id VMID, params WatchParameters, retries ...RetryStrategy) (
+		events <-chan VMWatchEvent,
+		stop func(),
+		err error,
+	)
+	// WaitForVMUnlock waits for a VM created from a template to leave the image_locked status and for all of its
+	// disks to be unlocked. Starting the VM before that fails. If a disk ends up in a status other than OK, an
+	// EUnexpectedDiskStatus error is returned.
+	WaitForVMUnlock(id VMID, retries ...RetryStrategy) (VM, error)
 	// ListVMs returns a list of all virtual machines.
 	ListVMs(retries ...RetryStrategy) ([]VM, error)
+	// ListVMsWithDetails returns all virtual machines along with the requested sub-collections, such as NICs or disk
+	// attachments. The engine returns everything in a single response, which avoids one request per VM for inventory
+	// purposes.
+	ListVMsWithDetails(follow []VMFollow, retries ...RetryStrategy) ([]VMDetails, error)
 	// SearchVMs lists all virtual machines matching a certain criteria specified in params.
 	SearchVMs(params VMSearchParameters, retries ...RetryStrategy) ([]VM, error)
-	// RemoveVM removes a virtual machine specified by id.
+	// ListVMsOnHost lists the VMs currently running on a host, for example to check what a host maintenance affects.
+	ListVMsOnHost(hostID HostID, retries ...RetryStrategy) ([]VM, error)
+	// ListVMsInCluster lists the VMs in a cluster. The filtering is done by the engine, so it is faster than ListVMs
+	// in large environments.
+	ListVMsInCluster(clusterID ClusterID, retries ...RetryStrategy) ([]VM, error)
+	// GetVMOVF fetches the OVF configuration of a VM from the engine and parses it. DR tooling can use it to
+	// re-register the VM on another engine.
+	GetVMOVF(id VMID, retries ...RetryStrategy) (VMOVF, error)
+	// RemoveVM removes a virtual machine specified by id. The disks of the VM are removed with it unless
+	// RemoveVMDetachDisks is passed.
 	RemoveVM(id VMID, retries ...RetryStrategy) error
+	// TeardownVMs removes the specified VMs along with their disks. Each VM is stopped first, then its tags, NICs
+	// and disk attachments are removed before the VM and finally its disks are removed. The tags themselves are not
+	// removed since they may be used elsewhere. Shareable disks are detached, but not removed, since they may be
+	// attached to other VMs. The result for every object processed is returned. If any of them failed, a BulkError
+	// is returned in addition. The teardown of a VM stops at its first failure, VMs that no longer exist are reported
+	// as successfully removed. The Hosted Engine VM is refused with EHostedEngine before anything is changed, unless
+	// AllowHostedEngine is passed.
+	TeardownVMs(ids []VMID, retries ...RetryStrategy) ([]TeardownResult, error)
+	// RemoveStaleResources removes the VMs and templates left behind by crashed runs, for example by tests against a
+	// shared engine. Resources are removed if they are older than the specified maximum age and match the name
+	// prefix and tag. VMs are removed with TeardownVMs, including their attached disks. Templates can't be tagged,
+	// so they are only removed if no tag is set. The engine does not report the creation time of disks, so disks that
+	// are not attached to a VM or template are only removed if no tag is set and the creation time can be determined
+	// from the alias, see StaleResourceParameters.DiskCreationTime.
+	RemoveStaleResources(params StaleResourceParameters, retries ...RetryStrategy) ([]TeardownResult, error)
 	// AddTagToVM Add tag specified by id to a VM.
 	AddTagToVM(id VMID, tagID TagID, retries ...RetryStrategy) error
 	// AddTagToVMByName Add tag specified by Name to a VM.
@@ -96,6 +203,9 @@ type VMClient interface {
 	// The returned result will be a map of network interface names and the list of non-local IP addresses assigned to
 	// them.
 	WaitForNonLocalVMIPAddress(id VMID, retries ...RetryStrategy) (map[string][]net.IP, error)
+	// ListVMReportedDevices lists the devices the guest agent reports for a VM, including the MAC and IP addresses
+	// per device. The list is empty if the VM is not running or has no guest agent.
+	ListVMReportedDevices(id VMID, retries ...RetryStrategy) ([]VMReportedDevice, error)
 }
 
 // VMIPSearchParams contains the parameters for searching or waiting for IP addresses on a VM.
@@ -235,6 +345,31 @@ type VMData interface {
 	InstanceTypeID() *InstanceTypeID
 	// VMType returns the VM type for the current VM.
 	VMType() VMType
+	// BIOSType returns the chipset and firmware combination the VM is started with.
+	BIOSType() VMBIOSType
+	// TPMEnabled returns true if the VM has a virtual TPM device.
+	TPMEnabled() bool
+	// NextRunConfigurationExists returns true if the VM has configuration changes that only take effect after the
+	// VM is restarted, for example after the compatibility version of its cluster has been raised.
+	NextRunConfigurationExists() bool
+	// TimeZone returns the name of the time zone the hardware clock of the VM is set to. It is empty if the engine
+	// did not report a time zone, in which case the engine default applies.
+	TimeZone() string
+	// DeleteProtected returns true if the VM cannot be removed until delete protection is disabled.
+	DeleteProtected() bool
+	// CreationTime returns the time the VM was created. It is the zero time if the engine did not report it.
+	CreationTime() time.Time
+	// EmulatedMachine returns the machine type the VM is emulated with, such as pc-q35-rhel8.6.0. It is empty if the
+	// VM uses the default of its cluster.
+	EmulatedMachine() string
+	// CustomCompatibilityVersion returns the compatibility version the VM uses instead of the one of its cluster. It
+	// is nil if the VM uses the compatibility version of its cluster.
+	CustomCompatibilityVersion() Version
+	// HostedEngine returns true if the VM runs the oVirt Engine itself in a Hosted Engine setup. Destructive calls,
+	// such as RemoveVM, refuse to act on this VM unless AllowHostedEngine is passed.
+	HostedEngine() bool
+	// CPUProfileID returns the ID of the CPU profile limiting the CPU usage of the VM.
+	CPUProfileID() CPUProfileID
 
 	// OS returns the operating system structure.
 	OS() VMOS
@@ -243,16 +378,142 @@ type VMData interface {
 // VMOS is the structure describing the virtual machine operating system, if set.
 type VMOS interface {
 	Type() string
+	// BootDevices returns the devices the VM attempts to boot from, in order.
+	BootDevices() []VMBootDevice
 }
 
 type vmOS struct {
-	t string
+	t           string
+	bootDevices []VMBootDevice
 }
 
 func (v vmOS) Type() string {
 	return v.t
 }
 
+func (v vmOS) BootDevices() []VMBootDevice {
+	return v.bootDevices
+}
+
+// withParams returns a copy of the OS with the set parameters applied.
+func (v *vmOS) withParams(params VMOSParameters) *vmOS {
+	result := &vmOS{
+		t:           v.t,
+		bootDevices: v.bootDevices,
+	}
+	if t := params.Type(); t != nil {
+		result.t = *t
+	}
+	if bootDevices := params.BootDevices(); bootDevices != nil {
+		result.bootDevices = bootDevices
+	}
+	return result
+}
+
+// VMBootDevice is a device a VM can boot from.
+type VMBootDevice string
+
+const (
+	// VMBootDeviceHD boots from the bootable disk of the VM.
+	VMBootDeviceHD VMBootDevice = "hd"
+	// VMBootDeviceCDROM boots from the CD inserted into the CD-ROM drive.
+	VMBootDeviceCDROM VMBootDevice = "cdrom"
+	// VMBootDeviceNetwork boots via PXE from the network. For PXE-based provisioning this device must come first in
+	// the boot order.
+	VMBootDeviceNetwork VMBootDevice = "network"
+)
+
+// Validate validates the boot device.
+func (v VMBootDevice) Validate() error {
+	for _, device := range VMBootDeviceValues() {
+		if device == v {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid boot device: %s must be one of: %v", v, VMBootDeviceValues())
+}
+
+// VMBootDeviceList is a list of VMBootDevice values.
+type VMBootDeviceList []VMBootDevice
+
+// Strings creates a string list of the values.
+func (l VMBootDeviceList) Strings() []string {
+	result := make([]string, len(l))
+	for i, device := range l {
+		result[i] = string(device)
+	}
+	return result
+}
+
+// VMBootDeviceValues returns all possible VMBootDevice values.
+func VMBootDeviceValues() VMBootDeviceList {
+	return []VMBootDevice{
+		VMBootDeviceHD,
+		VMBootDeviceCDROM,
+		VMBootDeviceNetwork,
+	}
+}
+
+// VMBIOSType is the combination of the emulated chipset and the firmware a VM is started with.
+type VMBIOSType string
+
+const (
+	// VMBIOSTypeClusterDefault uses the BIOS type configured on the cluster.
+	VMBIOSTypeClusterDefault VMBIOSType = "cluster_default"
+	// VMBIOSTypeI440FXSeaBIOS is the legacy i440FX chipset with SeaBIOS.
+	VMBIOSTypeI440FXSeaBIOS VMBIOSType = "i440fx_sea_bios"
+	// VMBIOSTypeQ35SeaBIOS is the Q35 chipset with SeaBIOS.
+	VMBIOSTypeQ35SeaBIOS VMBIOSType = "q35_sea_bios"
+	// VMBIOSTypeQ35OVMF is the Q35 chipset with UEFI firmware.
+	VMBIOSTypeQ35OVMF VMBIOSType = "q35_ovmf"
+	// VMBIOSTypeQ35SecureBoot is the Q35 chipset with UEFI firmware and secure boot enabled.
+	VMBIOSTypeQ35SecureBoot VMBIOSType = "q35_secure_boot"
+)
+
+// UEFI returns true if the BIOS type uses UEFI firmware.
+func (v VMBIOSType) UEFI() bool {
+	return v == VMBIOSTypeQ35OVMF || v == VMBIOSTypeQ35SecureBoot
+}
+
+// SecureBoot returns true if the BIOS type has secure boot enabled. oVirt has no separate secure boot flag, secure
+// boot is enabled by selecting VMBIOSTypeQ35SecureBoot.
+func (v VMBIOSType) SecureBoot() bool {
+	return v == VMBIOSTypeQ35SecureBoot
+}
+
+// Validate validates the BIOS type.
+func (v VMBIOSType) Validate() error {
+	for _, biosType := range VMBIOSTypeValues() {
+		if biosType == v {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid BIOS type: %s must be one of: %v", v, VMBIOSTypeValues())
+}
+
+// VMBIOSTypeList is a list of VMBIOSType values.
+type VMBIOSTypeList []VMBIOSType
+
+// Strings creates a string list of the values.
+func (l VMBIOSTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, biosType := range l {
+		result[i] = string(biosType)
+	}
+	return result
+}
+
+// VMBIOSTypeValues returns all possible VMBIOSType values.
+func VMBIOSTypeValues() VMBIOSTypeList {
+	return []VMBIOSType{
+		VMBIOSTypeClusterDefault,
+		VMBIOSTypeI440FXSeaBIOS,
+		VMBIOSTypeQ35SeaBIOS,
+		VMBIOSTypeQ35OVMF,
+		VMBIOSTypeQ35SecureBoot,
+	}
+}
+
 // VMPlacementPolicy is the structure that holds the rules for VM migration to other hosts.
 type VMPlacementPolicy interface {
 	Affinity() *VMAffinity
@@ -572,21 +833,33 @@ type VM interface {
 	// Update updates the virtual machine with the given parameters. Use UpdateVMParams to
 	// get a builder for the parameters.
 	Update(params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
+	// Rename changes the name of the VM, see VMClient.RenameVM for details.
+	Rename(name string, retries ...RetryStrategy) (VM, error)
+	// GetOVF fetches the parsed OVF configuration of the VM, see VMClient.GetVMOVF for details.
+	GetOVF(retries ...RetryStrategy) (VMOVF, error)
 	// Remove removes the current VM. This involves an API call and may be slow.
 	Remove(retries ...RetryStrategy) error
 
 	// Start will cause a VM to start. The actual start process takes some time and should be checked via WaitForStatus.
 	Start(retries ...RetryStrategy) error
+	// StartOnce starts the VM with one-time settings. See VMClient.StartVMOnce for details.
+	StartOnce(params RunOnceParameters, retries ...RetryStrategy) error
 	// Stop will cause the VM to power-off. The force parameter will cause the VM to stop even if a backup is currently
 	// running.
 	Stop(force bool, retries ...RetryStrategy) error
 	// Shutdown will cause the VM to shut down. The force parameter will cause the VM to shut down even if a backup
 	// is currently running.
 	Shutdown(force bool, retries ...RetryStrategy) error
+	// ApplyNextRunConfiguration restarts the VM if it has pending configuration changes. See
+	// VMClient.ApplyNextRunConfiguration for details.
+	ApplyNextRunConfiguration(powerOff bool, retries ...RetryStrategy) (VM, error)
 	// WaitForStatus will wait until the VM reaches the desired status. If the status is not reached within the
 	// specified amount of retries, an error will be returned. If the VM enters the desired state, an updated VM
 	// object will be returned.
 	WaitForStatus(status VMStatus, retries ...RetryStrategy) (VM, error)
+	// WaitForUnlock waits for the VM and its disks to be unlocked after creation from a template. It returns an
+	// updated VM object.
+	WaitForUnlock(retries ...RetryStrategy) (VM, error)
 
 	// CreateNIC creates a network interface on the current VM. This involves an API call and may be slow.
 	CreateNIC(name string, vnicProfileID VNICProfileID, params OptionalNICParameters, retries ...RetryStrategy) (NIC, error)
@@ -629,6 +902,9 @@ type VM interface {
 	// The returned result will be a map of network interface names and the list of IP addresses assigned to them,
 	// excluding any IP addresses and interfaces in the specified parameters.
 	WaitForIPAddresses(params VMIPSearchParams, retries ...RetryStrategy) (map[string][]net.IP, error)
+	// ListReportedDevices lists the devices reported by the guest agent. See VMClient.ListVMReportedDevices for
+	// details.
+	ListReportedDevices(retries ...RetryStrategy) ([]VMReportedDevice, error)
 	// WaitForNonLocalIPAddress waits for at least one IP address to be reported that is not in the following ranges:
 	//
 	// - 0.0.0.0/32
@@ -659,6 +935,12 @@ type VM interface {
 
 	// ListGraphicsConsoles lists the graphics consoles on the VM.
 	ListGraphicsConsoles(retries ...RetryStrategy) ([]VMGraphicsConsole, error)
+	// ListWatchdogs lists the watchdog devices on the VM.
+	ListWatchdogs(retries ...RetryStrategy) ([]VMWatchdog, error)
+	// ListErrata lists the errata available for the VM. See ErrataClient.ListVMErrata for details.
+	ListErrata(retries ...RetryStrategy) ([]Erratum, error)
+	// AddWatchdog adds a watchdog device to the VM.
+	AddWatchdog(model WatchdogModel, action WatchdogAction, retries ...RetryStrategy) (VMWatchdog, error)
 
 	// SerialConsole returns true if the VM has a serial console.
 	SerialConsole() bool
@@ -828,11 +1110,40 @@ type OptionalVMParameters interface {
 	// OS returns the operating system parameters, and true if the OS parameter has been set.
 	OS() (VMOSParameters, bool)
 
+	// BIOSType returns the chipset and firmware combination to use, if set.
+	BIOSType() *VMBIOSType
+
+	// TPMEnabled returns if a virtual TPM device should be added to the VM.
+	TPMEnabled() *bool
+
 	// SerialConsole returns if a serial console should be created or not.
 	SerialConsole() *bool
 
 	// SoundcardEnabled returns if a soundcard should be created or not.
 	SoundcardEnabled() *bool
+
+	// EmulatedMachine returns the machine type to emulate instead of the cluster default, if set.
+	EmulatedMachine() *string
+
+	// TimeZone returns the time zone of the hardware clock, if set.
+	TimeZone() *string
+
+	// CustomCompatibilityVersion returns the compatibility version to use instead of the one of the cluster, if set.
+	CustomCompatibilityVersion() Version
+
+	// UseLatestTemplateVersion returns true if the VM should be created from the latest version of the template
+	// family the passed template belongs to.
+	UseLatestTemplateVersion() *bool
+
+	// WaitForUnlock returns true if VM creation should only return once the VM and its disks are unlocked.
+	WaitForUnlock() *bool
+
+	// IdempotencyKey returns the key identifying the creation request, or an empty string if none is set.
+	IdempotencyKey() string
+
+	// CPUProfileID returns the ID of the CPU profile to assign to the VM. If it returns nil, the default profile of
+	// the cluster is used.
+	CPUProfileID() *CPUProfileID
 }
 
 // BuildableVMParameters is a variant of OptionalVMParameters that can be changed using the supplied
@@ -908,11 +1219,72 @@ type BuildableVMParameters interface {
 	// WithOS adds the operating system parameters to the VM creation.
 	WithOS(parameters VMOSParameters) BuildableVMParameters
 
+	// WithBIOSType sets the chipset and firmware combination for the VM. Use VMBIOSTypeQ35SecureBoot to enable
+	// secure boot.
+	WithBIOSType(biosType VMBIOSType) (BuildableVMParameters, error)
+	// MustWithBIOSType is identical to WithBIOSType, but panics instead of returning an error.
+	MustWithBIOSType(biosType VMBIOSType) BuildableVMParameters
+
+	// WithTPMEnabled adds or removes a virtual TPM device. A TPM requires a UEFI BIOS type and a cluster
+	// compatibility level of at least 4.6. VM creation fails with EUnsupported on older clusters.
+	WithTPMEnabled(tpmEnabled bool) BuildableVMParameters
+
 	// WithSerialConsole adds or removes a serial console to the VM.
 	WithSerialConsole(serialConsole bool) BuildableVMParameters
 
 	// WithSoundcardEnabled enables or disables a soundcard for the VM.
 	WithSoundcardEnabled(soundcardEnabled bool) BuildableVMParameters
+
+	// WithTimeZone sets the time zone of the hardware clock, for example "Etc/GMT" for Linux guests or
+	// "GMT Standard Time" for Windows guests. Windows guests expect the hardware clock in local time, so the time zone
+	// should match the one configured in the guest.
+	WithTimeZone(timeZone string) (BuildableVMParameters, error)
+	// MustWithTimeZone is identical to WithTimeZone, but panics instead of returning an error.
+	MustWithTimeZone(timeZone string) BuildableVMParameters
+
+	// WithEmulatedMachine sets the machine type to emulate, such as pc-q35-rhel8.6.0, instead of the cluster default.
+	WithEmulatedMachine(emulatedMachine string) (BuildableVMParameters, error)
+	// MustWithEmulatedMachine is identical to WithEmulatedMachine, but panics instead of returning an error.
+	MustWithEmulatedMachine(emulatedMachine string) BuildableVMParameters
+
+	// WithCustomCompatibilityVersion sets the compatibility version to use instead of the one of the cluster. This
+	// is typically used to keep a VM on an older version while its cluster is being upgraded.
+	WithCustomCompatibilityVersion(version Version) (BuildableVMParameters, error)
+	// MustWithCustomCompatibilityVersion is identical to WithCustomCompatibilityVersion, but panics instead of
+	// returning an error.
+	MustWithCustomCompatibilityVersion(version Version) BuildableVMParameters
+
+	// WithUseLatestTemplateVersion creates the VM from the latest version of the template family instead of the
+	// passed template version.
+	WithUseLatestTemplateVersion(useLatestTemplateVersion bool) BuildableVMParameters
+
+	// WithWaitForUnlock makes VM creation wait for the disks to be copied from the template before returning, same
+	// as calling WaitForVMUnlock.
+	WithWaitForUnlock(waitForUnlock bool) BuildableVMParameters
+
+	// WithIdempotencyKey sets a key identifying the creation request. If a VM has already been created with the
+	// same key, CreateVM returns that VM instead of creating a new one, so a creation can safely be repeated after
+	// a failure or a restart. The key may only contain letters, digits, dots, dashes and underscores.
+	//
+	// The created VM is marked with a tag named idempotency-<key>. If no VM has the tag, but a VM with the requested
+	// name exists, for example because the previous call failed before tagging it, that VM is tagged and returned.
+	// The tag is not removed along with the VM, use RemoveIdempotencyKey to remove it once the creation no longer
+	// needs to be repeated. Calls running at the same time with the same key are not detected and may create
+	// duplicate VMs.
+	WithIdempotencyKey(key string) (BuildableVMParameters, error)
+	// MustWithIdempotencyKey is identical to WithIdempotencyKey, but panics instead of returning an error.
+	MustWithIdempotencyKey(key string) BuildableVMParameters
+
+	// WithCPUProfileID sets the CPU profile of the VM, which limits its CPU usage through the QoS entry of the
+	// profile. The profile must belong to the cluster the VM is created in, see ListClusterCPUProfiles.
+	WithCPUProfileID(id CPUProfileID) (BuildableVMParameters, error)
+	// MustWithCPUProfileID is identical to WithCPUProfileID, but panics instead of returning an error.
+	MustWithCPUProfileID(id CPUProfileID) BuildableVMParameters
+
+	// Validate checks the parameters set so far and their combinations, returning an EBadArgument error naming the
+	// offending parameter. The With functions returning an error already check the combinations they affect, while
+	// the others, such as WithMemoryPolicy, are only checked here and on VM creation.
+	Validate() error
 }
 
 // VMCPUParams contain the CPU parameters for a VM.
@@ -1082,6 +1454,8 @@ func (v *vmCPUTopoParams) MustWithThreads(threads uint) BuildableVMCPUTopoParams
 type VMOSParameters interface {
 	// Type returns the type-string for the operating system.
 	Type() *string
+	// BootDevices returns the boot order for the VM. Returns nil if the boot order should not be changed.
+	BootDevices() []VMBootDevice
 }
 
 // BuildableVMOSParameters is a buildable version of VMOSParameters.
@@ -1090,6 +1464,11 @@ type BuildableVMOSParameters interface {
 
 	WithType(t string) (BuildableVMOSParameters, error)
 	MustWithType(t string) BuildableVMOSParameters
+
+	// WithBootDevices sets the devices the VM attempts to boot from, in order. Each device may only be listed once.
+	WithBootDevices(devices []VMBootDevice) (BuildableVMOSParameters, error)
+	// MustWithBootDevices is identical to WithBootDevices, but panics instead of returning an error.
+	MustWithBootDevices(devices []VMBootDevice) BuildableVMOSParameters
 }
 
 // NewVMOSParameters creates a new VMOSParameters structure.
@@ -1098,13 +1477,44 @@ func NewVMOSParameters() BuildableVMOSParameters {
 }
 
 type vmOSParameters struct {
-	t *string
+	t           *string
+	bootDevices []VMBootDevice
 }
 
 func (v *vmOSParameters) Type() *string {
 	return v.t
 }
 
+func (v *vmOSParameters) BootDevices() []VMBootDevice {
+	return v.bootDevices
+}
+
+func (v *vmOSParameters) WithBootDevices(devices []VMBootDevice) (BuildableVMOSParameters, error) {
+	if len(devices) == 0 {
+		return nil, newError(EBadArgument, "at least one boot device must be specified")
+	}
+	seen := map[VMBootDevice]bool{}
+	for _, device := range devices {
+		if err := device.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[device] {
+			return nil, newError(EBadArgument, "boot device %s is listed more than once", device)
+		}
+		seen[device] = true
+	}
+	v.bootDevices = devices
+	return v, nil
+}
+
+func (v *vmOSParameters) MustWithBootDevices(devices []VMBootDevice) BuildableVMOSParameters {
+	builder, err := v.WithBootDevices(devices)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func (v *vmOSParameters) WithType(t string) (BuildableVMOSParameters, error) {
 	v.t = &t
 	return v, nil
@@ -1338,6 +1748,9 @@ func (m *memoryPolicyParameters) Guaranteed() *int64 {
 }
 
 func (m *memoryPolicyParameters) WithGuaranteed(guaranteed int64) (BuildableMemoryPolicyParameters, error) {
+	if guaranteed < 0 {
+		return nil, newError(EBadArgument, "guaranteed memory must not be negative (%d)", guaranteed)
+	}
 	m.guaranteed = &guaranteed
 	return m, nil
 }
@@ -1355,6 +1768,9 @@ func (m *memoryPolicyParameters) Max() *int64 {
 }
 
 func (m *memoryPolicyParameters) WithMax(max int64) (BuildableMemoryPolicyParameters, error) {
+	if max <= 0 {
+		return nil, newError(EBadArgument, "maximum memory must be positive (%d)", max)
+	}
 	m.max = &max
 	return m, nil
 }
@@ -1399,6 +1815,8 @@ type OptionalVMDiskParameters interface {
 	Format() *ImageFormat
 	// StorageDomainID returns the optional storage domain ID to use for this disk.
 	StorageDomainID() *StorageDomainID
+	// ProvisionedSize returns the size in bytes the disk should have on the new VM, if set.
+	ProvisionedSize() *uint64
 }
 
 // BuildableVMDiskParameters is a buildable version of OptionalVMDiskParameters.
@@ -1421,6 +1839,12 @@ type BuildableVMDiskParameters interface {
 	WithStorageDomainID(storageDomainID StorageDomainID) (BuildableVMDiskParameters, error)
 	// MustWithStorageDomainID is identical to WithStorageDomainID but panics instead of returning an error.
 	MustWithStorageDomainID(storageDomainID StorageDomainID) BuildableVMDiskParameters
+
+	// WithProvisionedSize sets the size of the disk on the new VM in bytes. The size must not be smaller than the size
+	// of the template disk, since disks cannot be shrunk.
+	WithProvisionedSize(size uint64) (BuildableVMDiskParameters, error)
+	// MustWithProvisionedSize is identical to WithProvisionedSize but panics instead of returning an error.
+	MustWithProvisionedSize(size uint64) BuildableVMDiskParameters
 }
 
 // NewBuildableVMDiskParameters creates a new buildable OptionalVMDiskParameters.
@@ -1430,6 +1854,7 @@ func NewBuildableVMDiskParameters(diskID DiskID) (BuildableVMDiskParameters, err
 		nil,
 		nil,
 		nil,
+		nil,
 	}, nil
 }
 
@@ -1448,12 +1873,33 @@ type vmDiskParameters struct {
 	sparse          *bool
 	format          *ImageFormat
 	storageDomainID *StorageDomainID
+	provisionedSize *uint64
 }
 
 func (v *vmDiskParameters) StorageDomainID() *StorageDomainID {
 	return v.storageDomainID
 }
 
+func (v *vmDiskParameters) ProvisionedSize() *uint64 {
+	return v.provisionedSize
+}
+
+func (v *vmDiskParameters) WithProvisionedSize(size uint64) (BuildableVMDiskParameters, error) {
+	if size == 0 {
+		return nil, newError(EBadArgument, "the provisioned size of disk %s must not be zero", v.diskID)
+	}
+	v.provisionedSize = &size
+	return v, nil
+}
+
+func (v *vmDiskParameters) MustWithProvisionedSize(size uint64) BuildableVMDiskParameters {
+	b, err := v.WithProvisionedSize(size)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
 func (v *vmDiskParameters) WithStorageDomainID(storageDomainID StorageDomainID) (BuildableVMDiskParameters, error) {
 	v.storageDomainID = &storageDomainID
 	return v, nil
@@ -1516,6 +1962,36 @@ type UpdateVMParameters interface {
 	Comment() *string
 	// Description returns the description for the VM. Return nil if the name should not be changed.
 	Description() *string
+	// OS returns the operating system parameters to change, such as the boot order. Return nil if the operating
+	// system settings should not be changed.
+	OS() VMOSParameters
+	// BIOSType returns the new chipset and firmware combination for the VM. Return nil if the BIOS type should not be
+	// changed.
+	BIOSType() *VMBIOSType
+	// Memory returns the new memory size of the VM in bytes. Return nil if the memory should not be changed.
+	Memory() *int64
+	// MemoryPolicy returns the memory policy changes. Return nil if the memory policy should not be changed. Fields
+	// of the policy that are nil are left unchanged.
+	MemoryPolicy() MemoryPolicyParameters
+	// CPU returns the new CPU settings of the VM. Return nil if the CPU settings should not be changed.
+	CPU() VMCPUParams
+	// TimeZone returns the new time zone of the hardware clock. Return nil if the time zone should not be changed.
+	TimeZone() *string
+	// DeleteProtected returns whether delete protection should be enabled. Return nil if it should not be changed.
+	DeleteProtected() *bool
+	// EmulatedMachine returns the new emulated machine type. An empty string reverts to the cluster default. Return
+	// nil if the emulated machine should not be changed.
+	EmulatedMachine() *string
+	// CustomCompatibilityVersion returns the new custom compatibility version. Return nil if it should not be
+	// changed.
+	CustomCompatibilityVersion() Version
+	// RemoveCustomCompatibilityVersion returns true if the custom compatibility version should be removed, so the VM
+	// uses the compatibility version of its cluster again. It is ignored if CustomCompatibilityVersion is not nil.
+	RemoveCustomCompatibilityVersion() bool
+	// ExpectedState returns the VM state the update is based on, or nil if the update should be applied
+	// unconditionally. If set, the update fails with EObjectChanged when any of the fields the update changes no
+	// longer has the value it had in the expected state.
+	ExpectedState() VMData
 }
 
 // VMCPUTopo contains the CPU topology information about a VM.
@@ -1605,6 +2081,73 @@ type BuildableUpdateVMParameters interface {
 
 	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
 	MustWithDescription(comment string) BuildableUpdateVMParameters
+
+	// WithOS adds operating system changes, such as a new boot order, to the request.
+	WithOS(os VMOSParameters) (BuildableUpdateVMParameters, error)
+
+	// MustWithOS is identical to WithOS, but panics instead of returning an error.
+	MustWithOS(os VMOSParameters) BuildableUpdateVMParameters
+
+	// WithBIOSType adds a new BIOS type to the request. On a running VM the change takes effect after the next
+	// restart.
+	WithBIOSType(biosType VMBIOSType) (BuildableUpdateVMParameters, error)
+
+	// MustWithBIOSType is identical to WithBIOSType, but panics instead of returning an error.
+	MustWithBIOSType(biosType VMBIOSType) BuildableUpdateVMParameters
+
+	// WithMemory adds a new memory size in bytes to the request.
+	WithMemory(memory int64) (BuildableUpdateVMParameters, error)
+
+	// MustWithMemory is identical to WithMemory, but panics instead of returning an error.
+	MustWithMemory(memory int64) BuildableUpdateVMParameters
+
+	// WithMemoryPolicy adds memory policy changes, such as guaranteed memory or ballooning, to the request.
+	WithMemoryPolicy(memoryPolicy MemoryPolicyParameters) (BuildableUpdateVMParameters, error)
+
+	// MustWithMemoryPolicy is identical to WithMemoryPolicy, but panics instead of returning an error.
+	MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableUpdateVMParameters
+
+	// WithCPU adds new CPU settings, such as the topology or the CPU mode, to the request. On a running VM, the engine
+	// hot plugs additional sockets if possible, other changes take effect after the next restart.
+	WithCPU(cpu VMCPUParams) (BuildableUpdateVMParameters, error)
+
+	// MustWithCPU is identical to WithCPU, but panics instead of returning an error.
+	MustWithCPU(cpu VMCPUParams) BuildableUpdateVMParameters
+
+	// WithTimeZone adds a new hardware clock time zone to the request, for example "Etc/GMT" for Linux guests or
+	// "GMT Standard Time" for Windows guests.
+	WithTimeZone(timeZone string) (BuildableUpdateVMParameters, error)
+
+	// MustWithTimeZone is identical to WithTimeZone, but panics instead of returning an error.
+	MustWithTimeZone(timeZone string) BuildableUpdateVMParameters
+
+	// WithDeleteProtected enables or disables delete protection for the VM.
+	WithDeleteProtected(deleteProtected bool) BuildableUpdateVMParameters
+
+	// WithEmulatedMachine adds a new emulated machine type to the request. Pass an empty string to revert to the
+	// cluster default. On a running VM the change takes effect after the next restart.
+	WithEmulatedMachine(emulatedMachine string) BuildableUpdateVMParameters
+
+	// WithCustomCompatibilityVersion adds a new custom compatibility version to the request. On a running VM the
+	// change takes effect after the next restart.
+	WithCustomCompatibilityVersion(version Version) (BuildableUpdateVMParameters, error)
+
+	// MustWithCustomCompatibilityVersion is identical to WithCustomCompatibilityVersion, but panics instead of
+	// returning an error.
+	MustWithCustomCompatibilityVersion(version Version) BuildableUpdateVMParameters
+
+	// WithoutCustomCompatibilityVersion removes the custom compatibility version, so the VM uses the one of its
+	// cluster again. On a running VM the change takes effect after the next restart.
+	WithoutCustomCompatibilityVersion() BuildableUpdateVMParameters
+
+	// WithExpectedState makes the update conditional on the VM still matching the passed state, typically the VM
+	// the caller fetched before deciding on the update. The engine has no object versions, so the client compares
+	// the current state right before the update. This narrows the window for lost updates considerably, but a change
+	// that happens between the check and the update can still go unnoticed.
+	WithExpectedState(expected VMData) (BuildableUpdateVMParameters, error)
+
+	// MustWithExpectedState is identical to WithExpectedState, but panics instead of returning an error.
+	MustWithExpectedState(expected VMData) BuildableUpdateVMParameters
 }
 
 // UpdateVMParams returns a buildable set of update parameters.
@@ -1613,9 +2156,210 @@ func UpdateVMParams() BuildableUpdateVMParameters {
 }
 
 type updateVMParams struct {
-	name        *string
-	comment     *string
-	description *string
+	name            *string
+	comment         *string
+	description     *string
+	os              VMOSParameters
+	biosType        *VMBIOSType
+	memory          *int64
+	memoryPolicy    MemoryPolicyParameters
+	cpu             VMCPUParams
+	timeZone        *string
+	deleteProtected *bool
+	expectedState   VMData
+
+	emulatedMachine                  *string
+	customCompatibilityVersion       Version
+	removeCustomCompatibilityVersion bool
+}
+
+func (u *updateVMParams) EmulatedMachine() *string {
+	return u.emulatedMachine
+}
+
+func (u *updateVMParams) WithEmulatedMachine(emulatedMachine string) BuildableUpdateVMParameters {
+	u.emulatedMachine = &emulatedMachine
+	return u
+}
+
+func (u *updateVMParams) CustomCompatibilityVersion() Version {
+	return u.customCompatibilityVersion
+}
+
+func (u *updateVMParams) WithCustomCompatibilityVersion(version Version) (BuildableUpdateVMParameters, error) {
+	if version == nil {
+		return nil, newError(EBadArgument, "the custom compatibility version must not be nil for VM update")
+	}
+	u.customCompatibilityVersion = version
+	u.removeCustomCompatibilityVersion = false
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithCustomCompatibilityVersion(version Version) BuildableUpdateVMParameters {
+	builder, err := u.WithCustomCompatibilityVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) RemoveCustomCompatibilityVersion() bool {
+	return u.removeCustomCompatibilityVersion
+}
+
+func (u *updateVMParams) WithoutCustomCompatibilityVersion() BuildableUpdateVMParameters {
+	u.customCompatibilityVersion = nil
+	u.removeCustomCompatibilityVersion = true
+	return u
+}
+
+func (u *updateVMParams) ExpectedState() VMData {
+	return u.expectedState
+}
+
+func (u *updateVMParams) WithExpectedState(expected VMData) (BuildableUpdateVMParameters, error) {
+	if expected == nil {
+		return nil, newError(EBadArgument, "the expected state must not be nil for VM update")
+	}
+	u.expectedState = expected
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithExpectedState(expected VMData) BuildableUpdateVMParameters {
+	builder, err := u.WithExpectedState(expected)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) CPU() VMCPUParams {
+	return u.cpu
+}
+
+func (u *updateVMParams) WithCPU(cpu VMCPUParams) (BuildableUpdateVMParameters, error) {
+	if cpu == nil {
+		return nil, newError(EBadArgument, "the CPU parameters must not be nil for VM update")
+	}
+	u.cpu = cpu
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithCPU(cpu VMCPUParams) BuildableUpdateVMParameters {
+	builder, err := u.WithCPU(cpu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) TimeZone() *string {
+	return u.timeZone
+}
+
+func (u *updateVMParams) WithTimeZone(timeZone string) (BuildableUpdateVMParameters, error) {
+	if timeZone == "" {
+		return nil, newError(EBadArgument, "the time zone must not be empty for VM update")
+	}
+	u.timeZone = &timeZone
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithTimeZone(timeZone string) BuildableUpdateVMParameters {
+	builder, err := u.WithTimeZone(timeZone)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) DeleteProtected() *bool {
+	return u.deleteProtected
+}
+
+func (u *updateVMParams) WithDeleteProtected(deleteProtected bool) BuildableUpdateVMParameters {
+	u.deleteProtected = &deleteProtected
+	return u
+}
+
+func (u *updateVMParams) Memory() *int64 {
+	return u.memory
+}
+
+func (u *updateVMParams) WithMemory(memory int64) (BuildableUpdateVMParameters, error) {
+	if err := validateVMMemory(&memory, nil, nil); err != nil {
+		return nil, err
+	}
+	u.memory = &memory
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithMemory(memory int64) BuildableUpdateVMParameters {
+	builder, err := u.WithMemory(memory)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) MemoryPolicy() MemoryPolicyParameters {
+	return u.memoryPolicy
+}
+
+func (u *updateVMParams) WithMemoryPolicy(memoryPolicy MemoryPolicyParameters) (BuildableUpdateVMParameters, error) {
+	if memoryPolicy == nil {
+		return nil, newError(EBadArgument, "the memory policy must not be nil for VM update")
+	}
+	if err := validateVMMemory(nil, memoryPolicy.Guaranteed(), memoryPolicy.Max()); err != nil {
+		return nil, err
+	}
+	u.memoryPolicy = memoryPolicy
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableUpdateVMParameters {
+	builder, err := u.WithMemoryPolicy(memoryPolicy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) OS() VMOSParameters {
+	return u.os
+}
+
+func (u *updateVMParams) WithOS(os VMOSParameters) (BuildableUpdateVMParameters, error) {
+	u.os = os
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithOS(os VMOSParameters) BuildableUpdateVMParameters {
+	builder, err := u.WithOS(os)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) BIOSType() *VMBIOSType {
+	return u.biosType
+}
+
+func (u *updateVMParams) WithBIOSType(biosType VMBIOSType) (BuildableUpdateVMParameters, error) {
+	if err := biosType.Validate(); err != nil {
+		return nil, err
+	}
+	u.biosType = &biosType
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithBIOSType(biosType VMBIOSType) BuildableUpdateVMParameters {
+	builder, err := u.WithBIOSType(biosType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 func (u *updateVMParams) MustWithName(name string) BuildableUpdateVMParameters {
@@ -1712,20 +2456,149 @@ type vmParams struct {
 	os    VMOSParameters
 	osSet bool
 
+	biosType   *VMBIOSType
+	tpmEnabled *bool
+
 	serialConsole    *bool
 	soundcardEnabled *bool
+
+	emulatedMachine            *string
+	customCompatibilityVersion Version
+	timeZone                   *string
+	useLatestTemplateVersion   *bool
+	waitForUnlock              *bool
+	idempotencyKey             string
+	cpuProfileID               *CPUProfileID
 }
 
-func (v *vmParams) SerialConsole() *bool {
-	return v.serialConsole
+func (v *vmParams) TimeZone() *string {
+	return v.timeZone
 }
 
-func (v *vmParams) WithSerialConsole(serialConsole bool) BuildableVMParameters {
-	v.serialConsole = &serialConsole
-	return v
+func (v *vmParams) WithTimeZone(timeZone string) (BuildableVMParameters, error) {
+	if timeZone == "" {
+		return nil, newVMParameterError("TimeZone", "the time zone must not be empty")
+	}
+	v.timeZone = &timeZone
+	return v, nil
 }
 
-func (v *vmParams) SoundcardEnabled() *bool {
+func (v *vmParams) MustWithTimeZone(timeZone string) BuildableVMParameters {
+	builder, err := v.WithTimeZone(timeZone)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) EmulatedMachine() *string {
+	return v.emulatedMachine
+}
+
+func (v *vmParams) WithEmulatedMachine(emulatedMachine string) (BuildableVMParameters, error) {
+	if emulatedMachine == "" {
+		return nil, newVMParameterError("EmulatedMachine", "the emulated machine must not be empty")
+	}
+	v.emulatedMachine = &emulatedMachine
+	return v, nil
+}
+
+func (v *vmParams) MustWithEmulatedMachine(emulatedMachine string) BuildableVMParameters {
+	builder, err := v.WithEmulatedMachine(emulatedMachine)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) CustomCompatibilityVersion() Version {
+	return v.customCompatibilityVersion
+}
+
+func (v *vmParams) WithCustomCompatibilityVersion(version Version) (BuildableVMParameters, error) {
+	if version == nil {
+		return nil, newVMParameterError("CustomCompatibilityVersion", "the version must not be nil")
+	}
+	v.customCompatibilityVersion = version
+	return v, nil
+}
+
+func (v *vmParams) MustWithCustomCompatibilityVersion(version Version) BuildableVMParameters {
+	builder, err := v.WithCustomCompatibilityVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) UseLatestTemplateVersion() *bool {
+	return v.useLatestTemplateVersion
+}
+
+func (v *vmParams) WithUseLatestTemplateVersion(useLatestTemplateVersion bool) BuildableVMParameters {
+	v.useLatestTemplateVersion = &useLatestTemplateVersion
+	return v
+}
+
+func (v *vmParams) WaitForUnlock() *bool {
+	return v.waitForUnlock
+}
+
+func (v *vmParams) WithWaitForUnlock(waitForUnlock bool) BuildableVMParameters {
+	v.waitForUnlock = &waitForUnlock
+	return v
+}
+
+func (v *vmParams) IdempotencyKey() string {
+	return v.idempotencyKey
+}
+
+func (v *vmParams) WithIdempotencyKey(key string) (BuildableVMParameters, error) {
+	if err := validateIdempotencyKey(key); err != nil {
+		return nil, err
+	}
+	v.idempotencyKey = key
+	return v, nil
+}
+
+func (v *vmParams) MustWithIdempotencyKey(key string) BuildableVMParameters {
+	builder, err := v.WithIdempotencyKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) CPUProfileID() *CPUProfileID {
+	return v.cpuProfileID
+}
+
+func (v *vmParams) WithCPUProfileID(id CPUProfileID) (BuildableVMParameters, error) {
+	if id == "" {
+		return nil, newVMParameterError("CPUProfileID", "the CPU profile ID must not be empty")
+	}
+	v.cpuProfileID = &id
+	return v, nil
+}
+
+func (v *vmParams) MustWithCPUProfileID(id CPUProfileID) BuildableVMParameters {
+	builder, err := v.WithCPUProfileID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) SerialConsole() *bool {
+	return v.serialConsole
+}
+
+func (v *vmParams) WithSerialConsole(serialConsole bool) BuildableVMParameters {
+	v.serialConsole = &serialConsole
+	return v
+}
+
+func (v *vmParams) SoundcardEnabled() *bool {
 	return v.soundcardEnabled
 }
 
@@ -1744,13 +2617,45 @@ func (v *vmParams) WithOS(os VMOSParameters) BuildableVMParameters {
 	return v
 }
 
+func (v *vmParams) TPMEnabled() *bool {
+	return v.tpmEnabled
+}
+
+func (v *vmParams) WithTPMEnabled(tpmEnabled bool) BuildableVMParameters {
+	v.tpmEnabled = &tpmEnabled
+	return v
+}
+
+func (v *vmParams) BIOSType() *VMBIOSType {
+	return v.biosType
+}
+
+func (v *vmParams) WithBIOSType(biosType VMBIOSType) (BuildableVMParameters, error) {
+	if err := biosType.Validate(); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid BIOSType parameter for VM")
+	}
+	if err := validateVMTPMBIOSType(v.tpmEnabled, &biosType); err != nil {
+		return nil, err
+	}
+	v.biosType = &biosType
+	return v, nil
+}
+
+func (v *vmParams) MustWithBIOSType(biosType VMBIOSType) BuildableVMParameters {
+	builder, err := v.WithBIOSType(biosType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func (v *vmParams) VMType() *VMType {
 	return v.vmType
 }
 
 func (v *vmParams) WithVMType(vmType VMType) (BuildableVMParameters, error) {
 	if err := vmType.Validate(); err != nil {
-		return nil, err
+		return nil, wrap(err, EBadArgument, "invalid VMType parameter for VM")
 	}
 	v.vmType = &vmType
 	return v, nil
@@ -1769,6 +2674,9 @@ func (v *vmParams) InstanceTypeID() *InstanceTypeID {
 }
 
 func (v *vmParams) WithInstanceTypeID(instanceTypeID InstanceTypeID) (BuildableVMParameters, error) {
+	if instanceTypeID == "" {
+		return nil, newVMParameterError("InstanceTypeID", "the instance type ID must not be empty")
+	}
 	v.instanceTypeID = &instanceTypeID
 	return v, nil
 }
@@ -1821,17 +2729,8 @@ func (v *vmParams) Disks() []OptionalVMDiskParameters {
 }
 
 func (v *vmParams) WithDisks(disks []OptionalVMDiskParameters) (BuildableVMParameters, error) {
-	diskIDs := map[DiskID]int{}
-	for i, d := range disks {
-		if previousID, ok := diskIDs[d.DiskID()]; ok {
-			return nil, newError(
-				EBadArgument,
-				"Disk %s appears twice, in position %d and %d.",
-				d.DiskID(),
-				previousID,
-				i,
-			)
-		}
+	if err := validateVMDisks(disks); err != nil {
+		return nil, err
 	}
 	v.disks = disks
 	return v, nil
@@ -1851,7 +2750,7 @@ func (v *vmParams) HugePages() *VMHugePages {
 
 func (v *vmParams) WithHugePages(hugePages VMHugePages) (BuildableVMParameters, error) {
 	if err := hugePages.Validate(); err != nil {
-		return v, err
+		return v, wrap(err, EBadArgument, "invalid HugePages parameter for VM")
 	}
 	v.hugePages = &hugePages
 	return v, nil
@@ -1870,6 +2769,14 @@ func (v *vmParams) Memory() *int64 {
 }
 
 func (v *vmParams) WithMemory(memory int64) (BuildableVMParameters, error) {
+	var guaranteed, max *int64
+	if v.memoryPolicy != nil {
+		guaranteed = (*v.memoryPolicy).Guaranteed()
+		max = (*v.memoryPolicy).Max()
+	}
+	if err := validateVMMemory(&memory, guaranteed, max); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid Memory parameter for VM")
+	}
 	v.memory = &memory
 	return v, nil
 }
@@ -1909,6 +2816,9 @@ func (v *vmParams) CPU() VMCPUParams {
 }
 
 func (v *vmParams) WithCPU(cpu VMCPUParams) (BuildableVMParameters, error) {
+	if cpu == nil {
+		return nil, newVMParameterError("CPU", "the CPU parameters must not be nil")
+	}
 	v.cpu = cpu
 	return v, nil
 }
@@ -1993,6 +2903,10 @@ func (v *vmParams) WithDescription(description string) (BuildableVMParameters, e
 	return v, nil
 }
 
+func (v *vmParams) Validate() error {
+	return validateVMParameters(v)
+}
+
 func (v vmParams) Name() string {
 	return v.name
 }
@@ -2028,6 +2942,57 @@ type vm struct {
 	os               *vmOS
 	serialConsole    bool
 	soundcardEnabled bool
+	biosType         VMBIOSType
+	tpmEnabled       bool
+	// nextRunConfigurationExists indicates that the VM has configuration changes that only apply after a restart.
+	nextRunConfigurationExists bool
+	timeZone                   string
+	deleteProtected            bool
+	creationTime               time.Time
+	emulatedMachine            string
+	customCompatibilityVersion Version
+	hostedEngine               bool
+	cpuProfileID               CPUProfileID
+}
+
+func (v *vm) HostedEngine() bool {
+	return v.hostedEngine
+}
+
+func (v *vm) CPUProfileID() CPUProfileID {
+	return v.cpuProfileID
+}
+
+func (v *vm) EmulatedMachine() string {
+	return v.emulatedMachine
+}
+
+func (v *vm) CustomCompatibilityVersion() Version {
+	return v.customCompatibilityVersion
+}
+
+func (v *vm) CreationTime() time.Time {
+	return v.creationTime
+}
+
+func (v *vm) TimeZone() string {
+	return v.timeZone
+}
+
+func (v *vm) DeleteProtected() bool {
+	return v.deleteProtected
+}
+
+func (v *vm) BIOSType() VMBIOSType {
+	return v.biosType
+}
+
+func (v *vm) TPMEnabled() bool {
+	return v.tpmEnabled
+}
+
+func (v *vm) NextRunConfigurationExists() bool {
+	return v.nextRunConfigurationExists
 }
 
 func (v *vm) SoundcardEnabled() bool {
@@ -2038,6 +3003,18 @@ func (v *vm) SerialConsole() bool {
 	return v.serialConsole
 }
 
+func (v *vm) ListErrata(retries ...RetryStrategy) ([]Erratum, error) {
+	return v.client.ListVMErrata(v.id, retries...)
+}
+
+func (v *vm) ListWatchdogs(retries ...RetryStrategy) ([]VMWatchdog, error) {
+	return v.client.ListVMWatchdogs(v.id, retries...)
+}
+
+func (v *vm) AddWatchdog(model WatchdogModel, action WatchdogAction, retries ...RetryStrategy) (VMWatchdog, error) {
+	return v.client.AddVMWatchdog(v.id, model, action, retries...)
+}
+
 func (v *vm) ListGraphicsConsoles(retries ...RetryStrategy) ([]VMGraphicsConsole, error) {
 	return v.client.ListVMGraphicsConsoles(v.id, retries...)
 }
@@ -2082,6 +3059,10 @@ func (v *vm) WaitForNonLocalIPAddress(retries ...RetryStrategy) (map[string][]ne
 	return v.client.WaitForNonLocalVMIPAddress(v.id, retries...)
 }
 
+func (v *vm) ListReportedDevices(retries ...RetryStrategy) ([]VMReportedDevice, error) {
+	return v.client.ListVMReportedDevices(v.id, retries...)
+}
+
 func (v *vm) GetIPAddresses(params VMIPSearchParams, retries ...RetryStrategy) (map[string][]net.IP, error) {
 	return v.client.GetVMIPAddresses(v.id, params, retries...)
 }
@@ -2110,6 +3091,10 @@ func (v *vm) Start(retries ...RetryStrategy) error {
 	return v.client.StartVM(v.id, retries...)
 }
 
+func (v *vm) StartOnce(params RunOnceParameters, retries ...RetryStrategy) error {
+	return v.client.StartVMOnce(v.id, params, retries...)
+}
+
 func (v *vm) Stop(force bool, retries ...RetryStrategy) error {
 	return v.client.StopVM(v.id, force, retries...)
 }
@@ -2118,6 +3103,14 @@ func (v *vm) Shutdown(force bool, retries ...RetryStrategy) error {
 	return v.client.ShutdownVM(v.id, force, retries...)
 }
 
+func (v *vm) ApplyNextRunConfiguration(powerOff bool, retries ...RetryStrategy) (VM, error) {
+	return v.client.ApplyNextRunConfiguration(v.id, powerOff, retries...)
+}
+
+func (v *vm) WaitForUnlock(retries ...RetryStrategy) (VM, error) {
+	return v.client.WaitForVMUnlock(v.id, retries...)
+}
+
 func (v *vm) WaitForStatus(status VMStatus, retries ...RetryStrategy) (VM, error) {
 	return v.client.WaitForVMStatus(v.id, status, retries...)
 }
@@ -2159,6 +3152,16 @@ func (v *vm) withName(name string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
 	}
 }
 
@@ -2187,6 +3190,16 @@ func (v *vm) withComment(comment string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
 	}
 }
 
@@ -2215,6 +3228,312 @@ func (v *vm) withDescription(description string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withOS returns a copy of the VM with the new operating system settings.
+func (v *vm) withOS(os *vmOS) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withMemory returns a copy of the VM with the new memory size and memory policy.
+func (v *vm) withMemory(memory int64, memPolicy *memoryPolicy) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		memPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withBIOSType returns a copy of the VM with the new BIOS type.
+func (v *vm) withBIOSType(biosType VMBIOSType) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withCPU returns a copy of the VM with the new CPU settings.
+func (v *vm) withCPU(cpu *vmCPU) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withTimeZone returns a copy of the VM with the new time zone.
+func (v *vm) withTimeZone(timeZone string) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withDeleteProtected returns a copy of the VM with delete protection enabled or disabled.
+func (v *vm) withDeleteProtected(deleteProtected bool) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withEmulatedMachine returns a copy of the VM with the new emulated machine.
+func (v *vm) withEmulatedMachine(emulatedMachine string) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		emulatedMachine,
+		v.customCompatibilityVersion,
+		v.hostedEngine,
+		v.cpuProfileID,
+	}
+}
+
+// withCustomCompatibilityVersion returns a copy of the VM with the new custom compatibility version.
+func (v *vm) withCustomCompatibilityVersion(version Version) *vm {
+	return &vm{
+		v.client,
+		v.id,
+		v.name,
+		v.comment,
+		v.description,
+		v.clusterID,
+		v.templateID,
+		v.status,
+		v.cpu,
+		v.memory,
+		v.tagIDs,
+		v.hugePages,
+		v.initialization,
+		v.hostID,
+		v.placementPolicy,
+		v.memoryPolicy,
+		v.instanceTypeID,
+		v.vmType,
+		v.os,
+		v.serialConsole,
+		v.soundcardEnabled,
+		v.biosType,
+		v.tpmEnabled,
+		v.nextRunConfigurationExists,
+		v.timeZone,
+		v.deleteProtected,
+		v.creationTime,
+		v.emulatedMachine,
+		version,
+		v.hostedEngine,
+		v.cpuProfileID,
 	}
 }
 
@@ -2222,6 +3541,14 @@ func (v *vm) Update(params UpdateVMParameters, retries ...RetryStrategy) (VM, er
 	return v.client.UpdateVM(v.id, params, retries...)
 }
 
+func (v *vm) Rename(name string, retries ...RetryStrategy) (VM, error) {
+	return v.client.RenameVM(v.id, name, retries...)
+}
+
+func (v *vm) GetOVF(retries ...RetryStrategy) (VMOVF, error) {
+	return v.client.GetVMOVF(v.id, retries...)
+}
+
 func (v *vm) Status() VMStatus {
 	return v.status
 }
@@ -2295,13 +3622,19 @@ func (v *vm) TagIDs() []TagID {
 }
 
 func (v *vm) Tags(retries ...RetryStrategy) ([]Tag, error) {
-	tags := make([]Tag, len(v.tagIDs))
+	ids := make([]string, len(v.tagIDs))
 	for i, id := range v.tagIDs {
-		tag, err := v.client.GetTag(id, retries...)
-		if err != nil {
-			return nil, err
-		}
-		tags[i] = tag
+		ids[i] = string(id)
+	}
+	objects, err := ResolveIDs(ids, defaultResolveConcurrency, func(id string) (interface{}, error) {
+		return v.client.GetTag(TagID(id), retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]Tag, len(objects))
+	for i, object := range objects {
+		tags[i] = object.(Tag)
 	}
 	return tags, nil
 }
@@ -2347,6 +3680,16 @@ func convertSDKVM(sdkObject *ovirtsdk.Vm, client Client) (VM, error) {
 		vmOSConverter,
 		vmSoundcardEnabledConverter,
 		vmSerialConsoleConverter,
+		vmBIOSTypeConverter,
+		vmTPMEnabledConverter,
+		vmNextRunConfigurationExistsConverter,
+		vmTimeZoneConverter,
+		vmDeleteProtectedConverter,
+		vmCreationTimeConverter,
+		vmEmulatedMachineConverter,
+		vmCustomCompatibilityVersionConverter,
+		vmHostedEngineConverter,
+		vmCPUProfileConverter,
 	}
 	for _, converter := range vmConverters {
 		if err := converter(sdkObject, vmObject); err != nil {
@@ -2396,6 +3739,98 @@ func vmOSConverter(object *ovirtsdk.Vm, v *vm) error {
 		return newFieldNotFound("os on vm", "type")
 	}
 	v.os.t = osType
+	if boot, ok := sdkOS.Boot(); ok {
+		if devices, ok := boot.Devices(); ok {
+			v.os.bootDevices = make([]VMBootDevice, len(devices))
+			for i, device := range devices {
+				v.os.bootDevices[i] = VMBootDevice(device)
+			}
+		}
+	}
+	return nil
+}
+
+func vmTPMEnabledConverter(object *ovirtsdk.Vm, v *vm) error {
+	// tpm_enabled is only reported by engines that support a virtual TPM, so a missing value means no TPM.
+	if tpmEnabled, ok := object.TpmEnabled(); ok {
+		v.tpmEnabled = tpmEnabled
+	}
+	return nil
+}
+
+func vmNextRunConfigurationExistsConverter(object *ovirtsdk.Vm, v *vm) error {
+	if nextRunConfigurationExists, ok := object.NextRunConfigurationExists(); ok {
+		v.nextRunConfigurationExists = nextRunConfigurationExists
+	}
+	return nil
+}
+
+func vmTimeZoneConverter(object *ovirtsdk.Vm, v *vm) error {
+	if timeZone, ok := object.TimeZone(); ok {
+		v.timeZone, _ = timeZone.Name()
+	}
+	return nil
+}
+
+func vmDeleteProtectedConverter(object *ovirtsdk.Vm, v *vm) error {
+	if deleteProtected, ok := object.DeleteProtected(); ok {
+		v.deleteProtected = deleteProtected
+	}
+	return nil
+}
+
+// vmHostedEngineConverter detects the Hosted Engine VM by its origin. Older engines report the origin as
+// hosted_engine, current ones as managed_hosted_engine once the engine has imported the VM.
+func vmHostedEngineConverter(object *ovirtsdk.Vm, v *vm) error {
+	if origin, ok := object.Origin(); ok {
+		v.hostedEngine = origin == "hosted_engine" || origin == "managed_hosted_engine"
+	}
+	return nil
+}
+
+func vmCPUProfileConverter(object *ovirtsdk.Vm, v *vm) error {
+	if cpuProfile, ok := object.CpuProfile(); ok {
+		if id, ok := cpuProfile.Id(); ok {
+			v.cpuProfileID = CPUProfileID(id)
+		}
+	}
+	return nil
+}
+
+func vmCreationTimeConverter(object *ovirtsdk.Vm, v *vm) error {
+	if creationTime, ok := object.CreationTime(); ok {
+		v.creationTime = creationTime
+	}
+	return nil
+}
+
+func vmEmulatedMachineConverter(object *ovirtsdk.Vm, v *vm) error {
+	if emulatedMachine, ok := object.CustomEmulatedMachine(); ok {
+		v.emulatedMachine = emulatedMachine
+	}
+	return nil
+}
+
+func vmCustomCompatibilityVersionConverter(object *ovirtsdk.Vm, v *vm) error {
+	sdkVersion, ok := object.CustomCompatibilityVersion()
+	if !ok {
+		return nil
+	}
+	version, err := convertSDKVersion(sdkVersion)
+	if err != nil {
+		return wrap(err, EBug, "failed to convert custom compatibility version of VM")
+	}
+	v.customCompatibilityVersion = version
+	return nil
+}
+
+func vmBIOSTypeConverter(object *ovirtsdk.Vm, v *vm) error {
+	v.biosType = VMBIOSTypeClusterDefault
+	if bios, ok := object.Bios(); ok {
+		if biosType, ok := bios.Type(); ok {
+			v.biosType = VMBIOSType(biosType)
+		}
+	}
 	return nil
 }
 
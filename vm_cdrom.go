@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// CDROMClient contains the methods to change the CD-ROM inserted into a VM. ISO images can be uploaded to a data
+// storage domain using UploadToNewDisk with the DiskContentTypeISO content type and then inserted using InsertVMCDROM.
+type CDROMClient interface {
+	// GetVMCDROM returns the CD-ROM drive of a VM. If current is true, the state of the running VM is returned,
+	// otherwise the persistent configuration that is used on the next boot.
+	GetVMCDROM(vmID VMID, current bool, retries ...RetryStrategy) (CDROM, error)
+	// InsertVMCDROM inserts the ISO disk with the specified ID into the CD-ROM drive of the VM. If current is true, the
+	// CD is changed in the running VM only, otherwise the persistent configuration is changed and takes effect on the
+	// next boot. Disks without the DiskContentTypeISO content type are rejected with EBadArgument.
+	InsertVMCDROM(vmID VMID, diskID DiskID, current bool, retries ...RetryStrategy) error
+	// EjectVMCDROM removes the CD from the CD-ROM drive of the VM. The current parameter has the same meaning as for
+	// InsertVMCDROM.
+	EjectVMCDROM(vmID VMID, current bool, retries ...RetryStrategy) error
+}
+
+// CDROM is the CD-ROM drive of a VM.
+type CDROM interface {
+	// VMID returns the ID of the VM the drive belongs to.
+	VMID() VMID
+	// DiskID returns the ID of the ISO disk inserted into the drive, or nil if the drive is empty.
+	DiskID() *DiskID
+}
+
+type cdrom struct {
+	vmID   VMID
+	diskID *DiskID
+}
+
+func (c *cdrom) VMID() VMID {
+	return c.vmID
+}
+
+func (c *cdrom) DiskID() *DiskID {
+	return c.diskID
+}
+
+func convertSDKCDROM(sdkObject *ovirtsdk.Cdrom, vmID VMID) CDROM {
+	result := &cdrom{
+		vmID: vmID,
+	}
+	if file, ok := sdkObject.File(); ok {
+		if fileID, ok := file.Id(); ok && fileID != "" {
+			diskID := DiskID(fileID)
+			result.diskID = &diskID
+		}
+	}
+	return result
+}
+
+// mockCDROM holds the CD-ROM state of a VM in the mock. The current state is reset to the persistent state whenever
+// the VM is started.
+type mockCDROM struct {
+	persistent *DiskID
+	current    *DiskID
+}
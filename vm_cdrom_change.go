@@ -0,0 +1,131 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) InsertVMCDROM(vmID VMID, diskID DiskID, current bool, retries ...RetryStrategy) error {
+	if diskID == "" {
+		return newError(EBadArgument, "the disk ID must not be empty, use EjectVMCDROM to eject the CD")
+	}
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return err
+	}
+	if err := validateVMCDROMDisk(disk); err != nil {
+		return err
+	}
+	return o.changeVMCDROM(fmt.Sprintf("inserting disk %s into CD-ROM of VM %s", diskID, vmID), vmID, diskID, current, retries)
+}
+
+func (o *oVirtClient) EjectVMCDROM(vmID VMID, current bool, retries ...RetryStrategy) error {
+	return o.changeVMCDROM(fmt.Sprintf("ejecting CD-ROM of VM %s", vmID), vmID, "", current, retries)
+}
+
+func (o *oVirtClient) changeVMCDROM(
+	action string,
+	vmID VMID,
+	diskID DiskID,
+	current bool,
+	retries []RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	// An empty file ID ejects the CD.
+	sdkCDROM, err := ovirtsdk.NewCdromBuilder().File(ovirtsdk.NewFileBuilder().Id(string(diskID)).MustBuild()).Build()
+	if err != nil {
+		return wrap(err, EBug, "failed to build CD-ROM object for VM %s", vmID)
+	}
+	err = retry(
+		action,
+		mutating(ResourceTypeVM, string(vmID)).
+			withParameters(map[string]string{
+				"disk_id": string(diskID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			cdromID, err := o.getVMCDROMID(vmID, retries)
+			if err != nil {
+				return err
+			}
+			_, err = o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CdromsService().
+				CdromService(cdromID).
+				Update().
+				Cdrom(sdkCDROM).
+				Current(current).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(vmID), "", MutationTypeUpdated)
+	}
+	return err
+}
+
+func (m *mockClient) InsertVMCDROM(vmID VMID, diskID DiskID, current bool, _ ...RetryStrategy) error {
+	if diskID == "" {
+		return newError(EBadArgument, "the disk ID must not be empty, use EjectVMCDROM to eject the CD")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	disk, ok := m.disks[diskID]
+	if !ok {
+		return newError(ENotFound, "disk with ID %s not found", diskID)
+	}
+	if err := validateVMCDROMDisk(disk); err != nil {
+		return err
+	}
+	return m.changeVMCDROM(vmID, &diskID, current)
+}
+
+// validateVMCDROMDisk checks that a disk can be inserted into a CD-ROM drive, which requires the ISO content type.
+func validateVMCDROMDisk(disk Disk) error {
+	if disk.ContentType() != DiskContentTypeISO {
+		return newError(
+			EBadArgument,
+			"disk %s has content type %s, not %s",
+			disk.ID(),
+			disk.ContentType(),
+			DiskContentTypeISO,
+		)
+	}
+	return nil
+}
+
+func (m *mockClient) EjectVMCDROM(vmID VMID, current bool, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.changeVMCDROM(vmID, nil, current)
+}
+
+// changeVMCDROM changes the CD-ROM state in the mock. It must be called with the lock held.
+func (m *mockClient) changeVMCDROM(vmID VMID, diskID *DiskID, current bool) error {
+	vm, ok := m.vms[vmID]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	state, ok := m.cdroms[vmID]
+	if !ok {
+		state = &mockCDROM{}
+		m.cdroms[vmID] = state
+	}
+	if current {
+		if vm.status == VMStatusDown {
+			return newError(EConflict, "VM %s is not running, cannot change the CD-ROM of the running VM", vmID)
+		}
+		state.current = diskID
+	} else {
+		state.persistent = diskID
+	}
+	m.mutationListeners.notify(ResourceTypeVM, string(vmID), "", MutationTypeUpdated)
+	return nil
+}
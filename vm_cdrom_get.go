@@ -0,0 +1,82 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetVMCDROM(vmID VMID, current bool, retries ...RetryStrategy) (result CDROM, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting CD-ROM of VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			cdromID, err := o.getVMCDROMID(vmID, retries)
+			if err != nil {
+				return err
+			}
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CdromsService().
+				CdromService(cdromID).
+				Get().
+				Current(current).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Cdrom()
+			if !ok {
+				return newError(ENotFound, "no CD-ROM returned when getting CD-ROM of VM %s", vmID)
+			}
+			result = convertSDKCDROM(sdkObject, vmID)
+			return nil
+		})
+	return
+}
+
+// getVMCDROMID returns the ID of the first CD-ROM drive of a VM. oVirt VMs currently only have a single drive.
+func (o *oVirtClient) getVMCDROMID(vmID VMID, retries []RetryStrategy) (string, error) {
+	response, err := o.conn.SystemService().
+		VmsService().
+		VmService(string(vmID)).
+		CdromsService().
+		List().
+		Query("correlation_id", requestCorrelationID(retries)).
+		Send()
+	if err != nil {
+		return "", err
+	}
+	if cdroms, ok := response.Cdroms(); ok {
+		for _, sdkCDROM := range cdroms.Slice() {
+			if id, ok := sdkCDROM.Id(); ok {
+				return id, nil
+			}
+		}
+	}
+	return "", newError(ENotFound, "VM %s has no CD-ROM drive", vmID)
+}
+
+func (m *mockClient) GetVMCDROM(vmID VMID, current bool, _ ...RetryStrategy) (CDROM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := &cdrom{
+		vmID: vmID,
+	}
+	if state, ok := m.cdroms[vmID]; ok {
+		if current && m.vms[vmID].status != VMStatusDown {
+			result.diskID = state.current
+		} else {
+			result.diskID = state.persistent
+		}
+	}
+	return result, nil
+}
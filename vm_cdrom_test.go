@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCDROMInsertAndEject(t *testing.T) {
+	t.Parallel()
+	fh, size := getTestImageFile(t)
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	uploadResult, err := client.UploadToNewDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		size,
+		ovirtclient.CreateDiskParams().
+			MustWithAlias(helper.GenerateTestResourceName(t)).
+			MustWithContentType(ovirtclient.DiskContentTypeISO),
+		fh,
+	)
+	if err != nil {
+		t.Fatalf("Failed to upload ISO image (%v)", err)
+	}
+	iso := uploadResult.Disk()
+	t.Cleanup(func() {
+		if err := client.RemoveDisk(iso.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove ISO disk %s (%v)", iso.ID(), err)
+		}
+	})
+	if iso.ContentType() != ovirtclient.DiskContentTypeISO {
+		t.Fatalf("Incorrect content type on uploaded disk: %s", iso.ContentType())
+	}
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	if err := client.InsertVMCDROM(vm.ID(), iso.ID(), false); err != nil {
+		t.Fatalf("Failed to insert ISO %s into VM %s (%v)", iso.ID(), vm.ID(), err)
+	}
+	cdrom, err := client.GetVMCDROM(vm.ID(), false)
+	if err != nil {
+		t.Fatalf("Failed to get CD-ROM of VM %s (%v)", vm.ID(), err)
+	}
+	if cdrom.DiskID() == nil || *cdrom.DiskID() != iso.ID() {
+		t.Fatalf("The ISO %s is not inserted into the CD-ROM of VM %s.", iso.ID(), vm.ID())
+	}
+
+	if err := client.EjectVMCDROM(vm.ID(), false); err != nil {
+		t.Fatalf("Failed to eject CD-ROM of VM %s (%v)", vm.ID(), err)
+	}
+	cdrom, err = client.GetVMCDROM(vm.ID(), false)
+	if err != nil {
+		t.Fatalf("Failed to get CD-ROM of VM %s (%v)", vm.ID(), err)
+	}
+	if cdrom.DiskID() != nil {
+		t.Fatalf("The CD-ROM of VM %s is not empty after ejecting (%s).", vm.ID(), *cdrom.DiskID())
+	}
+}
+
+func TestVMCDROMRejectsDataDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk := assertCanCreateDisk(t, helper)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	err := client.InsertVMCDROM(vm.ID(), disk.ID(), false)
+	if err == nil {
+		t.Fatalf("Inserting a data disk into the CD-ROM did not fail.")
+	}
+}
@@ -26,19 +26,24 @@ func vmBuilderDescription(params OptionalVMParameters, builder *ovirtsdk.VmBuild
 
 func vmBuilderCPU(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if cpu := params.CPU(); cpu != nil {
-		cpuBuilder := ovirtsdk.NewCpuBuilder()
-		if cpuTopo := cpu.Topo(); cpuTopo != nil {
-			cpuBuilder.TopologyBuilder(ovirtsdk.
-				NewCpuTopologyBuilder().
-				Cores(int64(cpu.Topo().Cores())).
-				Threads(int64(cpu.Topo().Threads())).
-				Sockets(int64(cpu.Topo().Sockets())))
-		}
-		if mode := cpu.Mode(); mode != nil {
-			cpuBuilder.Mode(ovirtsdk.CpuMode(*mode))
-		}
-		builder.CpuBuilder(cpuBuilder)
+		builder.CpuBuilder(buildSDKVMCPU(cpu))
+	}
+}
+
+// buildSDKVMCPU converts the CPU parameters into an SDK builder. It is shared between VM creation and update.
+func buildSDKVMCPU(cpu VMCPUParams) *ovirtsdk.CpuBuilder {
+	cpuBuilder := ovirtsdk.NewCpuBuilder()
+	if cpuTopo := cpu.Topo(); cpuTopo != nil {
+		cpuBuilder.TopologyBuilder(ovirtsdk.
+			NewCpuTopologyBuilder().
+			Cores(int64(cpuTopo.Cores())).
+			Threads(int64(cpuTopo.Threads())).
+			Sockets(int64(cpuTopo.Sockets())))
+	}
+	if mode := cpu.Mode(); mode != nil {
+		cpuBuilder.Mode(ovirtsdk.CpuMode(*mode))
 	}
+	return cpuBuilder
 }
 
 func vmBuilderHugePages(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
@@ -68,8 +73,12 @@ func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBu
 	if params.Initialization() == nil {
 		return
 	}
+	builder.InitializationBuilder(buildSDKInitialization(params.Initialization()))
+}
 
-	init := params.Initialization()
+// buildSDKInitialization converts the initialization configuration into an SDK builder. It is shared between VM
+// creation and run-once starts.
+func buildSDKInitialization(init Initialization) *ovirtsdk.InitializationBuilder {
 	initBuilder := ovirtsdk.NewInitializationBuilder()
 
 	if init.CustomScript() != "" {
@@ -104,7 +113,7 @@ func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBu
 
 		initBuilder.NicConfigurationsOfAny(nicBuilder.MustBuild())
 	}
-	builder.InitializationBuilder(initBuilder)
+	return initBuilder
 }
 
 func vmPlacementPolicyParameterConverter(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
@@ -134,6 +143,21 @@ func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name
 		params = &vmParams{}
 	}
 
+	existing, err := findIdempotentVM(o, name, params, retries)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+
+	if vmNeedsClusterLevelCheck(params) {
+		cluster, err := o.GetCluster(clusterID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateVMClusterLevel(cluster, params); err != nil {
+			return nil, err
+		}
+	}
+
 	message := fmt.Sprintf("creating VM %s", name)
 	vm, err := createSDKVM(clusterID, templateID, name, params)
 	if err != nil {
@@ -142,6 +166,13 @@ func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name
 
 	err = retry(
 		message,
+		mutating(ResourceTypeVM, "").
+			withParameters(map[string]string{
+				"cluster_id":  string(clusterID),
+				"template_id": string(templateID),
+				"name":        name,
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -149,7 +180,7 @@ func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name
 			if clone := params.Clone(); clone != nil {
 				vmCreateRequest.Clone(*clone)
 			}
-			response, err := vmCreateRequest.Send()
+			response, err := vmCreateRequest.Query("correlation_id", requestCorrelationID(retries)).Send()
 			if err != nil {
 				return err
 			}
@@ -168,7 +199,17 @@ func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name
 			return nil
 		},
 	)
-	return result, err
+	if err != nil {
+		return result, err
+	}
+	o.mutationListeners.notify(ResourceTypeVM, string(result.ID()), "", MutationTypeCreated)
+	if err := tagIdempotentVM(o, result.ID(), params, retries); err != nil {
+		return result, err
+	}
+	if waitForUnlock := params.WaitForUnlock(); waitForUnlock != nil && *waitForUnlock {
+		return o.WaitForVMUnlock(result.ID(), retries...)
+	}
+	return result, nil
 }
 
 func createSDKVM(
@@ -193,8 +234,15 @@ func createSDKVM(
 		vmInstanceTypeID,
 		vmTypeCreator,
 		vmOSCreator,
+		vmBIOSTypeCreator,
+		vmTPMEnabledCreator,
 		vmSerialConsoleCreator,
 		vmSoundcardEnabledCreator,
+		vmEmulatedMachineCreator,
+		vmTimeZoneCreator,
+		vmCustomCompatibilityVersionCreator,
+		vmUseLatestTemplateVersionCreator,
+		vmCPUProfileCreator,
 	}
 
 	for _, part := range parts {
@@ -216,6 +264,9 @@ func createSDKVM(
 			if storageDomainID := d.StorageDomainID(); storageDomainID != nil {
 				diskBuilder.StorageDomainsBuilderOfAny(*ovirtsdk.NewStorageDomainBuilder().Id(string(*storageDomainID)))
 			}
+			if provisionedSize := d.ProvisionedSize(); provisionedSize != nil {
+				diskBuilder.ProvisionedSize(int64(*provisionedSize))
+			}
 			diskAttachment.DiskBuilder(diskBuilder)
 			sdkDisk, err := diskAttachment.Build()
 			if err != nil {
@@ -251,11 +302,65 @@ func vmSoundcardEnabledCreator(params OptionalVMParameters, builder *ovirtsdk.Vm
 
 func vmOSCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if os, ok := params.OS(); ok {
-		osBuilder := ovirtsdk.NewOperatingSystemBuilder()
-		if t := os.Type(); t != nil {
-			osBuilder.Type(*t)
+		builder.OsBuilder(buildSDKVMOS(os))
+	}
+}
+
+// buildSDKVMOS converts the OS parameters into an SDK builder. It is shared between VM creation and update.
+func buildSDKVMOS(os VMOSParameters) *ovirtsdk.OperatingSystemBuilder {
+	osBuilder := ovirtsdk.NewOperatingSystemBuilder()
+	if t := os.Type(); t != nil {
+		osBuilder.Type(*t)
+	}
+	if bootDevices := os.BootDevices(); bootDevices != nil {
+		sdkDevices := make([]ovirtsdk.BootDevice, len(bootDevices))
+		for i, device := range bootDevices {
+			sdkDevices[i] = ovirtsdk.BootDevice(device)
 		}
-		builder.OsBuilder(osBuilder)
+		osBuilder.BootBuilder(ovirtsdk.NewBootBuilder().Devices(sdkDevices))
+	}
+	return osBuilder
+}
+
+func vmTPMEnabledCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if tpmEnabled := params.TPMEnabled(); tpmEnabled != nil {
+		builder.TpmEnabled(*tpmEnabled)
+	}
+}
+
+func vmUseLatestTemplateVersionCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if useLatest := params.UseLatestTemplateVersion(); useLatest != nil {
+		builder.UseLatestTemplateVersion(*useLatest)
+	}
+}
+
+func vmTimeZoneCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if timeZone := params.TimeZone(); timeZone != nil {
+		builder.TimeZoneBuilder(ovirtsdk.NewTimeZoneBuilder().Name(*timeZone))
+	}
+}
+
+func vmEmulatedMachineCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if emulatedMachine := params.EmulatedMachine(); emulatedMachine != nil {
+		builder.CustomEmulatedMachine(*emulatedMachine)
+	}
+}
+
+func vmCustomCompatibilityVersionCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if version := params.CustomCompatibilityVersion(); version != nil {
+		builder.CustomCompatibilityVersionBuilder(buildSDKVersion(version))
+	}
+}
+
+func vmCPUProfileCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if cpuProfileID := params.CPUProfileID(); cpuProfileID != nil {
+		builder.CpuProfileBuilder(ovirtsdk.NewCpuProfileBuilder().Id(string(*cpuProfileID)))
+	}
+}
+
+func vmBIOSTypeCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if biosType := params.BIOSType(); biosType != nil {
+		builder.BiosBuilder(ovirtsdk.NewBiosBuilder().Type(ovirtsdk.BiosType(*biosType)))
 	}
 }
 
@@ -287,6 +392,90 @@ func vmBuilderMemoryPolicy(params OptionalVMParameters, builder *ovirtsdk.VmBuil
 	}
 }
 
+// validateVMMemory checks that guaranteed <= memory <= max. Values that are nil are not checked, so the function can
+// also be used for partial updates.
+func validateVMMemory(memory *int64, guaranteed *int64, max *int64) error {
+	if memory != nil && *memory <= 0 {
+		return newError(EBadArgument, "VM memory must be positive (%d)", *memory)
+	}
+	if memory != nil && guaranteed != nil && *guaranteed > *memory {
+		return newError(
+			EBadArgument,
+			"guaranteed memory is larger than the VM memory (%d > %d)",
+			*guaranteed,
+			*memory,
+		)
+	}
+	if memory != nil && max != nil && *memory > *max {
+		return newError(
+			EBadArgument,
+			"VM memory is larger than the maximum memory (%d > %d)",
+			*memory,
+			*max,
+		)
+	}
+	if guaranteed != nil && max != nil && *guaranteed > *max {
+		return newError(
+			EBadArgument,
+			"guaranteed memory is larger than the maximum memory (%d > %d)",
+			*guaranteed,
+			*max,
+		)
+	}
+	return nil
+}
+
+// vmNeedsClusterLevelCheck returns true if the parameters contain features that depend on the cluster compatibility
+// level.
+func vmNeedsClusterLevelCheck(params OptionalVMParameters) bool {
+	if tpmEnabled := params.TPMEnabled(); tpmEnabled != nil && *tpmEnabled {
+		return true
+	}
+	if biosType := params.BIOSType(); biosType != nil && biosType.UEFI() {
+		return true
+	}
+	return false
+}
+
+// validateVMClusterLevel checks if the cluster compatibility level is high enough for the UEFI and TPM settings in
+// params.
+func validateVMClusterLevel(cluster Cluster, params OptionalVMParameters) error {
+	if err := validateVMBIOSTypeClusterLevel(cluster, params.BIOSType()); err != nil {
+		return err
+	}
+	clusterVersion := cluster.CompatibilityVersion()
+	if tpmEnabled := params.TPMEnabled(); tpmEnabled != nil && *tpmEnabled {
+		if !clusterVersion.AtLeast(4, 6) {
+			return newError(
+				EUnsupported,
+				"a virtual TPM requires a cluster compatibility level of at least 4.6, cluster %s is at %s",
+				cluster.ID(),
+				clusterVersion,
+			)
+		}
+		if biosType := params.BIOSType(); biosType != nil && *biosType != VMBIOSTypeClusterDefault && !biosType.UEFI() {
+			return newError(EBadArgument, "a virtual TPM requires a UEFI BIOS type, got %s", *biosType)
+		}
+	}
+	return nil
+}
+
+// validateVMBIOSTypeClusterLevel checks if the cluster compatibility level is high enough for a UEFI BIOS type. It is
+// also used when the BIOS type of an existing VM is changed.
+func validateVMBIOSTypeClusterLevel(cluster Cluster, biosType *VMBIOSType) error {
+	clusterVersion := cluster.CompatibilityVersion()
+	if biosType != nil && biosType.UEFI() && !clusterVersion.AtLeast(4, 4) {
+		return newError(
+			EUnsupported,
+			"BIOS type %s requires a cluster compatibility level of at least 4.4, cluster %s is at %s",
+			*biosType,
+			cluster.ID(),
+			clusterVersion,
+		)
+	}
+	return nil
+}
+
 func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, name string, params OptionalVMParameters) error {
 	if name == "" {
 		return newError(EBadArgument, "name cannot be empty for VM creation")
@@ -300,48 +489,77 @@ func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, na
 	if params == nil {
 		return nil
 	}
+	return validateVMParameters(params)
+}
+
+// newVMParameterError creates an EBadArgument error naming the VM parameter that failed validation.
+func newVMParameterError(field string, format string, args ...interface{}) EngineError {
+	return newError(EBadArgument, "invalid %s parameter for VM: %s", field, fmt.Sprintf(format, args...))
+}
 
+// validateVMParameters checks the optional VM parameters and their combinations without contacting the engine. The
+// checks depending on the cluster are done by validateVMClusterLevel.
+func validateVMParameters(params OptionalVMParameters) error {
 	memory := params.Memory()
 	if memory == nil {
 		mem := int64(1024 * 1024 * 1024)
 		memory = &mem
 	}
-	guaranteedMemory := memory
+	var guaranteedMemory, maxMemory *int64
 	if memPolicy := params.MemoryPolicy(); memPolicy != nil {
-		guaranteed := (*memPolicy).Guaranteed()
-		if guaranteed != nil {
-			guaranteedMemory = guaranteed
+		guaranteedMemory = (*memPolicy).Guaranteed()
+		maxMemory = (*memPolicy).Max()
+	}
+	if err := validateVMMemory(memory, guaranteedMemory, maxMemory); err != nil {
+		return wrap(err, EBadArgument, "invalid Memory or MemoryPolicy parameter for VM")
+	}
+
+	if err := validateVMDisks(params.Disks()); err != nil {
+		return err
+	}
+
+	if vmType := params.VMType(); vmType != nil {
+		if err := vmType.Validate(); err != nil {
+			return wrap(err, EBadArgument, "invalid VMType parameter for VM")
 		}
 	}
-	if *guaranteedMemory > *memory {
-		return newError(
-			EBadArgument,
-			"guaranteed memory is larger than the VM memory (%d > %d)",
-			*guaranteedMemory,
-			*memory,
-		)
+
+	if err := validateVMTPMBIOSType(params.TPMEnabled(), params.BIOSType()); err != nil {
+		return err
+	}
+
+	if instanceTypeID := params.InstanceTypeID(); instanceTypeID != nil && *instanceTypeID == "" {
+		return newVMParameterError("InstanceTypeID", "the instance type ID must not be empty")
 	}
 
-	disks := params.Disks()
+	return nil
+}
+
+func validateVMDisks(disks []OptionalVMDiskParameters) error {
 	diskIDs := map[DiskID]int{}
 	for i, d := range disks {
 		if previousID, ok := diskIDs[d.DiskID()]; ok {
-			return newError(
-				EBadArgument,
-				"Disk %s appears twice, in position %d and %d.",
+			return newVMParameterError(
+				"Disks",
+				"disk %s appears twice, in position %d and %d",
 				d.DiskID(),
 				previousID,
 				i,
 			)
 		}
+		diskIDs[d.DiskID()] = i
 	}
+	return nil
+}
 
-	if vmType := params.VMType(); vmType != nil {
-		if err := vmType.Validate(); err != nil {
-			return err
-		}
+// validateVMTPMBIOSType checks that a virtual TPM is only requested with a BIOS type that supports it.
+func validateVMTPMBIOSType(tpmEnabled *bool, biosType *VMBIOSType) error {
+	if tpmEnabled == nil || !*tpmEnabled || biosType == nil {
+		return nil
+	}
+	if *biosType != VMBIOSTypeClusterDefault && !biosType.UEFI() {
+		return newVMParameterError("TPMEnabled", "a virtual TPM requires a UEFI BIOS type, got %s", *biosType)
 	}
-
 	return nil
 }
 
@@ -363,35 +581,58 @@ func (m *mockClient) CreateVM(
 	if name == "" {
 		return nil, newError(EBadArgument, "The name parameter is required for VM creation.")
 	}
+	existing, err := findIdempotentVM(m, name, params, retries)
+	if err != nil || existing != nil {
+		return existing, err
+	}
 	err = retry(
 		fmt.Sprintf("creating VM %s", name),
+		mutating(ResourceTypeVM, ""),
 		m.logger,
 		retries,
 		func() error {
 			m.lock.Lock()
 			defer m.lock.Unlock()
-			if _, ok := m.clusters[clusterID]; !ok {
+			cluster, ok := m.clusters[clusterID]
+			if !ok {
 				return newError(ENotFound, "cluster with ID %s not found", clusterID)
 			}
+			if err := validateVMClusterLevel(cluster, params); err != nil {
+				return err
+			}
+			if err := m.validateVMCPUProfile(clusterID, params); err != nil {
+				return err
+			}
 			tpl, ok := m.templates[templateID]
 			if !ok {
 				return newError(ENotFound, "template with ID %s not found", templateID)
 			}
+			if useLatest := params.UseLatestTemplateVersion(); useLatest != nil && *useLatest {
+				tpl = m.latestTemplateVersion(tpl.baseTemplateID)
+			}
 			if tpl.status != TemplateStatusOK {
 				return newError(EConflict, "template in status \"%s\"", tpl.status)
 			}
 
 			for _, vm := range m.vms {
 				if vm.name == name {
-					return newError(EConflict, "A VM with the name \"%s\" already exists.", name)
+					return newError(ENameInUse, "A VM with the name \"%s\" already exists.", name)
 				}
 			}
 
+			if err := m.validateTemplateDiskSizes(tpl, params); err != nil {
+				return err
+			}
+			if err := m.ensureStorageAvailable(m.templateDiskStorageNeeds(tpl, params)); err != nil {
+				return err
+			}
+
 			cpu := m.createVMCPU(params, tpl)
 
-			vm := m.createVM(name, params, clusterID, templateID, cpu)
+			vm := m.createVM(name, params, clusterID, tpl.id, cpu)
 
 			m.attachVMDisksFromTemplate(tpl, vm, params)
+			m.lockNewVMImage(vm)
 
 			if clone := params.Clone(); clone != nil && *clone {
 				vm.templateID = DefaultBlankTemplateID
@@ -401,11 +642,38 @@ func (m *mockClient) CreateVM(
 			m.addGraphicsConsoles(vm)
 
 			result = vm
+			m.mutationListeners.notify(ResourceTypeVM, string(vm.id), "", MutationTypeCreated)
 			return nil
 		},
 	)
+	if err != nil {
+		return result, err
+	}
+	if err := tagIdempotentVM(m, result.ID(), params, retries); err != nil {
+		return result, err
+	}
+	if waitForUnlock := params.WaitForUnlock(); waitForUnlock != nil && *waitForUnlock {
+		return m.WaitForVMUnlock(result.ID(), retries...)
+	}
+	return result, nil
+}
 
-	return result, err
+// lockNewVMImage places a newly created VM in the image_locked status if the transition delays require it. It must
+// be called with the lock held.
+func (m *mockClient) lockNewVMImage(vm *vm) {
+	delay := m.vmTransitionDelays.imageLocked
+	if delay == 0 {
+		return
+	}
+	vm.status = VMStatusImageLocked
+	go func() {
+		time.Sleep(delay)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if vm.status == VMStatusImageLocked {
+			vm.status = VMStatusDown
+		}
+	}()
 }
 
 func (m *mockClient) addGraphicsConsoles(vm *vm) {
@@ -469,11 +737,44 @@ func (m *mockClient) createVM(
 		m.createVMOS(params),
 		console,
 		soundcardEnabled,
+		m.createVMBIOSType(params),
+		params.TPMEnabled() != nil && *params.TPMEnabled(),
+		false,
+		createVMTimeZone(params),
+		false,
+		time.Now(),
+		createVMEmulatedMachine(params),
+		params.CustomCompatibilityVersion(),
+		false,
+		m.createVMCPUProfileID(params, clusterID),
 	}
 	m.vms[VMID(id)] = vm
 	return vm
 }
 
+// createVMCPUProfileID returns the CPU profile from the parameters, or the default profile of the cluster if none is
+// set. The profile must already be validated using validateVMCPUProfile.
+func (m *mockClient) createVMCPUProfileID(params OptionalVMParameters, clusterID ClusterID) CPUProfileID {
+	if cpuProfileID := params.CPUProfileID(); cpuProfileID != nil {
+		return *cpuProfileID
+	}
+	return m.defaultCPUProfileID(clusterID)
+}
+
+func createVMTimeZone(params OptionalVMParameters) string {
+	if timeZone := params.TimeZone(); timeZone != nil {
+		return *timeZone
+	}
+	return ""
+}
+
+func createVMEmulatedMachine(params OptionalVMParameters) string {
+	if emulatedMachine := params.EmulatedMachine(); emulatedMachine != nil {
+		return *emulatedMachine
+	}
+	return ""
+}
+
 func (m *mockClient) createVMMemory(params OptionalVMParameters) int64 {
 	memory := int64(1073741824)
 	if params.Memory() != nil {
@@ -504,16 +805,23 @@ func (m *mockClient) createVMMemoryPolicy(params OptionalVMParameters) *memoryPo
 
 func (m *mockClient) createVMOS(params OptionalVMParameters) *vmOS {
 	os := &vmOS{
-		t: "other",
+		t:           "other",
+		bootDevices: []VMBootDevice{VMBootDeviceHD},
 	}
 	if osParams, ok := params.OS(); ok {
-		if osType := osParams.Type(); osType != nil {
-			os.t = *osType
-		}
+		os = os.withParams(osParams)
 	}
 	return os
 }
 
+func (m *mockClient) createVMBIOSType(params OptionalVMParameters) VMBIOSType {
+	biosType := VMBIOSTypeClusterDefault
+	if paramBIOSType := params.BIOSType(); paramBIOSType != nil {
+		biosType = *paramBIOSType
+	}
+	return biosType
+}
+
 func (m *mockClient) createVMType(params OptionalVMParameters) VMType {
 	vmType := VMTypeServer
 	if paramVMType := params.VMType(); paramVMType != nil {
@@ -542,13 +850,18 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm, params Opt
 	for _, attachment := range m.templateDiskAttachmentsByTemplate[tpl.id] {
 		disk := m.disks[attachment.diskID]
 		var sparse *bool
+		var provisionedSize *uint64
 		for _, diskParam := range params.Disks() {
 			if diskParam.DiskID() == disk.ID() {
 				sparse = m.updateDiskParams(diskParam, disk, params)
+				provisionedSize = diskParam.ProvisionedSize()
 				break
 			}
 		}
 		newDisk := disk.clone(sparse)
+		if provisionedSize != nil {
+			newDisk.provisionedSize = *provisionedSize
+		}
 		_ = newDisk.Lock()
 		newDisk.alias = fmt.Sprintf("disk-%s", generateRandomID(5, m.nonSecureRandom))
 		m.disks[newDisk.ID()] = newDisk
@@ -568,10 +881,72 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm, params Opt
 			active:        attachment.active,
 		}
 		m.vmDiskAttachmentsByVM[vm.id][diskAttachment.id] = diskAttachment
-		m.vmDiskAttachmentsByDisk[disk.id] = diskAttachment
+		m.addDiskAttachmentByDisk(diskAttachment)
 	}
 }
 
+// templateDiskStorageNeeds returns the space needed on each storage domain for copying the disks of the template to a
+// new VM, taking the storage domains requested in the disk parameters into account.
+func (m *mockClient) templateDiskStorageNeeds(tpl *template, params OptionalVMParameters) map[StorageDomainID]uint64 {
+	needed := map[StorageDomainID]uint64{}
+	for _, attachment := range m.templateDiskAttachmentsByTemplate[tpl.id] {
+		disk := m.disks[attachment.diskID]
+		storageDomainIDs := disk.storageDomainIDs
+		for _, diskParam := range params.Disks() {
+			sd := diskParam.StorageDomainID()
+			if diskParam.DiskID() != disk.ID() || sd == nil {
+				continue
+			}
+			if params.Clone() != nil && *params.Clone() {
+				storageDomainIDs = []StorageDomainID{*sd}
+				break
+			}
+			for _, diskSD := range disk.storageDomainIDs {
+				if diskSD == *sd {
+					storageDomainIDs = []StorageDomainID{*sd}
+					break
+				}
+			}
+		}
+		provisionedSize := disk.provisionedSize
+		for _, diskParam := range params.Disks() {
+			if diskParam.DiskID() == disk.ID() && diskParam.ProvisionedSize() != nil {
+				provisionedSize = *diskParam.ProvisionedSize()
+			}
+		}
+		for _, id := range storageDomainIDs {
+			needed[id] += provisionedSize
+		}
+	}
+	return needed
+}
+
+// validateTemplateDiskSizes checks that the disk sizes requested in the disk parameters do not shrink the disks of
+// the template. It must be called with the lock held.
+func (m *mockClient) validateTemplateDiskSizes(tpl *template, params OptionalVMParameters) error {
+	for _, diskParam := range params.Disks() {
+		provisionedSize := diskParam.ProvisionedSize()
+		if provisionedSize == nil {
+			continue
+		}
+		disk, ok := m.disks[diskParam.DiskID()]
+		if !ok || m.templateDiskAttachmentsByDisk[disk.id] == nil ||
+			m.templateDiskAttachmentsByDisk[disk.id].templateID != tpl.id {
+			return newVMParameterError("Disks", "disk %s does not belong to template %s", diskParam.DiskID(), tpl.id)
+		}
+		if *provisionedSize < disk.provisionedSize {
+			return newVMParameterError(
+				"Disks",
+				"the provisioned size of disk %s cannot be reduced from %d to %d bytes",
+				disk.id,
+				disk.provisionedSize,
+				*provisionedSize,
+			)
+		}
+	}
+	return nil
+}
+
 func (m *mockClient) updateDiskParams(
 	diskParam OptionalVMDiskParameters,
 	disk *diskWithData,
@@ -591,6 +966,7 @@ func (m *mockClient) updateDiskParams(
 	if sd := diskParam.StorageDomainID(); sd != nil {
 		if params.Clone() != nil && *params.Clone() {
 			disk.storageDomainIDs = []StorageDomainID{*sd}
+			disk.diskProfileID = m.defaultDiskProfileID(*sd)
 		} else {
 			for _, diskSD := range disk.storageDomainIDs {
 				if diskSD == *sd {
@@ -609,17 +985,7 @@ func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vm
 	cpuParams := params.CPU()
 	switch {
 	case cpuParams != nil:
-		cpu = &vmCPU{}
-		if topo := cpuParams.Topo(); topo != nil {
-			cpu.topo = &vmCPUTopo{
-				cores:   topo.Cores(),
-				sockets: topo.Sockets(),
-				threads: topo.Threads(),
-			}
-		}
-		if mode := cpuParams.Mode(); mode != nil {
-			cpu.mode = mode
-		}
+		cpu = applyVMCPUParams(&vmCPU{}, cpuParams)
 	case tpl.cpu != nil:
 		cpu = tpl.cpu.clone()
 	default:
@@ -633,3 +999,23 @@ func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vm
 	}
 	return cpu
 }
+
+// applyVMCPUParams returns a copy of the mock CPU with the topology and mode from the parameters applied. Values not
+// set in the parameters are kept.
+func applyVMCPUParams(cpu *vmCPU, cpuParams VMCPUParams) *vmCPU {
+	result := &vmCPU{
+		topo: cpu.topo.clone(),
+		mode: cpu.mode,
+	}
+	if topo := cpuParams.Topo(); topo != nil {
+		result.topo = &vmCPUTopo{
+			cores:   topo.Cores(),
+			sockets: topo.Sockets(),
+			threads: topo.Threads(),
+		}
+	}
+	if mode := cpuParams.Mode(); mode != nil {
+		result.mode = mode
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package ovirtclient
+
+import (
+	"sync"
+)
+
+// CreateVMSpec describes a single VM to create using CreateVMs.
+type CreateVMSpec interface {
+	// ClusterID returns the cluster to create the VM in.
+	ClusterID() ClusterID
+	// TemplateID returns the template to create the VM from.
+	TemplateID() TemplateID
+	// Name returns the name of the VM. It must be unique within the specs passed to CreateVMs.
+	Name() string
+	// Optional returns the optional parameters for the VM. May be nil.
+	Optional() OptionalVMParameters
+}
+
+// NewCreateVMSpec creates a CreateVMSpec with the same parameters as CreateVM accepts.
+func NewCreateVMSpec(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+) CreateVMSpec {
+	return &createVMSpec{
+		clusterID:  clusterID,
+		templateID: templateID,
+		name:       name,
+		optional:   optional,
+	}
+}
+
+type createVMSpec struct {
+	clusterID  ClusterID
+	templateID TemplateID
+	name       string
+	optional   OptionalVMParameters
+}
+
+func (c *createVMSpec) ClusterID() ClusterID {
+	return c.clusterID
+}
+
+func (c *createVMSpec) TemplateID() TemplateID {
+	return c.templateID
+}
+
+func (c *createVMSpec) Name() string {
+	return c.name
+}
+
+func (c *createVMSpec) Optional() OptionalVMParameters {
+	return c.optional
+}
+
+func (o *oVirtClient) CreateVMs(
+	specs []CreateVMSpec,
+	concurrency uint,
+	rollback bool,
+	retries ...RetryStrategy,
+) ([]VM, error) {
+	return createVMs(o, specs, concurrency, rollback, retries)
+}
+
+func (m *mockClient) CreateVMs(
+	specs []CreateVMSpec,
+	concurrency uint,
+	rollback bool,
+	retries ...RetryStrategy,
+) ([]VM, error) {
+	return createVMs(m, specs, concurrency, rollback, retries)
+}
+
+// createVMs contains the bulk creation logic shared between the live and mock clients. Each VM is created with its
+// own copy of the retry strategies, so a slow VM does not use up the timeout of the others.
+func createVMs(
+	client Client,
+	specs []CreateVMSpec,
+	concurrency uint,
+	rollback bool,
+	retries []RetryStrategy,
+) ([]VM, error) {
+	if concurrency == 0 {
+		return nil, newError(EBadArgument, "the concurrency for creating VMs must be at least 1")
+	}
+	names := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		if _, ok := names[spec.Name()]; ok {
+			return nil, newError(EBadArgument, "the VM name %s is used by more than one spec", spec.Name())
+		}
+		names[spec.Name()] = struct{}{}
+	}
+
+	result := make([]VM, len(specs))
+	lock := &sync.Mutex{}
+	failures := map[string]error{}
+	semaphore := make(chan struct{}, concurrency)
+	wg := &sync.WaitGroup{}
+	for i, spec := range specs {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, spec CreateVMSpec) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			vm, err := client.CreateVM(spec.ClusterID(), spec.TemplateID(), spec.Name(), spec.Optional(), retries...)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				failures[spec.Name()] = err
+				return
+			}
+			result[i] = vm
+		}(i, spec)
+	}
+	wg.Wait()
+
+	if len(failures) == 0 {
+		return result, nil
+	}
+	if rollback {
+		for _, vm := range result {
+			if vm == nil {
+				continue
+			}
+			if err := client.RemoveVM(vm.ID(), retries...); err != nil && !HasErrorCode(err, ENotFound) {
+				failures[vm.Name()] = wrap(err, EUnidentified, "failed to roll back VM %s", vm.ID())
+			}
+		}
+		return nil, newBulkError("creating VMs", failures)
+	}
+	return result, newBulkError("creating VMs", failures)
+}
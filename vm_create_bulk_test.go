@@ -0,0 +1,81 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestCreateVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	baseName := helper.GenerateTestResourceName(t)
+
+	var specs []ovirtclient.CreateVMSpec
+	for i := 0; i < 3; i++ {
+		specs = append(specs, ovirtclient.NewCreateVMSpec(
+			helper.GetClusterID(),
+			helper.GetBlankTemplateID(),
+			fmt.Sprintf("%s-%d", baseName, i),
+			nil,
+		))
+	}
+	vms, err := client.CreateVMs(specs, 2, false)
+	t.Cleanup(func() {
+		for _, vm := range vms {
+			if vm == nil {
+				continue
+			}
+			if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+				t.Fatalf("Failed to remove test VM %s (%v)", vm.ID(), err)
+			}
+		}
+	})
+	if err != nil {
+		t.Fatalf("Failed to create VMs (%v)", err)
+	}
+	for i, vm := range vms {
+		if vm == nil || vm.Name() != specs[i].Name() {
+			t.Fatalf("VM #%d does not match its spec.", i)
+		}
+	}
+}
+
+func TestCreateVMsRollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	existing := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	newName := fmt.Sprintf("%s-new", existing.Name())
+
+	vms, err := client.CreateVMs(
+		[]ovirtclient.CreateVMSpec{
+			ovirtclient.NewCreateVMSpec(helper.GetClusterID(), helper.GetBlankTemplateID(), newName, nil),
+			ovirtclient.NewCreateVMSpec(helper.GetClusterID(), helper.GetBlankTemplateID(), existing.Name(), nil),
+		},
+		2,
+		true,
+	)
+	if err == nil {
+		t.Fatalf("Creating a VM with a name that is already in use did not fail.")
+	}
+	if vms != nil {
+		t.Fatalf("VMs were returned even though the creation was rolled back.")
+	}
+	var bulkErr ovirtclient.BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("The returned error is not a BulkError (%v)", err)
+	}
+	if !bulkErr.HasCode(ovirtclient.ENameInUse) {
+		t.Fatalf("The returned error does not have the ENameInUse code (%v)", err)
+	}
+	if _, ok := bulkErr.Failures()[existing.Name()]; !ok || len(bulkErr.Failures()) != 1 {
+		t.Fatalf("Incorrect failures reported: %v", bulkErr.Failures())
+	}
+	if _, err := client.GetVMByName(newName); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The successfully created VM %s was not rolled back (%v)", newName, err)
+	}
+}
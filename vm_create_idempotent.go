@@ -0,0 +1,138 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// idempotencyTagPrefix is prepended to the idempotency key of a VM creation to form the name of the tag marking the
+// VM created with the key.
+const idempotencyTagPrefix = "idempotency-"
+
+func idempotencyTagName(key string) string {
+	return idempotencyTagPrefix + key
+}
+
+// validateIdempotencyKey checks that the key can be used as part of a tag name.
+func validateIdempotencyKey(key string) error {
+	if key == "" {
+		return newVMParameterError("IdempotencyKey", "the idempotency key must not be empty")
+	}
+	for _, c := range key {
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if !isLetter && !isDigit && c != '.' && c != '-' && c != '_' {
+			return newVMParameterError(
+				"IdempotencyKey",
+				"the idempotency key %s contains the invalid character %q",
+				key,
+				c,
+			)
+		}
+	}
+	return nil
+}
+
+// findIdempotentVM returns the VM created by a previous call with the idempotency key in params, or nil if there is
+// none or no key is set. If no VM is tagged with the key, a VM with the specified name is looked up and tagged, since
+// the previous call may have failed after creating the VM but before tagging it. If the parameters request waiting
+// for the unlock, the VM is returned once it is unlocked.
+func findIdempotentVM(client Client, name string, params OptionalVMParameters, retries []RetryStrategy) (VM, error) {
+	key := params.IdempotencyKey()
+	if key == "" {
+		return nil, nil
+	}
+	vms, err := client.SearchVMs(VMSearchParams().WithTag(idempotencyTagName(key)), retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to search for VMs created with idempotency key %s", key)
+	}
+	var vm VM
+	switch len(vms) {
+	case 0:
+		vm, err = client.GetVMByName(name, retries...)
+		if err != nil {
+			if HasErrorCode(err, ENotFound) {
+				return nil, nil
+			}
+			return nil, wrap(err, EUnidentified, "failed to look up VM %s for idempotency key %s", name, key)
+		}
+		if err := tagIdempotentVM(client, vm.ID(), params, retries); err != nil {
+			return nil, err
+		}
+	case 1:
+		vm = vms[0]
+	default:
+		return nil, newError(EConflict, "%d VMs have been created with idempotency key %s", len(vms), key)
+	}
+	if waitForUnlock := params.WaitForUnlock(); waitForUnlock != nil && *waitForUnlock {
+		return client.WaitForVMUnlock(vm.ID(), retries...)
+	}
+	return vm, nil
+}
+
+// RemoveIdempotencyKey removes the tag marking the VM created with the specified idempotency key. Call it once the
+// creation no longer needs to be repeated, for example after the VM ID has been stored, or after removing the VM,
+// since the tag is not removed along with the VM. Returns nil if there is no tag for the key.
+func RemoveIdempotencyKey(client Client, key string, retries ...RetryStrategy) error {
+	if err := validateIdempotencyKey(key); err != nil {
+		return err
+	}
+	tagName := idempotencyTagName(key)
+	tags, err := client.ListTags(retries...)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if tag.Name() != tagName {
+			continue
+		}
+		if err := client.RemoveTag(tag.ID(), retries...); err != nil && !HasErrorCode(err, ENotFound) {
+			return wrap(err, EUnidentified, "failed to remove the tag of idempotency key %s", key)
+		}
+	}
+	return nil
+}
+
+// tagIdempotentVM marks a newly created VM with the idempotency key in params, if any, creating the tag if needed.
+func tagIdempotentVM(client Client, vmID VMID, params OptionalVMParameters, retries []RetryStrategy) error {
+	key := params.IdempotencyKey()
+	if key == "" {
+		return nil
+	}
+	if err := addIdempotencyTag(client, vmID, key, retries); err != nil {
+		return wrap(
+			err,
+			EUnidentified,
+			"VM %s has been created, but could not be marked with idempotency key %s",
+			vmID,
+			key,
+		)
+	}
+	return nil
+}
+
+func addIdempotencyTag(client Client, vmID VMID, key string, retries []RetryStrategy) error {
+	tagName := idempotencyTagName(key)
+	tags, err := client.ListTags(retries...)
+	if err != nil {
+		return err
+	}
+	var tagID TagID
+	for _, tag := range tags {
+		if tag.Name() == tagName {
+			tagID = tag.ID()
+			break
+		}
+	}
+	if tagID == "" {
+		tag, err := client.CreateTag(
+			tagName,
+			NewCreateTagParams().MustWithDescription(fmt.Sprintf("VM created with idempotency key %s", key)),
+			retries...,
+		)
+		if err != nil {
+			return err
+		}
+		tagID = tag.ID()
+	}
+	return client.AddTagToVM(vmID, tagID, retries...)
+}
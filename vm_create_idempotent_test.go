@@ -0,0 +1,119 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCreationWithIdempotencyKey(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	key := helper.GenerateRandomID(10)
+	params := ovirtclient.NewCreateVMParams().MustWithIdempotencyKey(key)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), params)
+	t.Cleanup(func() {
+		tags, err := client.ListTags()
+		if err != nil {
+			t.Fatalf("Failed to list tags for cleanup (%v)", err)
+		}
+		for _, tag := range tags {
+			if tag.Name() != "idempotency-"+key {
+				continue
+			}
+			if err := client.RemoveTag(tag.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+				t.Fatalf("Failed to remove tag %s (%v)", tag.ID(), err)
+			}
+		}
+	})
+
+	// The repeated call uses a different name to show that the existing VM is found by the key.
+	repeated, err := client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		params,
+	)
+	if err != nil {
+		t.Fatalf("Failed to repeat VM creation with idempotency key %s (%v)", key, err)
+	}
+	if repeated.ID() != vm.ID() {
+		t.Fatalf("Repeated VM creation returned a different VM (%s instead of %s).", repeated.ID(), vm.ID())
+	}
+}
+
+func TestVMCreationWithInvalidIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := ovirtclient.NewCreateVMParams().WithIdempotencyKey("invalid key")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an invalid idempotency key did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func TestVMCreationWithIdempotencyKeyFindsUntaggedVMByName(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	key := helper.GenerateRandomID(10)
+	name := helper.GenerateTestResourceName(t)
+
+	// The VM is created without the key, as if the previous call failed before tagging it.
+	vm := assertCanCreateVM(t, helper, name, nil)
+	t.Cleanup(func() {
+		if err := ovirtclient.RemoveIdempotencyKey(client, key); err != nil {
+			t.Fatalf("Failed to remove idempotency key %s (%v)", key, err)
+		}
+	})
+
+	repeated, err := client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		name,
+		ovirtclient.NewCreateVMParams().MustWithIdempotencyKey(key),
+	)
+	if err != nil {
+		t.Fatalf("Failed to repeat VM creation with idempotency key %s (%v)", key, err)
+	}
+	if repeated.ID() != vm.ID() {
+		t.Fatalf("Repeated VM creation returned a different VM (%s instead of %s).", repeated.ID(), vm.ID())
+	}
+	tagged, err := client.SearchVMs(ovirtclient.VMSearchParams().WithTag("idempotency-" + key))
+	if err != nil {
+		t.Fatalf("Failed to search for VMs tagged with idempotency key %s (%v)", key, err)
+	}
+	if len(tagged) != 1 || tagged[0].ID() != vm.ID() {
+		t.Fatalf("The existing VM has not been tagged with idempotency key %s.", key)
+	}
+}
+
+func TestRemoveIdempotencyKey(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	key := helper.GenerateRandomID(10)
+	assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithIdempotencyKey(key),
+	)
+
+	if err := ovirtclient.RemoveIdempotencyKey(client, key); err != nil {
+		t.Fatalf("Failed to remove idempotency key %s (%v)", key, err)
+	}
+	tags, err := client.ListTags()
+	if err != nil {
+		t.Fatalf("Failed to list tags (%v)", err)
+	}
+	for _, tag := range tags {
+		if tag.Name() == "idempotency-"+key {
+			t.Fatalf("The tag of idempotency key %s still exists after removing the key.", key)
+		}
+	}
+	if err := ovirtclient.RemoveIdempotencyKey(client, key); err != nil {
+		t.Fatalf("Removing idempotency key %s a second time failed (%v)", key, err)
+	}
+}
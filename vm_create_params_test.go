@@ -0,0 +1,67 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMParamsMemoryConflictsWithMemoryPolicy(t *testing.T) {
+	policy := ovirtclient.NewMemoryPolicyParameters().MustWithGuaranteed(2 * 1024 * 1024 * 1024)
+	params := ovirtclient.NewCreateVMParams().WithMemoryPolicy(policy)
+	if _, err := params.WithMemory(1024 * 1024 * 1024); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("setting a memory smaller than the guaranteed memory did not return an EBadArgument error (%v)", err)
+	}
+	if _, err := params.WithMemory(-1); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("setting a negative memory did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func TestVMParamsDuplicateDisks(t *testing.T) {
+	disk, err := ovirtclient.NewBuildableVMDiskParameters("disk-1")
+	if err != nil {
+		t.Fatalf("failed to create disk parameters (%v)", err)
+	}
+	_, err = ovirtclient.NewCreateVMParams().WithDisks([]ovirtclient.OptionalVMDiskParameters{disk, disk})
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("passing the same disk twice did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func TestVMParamsTPMRequiresUEFI(t *testing.T) {
+	params := ovirtclient.NewCreateVMParams().WithTPMEnabled(true)
+	if _, err := params.WithBIOSType(ovirtclient.VMBIOSTypeQ35SeaBIOS); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("setting a non-UEFI BIOS type with a TPM did not return an EBadArgument error (%v)", err)
+	}
+
+	// The TPM setter does not return an error, so the combination is only caught by Validate.
+	params = ovirtclient.NewCreateVMParams().MustWithBIOSType(ovirtclient.VMBIOSTypeQ35SeaBIOS).WithTPMEnabled(true)
+	if err := params.Validate(); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("validating a non-UEFI BIOS type with a TPM did not return an EBadArgument error (%v)", err)
+	}
+	if _, err := params.WithBIOSType(ovirtclient.VMBIOSTypeQ35OVMF); err != nil {
+		t.Fatalf("failed to set UEFI BIOS type (%v)", err)
+	}
+}
+
+func TestVMParamsValidateBeforeCreation(t *testing.T) {
+	helper := getHelper(t)
+
+	policy := ovirtclient.NewMemoryPolicyParameters().MustWithMax(512 * 1024 * 1024)
+	params := ovirtclient.NewCreateVMParams().MustWithMemory(1024 * 1024 * 1024).WithMemoryPolicy(policy)
+	if err := params.Validate(); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("validating a memory larger than the maximum memory did not return an EBadArgument error (%v)", err)
+	}
+	_, err := helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		params,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("creating a VM with a memory larger than the maximum memory did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -0,0 +1,128 @@
+package ovirtclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CreateVMNICSpec describes a NIC to create on a new VM using CreateVMWithNICs.
+type CreateVMNICSpec interface {
+	// Name returns the name of the NIC.
+	Name() string
+	// VNICProfileID returns the VNIC profile to use for the NIC.
+	VNICProfileID() VNICProfileID
+	// Optional returns the optional parameters for the NIC. May be nil.
+	Optional() OptionalNICParameters
+}
+
+// NewCreateVMNICSpec creates a CreateVMNICSpec with the same parameters as CreateNIC accepts.
+func NewCreateVMNICSpec(name string, vnicProfileID VNICProfileID, optional OptionalNICParameters) CreateVMNICSpec {
+	return &createVMNICSpec{
+		name:          name,
+		vnicProfileID: vnicProfileID,
+		optional:      optional,
+	}
+}
+
+type createVMNICSpec struct {
+	name          string
+	vnicProfileID VNICProfileID
+	optional      OptionalNICParameters
+}
+
+func (c *createVMNICSpec) Name() string {
+	return c.name
+}
+
+func (c *createVMNICSpec) VNICProfileID() VNICProfileID {
+	return c.vnicProfileID
+}
+
+func (c *createVMNICSpec) Optional() OptionalNICParameters {
+	return c.optional
+}
+
+func (o *oVirtClient) CreateVMWithNICs(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+	nics []CreateVMNICSpec,
+	rollback bool,
+	retries ...RetryStrategy,
+) (VM, error) {
+	return createVMWithNICs(
+		o,
+		clusterID,
+		templateID,
+		name,
+		optional,
+		nics,
+		rollback,
+		defaultRetries(retries, defaultLongTimeouts(o)),
+	)
+}
+
+func (m *mockClient) CreateVMWithNICs(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+	nics []CreateVMNICSpec,
+	rollback bool,
+	retries ...RetryStrategy,
+) (VM, error) {
+	return createVMWithNICs(m, clusterID, templateID, name, optional, nics, rollback, retries)
+}
+
+// createVMWithNICs contains the creation and rollback logic shared between the live and mock clients.
+func createVMWithNICs(
+	client Client,
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+	nics []CreateVMNICSpec,
+	rollback bool,
+	retries []RetryStrategy,
+) (VM, error) {
+	vm, err := client.CreateVM(clusterID, templateID, name, optional, retries...)
+	if err != nil {
+		return nil, err
+	}
+	err = completeVMCreation(client, vm.ID(), nics, retries)
+	if err == nil {
+		return client.GetVM(vm.ID(), retries...)
+	}
+	if !rollback {
+		return vm, err
+	}
+
+	failures := map[string]error{
+		fmt.Sprintf("VM %s", name): err,
+	}
+	if _, rollbackErr := client.TeardownVMs([]VMID{vm.ID()}, retries...); rollbackErr != nil {
+		var bulkErr BulkError
+		if errors.As(rollbackErr, &bulkErr) {
+			for key, failure := range bulkErr.Failures() {
+				failures[fmt.Sprintf("rollback of %s", key)] = failure
+			}
+		} else {
+			failures[fmt.Sprintf("rollback of VM %s", vm.ID())] = rollbackErr
+		}
+	}
+	return nil, newBulkError(fmt.Sprintf("creating VM %s", name), failures)
+}
+
+// completeVMCreation waits for the disks of a new VM to be copied from the template and then creates the NICs.
+func completeVMCreation(client Client, vmID VMID, nics []CreateVMNICSpec, retries []RetryStrategy) error {
+	if _, err := client.WaitForVMUnlock(vmID, retries...); err != nil {
+		return wrap(err, EUnidentified, "failed to wait for the disks of VM %s to be created", vmID)
+	}
+	for _, nic := range nics {
+		if _, err := client.CreateNIC(vmID, nic.VNICProfileID(), nic.Name(), nic.Optional(), retries...); err != nil {
+			return wrap(err, EUnidentified, "failed to create NIC %s on VM %s", nic.Name(), vmID)
+		}
+	}
+	return nil
+}
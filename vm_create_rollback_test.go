@@ -0,0 +1,77 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestCreateVMWithNICs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	nicName := helper.GenerateTestResourceName(t)
+
+	vm, err := client.CreateVMWithNICs(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		nil,
+		[]ovirtclient.CreateVMNICSpec{
+			ovirtclient.NewCreateVMNICSpec(nicName, helper.GetVNICProfileID(), nil),
+		},
+		true,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create VM with NICs (%v)", err)
+	}
+	t.Cleanup(func() {
+		if _, err := client.TeardownVMs([]ovirtclient.VMID{vm.ID()}); err != nil {
+			t.Fatalf("Failed to tear down test VM %s (%v)", vm.ID(), err)
+		}
+	})
+	nics, err := vm.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of VM %s (%v)", vm.ID(), err)
+	}
+	if len(nics) != 1 || nics[0].Name() != nicName {
+		t.Fatalf("Incorrect NICs on VM %s: %v", vm.ID(), nics)
+	}
+}
+
+func TestCreateVMWithNICsRollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := helper.GenerateTestResourceName(t)
+	nicName := helper.GenerateTestResourceName(t)
+
+	vm, err := client.CreateVMWithNICs(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		name,
+		nil,
+		[]ovirtclient.CreateVMNICSpec{
+			ovirtclient.NewCreateVMNICSpec(nicName, helper.GetVNICProfileID(), nil),
+			ovirtclient.NewCreateVMNICSpec(nicName, helper.GetVNICProfileID(), nil),
+		},
+		true,
+	)
+	if err == nil {
+		t.Fatalf("Creating a VM with duplicate NIC names did not fail.")
+	}
+	if vm != nil {
+		t.Fatalf("A VM was returned even though the creation was rolled back.")
+	}
+	var bulkErr ovirtclient.BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("The returned error is not a BulkError (%v)", err)
+	}
+	if !bulkErr.HasCode(ovirtclient.ENameInUse) {
+		t.Fatalf("The returned error does not have the ENameInUse code (%v)", err)
+	}
+	if _, err := client.GetVMByName(name); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The partially created VM %s was not rolled back (%v)", name, err)
+	}
+}
@@ -0,0 +1,173 @@
+package ovirtclient
+
+// EnsureVMResult describes the outcome of an EnsureVM call.
+type EnsureVMResult interface {
+	// VM returns the VM after it has been created or updated.
+	VM() VM
+	// Created returns true if the VM did not exist and has been created.
+	Created() bool
+	// Changes returns the names of the fields that were updated because they drifted from the requested
+	// parameters. The names are comment, description, memory, memory_policy, cpu, bios_type and os. The list is
+	// empty if the VM was created or if nothing needed to be changed.
+	Changes() []string
+}
+
+type ensureVMResult struct {
+	vm      VM
+	created bool
+	changes []string
+}
+
+func (e *ensureVMResult) VM() VM {
+	return e.vm
+}
+
+func (e *ensureVMResult) Created() bool {
+	return e.created
+}
+
+func (e *ensureVMResult) Changes() []string {
+	return e.changes
+}
+
+func (o *oVirtClient) EnsureVM(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+	retries ...RetryStrategy,
+) (EnsureVMResult, error) {
+	return ensureVM(o, clusterID, templateID, name, optional, defaultRetries(retries, defaultWriteTimeouts(o)))
+}
+
+func (m *mockClient) EnsureVM(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+	retries ...RetryStrategy,
+) (EnsureVMResult, error) {
+	return ensureVM(m, clusterID, templateID, name, optional, retries)
+}
+
+// ensureVM contains the reconciliation logic shared between the live and mock clients. The template is only used when
+// the VM has to be created, an existing VM is never moved to a different template.
+func ensureVM(
+	client Client,
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	optional OptionalVMParameters,
+	retries []RetryStrategy,
+) (EnsureVMResult, error) {
+	existing, err := client.GetVMByName(name, retries...)
+	if err != nil {
+		if !HasErrorCode(err, ENotFound) {
+			return nil, err
+		}
+		vm, err := client.CreateVM(clusterID, templateID, name, optional, retries...)
+		if err != nil {
+			return nil, err
+		}
+		return &ensureVMResult{vm: vm, created: true, changes: []string{}}, nil
+	}
+	if existing.ClusterID() != clusterID {
+		return nil, newError(
+			EConflict,
+			"VM %s already exists in cluster %s instead of %s",
+			name,
+			existing.ClusterID(),
+			clusterID,
+		)
+	}
+	if optional == nil {
+		return &ensureVMResult{vm: existing, changes: []string{}}, nil
+	}
+
+	params, changes := buildVMDriftUpdate(existing, optional)
+	if len(changes) == 0 {
+		return &ensureVMResult{vm: existing, changes: changes}, nil
+	}
+	// The update is based on the state that was compared, so concurrent changes are not overwritten.
+	params.MustWithExpectedState(existing)
+	vm, err := client.UpdateVM(existing.ID(), params, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return &ensureVMResult{vm: vm, changes: changes}, nil
+}
+
+// buildVMDriftUpdate compares the VM with the creation parameters and returns an update for the fields that differ.
+// Fields that are not set in the parameters are ignored.
+func buildVMDriftUpdate(vm VM, optional OptionalVMParameters) (BuildableUpdateVMParameters, []string) {
+	params := UpdateVMParams()
+	changes := []string{}
+	if comment := optional.Comment(); comment != "" && comment != vm.Comment() {
+		params.MustWithComment(comment)
+		changes = append(changes, "comment")
+	}
+	if description := optional.Description(); description != "" && description != vm.Description() {
+		params.MustWithDescription(description)
+		changes = append(changes, "description")
+	}
+	if memory := optional.Memory(); memory != nil && *memory != vm.Memory() {
+		params.MustWithMemory(*memory)
+		changes = append(changes, "memory")
+	}
+	if memoryPolicy := optional.MemoryPolicy(); memoryPolicy != nil && *memoryPolicy != nil &&
+		memoryPolicyDrifted(vm.MemoryPolicy(), *memoryPolicy) {
+		params.MustWithMemoryPolicy(*memoryPolicy)
+		changes = append(changes, "memory_policy")
+	}
+	if cpu := optional.CPU(); cpu != nil && cpuDrifted(vm.CPU(), cpu) {
+		params.MustWithCPU(cpu)
+		changes = append(changes, "cpu")
+	}
+	if biosType := optional.BIOSType(); biosType != nil && *biosType != vm.BIOSType() {
+		params.MustWithBIOSType(*biosType)
+		changes = append(changes, "bios_type")
+	}
+	if os, ok := optional.OS(); ok && os.Type() != nil && *os.Type() != vm.OS().Type() {
+		params.MustWithOS(os)
+		changes = append(changes, "os")
+	}
+	return params, changes
+}
+
+func memoryPolicyDrifted(current MemoryPolicy, desired MemoryPolicyParameters) bool {
+	if current == nil {
+		return true
+	}
+	if guaranteed := desired.Guaranteed(); guaranteed != nil &&
+		(current.Guaranteed() == nil || *current.Guaranteed() != *guaranteed) {
+		return true
+	}
+	if max := desired.Max(); max != nil && (current.Max() == nil || *current.Max() != *max) {
+		return true
+	}
+	if ballooning := desired.Ballooning(); ballooning != nil && current.Ballooning() != *ballooning {
+		return true
+	}
+	return false
+}
+
+func cpuDrifted(current VMCPU, desired VMCPUParams) bool {
+	if current == nil {
+		return true
+	}
+	if mode := desired.Mode(); mode != nil && (current.Mode() == nil || *current.Mode() != *mode) {
+		return true
+	}
+	if topo := desired.Topo(); topo != nil {
+		currentTopo := current.Topo()
+		if currentTopo == nil {
+			return true
+		}
+		if currentTopo.Cores() != topo.Cores() ||
+			currentTopo.Threads() != topo.Threads() ||
+			currentTopo.Sockets() != topo.Sockets() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestEnsureVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := helper.GenerateTestResourceName(t)
+	params := ovirtclient.NewCreateVMParams().
+		MustWithComment("ensured").
+		MustWithMemory(256 * 1024 * 1024)
+
+	result, err := client.EnsureVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, params)
+	if err != nil {
+		t.Fatalf("Failed to ensure VM %s (%v)", name, err)
+	}
+	vm := result.VM()
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove test VM %s (%v)", vm.ID(), err)
+		}
+	})
+	if !result.Created() {
+		t.Fatalf("EnsureVM did not report creating the missing VM %s.", name)
+	}
+
+	result, err = client.EnsureVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, params)
+	if err != nil {
+		t.Fatalf("Failed to ensure existing VM %s (%v)", name, err)
+	}
+	if result.Created() || len(result.Changes()) != 0 {
+		t.Fatalf(
+			"EnsureVM changed VM %s even though it matched (created: %t, changes: %v)",
+			name,
+			result.Created(),
+			result.Changes(),
+		)
+	}
+	if result.VM().ID() != vm.ID() {
+		t.Fatalf("EnsureVM returned a different VM (%s instead of %s).", result.VM().ID(), vm.ID())
+	}
+
+	result, err = client.EnsureVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		name,
+		params.MustWithComment("drifted").MustWithMemory(512*1024*1024),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update drifted VM %s (%v)", name, err)
+	}
+	changes := result.Changes()
+	if len(changes) != 2 || changes[0] != "comment" || changes[1] != "memory" {
+		t.Fatalf("Incorrect changes reported for drifted VM %s: %v", name, changes)
+	}
+	if result.VM().Comment() != "drifted" || result.VM().Memory() != 512*1024*1024 {
+		t.Fatalf(
+			"The drifted fields were not updated (comment: %s, memory: %d).",
+			result.VM().Comment(),
+			result.VM().Memory(),
+		)
+	}
+}
@@ -5,27 +5,43 @@ import (
 )
 
 func (o *oVirtClient) GetVMByName(name string, retries ...RetryStrategy) (result VM, err error) {
-
+	quotedName, err := quoteSearchString(name)
+	if err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting vm name %s", name),
+		reading(ResourceTypeVM, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().List().Search("name=" + name).Send()
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				List().
+				Search("name="+quotedName).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
+			var results []VM
 			for _, sdkObject := range response.MustVms().Slice() {
-				if mName, ok := sdkObject.Name(); ok {
-					// We re-scan for the name here since the search function may result other VMs too.
-					if name == mName {
-						result, err = convertSDKVM(sdkObject, o)
+				// We re-scan for the name here since the search function may result other VMs too.
+				if mName, ok := sdkObject.Name(); ok && name == mName {
+					item, err := convertSDKVM(sdkObject, o)
+					if err != nil {
 						return err
 					}
+					results = append(results, item)
 				}
 			}
-			return newError(ENotFound, "No VM found with name %s", name)
+			if err := checkNameMatches(len(results), "VM", name); err != nil {
+				return err
+			}
+			result = results[0]
+			return nil
 		})
 	return result, err
 }
@@ -33,10 +49,14 @@ func (o *oVirtClient) GetVMByName(name string, retries ...RetryStrategy) (result
 func (m *mockClient) GetVMByName(name string, _ ...RetryStrategy) (result VM, err error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+	var results []VM
 	for _, vm := range m.vms {
 		if vm.name == name {
-			return vm, nil
+			results = append(results, vm)
 		}
 	}
-	return nil, newError(ENotFound, "No VM found with name %s", name)
+	if err := checkNameMatches(len(results), "VM", name); err != nil {
+		return nil, err
+	}
+	return results[0], nil
 }
@@ -6,10 +6,18 @@ func (o *oVirtClient) ListVMGraphicsConsoles(vmID VMID, retries ...RetryStrategy
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("listing graphics consoles for VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
 		o.logger,
 		retries,
 		func() error {
-			resp, err := o.conn.SystemService().VmsService().VmService(string(vmID)).GraphicsConsolesService().List().Send()
+			resp, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				GraphicsConsolesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -8,8 +8,12 @@ func (o *oVirtClient) RemoveVMGraphicsConsole(
 	retries ...RetryStrategy,
 ) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	return retry(
+	err = retry(
 		fmt.Sprintf("removing graphics consoles %s from VM %s", graphicsConsoleID, vmID),
+		mutating(ResourceTypeGraphicsConsole, string(graphicsConsoleID)).
+			withParameters(map[string]string{
+				"vm_id": string(vmID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -20,10 +24,20 @@ func (o *oVirtClient) RemoveVMGraphicsConsole(
 				GraphicsConsolesService().
 				ConsoleService(string(graphicsConsoleID)).
 				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			return err
 		},
 	)
+	if err == nil {
+		o.mutationListeners.notify(
+			ResourceTypeGraphicsConsole,
+			string(graphicsConsoleID),
+			string(vmID),
+			MutationTypeRemoved,
+		)
+	}
+	return err
 }
 
 func (m *mockClient) RemoveVMGraphicsConsole(
@@ -54,6 +68,7 @@ func (m *mockClient) RemoveVMGraphicsConsole(
 		m.graphicsConsolesByVM[vmID][:foundIndex],
 		m.graphicsConsolesByVM[vmID][foundIndex+1:]...,
 	)
+	m.mutationListeners.notify(ResourceTypeGraphicsConsole, string(graphicsConsoleID), string(vmID), MutationTypeRemoved)
 
 	return nil
 }
@@ -26,10 +26,18 @@ func (o *oVirtClient) GetVMIPAddresses(id VMID, params VMIPSearchParams, retries
 	result = map[string][]net.IP{}
 	err = retry(
 		fmt.Sprintf("getting IP addresses for VM %s", id),
+		reading(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			reportedDevicesResponse, err := o.conn.SystemService().VmsService().VmService(string(id)).ReportedDevicesService().List().Send()
+			reportedDevicesResponse, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				ReportedDevicesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 
 			reportedDevices, ok := reportedDevicesResponse.ReportedDevice()
 			if !ok {
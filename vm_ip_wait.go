@@ -34,6 +34,7 @@ func waitForIPAddresses(
 	hasNICs := false
 	err = retry(
 		fmt.Sprintf("waiting for IP addresses on VM %s", id),
+		reading(ResourceTypeVM, string(id)).viaClient(),
 		logger,
 		retries,
 		func() error {
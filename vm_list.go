@@ -5,10 +5,16 @@ func (o *oVirtClient) ListVMs(retries ...RetryStrategy) (result []VM, err error)
 	result = []VM{}
 	err = retry(
 		"listing vms",
+		reading(ResourceTypeVM, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				VmsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
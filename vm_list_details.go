@@ -0,0 +1,242 @@
+package ovirtclient
+
+import (
+	"sort"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMFollow is a sub-collection of VMs the engine can return along with the VMs themselves, using the follow
+// parameter of the list request.
+type VMFollow string
+
+const (
+	// VMFollowNICs fetches the network interfaces of the VMs.
+	VMFollowNICs VMFollow = "nics"
+	// VMFollowDiskAttachments fetches the disk attachments of the VMs.
+	VMFollowDiskAttachments VMFollow = "disk_attachments"
+	// VMFollowReportedDevices fetches the devices reported by the guest agents of the VMs.
+	VMFollowReportedDevices VMFollow = "reported_devices"
+)
+
+// VMFollowList is a list of VMFollow values.
+type VMFollowList []VMFollow
+
+// VMFollowValues returns all possible values for VMFollow.
+func VMFollowValues() VMFollowList {
+	return []VMFollow{
+		VMFollowNICs,
+		VMFollowDiskAttachments,
+		VMFollowReportedDevices,
+	}
+}
+
+// Strings returns a list of strings.
+func (l VMFollowList) Strings() []string {
+	result := make([]string, len(l))
+	for i, follow := range l {
+		result[i] = string(follow)
+	}
+	return result
+}
+
+// Validate returns an error if any of the values in the list is invalid.
+func (l VMFollowList) Validate() error {
+	for _, follow := range l {
+		if err := follow.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the VMFollow doesn't have a valid value.
+func (f VMFollow) Validate() error {
+	for _, follow := range VMFollowValues() {
+		if follow == f {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid follow value: %s must be one of: %s",
+		f,
+		strings.Join(VMFollowValues().Strings(), ", "),
+	)
+}
+
+// VMDetails contains a VM along with the sub-collections requested in ListVMsWithDetails. The sub-collections that
+// were not requested are nil.
+type VMDetails interface {
+	// VM returns the VM itself.
+	VM() VM
+	// NICs returns the network interfaces of the VM if VMFollowNICs was requested.
+	NICs() []NIC
+	// DiskAttachments returns the disk attachments of the VM if VMFollowDiskAttachments was requested.
+	DiskAttachments() []DiskAttachment
+	// ReportedDevices returns the devices reported by the guest agent if VMFollowReportedDevices was requested.
+	ReportedDevices() []VMReportedDevice
+}
+
+type vmDetails struct {
+	vm              VM
+	nics            []NIC
+	diskAttachments []DiskAttachment
+	reportedDevices []VMReportedDevice
+}
+
+func (v *vmDetails) VM() VM {
+	return v.vm
+}
+
+func (v *vmDetails) NICs() []NIC {
+	return v.nics
+}
+
+func (v *vmDetails) DiskAttachments() []DiskAttachment {
+	return v.diskAttachments
+}
+
+func (v *vmDetails) ReportedDevices() []VMReportedDevice {
+	return v.reportedDevices
+}
+
+func (o *oVirtClient) ListVMsWithDetails(follow []VMFollow, retries ...RetryStrategy) (result []VMDetails, err error) {
+	if err := VMFollowList(follow).Validate(); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []VMDetails{}
+	err = retry(
+		"listing vms with details",
+		reading(ResourceTypeVM, ""),
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().VmsService().List()
+			if len(follow) > 0 {
+				request.Follow(strings.Join(VMFollowList(follow).Strings(), ","))
+			}
+			response, e := request.Query("correlation_id", requestCorrelationID(retries)).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Vms()
+			if !ok {
+				return nil
+			}
+			result = make([]VMDetails, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = o.convertSDKVMDetails(sdkObject, follow)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert vm during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) convertSDKVMDetails(sdkObject *ovirtsdk.Vm, follow []VMFollow) (VMDetails, error) {
+	vm, err := convertSDKVM(sdkObject, o)
+	if err != nil {
+		return nil, err
+	}
+	result := &vmDetails{vm: vm}
+	for _, f := range follow {
+		switch f {
+		case VMFollowNICs:
+			result.nics = []NIC{}
+			if sdkNICs, ok := sdkObject.Nics(); ok {
+				for _, sdkNIC := range sdkNICs.Slice() {
+					nic, err := convertSDKNIC(sdkNIC, o)
+					if err != nil {
+						return nil, err
+					}
+					result.nics = append(result.nics, nic)
+				}
+			}
+		case VMFollowDiskAttachments:
+			result.diskAttachments = []DiskAttachment{}
+			if sdkAttachments, ok := sdkObject.DiskAttachments(); ok {
+				for _, sdkAttachment := range sdkAttachments.Slice() {
+					attachment, err := convertSDKDiskAttachment(sdkAttachment, o)
+					if err != nil {
+						return nil, err
+					}
+					result.diskAttachments = append(result.diskAttachments, attachment)
+				}
+			}
+		case VMFollowReportedDevices:
+			result.reportedDevices = []VMReportedDevice{}
+			if sdkDevices, ok := sdkObject.ReportedDevices(); ok {
+				for _, sdkDevice := range sdkDevices.Slice() {
+					device, err := convertSDKReportedDevice(sdkDevice, vm.ID())
+					if err != nil {
+						return nil, err
+					}
+					result.reportedDevices = append(result.reportedDevices, device)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListVMsWithDetails(follow []VMFollow, _ ...RetryStrategy) ([]VMDetails, error) {
+	if err := VMFollowList(follow).Validate(); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]VMDetails, 0, len(m.vms))
+	for _, item := range m.vms {
+		details := &vmDetails{vm: item}
+		for _, f := range follow {
+			switch f {
+			case VMFollowNICs:
+				details.nics = m.listVMNICs(item.id)
+			case VMFollowDiskAttachments:
+				details.diskAttachments = m.listVMDiskAttachments(item.id)
+			case VMFollowReportedDevices:
+				details.reportedDevices = m.listVMReportedDevices(item.id)
+			}
+		}
+		result = append(result, details)
+	}
+	return result, nil
+}
+
+// listVMNICs returns the NICs of the VM. It must be called with the lock held.
+func (m *mockClient) listVMNICs(vmID VMID) []NIC {
+	result := []NIC{}
+	for _, item := range m.nics {
+		if item.vmid == vmID {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// listVMDiskAttachments returns the disk attachments of the VM. It must be called with the lock held.
+func (m *mockClient) listVMDiskAttachments(vmID VMID) []DiskAttachment {
+	result := make([]DiskAttachment, 0, len(m.vmDiskAttachmentsByVM[vmID]))
+	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
+		result = append(result, attachment)
+	}
+	return result
+}
+
+// listVMReportedDevices returns the devices reported by the guest agent of the VM sorted by name. It must be called
+// with the lock held.
+func (m *mockClient) listVMReportedDevices(vmID VMID) []VMReportedDevice {
+	result := make([]VMReportedDevice, len(m.reportedDevicesByVM[vmID]))
+	for i, device := range m.reportedDevicesByVM[vmID] {
+		result[i] = device
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result
+}
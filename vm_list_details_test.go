@@ -0,0 +1,52 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListVMsWithDetails(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	nic := assertCanCreateNIC(t, helper, vm, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	details, err := helper.GetClient().ListVMsWithDetails(
+		[]ovirtclient.VMFollow{ovirtclient.VMFollowNICs, ovirtclient.VMFollowDiskAttachments},
+	)
+	if err != nil {
+		t.Fatalf("Failed to list VMs with details (%v)", err)
+	}
+	var vmDetails ovirtclient.VMDetails
+	for _, item := range details {
+		if item.VM().ID() == vm.ID() {
+			vmDetails = item
+		}
+	}
+	if vmDetails == nil {
+		t.Fatalf("VM %s not found in the VM list.", vm.ID())
+	}
+	if len(vmDetails.NICs()) != 1 || vmDetails.NICs()[0].ID() != nic.ID() {
+		t.Fatalf("Incorrect NICs returned for VM %s: %v", vm.ID(), vmDetails.NICs())
+	}
+	if len(vmDetails.DiskAttachments()) != 1 || vmDetails.DiskAttachments()[0].DiskID() != disk.ID() {
+		t.Fatalf("Incorrect disk attachments returned for VM %s: %v", vm.ID(), vmDetails.DiskAttachments())
+	}
+	if vmDetails.ReportedDevices() != nil {
+		t.Fatalf("Reported devices were returned for VM %s even though they were not requested.", vm.ID())
+	}
+}
+
+func TestListVMsWithDetailsInvalidFollow(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().ListVMsWithDetails([]ovirtclient.VMFollow{"tags"})
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Listing VMs with an invalid follow value did not fail with EBadArgument (%v)", err)
+	}
+}
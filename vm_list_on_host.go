@@ -0,0 +1,121 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMsOnHost(hostID HostID, retries ...RetryStrategy) ([]VM, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	// The engine search works on host names only, so the host is fetched first. This also results in an ENotFound
+	// error for non-existent hosts.
+	host, err := o.GetHost(hostID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	quotedName, err := quoteSearchString(host.Name())
+	if err != nil {
+		return nil, wrap(err, EUnsupported, "cannot search for VMs on host %s", hostID)
+	}
+	return o.listVMsBySearch(
+		fmt.Sprintf("listing VMs on host %s", hostID),
+		fmt.Sprintf("host = %s", quotedName),
+		func(vm VM) bool {
+			return vm.HostID() != nil && *vm.HostID() == hostID
+		},
+		retries,
+	)
+}
+
+func (o *oVirtClient) ListVMsInCluster(clusterID ClusterID, retries ...RetryStrategy) ([]VM, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	cluster, err := o.GetCluster(clusterID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	quotedName, err := quoteSearchString(cluster.Name())
+	if err != nil {
+		return nil, wrap(err, EUnsupported, "cannot search for VMs in cluster %s", clusterID)
+	}
+	return o.listVMsBySearch(
+		fmt.Sprintf("listing VMs in cluster %s", clusterID),
+		fmt.Sprintf("cluster = %s", quotedName),
+		func(vm VM) bool {
+			return vm.ClusterID() == clusterID
+		},
+		retries,
+	)
+}
+
+// listVMsBySearch lists the VMs matching the search query. The engine search matches names, so the results are
+// filtered again in case another host or cluster has a similar name.
+func (o *oVirtClient) listVMsBySearch(
+	description string,
+	query string,
+	filter func(vm VM) bool,
+	retries []RetryStrategy,
+) (result []VM, err error) {
+	result = []VM{}
+	err = retry(
+		description,
+		reading(ResourceTypeVM, ""),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.
+				SystemService().
+				VmsService().
+				List().
+				Search(query).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Vms()
+			if !ok {
+				return nil
+			}
+			result = []VM{}
+			for i, sdkObject := range sdkObjects.Slice() {
+				item, e := convertSDKVM(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert VM during listing item #%d", i)
+				}
+				if filter(item) {
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListVMsOnHost(hostID HostID, _ ...RetryStrategy) ([]VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := []VM{}
+	for _, item := range m.vms {
+		if item.hostID != nil && *item.hostID == hostID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListVMsInCluster(clusterID ClusterID, _ ...RetryStrategy) ([]VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	result := []VM{}
+	for _, item := range m.vms {
+		if item.clusterID == clusterID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListVMsOnHost(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateBootableVM(t, helper)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+	if vm.HostID() == nil {
+		t.Fatalf("Running VM %s has no host.", vm.ID())
+	}
+
+	vms, err := client.ListVMsOnHost(*vm.HostID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs on host %s (%v)", *vm.HostID(), err)
+	}
+	if !vmIDInList(vm, vms) {
+		t.Fatalf("VM %s not listed on host %s.", vm.ID(), *vm.HostID())
+	}
+}
+
+func TestListVMsInCluster(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	vms, err := client.ListVMsInCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs in cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	if !vmIDInList(vm, vms) {
+		t.Fatalf("VM %s not listed in cluster %s.", vm.ID(), helper.GetClusterID())
+	}
+	for _, item := range vms {
+		if item.ClusterID() != helper.GetClusterID() {
+			t.Fatalf("VM %s from cluster %s listed in cluster %s.", item.ID(), item.ClusterID(), helper.GetClusterID())
+		}
+	}
+}
+
+func vmIDInList(vm ovirtclient.VM, vms []ovirtclient.VM) bool {
+	for _, item := range vms {
+		if item.ID() == vm.ID() {
+			return true
+		}
+	}
+	return false
+}
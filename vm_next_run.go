@@ -0,0 +1,41 @@
+package ovirtclient
+
+func (o *oVirtClient) ApplyNextRunConfiguration(id VMID, powerOff bool, retries ...RetryStrategy) (VM, error) {
+	return applyNextRunConfiguration(o, id, powerOff, retries)
+}
+
+func (m *mockClient) ApplyNextRunConfiguration(id VMID, powerOff bool, retries ...RetryStrategy) (VM, error) {
+	return applyNextRunConfiguration(m, id, powerOff, retries)
+}
+
+// applyNextRunConfiguration restarts the VM using the public API of the client. Holding the mock lock is not
+// necessary since each step is a separate call.
+func applyNextRunConfiguration(client Client, id VMID, powerOff bool, retries []RetryStrategy) (VM, error) {
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if !vm.NextRunConfigurationExists() || vm.Status() == VMStatusDown {
+		return vm, nil
+	}
+	if powerOff {
+		err = client.StopVM(id, false, retries...)
+	} else {
+		err = client.ShutdownVM(id, false, retries...)
+	}
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to stop VM %s to apply its next run configuration", id)
+	}
+	if _, err := client.WaitForVMStatus(id, VMStatusDown, retries...); err != nil {
+		return nil, wrap(err, EUnidentified, "VM %s did not stop while applying its next run configuration", id)
+	}
+	if err := client.StartVM(id, retries...); err != nil {
+		return nil, wrap(
+			err,
+			EUnidentified,
+			"VM %s was stopped to apply its next run configuration, but starting it failed",
+			id,
+		)
+	}
+	return client.GetVM(id, retries...)
+}
@@ -0,0 +1,36 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestApplyNextRunConfiguration(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateBootableVM(t, helper)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	vm, err := vm.Update(
+		ovirtclient.UpdateVMParams().MustWithBIOSType(ovirtclient.VMBIOSTypeQ35OVMF),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update BIOS type of running VM %s (%v)", vm.ID(), err)
+	}
+	if !vm.NextRunConfigurationExists() {
+		t.Fatalf("Changing the BIOS type of running VM %s did not create a next run configuration.", vm.ID())
+	}
+
+	vm, err = vm.ApplyNextRunConfiguration(true)
+	if err != nil {
+		t.Fatalf("Failed to apply next run configuration of VM %s (%v)", vm.ID(), err)
+	}
+	if vm.NextRunConfigurationExists() {
+		t.Fatalf("VM %s still has a next run configuration after it was restarted.", vm.ID())
+	}
+	vm = assertVMWillStart(t, vm)
+	if vm.BIOSType() != ovirtclient.VMBIOSTypeQ35OVMF {
+		t.Fatalf("Incorrect BIOS type after restart (%s)", vm.BIOSType())
+	}
+}
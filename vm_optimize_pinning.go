@@ -4,8 +4,9 @@ import "fmt"
 
 func (o *oVirtClient) AutoOptimizeVMCPUPinningSettings(id VMID, optimize bool, retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	return retry(
+	err := retry(
 		fmt.Sprintf("optimizing CPU pinning settings for VM %s", id),
+		mutating(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
@@ -14,9 +15,14 @@ func (o *oVirtClient) AutoOptimizeVMCPUPinningSettings(id VMID, optimize bool, r
 				VmService(string(id)).
 				AutoPinCpuAndNumaNodes().
 				OptimizeCpuSettings(optimize).
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
+	return err
 }
 
 func (m *mockClient) AutoOptimizeVMCPUPinningSettings(_ VMID, _ bool, _ ...RetryStrategy) error {
@@ -0,0 +1,465 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+)
+
+// VMOVF is the parsed OVF configuration of a VM. The engine stores the OVF of each VM in the OVF stores of its
+// storage domains, so DR tooling can use it to re-register the VM on another engine.
+type VMOVF interface {
+	// Data returns the raw OVF XML as returned by the engine.
+	Data() string
+	// VMID returns the ID of the VM the OVF belongs to.
+	VMID() VMID
+	// Name returns the name of the VM.
+	Name() string
+	// CPU returns the CPU topology of the VM.
+	CPU() VMCPUTopo
+	// Memory returns the memory of the VM in bytes.
+	Memory() int64
+	// Disks returns the disks of the VM.
+	Disks() []VMOVFDisk
+	// NICs returns the network interfaces of the VM.
+	NICs() []VMOVFNIC
+}
+
+// VMOVFDisk is a disk described in the OVF of a VM.
+type VMOVFDisk interface {
+	// ID returns the ID of the disk.
+	ID() DiskID
+	// Alias returns the alias of the disk.
+	Alias() string
+	// ProvisionedSize returns the size of the disk as seen by the VM in bytes.
+	ProvisionedSize() uint64
+	// Format returns the image format of the disk.
+	Format() ImageFormat
+	// StorageDomainID returns the ID of the storage domain holding the disk.
+	StorageDomainID() StorageDomainID
+	// Bootable returns true if the VM boots from the disk.
+	Bootable() bool
+}
+
+// VMOVFNIC is a network interface described in the OVF of a VM.
+type VMOVFNIC interface {
+	// Name returns the name of the network interface.
+	Name() string
+	// MACAddress returns the MAC address of the network interface.
+	MACAddress() string
+	// NetworkName returns the name of the logical network the interface is connected to, or an empty string if it
+	// is not connected.
+	NetworkName() string
+}
+
+// ParseVMOVF parses an OVF configuration, for example one extracted from an OVF store disk. Use GetVMOVF to fetch the
+// OVF of an existing VM from the engine.
+func ParseVMOVF(data []byte) (VMOVF, error) {
+	envelope := &ovfEnvelope{}
+	if err := xml.Unmarshal(data, envelope); err != nil {
+		return nil, wrap(err, EBadArgument, "failed to parse OVF")
+	}
+	result := &vmOVF{
+		data: string(data),
+		name: envelope.Content.Name,
+		cpu: &vmCPUTopo{
+			cores:   1,
+			threads: 1,
+			sockets: 1,
+		},
+	}
+	for _, section := range envelope.Content.Sections {
+		if strings.HasSuffix(section.Type, "OperatingSystemSection_Type") {
+			result.vmID = VMID(section.ID)
+		}
+	}
+	diskStorageDomains := map[string]StorageDomainID{}
+	for _, section := range envelope.Content.Sections {
+		if !strings.HasSuffix(section.Type, "VirtualHardwareSection_Type") {
+			continue
+		}
+		for _, item := range section.Items {
+			if err := result.parseHardwareItem(item, diskStorageDomains); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if result.vmID == "" {
+		return nil, newError(EFieldMissing, "the OVF contains no VM ID")
+	}
+	for _, section := range envelope.Sections {
+		if !strings.HasSuffix(section.Type, "DiskSection_Type") {
+			continue
+		}
+		for _, d := range section.Disks {
+			disk, err := convertOVFDisk(d, diskStorageDomains)
+			if err != nil {
+				return nil, err
+			}
+			result.disks = append(result.disks, disk)
+		}
+	}
+	return result, nil
+}
+
+// The resource types of the hardware items in the OVF, as defined by the CIM_ResourceAllocationSettingData schema.
+const (
+	ovfResourceTypeCPU     = "3"
+	ovfResourceTypeMemory  = "4"
+	ovfResourceTypeNetwork = "10"
+	ovfResourceTypeDisk    = "17"
+)
+
+func (v *vmOVF) parseHardwareItem(item ovfItem, diskStorageDomains map[string]StorageDomainID) error {
+	switch item.ResourceType {
+	case ovfResourceTypeCPU:
+		cpu := &vmCPUTopo{}
+		var err error
+		if cpu.sockets, err = parseOVFUint(item.Sockets, 1); err != nil {
+			return wrap(err, EBadArgument, "invalid number of CPU sockets in OVF")
+		}
+		if cpu.cores, err = parseOVFUint(item.CoresPerSocket, 1); err != nil {
+			return wrap(err, EBadArgument, "invalid number of CPU cores in OVF")
+		}
+		if cpu.threads, err = parseOVFUint(item.ThreadsPerCore, 1); err != nil {
+			return wrap(err, EBadArgument, "invalid number of CPU threads in OVF")
+		}
+		v.cpu = cpu
+	case ovfResourceTypeMemory:
+		// The engine always writes the memory in MiB.
+		memory, err := strconv.ParseInt(item.VirtualQuantity, 10, 64)
+		if err != nil {
+			return wrap(err, EBadArgument, "invalid memory size in OVF: %s", item.VirtualQuantity)
+		}
+		v.memory = memory * 1024 * 1024
+	case ovfResourceTypeNetwork:
+		v.nics = append(v.nics, &vmOVFNIC{
+			name:        item.Name,
+			macAddress:  item.MACAddress,
+			networkName: item.Connection,
+		})
+	case ovfResourceTypeDisk:
+		diskStorageDomains[item.HostResource] = StorageDomainID(item.StorageID)
+	}
+	return nil
+}
+
+func parseOVFUint(value string, defaultValue uint) (uint, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	result, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint(result), nil
+}
+
+func convertOVFDisk(d ovfDisk, diskStorageDomains map[string]StorageDomainID) (*vmOVFDisk, error) {
+	// The file reference has the format of <disk ID>/<image ID>.
+	parts := strings.Split(d.FileRef, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, newError(EBadArgument, "invalid file reference for disk %s in OVF: %s", d.DiskID, d.FileRef)
+	}
+	// The engine writes the disk size in GiB.
+	size, err := strconv.ParseUint(d.Size, 10, 64)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid size for disk %s in OVF: %s", d.DiskID, d.Size)
+	}
+	format := ImageFormat(strings.ToLower(d.VolumeFormat))
+	if err := format.Validate(); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid format for disk %s in OVF", d.DiskID)
+	}
+	return &vmOVFDisk{
+		id:              DiskID(parts[0]),
+		alias:           d.Alias,
+		provisionedSize: size * 1024 * 1024 * 1024,
+		format:          format,
+		storageDomainID: diskStorageDomains[d.FileRef],
+		bootable:        d.Boot == "true",
+	}, nil
+}
+
+func (o *oVirtClient) GetVMOVF(id VMID, retries ...RetryStrategy) (result VMOVF, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("fetching OVF of VM %s", id),
+		reading(ResourceTypeVM, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			// The engine only includes the OVF in the initialization section when asked for all content.
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Get().
+				AllContent(true).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkVM, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no VM returned when fetching the OVF of VM ID %s", id)
+			}
+			initialization, ok := sdkVM.Initialization()
+			if !ok {
+				return newFieldNotFound("VM", "initialization")
+			}
+			configuration, ok := initialization.Configuration()
+			if !ok {
+				return newFieldNotFound("VM initialization", "configuration")
+			}
+			data, ok := configuration.Data()
+			if !ok {
+				return newFieldNotFound("VM configuration", "data")
+			}
+			result, err = ParseVMOVF([]byte(data))
+			return err
+		})
+	return result, err
+}
+
+func (m *mockClient) GetVMOVF(id VMID, _ ...RetryStrategy) (VMOVF, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	vm, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	data, err := m.generateVMOVF(vm)
+	if err != nil {
+		return nil, err
+	}
+	// Parsing the generated OVF makes sure the mock behaves the same as the live client.
+	return ParseVMOVF(data)
+}
+
+// generateVMOVF writes the OVF of a mock VM in the format the engine uses. It must be called with the lock held.
+func (m *mockClient) generateVMOVF(vm *vm) ([]byte, error) {
+	data := mockOVFData{
+		VM:  vm,
+		CPU: vm.cpu.Topo(),
+		// The engine writes the memory in MiB.
+		MemoryMiB: vm.memory / 1024 / 1024,
+	}
+	for _, attachment := range m.vmDiskAttachmentsByVM[vm.id] {
+		disk, ok := m.disks[attachment.diskID]
+		if !ok {
+			continue
+		}
+		item := mockOVFDisk{
+			ID:       disk.id,
+			ImageID:  disk.id,
+			Alias:    disk.alias,
+			Format:   strings.ToUpper(string(disk.format)),
+			Bootable: attachment.bootable,
+			// The engine writes the disk size in GiB, rounded up.
+			SizeGiB: (disk.provisionedSize + 1024*1024*1024 - 1) / 1024 / 1024 / 1024,
+		}
+		if len(disk.storageDomainIDs) > 0 {
+			item.StorageDomainID = disk.storageDomainIDs[0]
+		}
+		data.Disks = append(data.Disks, item)
+	}
+	for _, n := range m.nics {
+		if n.vmid != vm.id {
+			continue
+		}
+		item := mockOVFNIC{NIC: n}
+		if profile, ok := m.vnicProfiles[n.vnicProfileID]; ok {
+			if network, ok := m.networks[profile.networkID]; ok {
+				item.NetworkName = network.name
+			}
+		}
+		data.NICs = append(data.NICs, item)
+	}
+	buf := &bytes.Buffer{}
+	if err := mockOVFTemplate.Execute(buf, data); err != nil {
+		return nil, wrap(err, EBug, "failed to generate OVF for VM %s", vm.id)
+	}
+	return buf.Bytes(), nil
+}
+
+type mockOVFData struct {
+	VM        *vm
+	CPU       VMCPUTopo
+	MemoryMiB int64
+	Disks     []mockOVFDisk
+	NICs      []mockOVFNIC
+}
+
+type mockOVFDisk struct {
+	ID              DiskID
+	ImageID         DiskID
+	Alias           string
+	Format          string
+	Bootable        bool
+	SizeGiB         uint64
+	StorageDomainID StorageDomainID
+}
+
+type mockOVFNIC struct {
+	NIC         *nic
+	NetworkName string
+}
+
+//nolint:gochecknoglobals,lll
+var mockOVFTemplate = texttemplate.Must(texttemplate.New("ovf").Funcs(texttemplate.FuncMap{"xml": xmlEscape}).Parse(
+	`<?xml version="1.0" encoding="UTF-8"?>
+<ovf:Envelope xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1/" xmlns:rasd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData" xmlns:vssd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" ovf:version="4.4.0.0">
+<References>{{range .Disks}}<File ovf:href="{{.ID}}/{{.ImageID}}" ovf:id="{{.ImageID}}" ovf:size="{{.SizeGiB}}"/>{{end}}</References>
+<Section xsi:type="ovf:DiskSection_Type"><Info>List of Virtual Disks</Info>{{range .Disks}}<Disk ovf:diskId="{{.ImageID}}" ovf:size="{{.SizeGiB}}" ovf:fileRef="{{.ID}}/{{.ImageID}}" ovf:volume-format="{{.Format}}" ovf:boot="{{.Bootable}}" ovf:disk-alias="{{xml .Alias}}"/>{{end}}</Section>
+<Content ovf:id="out" xsi:type="ovf:VirtualSystem_Type">
+<Name>{{xml .VM.Name}}</Name>
+<Section ovf:id="{{.VM.ID}}" ovf:required="false" xsi:type="ovf:OperatingSystemSection_Type"><Info>Guest Operating System</Info></Section>
+<Section xsi:type="ovf:VirtualHardwareSection_Type"><Info>{{.CPU.Sockets}} CPU, {{.MemoryMiB}} Memory</Info>
+<Item><rasd:ResourceType>3</rasd:ResourceType><rasd:num_of_sockets>{{.CPU.Sockets}}</rasd:num_of_sockets><rasd:cpu_per_socket>{{.CPU.Cores}}</rasd:cpu_per_socket><rasd:threads_per_cpu>{{.CPU.Threads}}</rasd:threads_per_cpu></Item>
+<Item><rasd:ResourceType>4</rasd:ResourceType><rasd:AllocationUnits>MegaBytes</rasd:AllocationUnits><rasd:VirtualQuantity>{{.MemoryMiB}}</rasd:VirtualQuantity></Item>
+{{range .Disks}}<Item><rasd:ResourceType>17</rasd:ResourceType><rasd:HostResource>{{.ID}}/{{.ImageID}}</rasd:HostResource><rasd:StorageId>{{.StorageDomainID}}</rasd:StorageId></Item>
+{{end}}{{range .NICs}}<Item><rasd:ResourceType>10</rasd:ResourceType><rasd:Name>{{xml .NIC.Name}}</rasd:Name><rasd:MACAddress>{{.NIC.Mac}}</rasd:MACAddress><rasd:Connection>{{xml .NetworkName}}</rasd:Connection></Item>
+{{end}}</Section>
+</Content>
+</ovf:Envelope>
+`))
+
+func xmlEscape(text string) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := xml.EscapeText(buf, []byte(text)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+type ovfEnvelope struct {
+	Sections []ovfSection `xml:"Section"`
+	Content  ovfContent   `xml:"Content"`
+}
+
+type ovfSection struct {
+	Type  string    `xml:"type,attr"`
+	ID    string    `xml:"id,attr"`
+	Disks []ovfDisk `xml:"Disk"`
+	Items []ovfItem `xml:"Item"`
+}
+
+type ovfDisk struct {
+	DiskID       string `xml:"diskId,attr"`
+	Size         string `xml:"size,attr"`
+	FileRef      string `xml:"fileRef,attr"`
+	VolumeFormat string `xml:"volume-format,attr"`
+	Boot         string `xml:"boot,attr"`
+	Alias        string `xml:"disk-alias,attr"`
+}
+
+type ovfContent struct {
+	Name     string       `xml:"Name"`
+	Sections []ovfSection `xml:"Section"`
+}
+
+type ovfItem struct {
+	ResourceType    string `xml:"ResourceType"`
+	Sockets         string `xml:"num_of_sockets"`
+	CoresPerSocket  string `xml:"cpu_per_socket"`
+	ThreadsPerCore  string `xml:"threads_per_cpu"`
+	VirtualQuantity string `xml:"VirtualQuantity"`
+	HostResource    string `xml:"HostResource"`
+	StorageID       string `xml:"StorageId"`
+	Name            string `xml:"Name"`
+	MACAddress      string `xml:"MACAddress"`
+	Connection      string `xml:"Connection"`
+}
+
+type vmOVF struct {
+	data   string
+	vmID   VMID
+	name   string
+	cpu    VMCPUTopo
+	memory int64
+	disks  []VMOVFDisk
+	nics   []VMOVFNIC
+}
+
+func (v *vmOVF) Data() string {
+	return v.data
+}
+
+func (v *vmOVF) VMID() VMID {
+	return v.vmID
+}
+
+func (v *vmOVF) Name() string {
+	return v.name
+}
+
+func (v *vmOVF) CPU() VMCPUTopo {
+	return v.cpu
+}
+
+func (v *vmOVF) Memory() int64 {
+	return v.memory
+}
+
+func (v *vmOVF) Disks() []VMOVFDisk {
+	return v.disks
+}
+
+func (v *vmOVF) NICs() []VMOVFNIC {
+	return v.nics
+}
+
+type vmOVFDisk struct {
+	id              DiskID
+	alias           string
+	provisionedSize uint64
+	format          ImageFormat
+	storageDomainID StorageDomainID
+	bootable        bool
+}
+
+func (v *vmOVFDisk) ID() DiskID {
+	return v.id
+}
+
+func (v *vmOVFDisk) Alias() string {
+	return v.alias
+}
+
+func (v *vmOVFDisk) ProvisionedSize() uint64 {
+	return v.provisionedSize
+}
+
+func (v *vmOVFDisk) Format() ImageFormat {
+	return v.format
+}
+
+func (v *vmOVFDisk) StorageDomainID() StorageDomainID {
+	return v.storageDomainID
+}
+
+func (v *vmOVFDisk) Bootable() bool {
+	return v.bootable
+}
+
+type vmOVFNIC struct {
+	name        string
+	macAddress  string
+	networkName string
+}
+
+func (v *vmOVFNIC) Name() string {
+	return v.name
+}
+
+func (v *vmOVFNIC) MACAddress() string {
+	return v.macAddress
+}
+
+func (v *vmOVFNIC) NetworkName() string {
+	return v.networkName
+}
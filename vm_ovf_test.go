@@ -0,0 +1,47 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGetVMOVF(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	ovf, err := vm.GetOVF()
+	if err != nil {
+		t.Fatalf("Failed to fetch the OVF of VM %s (%v)", vm.ID(), err)
+	}
+	if ovf.VMID() != vm.ID() {
+		t.Fatalf("Incorrect VM ID in OVF: %s instead of %s", ovf.VMID(), vm.ID())
+	}
+	if ovf.Name() != vm.Name() {
+		t.Fatalf("Incorrect VM name in OVF: %s instead of %s", ovf.Name(), vm.Name())
+	}
+	if ovf.Memory() != vm.Memory() {
+		t.Fatalf("Incorrect memory in OVF: %d instead of %d", ovf.Memory(), vm.Memory())
+	}
+	disks := ovf.Disks()
+	if len(disks) != 1 || disks[0].ID() != disk.ID() {
+		t.Fatalf("Incorrect disks in OVF of VM %s: %v", vm.ID(), disks)
+	}
+	if disks[0].ProvisionedSize() < disk.ProvisionedSize() {
+		t.Fatalf(
+			"Disk size in OVF is smaller than the disk: %d instead of %d",
+			disks[0].ProvisionedSize(),
+			disk.ProvisionedSize(),
+		)
+	}
+}
+
+func TestParseVMOVFInvalid(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.ParseVMOVF([]byte("<ovf:Envelope"))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Parsing an invalid OVF did not fail with EBadArgument (%v)", err)
+	}
+}
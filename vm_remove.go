@@ -6,42 +6,94 @@ import (
 
 func (o *oVirtClient) RemoveVM(id VMID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := checkHostedEngineVM(o, id, "remove", retries); err != nil {
+		return err
+	}
+	if hasRemoveVMOption(retries, removeVMOptionDisableDeleteProtection) {
+		if err := o.disableDeleteProtection(id, retries); err != nil {
+			return err
+		}
+	}
 	err = retry(
 		fmt.Sprintf("removing VM %s", id),
+		mutating(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Remove().Send()
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Remove()
+			if hasRemoveVMOption(retries, removeVMOptionDetachDisks) {
+				request.DetachOnly(true)
+			}
+			if hasRemoveVMOption(retries, removeVMOptionForce) {
+				request.Force(true)
+			}
+			_, err := request.Query("correlation_id", requestCorrelationID(retries)).Send()
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeRemoved)
+	}
 	return
 }
 
+// disableDeleteProtection disables the delete protection of the VM if it is enabled.
+func (o *oVirtClient) disableDeleteProtection(id VMID, retries []RetryStrategy) error {
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	if !vm.DeleteProtected() {
+		return nil
+	}
+	if _, err := o.UpdateVM(id, UpdateVMParams().WithDeleteProtected(false), retries...); err != nil {
+		return wrap(err, EUnidentified, "failed to disable delete protection on VM %s", id)
+	}
+	return nil
+}
+
 func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 
 	retries = defaultRetries(retries, defaultWriteTimeouts(m))
 
-	return retry(
+	err := retry(
 		fmt.Sprintf("removing VM %s", id),
+		mutating(ResourceTypeVM, string(id)),
 		m.logger,
 		retries,
 		func() error {
 			m.lock.Lock()
 			defer m.lock.Unlock()
 
-			if _, ok := m.vms[id]; !ok {
+			item, ok := m.vms[id]
+			if !ok {
 				return newError(ENotFound, "VM with ID %s not found", id)
 			}
+			if err := validateHostedEngineOperation(item, "remove", retries); err != nil {
+				return err
+			}
+			if item.deleteProtected && !hasRemoveVMOption(retries, removeVMOptionDisableDeleteProtection) {
+				return newError(
+					EDeleteProtected,
+					"cannot remove VM %s, delete protection is enabled, disable it first",
+					id,
+				)
+			}
 
+			detachDisks := hasRemoveVMOption(retries, removeVMOptionDetachDisks)
+			force := hasRemoveVMOption(retries, removeVMOptionForce)
 			for _, diskAttachment := range m.vmDiskAttachmentsByVM[id] {
-				if m.disks[diskAttachment.DiskID()].status == DiskStatusLocked {
+				if m.disks[diskAttachment.DiskID()].status == DiskStatusLocked && !force {
 					return newError(EConflict, "Cannot delete VM, disk %s is locked.", diskAttachment.DiskID())
 				}
-				delete(m.disks, diskAttachment.DiskID())
-				delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+				// Shareable disks attached to other VMs are only detached.
+				delete(m.vmDiskAttachmentsByDisk[diskAttachment.DiskID()], diskAttachment.ID())
+				if len(m.vmDiskAttachmentsByDisk[diskAttachment.DiskID()]) == 0 && !detachDisks {
+					delete(m.disks, diskAttachment.DiskID())
+					delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+				}
 			}
 			for nicID, nic := range m.nics {
 				if nic.VMID() == id {
@@ -49,10 +101,68 @@ func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 				}
 			}
 			delete(m.vmIPs, id)
+			delete(m.reportedDevicesByVM, id)
 			delete(m.vmDiskAttachmentsByVM, id)
 			delete(m.graphicsConsolesByVM, id)
+			delete(m.cdroms, id)
+			delete(m.watchdogsByVM, id)
+			delete(m.vmMigrationSettings, id)
 			delete(m.vms, id)
 
 			return nil
 		})
+	if err == nil {
+		m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeRemoved)
+	}
+	return err
+}
+
+// RemoveVMDetachDisks returns an option for RemoveVM that detaches the disks of the VM instead of removing them, so
+// persistent data volumes survive when a VM is replaced. Like AllowHostedEngine, it can be passed alongside the retry
+// strategies:
+//
+//	err := client.RemoveVM(id, ovirtclient.RemoveVMDetachDisks())
+//
+// The detached disks can then be attached to the replacement VM using CreateDiskAttachment.
+func RemoveVMDetachDisks() RetryStrategy {
+	return &removeVMOptionStrategy{option: removeVMOptionDetachDisks}
+}
+
+// RemoveVMForce returns an option for RemoveVM that forces the removal, for example when the disks of the VM are
+// locked by a stuck operation. Use it with care, the engine skips some of its consistency checks.
+func RemoveVMForce() RetryStrategy {
+	return &removeVMOptionStrategy{option: removeVMOptionForce}
+}
+
+// RemoveVMDisableDeleteProtection returns an option for RemoveVM that disables delete protection on the VM before
+// removing it. Without it, RemoveVM returns an EDeleteProtected error for protected VMs.
+func RemoveVMDisableDeleteProtection() RetryStrategy {
+	return &removeVMOptionStrategy{option: removeVMOptionDisableDeleteProtection}
+}
+
+// removeVMOption is the kind of options RemoveVM understands.
+type removeVMOption string
+
+const (
+	removeVMOptionDetachDisks             removeVMOption = "detach_disks"
+	removeVMOptionForce                   removeVMOption = "force"
+	removeVMOptionDisableDeleteProtection removeVMOption = "disable_delete_protection"
+)
+
+// removeVMOptionStrategy carries an option for RemoveVM. It does not alter the retry behavior, the option is only
+// checked for presence.
+type removeVMOptionStrategy struct {
+	callOption
+
+	option removeVMOption
+}
+
+// hasRemoveVMOption returns true if the retry strategies contain the specified RemoveVM option.
+func hasRemoveVMOption(retries []RetryStrategy, option removeVMOption) bool {
+	for _, r := range retries {
+		if o, ok := r.(*removeVMOptionStrategy); ok && o.option == option {
+			return true
+		}
+	}
+	return false
 }
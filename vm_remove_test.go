@@ -24,3 +24,39 @@ func TestVMRemovalShouldRemoveAttachedDisks(t *testing.T) {
 		t.Fatalf("Getting disk after VM removal did not result in a non found error (%v).", err)
 	}
 }
+
+func TestVMRemovalShouldDetachDisksWhenRequested(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	if err := vm.Remove(ovirtclient.RemoveVMDetachDisks()); err != nil {
+		t.Fatalf("Cannot remove test VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := helper.GetClient().GetDisk(disk.ID()); err != nil {
+		t.Fatalf("Disk was not present after the VM has been removed with detached disks (%v).", err)
+	}
+
+	replacement := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	assertCanAttachDisk(t, replacement, disk)
+}
+
+func TestVMRemovalShouldFailOnDeleteProtectedVM(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	protectedVM, err := vm.Update(ovirtclient.UpdateVMParams().WithDeleteProtected(true))
+	if err != nil {
+		t.Fatalf("Failed to enable delete protection on VM %s (%v)", vm.ID(), err)
+	}
+	if err := protectedVM.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.EDeleteProtected) {
+		t.Fatalf("Removing a delete protected VM did not fail with EDeleteProtected (%v)", err)
+	}
+	if err := protectedVM.Remove(ovirtclient.RemoveVMDisableDeleteProtection()); err != nil {
+		t.Fatalf("Cannot remove delete protected VM %s after disabling the protection (%v)", vm.ID(), err)
+	}
+	if _, err := helper.GetClient().GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("VM %s still present after removal (%v)", vm.ID(), err)
+	}
+}
@@ -0,0 +1,77 @@
+package ovirtclient
+
+func (o *oVirtClient) RenameVM(id VMID, name string, retries ...RetryStrategy) (VM, error) {
+	return renameVM(o, id, name, defaultRetries(retries, defaultWriteTimeouts(o)))
+}
+
+func (m *mockClient) RenameVM(id VMID, name string, retries ...RetryStrategy) (VM, error) {
+	return renameVM(m, id, name, retries)
+}
+
+// renameVM contains the logic shared between the live and mock clients.
+func renameVM(client Client, id VMID, name string, retries []RetryStrategy) (VM, error) {
+	params, err := UpdateVMParams().WithName(name)
+	if err != nil {
+		return nil, err
+	}
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if vm.Name() == name {
+		return vm, nil
+	}
+	candidates, err := client.SearchVMs(VMSearchParams().WithName(name), retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to search for VMs named %s", name)
+	}
+	for _, candidate := range candidates {
+		if candidate.ID() == id {
+			continue
+		}
+		sameDatacenter, err := clustersShareDatacenter(client, vm.ClusterID(), candidate.ClusterID(), retries)
+		if err != nil {
+			return nil, err
+		}
+		if sameDatacenter {
+			return nil, newError(
+				ENameInUse,
+				"cannot rename VM %s to %s, the name is already used by VM %s in the same datacenter",
+				id,
+				name,
+				candidate.ID(),
+			)
+		}
+	}
+	return client.UpdateVM(id, params, retries...)
+}
+
+// clustersShareDatacenter returns true if the two clusters belong to the same datacenter.
+func clustersShareDatacenter(client Client, clusterID1 ClusterID, clusterID2 ClusterID, retries []RetryStrategy) (
+	bool,
+	error,
+) {
+	if clusterID1 == clusterID2 {
+		return true, nil
+	}
+	datacenters, err := client.ListDatacenters(retries...)
+	if err != nil {
+		return false, wrap(err, EUnidentified, "failed to list datacenters")
+	}
+	for _, dc := range datacenters {
+		clusters, err := dc.Clusters(retries...)
+		if err != nil {
+			return false, wrap(err, EUnidentified, "failed to list clusters of datacenter %s", dc.ID())
+		}
+		found := 0
+		for _, cluster := range clusters {
+			if cluster.ID() == clusterID1 || cluster.ID() == clusterID2 {
+				found++
+			}
+		}
+		if found == 2 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
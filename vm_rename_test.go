@@ -0,0 +1,32 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMRename(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	newName := helper.GenerateTestResourceName(t)
+
+	renamedVM, err := vm.Rename(newName)
+	if err != nil {
+		t.Fatalf("Failed to rename VM %s to %s (%v)", vm.ID(), newName, err)
+	}
+	if renamedVM.Name() != newName {
+		t.Fatalf("Incorrect VM name after rename: %s instead of %s", renamedVM.Name(), newName)
+	}
+}
+
+func TestVMRenameToNameInUse(t *testing.T) {
+	helper := getHelper(t)
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	_, err := vm2.Rename(vm1.Name())
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENameInUse) {
+		t.Fatalf("Renaming VM %s to the name of VM %s did not fail with ENameInUse (%v)", vm2.ID(), vm1.ID(), err)
+	}
+}
@@ -0,0 +1,137 @@
+package ovirtclient
+
+import (
+	"net"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMReportedDeviceID is the identifier of a device reported by the guest agent of a VM.
+type VMReportedDeviceID string
+
+// VMReportedDeviceType is the type of a device reported by the guest agent.
+type VMReportedDeviceType string
+
+const (
+	// VMReportedDeviceTypeNetwork is a network interface as seen from inside the guest.
+	VMReportedDeviceTypeNetwork VMReportedDeviceType = "network"
+)
+
+// VMReportedDeviceTypeList is a list of VMReportedDeviceType values.
+type VMReportedDeviceTypeList []VMReportedDeviceType
+
+// Strings creates a string list of the values.
+func (l VMReportedDeviceTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, deviceType := range l {
+		result[i] = string(deviceType)
+	}
+	return result
+}
+
+// VMReportedDeviceTypeValues returns all possible VMReportedDeviceType values.
+func VMReportedDeviceTypeValues() VMReportedDeviceTypeList {
+	return []VMReportedDeviceType{
+		VMReportedDeviceTypeNetwork,
+	}
+}
+
+// VMReportedDevice is a device as the guest agent sees it. Unlike NICs, which describe the configuration stored in
+// the engine, reported devices contain the interface names, MAC addresses and IP addresses from inside the guest.
+// Devices are only reported while the VM is running and the guest agent is active.
+type VMReportedDevice interface {
+	// ID returns the identifier of the reported device.
+	ID() VMReportedDeviceID
+	// VMID returns the ID of the VM the device was reported for.
+	VMID() VMID
+	// Name returns the name of the device inside the guest, for example eth0.
+	Name() string
+	// Description returns the description of the device, if any.
+	Description() string
+	// Type returns the type of the device.
+	Type() VMReportedDeviceType
+	// MAC returns the MAC address of the device. It is empty for devices that have no MAC address, such as the
+	// loopback interface.
+	MAC() string
+	// IPs returns the IP addresses assigned to the device.
+	IPs() []net.IP
+}
+
+func convertSDKReportedDevice(object *ovirtsdk.ReportedDevice, vmID VMID) (VMReportedDevice, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("reported device", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("reported device", "name")
+	}
+	deviceType, ok := object.Type()
+	if !ok {
+		return nil, newFieldNotFound("reported device", "type")
+	}
+	description, _ := object.Description()
+	mac := ""
+	if sdkMAC, ok := object.Mac(); ok {
+		mac, _ = sdkMAC.Address()
+	}
+	var ips []net.IP
+	if sdkIPs, ok := object.Ips(); ok {
+		for _, sdkIP := range sdkIPs.Slice() {
+			address, ok := sdkIP.Address()
+			if !ok {
+				continue
+			}
+			if ip := net.ParseIP(address); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return &vmReportedDevice{
+		id:          VMReportedDeviceID(id),
+		vmID:        vmID,
+		name:        name,
+		description: description,
+		deviceType:  VMReportedDeviceType(deviceType),
+		mac:         mac,
+		ips:         ips,
+	}, nil
+}
+
+type vmReportedDevice struct {
+	id          VMReportedDeviceID
+	vmID        VMID
+	name        string
+	description string
+	deviceType  VMReportedDeviceType
+	mac         string
+	ips         []net.IP
+}
+
+func (v *vmReportedDevice) ID() VMReportedDeviceID {
+	return v.id
+}
+
+func (v *vmReportedDevice) VMID() VMID {
+	return v.vmID
+}
+
+func (v *vmReportedDevice) Name() string {
+	return v.name
+}
+
+func (v *vmReportedDevice) Description() string {
+	return v.description
+}
+
+func (v *vmReportedDevice) Type() VMReportedDeviceType {
+	return v.deviceType
+}
+
+func (v *vmReportedDevice) MAC() string {
+	return v.mac
+}
+
+func (v *vmReportedDevice) IPs() []net.IP {
+	return v.ips
+}
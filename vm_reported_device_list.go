@@ -0,0 +1,64 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+)
+
+func (o *oVirtClient) ListVMReportedDevices(id VMID, retries ...RetryStrategy) (result []VMReportedDevice, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing reported devices for VM %s", id),
+		reading(ResourceTypeVM, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				ReportedDevicesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.ReportedDevice()
+			if !ok {
+				return nil
+			}
+			result = make([]VMReportedDevice, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKReportedDevice(sdkObject, id)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert reported device #%d", i)
+				}
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListVMReportedDevices(id VMID, _ ...RetryStrategy) ([]VMReportedDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[id]; !ok {
+		return nil, newError(ENotFound, "VM %s not found", id)
+	}
+	return m.listVMReportedDevices(id), nil
+}
+
+// newMockReportedDevice creates a network device as the guest agent of a started mock VM would report it.
+func (m *mockClient) newMockReportedDevice(vmID VMID, name string, mac string, ips []net.IP) *vmReportedDevice {
+	return &vmReportedDevice{
+		id:         VMReportedDeviceID(m.GenerateUUID()),
+		vmID:       vmID,
+		name:       name,
+		deviceType: VMReportedDeviceTypeNetwork,
+		mac:        mac,
+		ips:        ips,
+	}
+}
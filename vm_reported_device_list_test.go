@@ -0,0 +1,45 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMReportedDevices(t *testing.T) {
+	helper := getHelper(t)
+
+	disk := assertCanCreateDiskWithParameters(t, helper, ovirtclient.ImageFormatCow, nil)
+	assertCanUploadFullyFunctionalDiskImage(t, helper, disk)
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams().MustWithMemory(512*1024*1024),
+	)
+	assertCanAttachDisk(t, vm, disk)
+	nic := assertCanCreateNICMac(t, helper, vm, "00:1a:4a:16:01:51")
+	assertCanStartVM(t, helper, vm)
+	assertVMWillStart(t, vm)
+	assertVMGetsIPAddress(t, vm)
+
+	devices, err := vm.ListReportedDevices()
+	if err != nil {
+		t.Fatalf("Failed to list reported devices of VM %s (%v)", vm.ID(), err)
+	}
+	for _, device := range devices {
+		if !strings.EqualFold(device.MAC(), nic.Mac()) {
+			continue
+		}
+		if device.Type() != ovirtclient.VMReportedDeviceTypeNetwork {
+			t.Fatalf("Incorrect type for reported device %s (%s)", device.Name(), device.Type())
+		}
+		if len(device.IPs()) == 0 {
+			t.Fatalf("No IP addresses reported for device %s", device.Name())
+		}
+		return
+	}
+	t.Fatalf("No reported device found for NIC %s with MAC %s.", nic.ID(), nic.Mac())
+}
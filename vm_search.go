@@ -90,10 +90,17 @@ func (o *oVirtClient) SearchVMs(params VMSearchParameters, retries ...RetryStrat
 	}
 	err = retry(
 		"searching for VMs",
+		reading(ResourceTypeVM, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().List().Search(qs).Send()
+			response, e := o.conn.
+				SystemService().
+				VmsService().
+				List().
+				Search(qs).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -123,6 +130,9 @@ func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([
 		if name := params.Name(); name != nil && vm.name != *name {
 			continue
 		}
+		if tag := params.Tag(); tag != nil && !m.vmHasTagName(vm, *tag) {
+			continue
+		}
 		if statuses := params.Statuses(); statuses != nil {
 			foundStatus := false
 			for _, status := range *statuses {
@@ -151,3 +161,13 @@ func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([
 	}
 	return result, nil
 }
+
+// vmHasTagName returns true if the VM has a tag with the specified name. It must be called with the lock held.
+func (m *mockClient) vmHasTagName(vm *vm, tagName string) bool {
+	for _, tagID := range vm.tagIDs {
+		if tag, ok := m.tags[tagID]; ok && tag.name == tagName {
+			return true
+		}
+	}
+	return false
+}
@@ -10,12 +10,22 @@ func (o *oVirtClient) StartVM(id VMID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("starting VM %s", id),
+		mutating(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Start().Send()
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Start().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
 	return
 }
 
@@ -30,15 +40,30 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 	if item.Status() == VMStatusUp {
 		return nil
 	}
+	if item.Status() == VMStatusImageLocked {
+		return newError(EConflict, "VM %s is image locked", id)
+	}
+
+	return m.startVM(item)
+}
 
+// startVM places the VM on a host and starts the background status transitions. It must be called with the lock
+// held.
+func (m *mockClient) startVM(item *vm) error {
+	id := item.id
 	hostID, err := m.findSuitableHost(id)
 	if err != nil {
 		return err
 	}
 	item.hostID = &hostID
 	item.status = VMStatusWaitForLaunch
+	if state, ok := m.cdroms[id]; ok {
+		// A freshly started VM boots with the persistent CD-ROM configuration.
+		state.current = state.persistent
+	}
+	delays := *m.vmTransitionDelays
 	go func() {
-		time.Sleep(2 * time.Second)
+		time.Sleep(delays.waitForLaunch)
 		m.lock.Lock()
 		if item.status != VMStatusWaitForLaunch {
 			m.lock.Unlock()
@@ -46,7 +71,7 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 		}
 		item.status = VMStatusPoweringUp
 		m.lock.Unlock()
-		time.Sleep(2 * time.Second)
+		time.Sleep(delays.poweringUp)
 		m.lock.Lock()
 		if item.status != VMStatusPoweringUp {
 			m.lock.Unlock()
@@ -54,7 +79,7 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 		}
 		item.status = VMStatusUp
 		m.lock.Unlock()
-		time.Sleep(10 * time.Second)
+		time.Sleep(delays.ipReport)
 		m.lock.Lock()
 		if item.status == VMStatusUp {
 			m.vmIPs[item.id] = map[string][]net.IP{
@@ -63,19 +88,28 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 					net.ParseIP("127.0.0.1"),
 				},
 			}
+			m.reportedDevicesByVM[item.id] = []*vmReportedDevice{
+				m.newMockReportedDevice(item.id, "lo", "", m.vmIPs[item.id]["lo"]),
+			}
 			i := 0
 			for _, nic := range m.nics {
 				if nic.vmid == item.id {
-					m.vmIPs[item.id][fmt.Sprintf("eth%d", i)] = []net.IP{
+					name := fmt.Sprintf("eth%d", i)
+					m.vmIPs[item.id][name] = []net.IP{
 						net.ParseIP("192.168.0.123"),
 						net.ParseIP("fe80::123"),
 					}
+					m.reportedDevicesByVM[item.id] = append(
+						m.reportedDevicesByVM[item.id],
+						m.newMockReportedDevice(item.id, name, nic.mac, m.vmIPs[item.id][name]),
+					)
 					i++
 				}
 			}
 		}
 		m.lock.Unlock()
 	}()
+	m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
 	return nil
 }
 
@@ -0,0 +1,280 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// RunOnceParameters contains the one-time settings for starting a VM with StartVMOnce. None of these settings are
+// stored on the VM; they only apply to the current run.
+type RunOnceParameters interface {
+	// BootDevices returns the devices to boot from for this run, in order. Returns nil to use the configured boot order.
+	BootDevices() []VMBootDevice
+	// Kernel returns the path of the kernel to boot directly, if any. The path refers to a file on the host or an ISO
+	// domain.
+	Kernel() *string
+	// Initrd returns the path of the initial ramdisk to use with Kernel, if any.
+	Initrd() *string
+	// KernelCmdline returns the kernel command line to pass to Kernel, if any.
+	KernelCmdline() *string
+	// Initialization returns the cloud-init payload to pass to the VM for this run, if any.
+	Initialization() Initialization
+	// Stateless returns true if all changes to the disks of the VM should be discarded when it is shut down.
+	Stateless() *bool
+	// Volatile returns true if the run-once configuration should be discarded even on a guest-initiated reboot.
+	Volatile() *bool
+}
+
+// BuildableRunOnceParameters is a buildable version of RunOnceParameters.
+type BuildableRunOnceParameters interface {
+	RunOnceParameters
+
+	// WithBootDevices sets the boot order for this run. Each device may only be listed once.
+	WithBootDevices(devices []VMBootDevice) (BuildableRunOnceParameters, error)
+	// MustWithBootDevices is identical to WithBootDevices, but panics instead of returning an error.
+	MustWithBootDevices(devices []VMBootDevice) BuildableRunOnceParameters
+
+	// WithKernel sets the path of the kernel to boot directly.
+	WithKernel(kernel string) (BuildableRunOnceParameters, error)
+	// MustWithKernel is identical to WithKernel, but panics instead of returning an error.
+	MustWithKernel(kernel string) BuildableRunOnceParameters
+
+	// WithInitrd sets the path of the initial ramdisk. It requires a kernel to be set.
+	WithInitrd(initrd string) (BuildableRunOnceParameters, error)
+	// MustWithInitrd is identical to WithInitrd, but panics instead of returning an error.
+	MustWithInitrd(initrd string) BuildableRunOnceParameters
+
+	// WithKernelCmdline sets the kernel command line. It requires a kernel to be set.
+	WithKernelCmdline(cmdline string) (BuildableRunOnceParameters, error)
+	// MustWithKernelCmdline is identical to WithKernelCmdline, but panics instead of returning an error.
+	MustWithKernelCmdline(cmdline string) BuildableRunOnceParameters
+
+	// WithInitialization sets the cloud-init payload for this run.
+	WithInitialization(initialization Initialization) BuildableRunOnceParameters
+
+	// WithStateless sets if changes to the VM disks should be discarded on shutdown.
+	WithStateless(stateless bool) BuildableRunOnceParameters
+
+	// WithVolatile sets if the run-once configuration should be discarded on a guest-initiated reboot.
+	WithVolatile(volatile bool) BuildableRunOnceParameters
+}
+
+// RunOnceParams creates a new set of run-once parameters.
+func RunOnceParams() BuildableRunOnceParameters {
+	return &runOnceParams{}
+}
+
+type runOnceParams struct {
+	bootDevices    []VMBootDevice
+	kernel         *string
+	initrd         *string
+	kernelCmdline  *string
+	initialization Initialization
+	stateless      *bool
+	volatile       *bool
+}
+
+func (r *runOnceParams) BootDevices() []VMBootDevice {
+	return r.bootDevices
+}
+
+func (r *runOnceParams) Kernel() *string {
+	return r.kernel
+}
+
+func (r *runOnceParams) Initrd() *string {
+	return r.initrd
+}
+
+func (r *runOnceParams) KernelCmdline() *string {
+	return r.kernelCmdline
+}
+
+func (r *runOnceParams) Initialization() Initialization {
+	return r.initialization
+}
+
+func (r *runOnceParams) Stateless() *bool {
+	return r.stateless
+}
+
+func (r *runOnceParams) Volatile() *bool {
+	return r.volatile
+}
+
+func (r *runOnceParams) WithBootDevices(devices []VMBootDevice) (BuildableRunOnceParameters, error) {
+	// Reuse the OS parameter validation so both code paths apply the same rules.
+	if _, err := NewVMOSParameters().WithBootDevices(devices); err != nil {
+		return nil, err
+	}
+	r.bootDevices = devices
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithBootDevices(devices []VMBootDevice) BuildableRunOnceParameters {
+	builder, err := r.WithBootDevices(devices)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) WithKernel(kernel string) (BuildableRunOnceParameters, error) {
+	if kernel == "" {
+		return nil, newError(EBadArgument, "the kernel path must not be empty")
+	}
+	r.kernel = &kernel
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithKernel(kernel string) BuildableRunOnceParameters {
+	builder, err := r.WithKernel(kernel)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) WithInitrd(initrd string) (BuildableRunOnceParameters, error) {
+	if initrd == "" {
+		return nil, newError(EBadArgument, "the initrd path must not be empty")
+	}
+	r.initrd = &initrd
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithInitrd(initrd string) BuildableRunOnceParameters {
+	builder, err := r.WithInitrd(initrd)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) WithKernelCmdline(cmdline string) (BuildableRunOnceParameters, error) {
+	r.kernelCmdline = &cmdline
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithKernelCmdline(cmdline string) BuildableRunOnceParameters {
+	builder, err := r.WithKernelCmdline(cmdline)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) WithInitialization(initialization Initialization) BuildableRunOnceParameters {
+	r.initialization = initialization
+	return r
+}
+
+func (r *runOnceParams) WithStateless(stateless bool) BuildableRunOnceParameters {
+	r.stateless = &stateless
+	return r
+}
+
+func (r *runOnceParams) WithVolatile(volatile bool) BuildableRunOnceParameters {
+	r.volatile = &volatile
+	return r
+}
+
+// validateRunOnceParameters checks the parameter combinations that can only be validated once all parameters are set.
+func validateRunOnceParameters(params RunOnceParameters) error {
+	if params.Kernel() == nil {
+		if params.Initrd() != nil {
+			return newError(EBadArgument, "an initrd can only be used together with a kernel")
+		}
+		if params.KernelCmdline() != nil {
+			return newError(EBadArgument, "a kernel command line can only be used together with a kernel")
+		}
+	}
+	return nil
+}
+
+func (o *oVirtClient) StartVMOnce(id VMID, params RunOnceParameters, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = RunOnceParams()
+	}
+	if err := validateRunOnceParameters(params); err != nil {
+		return err
+	}
+	sdkVM, err := buildSDKRunOnceVM(id, params)
+	if err != nil {
+		return err
+	}
+	err = retry(
+		fmt.Sprintf("starting VM %s once", id),
+		mutating(ResourceTypeVM, string(id)),
+		o.logger,
+		retries,
+		func() error {
+			req := o.conn.SystemService().VmsService().VmService(string(id)).Start().Vm(sdkVM)
+			if params.Initialization() != nil {
+				req.UseCloudInit(true)
+			}
+			if volatile := params.Volatile(); volatile != nil {
+				req.Volatile(*volatile)
+			}
+			_, err := req.Query("correlation_id", requestCorrelationID(retries)).Send()
+			return err
+		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
+	return
+}
+
+func buildSDKRunOnceVM(id VMID, params RunOnceParameters) (*ovirtsdk.Vm, error) {
+	builder := ovirtsdk.NewVmBuilder().Id(string(id))
+	osBuilder := ovirtsdk.NewOperatingSystemBuilder()
+	if bootDevices := params.BootDevices(); bootDevices != nil {
+		sdkDevices := make([]ovirtsdk.BootDevice, len(bootDevices))
+		for i, device := range bootDevices {
+			sdkDevices[i] = ovirtsdk.BootDevice(device)
+		}
+		osBuilder.BootBuilder(ovirtsdk.NewBootBuilder().Devices(sdkDevices))
+	}
+	if kernel := params.Kernel(); kernel != nil {
+		osBuilder.Kernel(*kernel)
+	}
+	if initrd := params.Initrd(); initrd != nil {
+		osBuilder.Initrd(*initrd)
+	}
+	if cmdline := params.KernelCmdline(); cmdline != nil {
+		osBuilder.Cmdline(*cmdline)
+	}
+	builder.OsBuilder(osBuilder)
+	if init := params.Initialization(); init != nil {
+		builder.InitializationBuilder(buildSDKInitialization(init))
+	}
+	if stateless := params.Stateless(); stateless != nil {
+		builder.Stateless(*stateless)
+	}
+	sdkVM, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build run-once configuration for VM %s", id)
+	}
+	return sdkVM, nil
+}
+
+func (m *mockClient) StartVMOnce(id VMID, params RunOnceParameters, _ ...RetryStrategy) error {
+	if params == nil {
+		params = RunOnceParams()
+	}
+	if err := validateRunOnceParameters(params); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "vm with ID %s not found", id)
+	}
+	if item.Status() != VMStatusDown {
+		return newError(EConflict, "VM %s must be down to start it in run-once mode (status: %s)", id, item.Status())
+	}
+	return m.startVM(item)
+}
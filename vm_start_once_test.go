@@ -0,0 +1,42 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMStartOnce(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateBootableVM(t, helper)
+
+	params := ovirtclient.RunOnceParams().
+		MustWithBootDevices([]ovirtclient.VMBootDevice{ovirtclient.VMBootDeviceHD}).
+		WithStateless(true).
+		WithInitialization(ovirtclient.NewInitialization("", "run-once"))
+	if err := vm.StartOnce(params); err != nil {
+		t.Fatalf("Failed to start VM %s once (%v)", vm.ID(), err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Stop(true); err != nil {
+			t.Fatalf("Failed to stop VM %s after test (%v)", vm.ID(), err)
+		}
+		if _, err := vm.WaitForStatus(ovirtclient.VMStatusDown); err != nil {
+			t.Fatalf("Failed to wait for VM %s to stop (%v)", vm.ID(), err)
+		}
+	})
+	assertVMWillStart(t, vm)
+}
+
+func TestVMStartOnceRejectsInitrdWithoutKernel(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	err := vm.StartOnce(ovirtclient.RunOnceParams().MustWithInitrd("/var/lib/initrd.img"))
+	if err == nil {
+		t.Fatalf("Starting a VM with an initrd but no kernel did not fail.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Incorrect error code returned (%v)", err)
+	}
+}
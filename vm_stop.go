@@ -8,38 +8,60 @@ import (
 
 func (o *oVirtClient) StopVM(id VMID, force bool, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := checkHostedEngineVM(o, id, "stop", retries); err != nil {
+		return err
+	}
 	err = retry(
 		fmt.Sprintf("stopping VM %s", id),
+		mutating(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Stop().Force(force).Send()
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Stop().
+				Force(force).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
 	return
 }
 
-func (m *mockClient) StopVM(id VMID, force bool, _ ...RetryStrategy) error {
+func (m *mockClient) StopVM(id VMID, force bool, retries ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
+		if err := validateHostedEngineOperation(item, "stop", retries); err != nil {
+			return err
+		}
 		if (item.status == VMStatusSavingState || item.status == VMStatusRestoringState) && !force {
 			return newError(EConflict, "VM is currently backing up or restoring.")
 		}
 		m.vmIPs[id] = map[string][]net.IP{}
+		delete(m.reportedDevicesByVM, id)
 		if item.status != VMStatusDown {
 			item.status = VMStatusPoweringDown
+			delay := m.vmTransitionDelays.poweringDown
 			go func() {
-				time.Sleep(2 * time.Second)
+				time.Sleep(delay)
 				m.lock.Lock()
 				defer m.lock.Unlock()
 				if item.status != VMStatusPoweringDown {
 					return
 				}
 				item.status = VMStatusDown
+				// Pending configuration changes are applied once the VM is down.
+				item.nextRunConfigurationExists = false
 				item.hostID = nil
 			}()
 		}
+		m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
 		return nil
 	}
 	return newError(ENotFound, "vm with ID %s not found", id)
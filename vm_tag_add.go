@@ -10,17 +10,26 @@ func (o *oVirtClient) AddTagToVM(id VMID, tagID TagID, retries ...RetryStrategy)
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("adding tag %s to VM %s", tagID, id),
+		mutating(ResourceTypeVM, string(id)).
+			withParameters(map[string]string{
+				"tag_id": string(tagID),
+			}),
 		o.logger,
 		retries,
 		func() error {
 			_, err := o.conn.SystemService().VmsService().VmService(string(id)).TagsService().Add().
-				Tag(ovirtsdk.NewTagBuilder().Id(string(tagID)).MustBuild()).Send()
+				Tag(ovirtsdk.NewTagBuilder().Id(string(tagID)).MustBuild()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
 	return
 }
 
@@ -37,6 +46,7 @@ func (m *mockClient) AddTagToVM(id VMID, tagID TagID, _ ...RetryStrategy) (err e
 	}
 
 	m.vms[id].tagIDs = append(m.vms[id].tagIDs, tagID)
+	m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
 	return nil
 
 }
@@ -45,14 +55,23 @@ func (o *oVirtClient) AddTagToVMByName(id VMID, tagName string, retries ...Retry
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("adding tag %s to VM %s", tagName, id),
+		mutating(ResourceTypeVM, string(id)).
+			withParameters(map[string]string{
+				"tag_name": tagName,
+			}),
 		o.logger,
 		retries,
 		func() error {
 			_, err := o.conn.SystemService().VmsService().VmService(string(id)).TagsService().Add().
-				Tag(ovirtsdk.NewTagBuilder().Name(tagName).MustBuild()).Send()
+				Tag(ovirtsdk.NewTagBuilder().Name(tagName).MustBuild()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
 	return
 }
 
@@ -67,6 +86,7 @@ func (m *mockClient) AddTagToVMByName(id VMID, tagName string, retries ...RetryS
 	for tagID, tag := range m.tags {
 		if tag.name == tagName {
 			m.vms[id].tagIDs = append(m.vms[id].tagIDs, tagID)
+			m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
 			return nil
 		}
 	}
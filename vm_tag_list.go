@@ -8,10 +8,18 @@ func (o *oVirtClient) ListVMTags(id VMID, retries ...RetryStrategy) (result []Ta
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("listing tags for vm %s", id),
+		reading(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(string(id)).TagsService().List().Send()
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				TagsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
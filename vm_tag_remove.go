@@ -8,6 +8,10 @@ func (o *oVirtClient) RemoveTagFromVM(id VMID, tagID TagID, retries ...RetryStra
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 	err = retry(
 		fmt.Sprintf("removing tag from VM %s", id),
+		mutating(ResourceTypeVM, string(id)).
+			withParameters(map[string]string{
+				"tag_id": string(tagID),
+			}),
 		o.logger,
 		retries,
 		func() error {
@@ -18,9 +22,13 @@ func (o *oVirtClient) RemoveTagFromVM(id VMID, tagID TagID, retries ...RetryStra
 				TagsService().
 				TagService(string(tagID)).
 				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
 				Send()
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
 	return
 }
 
@@ -43,5 +51,6 @@ func (m *mockClient) RemoveTagFromVM(id VMID, tagID TagID, _ ...RetryStrategy) e
 		return newError(ENotFound, "tag with ID %s not found on VM %s", tagID, id)
 	}
 	m.vms[id].tagIDs = append(m.vms[id].tagIDs[:foundIndex], m.vms[id].tagIDs[foundIndex+1:]...)
+	m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
 	return nil
 }
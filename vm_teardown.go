@@ -0,0 +1,152 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// TeardownResult is the outcome of removing or detaching a single object during TeardownVMs.
+type TeardownResult interface {
+	// ResourceType returns the type of the object.
+	ResourceType() ResourceType
+	// ID returns the ID of the object.
+	ID() string
+	// Err returns the error that occurred while processing the object, or nil if it succeeded.
+	Err() error
+}
+
+type teardownResult struct {
+	resourceType ResourceType
+	id           string
+	err          error
+}
+
+func (t *teardownResult) ResourceType() ResourceType {
+	return t.resourceType
+}
+
+func (t *teardownResult) ID() string {
+	return t.id
+}
+
+func (t *teardownResult) Err() error {
+	return t.err
+}
+
+func (o *oVirtClient) TeardownVMs(ids []VMID, retries ...RetryStrategy) ([]TeardownResult, error) {
+	return teardownVMs(o, ids, retries)
+}
+
+func (m *mockClient) TeardownVMs(ids []VMID, retries ...RetryStrategy) ([]TeardownResult, error) {
+	return teardownVMs(m, ids, retries)
+}
+
+// teardownVMs contains the teardown logic shared between the live and mock clients.
+func teardownVMs(client Client, ids []VMID, retries []RetryStrategy) ([]TeardownResult, error) {
+	var results []TeardownResult
+	failures := map[string]error{}
+	for _, id := range ids {
+		for _, result := range teardownVM(client, id, retries) {
+			results = append(results, result)
+			if result.Err() != nil {
+				failures[fmt.Sprintf("%s %s", result.ResourceType(), result.ID())] = result.Err()
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return results, newBulkError("tearing down VMs", failures)
+	}
+	return results, nil
+}
+
+// teardownVM removes a single VM and its dependencies. It stops at the first failure so the VM is never removed
+// while it still has disks attached, which would remove the disks without reporting them. The Hosted Engine VM is
+// rejected before anything is changed, and shareable disks are only detached since they may be in use by other VMs.
+func teardownVM(client Client, id VMID, retries []RetryStrategy) []TeardownResult {
+	vmResult := func(err error) *teardownResult {
+		return &teardownResult{ResourceTypeVM, string(id), err}
+	}
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		if HasErrorCode(err, ENotFound) {
+			return []TeardownResult{vmResult(nil)}
+		}
+		return []TeardownResult{vmResult(err)}
+	}
+	if err := validateHostedEngineOperation(vm, "tear down", retries); err != nil {
+		return []TeardownResult{vmResult(err)}
+	}
+	if vm.Status() != VMStatusDown {
+		if err := client.StopVM(id, true, retries...); err != nil {
+			return []TeardownResult{vmResult(wrap(err, EUnidentified, "failed to stop VM %s", id))}
+		}
+		if _, err := client.WaitForVMStatus(id, VMStatusDown, retries...); err != nil {
+			return []TeardownResult{vmResult(wrap(err, EUnidentified, "failed to wait for VM %s to stop", id))}
+		}
+	}
+
+	var results []TeardownResult
+	tags, err := client.ListVMTags(id, retries...)
+	if err != nil {
+		return append(results, vmResult(wrap(err, EUnidentified, "failed to list tags of VM %s", id)))
+	}
+	for _, tag := range tags {
+		err := client.RemoveTagFromVM(id, tag.ID(), retries...)
+		results = append(results, &teardownResult{ResourceTypeTag, string(tag.ID()), err})
+		if err != nil {
+			return results
+		}
+	}
+
+	nics, err := client.ListNICs(id, retries...)
+	if err != nil {
+		return append(results, vmResult(wrap(err, EUnidentified, "failed to list NICs of VM %s", id)))
+	}
+	for _, nic := range nics {
+		err := client.RemoveNIC(id, nic.ID(), retries...)
+		results = append(results, &teardownResult{ResourceTypeNIC, string(nic.ID()), err})
+		if err != nil {
+			return results
+		}
+	}
+
+	// Only shareable disks can be attached to more than one VM, so these are the disks that may still be in use.
+	disks, err := client.ListVMDisks(id, retries...)
+	if err != nil {
+		return append(results, vmResult(wrap(err, EUnidentified, "failed to list disks of VM %s", id)))
+	}
+	shareable := map[DiskID]bool{}
+	for _, disk := range disks {
+		shareable[disk.ID()] = disk.Shareable()
+	}
+	attachments, err := client.ListDiskAttachments(id, retries...)
+	if err != nil {
+		return append(results, vmResult(wrap(err, EUnidentified, "failed to list disk attachments of VM %s", id)))
+	}
+	var diskIDs []DiskID
+	for _, attachment := range attachments {
+		err := client.RemoveDiskAttachment(id, attachment.ID(), retries...)
+		results = append(results, &teardownResult{ResourceTypeDiskAttachment, string(attachment.ID()), err})
+		if err != nil {
+			return results
+		}
+		if !shareable[attachment.DiskID()] {
+			diskIDs = append(diskIDs, attachment.DiskID())
+		}
+	}
+
+	err = client.RemoveVM(id, retries...)
+	results = append(results, vmResult(err))
+	if err != nil {
+		return results
+	}
+
+	// The disks are detached at this point, so a failure to remove one doesn't affect the others.
+	for _, diskID := range diskIDs {
+		err := client.RemoveDisk(diskID, retries...)
+		if err != nil && HasErrorCode(err, ENotFound) {
+			err = nil
+		}
+		results = append(results, &teardownResult{ResourceTypeDisk, string(diskID), err})
+	}
+	return results
+}
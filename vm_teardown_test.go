@@ -0,0 +1,88 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestTeardownVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	nic := assertCanCreateNIC(t, helper, vm, "eth0", nil)
+	tag := assertCanCreateTag(t, helper, helper.GenerateTestResourceName(t), "")
+	assertCanAddTagToVM(t, vm, tag)
+
+	results, err := client.TeardownVMs([]ovirtclient.VMID{vm.ID(), "00000000-0000-0000-0000-000000000000"})
+	if err != nil {
+		t.Fatalf("Failed to tear down VM %s (%v)", vm.ID(), err)
+	}
+
+	processed := map[ovirtclient.ResourceType]string{}
+	for _, result := range results {
+		if result.Err() != nil {
+			t.Fatalf("Teardown of %s %s failed (%v)", result.ResourceType(), result.ID(), result.Err())
+		}
+		if result.ID() == "00000000-0000-0000-0000-000000000000" {
+			continue
+		}
+		processed[result.ResourceType()] = result.ID()
+	}
+	expected := map[ovirtclient.ResourceType]string{
+		ovirtclient.ResourceTypeVM:   string(vm.ID()),
+		ovirtclient.ResourceTypeDisk: string(disk.ID()),
+		ovirtclient.ResourceTypeNIC:  string(nic.ID()),
+		ovirtclient.ResourceTypeTag:  string(tag.ID()),
+	}
+	for resourceType, id := range expected {
+		if processed[resourceType] != id {
+			t.Fatalf("%s %s was not reported in the teardown results (%v).", resourceType, id, processed)
+		}
+	}
+
+	if _, err := client.GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("VM %s still exists after teardown (%v)", vm.ID(), err)
+	}
+	if _, err := client.GetDisk(disk.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Disk %s still exists after teardown (%v)", disk.ID(), err)
+	}
+	if _, err := client.GetTag(tag.ID()); err != nil {
+		t.Fatalf("Tag %s was removed during teardown (%v)", tag.ID(), err)
+	}
+}
+
+func TestTeardownVMsKeepsShareableDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+	assertCanAttachDisk(t, vm1, disk)
+	assertCanAttachDisk(t, vm2, disk)
+
+	results, err := client.TeardownVMs([]ovirtclient.VMID{vm1.ID()})
+	if err != nil {
+		t.Fatalf("Failed to tear down VM %s (%v)", vm1.ID(), err)
+	}
+	for _, result := range results {
+		if result.ResourceType() == ovirtclient.ResourceTypeDisk {
+			t.Fatalf("Shareable disk %s was removed during teardown.", result.ID())
+		}
+	}
+	if _, err := client.GetDisk(disk.ID()); err != nil {
+		t.Fatalf("Shareable disk %s no longer exists after teardown (%v)", disk.ID(), err)
+	}
+	assertDiskAttachmentCount(t, vm2, 1)
+}
@@ -371,6 +371,40 @@ func TestVMCreationWithDescription(t *testing.T) {
 
 }
 
+func TestVMCreationWithEmulatedMachineAndCompatibilityVersion(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithEmulatedMachine("pc-q35-rhel8.4.0").
+			MustWithCustomCompatibilityVersion(ovirtclient.NewVersion(4, 6)),
+	)
+	if vm.EmulatedMachine() != "pc-q35-rhel8.4.0" {
+		t.Fatalf("Incorrect emulated machine after VM creation (%s)", vm.EmulatedMachine())
+	}
+	if version := vm.CustomCompatibilityVersion(); version == nil || version.String() != "4.6" {
+		t.Fatalf("Incorrect custom compatibility version after VM creation (%v)", version)
+	}
+
+	updatedVM, err := vm.Update(ovirtclient.UpdateVMParams().WithEmulatedMachine(""))
+	if err != nil {
+		t.Fatalf("Failed to update VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.EmulatedMachine() != "" {
+		t.Fatalf("Emulated machine not reverted to the cluster default (%s)", updatedVM.EmulatedMachine())
+	}
+
+	updatedVM, err = updatedVM.Update(ovirtclient.UpdateVMParams().WithoutCustomCompatibilityVersion())
+	if err != nil {
+		t.Fatalf("Failed to update VM %s (%v)", vm.ID(), err)
+	}
+	if version := updatedVM.CustomCompatibilityVersion(); version != nil {
+		t.Fatalf("Custom compatibility version not removed (%s)", version)
+	}
+}
+
 // TestVMStartStop creates a micro VM with a tiny operating system, starts it and then stops it. The OS doesn't support
 // ACPI, so shutdown cannot be tested.
 func TestVMStartStop(t *testing.T) {
@@ -612,6 +646,97 @@ func TestVMCreationWithSparseDisks(t *testing.T) {
 	)
 }
 
+func TestVMCreationFromTemplateWithOverrides(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	startVM := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)),
+		nil,
+	)
+	assertCanAttachDisk(t, startVM, disk)
+	tpl := assertCanCreateTemplate(t, helper, startVM)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+	diskAttachments, err := tpl.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments for template %s (%v).", tpl.ID(), err)
+	}
+	templateDisk, err := diskAttachments[0].Disk()
+	if err != nil {
+		t.Fatalf("Failed to fetch template disk %s (%v).", diskAttachments[0].DiskID(), err)
+	}
+	newSize := templateDisk.ProvisionedSize() + 1024*1024
+	memory := int64(512 * 1024 * 1024)
+
+	vm := assertCanCreateVMFromTemplate(
+		t,
+		helper,
+		fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)),
+		tpl.ID(),
+		ovirtclient.CreateVMParams().
+			MustWithMemory(memory).
+			MustWithCPUParameters(2, 1, 1).
+			MustWithDisks(
+				[]ovirtclient.OptionalVMDiskParameters{
+					ovirtclient.
+						MustNewBuildableVMDiskParameters(templateDisk.ID()).
+						MustWithProvisionedSize(newSize),
+				},
+			),
+	)
+	if vm.Memory() != memory {
+		t.Fatalf("Incorrect memory on VM: %d instead of %d bytes", vm.Memory(), memory)
+	}
+	if cores := vm.CPU().Topo().Cores(); cores != 2 {
+		t.Fatalf("Incorrect number of cores on VM: %d instead of 2", cores)
+	}
+	vmDiskAttachments, err := vm.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments for VM %s (%v).", vm.ID(), err)
+	}
+	vmDisk, err := vmDiskAttachments[0].Disk()
+	if err != nil {
+		t.Fatalf("Failed to fetch VM disk %s (%v).", vmDiskAttachments[0].DiskID(), err)
+	}
+	if vmDisk.ProvisionedSize() != newSize {
+		t.Fatalf("Incorrect disk size on VM: %d instead of %d bytes", vmDisk.ProvisionedSize(), newSize)
+	}
+}
+
+func TestVMCreationFromTemplateCannotShrinkDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelperMock(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	startVM := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	assertCanAttachDisk(t, startVM, disk)
+	tpl := assertCanCreateTemplate(t, helper, startVM)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+	diskAttachments, err := tpl.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments for template %s (%v).", tpl.ID(), err)
+	}
+
+	_, err = helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		tpl.ID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.CreateVMParams().MustWithDisks(
+			[]ovirtclient.OptionalVMDiskParameters{
+				ovirtclient.
+					MustNewBuildableVMDiskParameters(diskAttachments[0].DiskID()).
+					MustWithProvisionedSize(1),
+			},
+		),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Shrinking a template disk on VM creation did not fail with EBadArgument (%v)", err)
+	}
+}
+
 func TestMemoryPolicyDefaults(t *testing.T) {
 	helper := getHelper(t)
 	vm := assertCanCreateVM(
@@ -748,6 +873,141 @@ func checkVMDiskSparseness(t *testing.T, checkVM ovirtclient.VM, sparse bool, me
 	}
 }
 
+func TestUpdateVMMemory(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	memory := int64(2 * 1024 * 1024 * 1024)
+	guaranteed := int64(1024 * 1024 * 1024)
+	updatedVM, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithMemory(memory).
+			MustWithMemoryPolicy(
+				ovirtclient.NewMemoryPolicyParameters().
+					MustWithGuaranteed(guaranteed).
+					MustWithMax(2 * memory).
+					MustWithBallooning(false),
+			),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update memory of VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.Memory() != memory {
+		t.Fatalf("Incorrect memory after update (expected: %d, got: %d)", memory, updatedVM.Memory())
+	}
+	memoryPolicy := updatedVM.MemoryPolicy()
+	if memoryPolicy.Guaranteed() == nil || *memoryPolicy.Guaranteed() != guaranteed {
+		t.Fatalf("Incorrect guaranteed memory after update.")
+	}
+	if memoryPolicy.Ballooning() {
+		t.Fatalf("Ballooning is still enabled after update.")
+	}
+}
+
+func TestUpdateVMMemoryRejectsGuaranteedAboveMemory(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	_, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithMemory(1024 * 1024 * 1024).
+			MustWithMemoryPolicy(ovirtclient.NewMemoryPolicyParameters().MustWithGuaranteed(2 * 1024 * 1024 * 1024)),
+	)
+	if err == nil {
+		t.Fatalf("Setting guaranteed memory above the VM memory did not fail.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Incorrect error code returned (%v)", err)
+	}
+}
+
+func TestUpdateVMMemoryPolicyRejectsGuaranteedAboveCurrentMemory(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithMemory(1024*1024*1024),
+	)
+
+	_, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithMemoryPolicy(ovirtclient.NewMemoryPolicyParameters().MustWithGuaranteed(2 * 1024 * 1024 * 1024)),
+	)
+	if err == nil {
+		t.Fatalf("Setting guaranteed memory above the current VM memory did not fail.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Incorrect error code returned (%v)", err)
+	}
+}
+
+func TestUpdateVMParamsRejectsNilMemoryPolicy(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.UpdateVMParams().WithMemoryPolicy(nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting a nil memory policy did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func TestUpdateVMCPUTimeZoneAndDeleteProtection(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	updatedVM, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithCPU(ovirtclient.NewVMCPUParams().MustWithTopo(ovirtclient.MustNewVMCPUTopo(2, 1, 1))).
+			MustWithTimeZone("Etc/GMT").
+			WithDeleteProtected(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update VM %s (%v)", vm.ID(), err)
+	}
+	if cores := updatedVM.CPU().Topo().Cores(); cores != 2 {
+		t.Fatalf("Incorrect number of CPU cores after update (%d)", cores)
+	}
+	if updatedVM.TimeZone() != "Etc/GMT" {
+		t.Fatalf("Incorrect time zone after update (%s)", updatedVM.TimeZone())
+	}
+	if !updatedVM.DeleteProtected() {
+		t.Fatalf("Delete protection not enabled after update.")
+	}
+
+	updatedVM, err = updatedVM.Update(ovirtclient.UpdateVMParams().WithDeleteProtected(false))
+	if err != nil {
+		t.Fatalf("Failed to disable delete protection on VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.DeleteProtected() {
+		t.Fatalf("Delete protection still enabled after update.")
+	}
+}
+
+func TestUpdateVMWithExpectedState(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	if _, err := vm.Update(ovirtclient.UpdateVMParams().MustWithComment("changed by someone else")); err != nil {
+		t.Fatalf("Failed to update comment of VM %s (%v)", vm.ID(), err)
+	}
+
+	_, err := vm.Update(
+		ovirtclient.UpdateVMParams().MustWithComment("stale update").MustWithExpectedState(vm),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EObjectChanged) {
+		t.Fatalf("Updating a changed comment based on a stale state did not result in a conflict (%v)", err)
+	}
+
+	updatedVM, err := vm.Update(
+		ovirtclient.UpdateVMParams().MustWithDescription("unrelated field").MustWithExpectedState(vm),
+	)
+	if err != nil {
+		t.Fatalf("Updating an unchanged field based on a stale state failed (%v)", err)
+	}
+	if updatedVM.Comment() != "changed by someone else" {
+		t.Fatalf("The comment was overwritten by a conditional update (%s)", updatedVM.Comment())
+	}
+}
+
 func TestPlacementPolicy(t *testing.T) {
 	helper := getHelper(t)
 
@@ -807,6 +1067,78 @@ func TestVMOSParameter(t *testing.T) {
 	}
 }
 
+func TestVMBootOrder(t *testing.T) {
+	helper := getHelper(t)
+
+	bootDevices := []ovirtclient.VMBootDevice{ovirtclient.VMBootDeviceNetwork, ovirtclient.VMBootDeviceHD}
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			WithOS(ovirtclient.NewVMOSParameters().MustWithBootDevices(bootDevices)).
+			MustWithBIOSType(ovirtclient.VMBIOSTypeQ35OVMF),
+	)
+	assertVMBootDevices(t, vm, bootDevices)
+	if vm.BIOSType() != ovirtclient.VMBIOSTypeQ35OVMF {
+		t.Fatalf("Incorrect BIOS type (expected: %s, got: %s)", ovirtclient.VMBIOSTypeQ35OVMF, vm.BIOSType())
+	}
+
+	newBootDevices := []ovirtclient.VMBootDevice{ovirtclient.VMBootDeviceCDROM, ovirtclient.VMBootDeviceHD}
+	updatedVM, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithOS(ovirtclient.NewVMOSParameters().MustWithBootDevices(newBootDevices)).
+			MustWithBIOSType(ovirtclient.VMBIOSTypeQ35SecureBoot),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update boot settings of VM %s (%v)", vm.ID(), err)
+	}
+	assertVMBootDevices(t, updatedVM, newBootDevices)
+	if !updatedVM.BIOSType().SecureBoot() {
+		t.Fatalf("Secure boot is not enabled after update (BIOS type: %s)", updatedVM.BIOSType())
+	}
+}
+
+func TestVMSecureBootWithTPM(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithBIOSType(ovirtclient.VMBIOSTypeQ35SecureBoot).
+			WithTPMEnabled(true),
+	)
+	if !vm.BIOSType().SecureBoot() {
+		t.Fatalf("Secure boot is not enabled on VM %s (BIOS type: %s)", vm.ID(), vm.BIOSType())
+	}
+	if !vm.TPMEnabled() {
+		t.Fatalf("TPM is not enabled on VM %s", vm.ID())
+	}
+}
+
+func TestVMBootDevicesRejectsDuplicates(t *testing.T) {
+	_, err := ovirtclient.NewVMOSParameters().WithBootDevices(
+		[]ovirtclient.VMBootDevice{ovirtclient.VMBootDeviceHD, ovirtclient.VMBootDeviceHD},
+	)
+	if err == nil {
+		t.Fatalf("Duplicate boot devices did not result in an error.")
+	}
+}
+
+func assertVMBootDevices(t *testing.T, vm ovirtclient.VM, expected []ovirtclient.VMBootDevice) {
+	actual := vm.OS().BootDevices()
+	if len(actual) != len(expected) {
+		t.Fatalf("Incorrect boot devices on VM %s (expected: %v, got: %v)", vm.ID(), expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("Incorrect boot devices on VM %s (expected: %v, got: %v)", vm.ID(), expected, actual)
+		}
+	}
+}
+
 func TestVMCPUMode(t *testing.T) {
 	helper := getHelper(t)
 
@@ -13,6 +13,37 @@ func (o *oVirtClient) UpdateVM(
 ) (result VM, err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 
+	// The memory changes are validated against the values that are left unchanged, and UEFI against the cluster of
+	// the VM, so we need the current VM for them too.
+	memoryChanged := params.Memory() != nil || params.MemoryPolicy() != nil
+	uefiRequested := params.BIOSType() != nil && params.BIOSType().UEFI()
+	if params.ExpectedState() != nil || !isHostedEngineAllowed(retries) || memoryChanged || uefiRequested {
+		current, err := o.GetVM(id, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateHostedEngineOperation(current, "update", retries); err != nil {
+			return nil, err
+		}
+		if err := checkVMUpdatePrecondition(params, current); err != nil {
+			return nil, err
+		}
+		if memoryChanged {
+			if err := validateVMMemoryUpdate(current, params); err != nil {
+				return nil, err
+			}
+		}
+		if uefiRequested {
+			cluster, err := o.GetCluster(current.ClusterID(), retries...)
+			if err != nil {
+				return nil, err
+			}
+			if err := validateVMBIOSTypeClusterLevel(cluster, params.BIOSType()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	vm := &ovirtsdk.Vm{}
 	vm.SetId(string(id))
 	if name := params.Name(); name != nil {
@@ -27,13 +58,73 @@ func (o *oVirtClient) UpdateVM(
 	if description := params.Description(); description != nil {
 		vm.SetDescription(*description)
 	}
+	if os := params.OS(); os != nil {
+		sdkOS, err := buildSDKVMOS(os).Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build operating system parameters for VM update")
+		}
+		vm.SetOs(sdkOS)
+	}
+	if biosType := params.BIOSType(); biosType != nil {
+		vm.SetBios(ovirtsdk.NewBiosBuilder().Type(ovirtsdk.BiosType(*biosType)).MustBuild())
+	}
+	if memory := params.Memory(); memory != nil {
+		vm.SetMemory(*memory)
+	}
+	if memoryPolicy := params.MemoryPolicy(); memoryPolicy != nil {
+		memoryPolicyBuilder := ovirtsdk.NewMemoryPolicyBuilder()
+		if guaranteed := memoryPolicy.Guaranteed(); guaranteed != nil {
+			memoryPolicyBuilder.Guaranteed(*guaranteed)
+		}
+		if max := memoryPolicy.Max(); max != nil {
+			memoryPolicyBuilder.Max(*max)
+		}
+		if ballooning := memoryPolicy.Ballooning(); ballooning != nil {
+			memoryPolicyBuilder.Ballooning(*ballooning)
+		}
+		sdkMemoryPolicy, err := memoryPolicyBuilder.Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build memory policy for VM update")
+		}
+		vm.SetMemoryPolicy(sdkMemoryPolicy)
+	}
+	if cpu := params.CPU(); cpu != nil {
+		sdkCPU, err := buildSDKVMCPU(cpu).Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build CPU parameters for VM update")
+		}
+		vm.SetCpu(sdkCPU)
+	}
+	if timeZone := params.TimeZone(); timeZone != nil {
+		vm.SetTimeZone(ovirtsdk.NewTimeZoneBuilder().Name(*timeZone).MustBuild())
+	}
+	if deleteProtected := params.DeleteProtected(); deleteProtected != nil {
+		vm.SetDeleteProtected(*deleteProtected)
+	}
+	if emulatedMachine := params.EmulatedMachine(); emulatedMachine != nil {
+		vm.SetCustomEmulatedMachine(*emulatedMachine)
+	}
+	if version := params.CustomCompatibilityVersion(); version != nil {
+		vm.SetCustomCompatibilityVersion(buildSDKVersion(version).MustBuild())
+	} else if params.RemoveCustomCompatibilityVersion() {
+		// The engine removes the custom compatibility version when it receives an empty one.
+		vm.SetCustomCompatibilityVersion(ovirtsdk.NewVersionBuilder().MustBuild())
+	}
 
 	err = retry(
 		fmt.Sprintf("updating vm %s", id),
+		mutating(ResourceTypeVM, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(vm).Send()
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Update().
+				Vm(vm).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return wrap(err, EUnidentified, "failed to update VM")
 			}
@@ -51,10 +142,13 @@ func (o *oVirtClient) UpdateVM(
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
+	}
 	return result, err
 }
 
-func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, _ ...RetryStrategy) (VM, error) {
+func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, retries ...RetryStrategy) (VM, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -63,10 +157,16 @@ func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, _ ...RetryStra
 	}
 
 	vm := m.vms[id]
+	if err := validateHostedEngineOperation(vm, "update", retries); err != nil {
+		return nil, err
+	}
+	if err := checkVMUpdatePrecondition(params, vm); err != nil {
+		return nil, err
+	}
 	if name := params.Name(); name != nil {
 		for _, otherVM := range m.vms {
 			if otherVM.name == *name && otherVM.ID() != vm.ID() {
-				return nil, newError(EConflict, "A VM with the name \"%s\" already exists.", *name)
+				return nil, newError(ENameInUse, "A VM with the name \"%s\" already exists.", *name)
 			}
 		}
 		vm = vm.withName(*name)
@@ -77,7 +177,102 @@ func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, _ ...RetryStra
 	if description := params.Description(); description != nil {
 		vm = vm.withDescription(*description)
 	}
+	if os := params.OS(); os != nil {
+		vm = vm.withOS(vm.os.withParams(os))
+	}
+	if biosType := params.BIOSType(); biosType != nil {
+		cluster, ok := m.clusters[vm.clusterID]
+		if !ok {
+			return nil, newError(ENotFound, "cluster with ID %s not found", vm.clusterID)
+		}
+		if err := validateVMBIOSTypeClusterLevel(cluster, biosType); err != nil {
+			return nil, err
+		}
+		vm = vm.withBIOSType(*biosType)
+	}
+	if params.Memory() != nil || params.MemoryPolicy() != nil {
+		newVM, err := m.updateVMMemory(vm, params)
+		if err != nil {
+			return nil, err
+		}
+		vm = newVM
+	}
+	if cpu := params.CPU(); cpu != nil {
+		vm = vm.withCPU(applyVMCPUParams(vm.cpu, cpu))
+	}
+	if timeZone := params.TimeZone(); timeZone != nil {
+		vm = vm.withTimeZone(*timeZone)
+	}
+	if deleteProtected := params.DeleteProtected(); deleteProtected != nil {
+		vm = vm.withDeleteProtected(*deleteProtected)
+	}
+	if emulatedMachine := params.EmulatedMachine(); emulatedMachine != nil {
+		vm = vm.withEmulatedMachine(*emulatedMachine)
+	}
+	if version := params.CustomCompatibilityVersion(); version != nil {
+		vm = vm.withCustomCompatibilityVersion(version)
+	} else if params.RemoveCustomCompatibilityVersion() {
+		vm = vm.withCustomCompatibilityVersion(nil)
+	}
+	if vm.status != VMStatusDown && (params.OS() != nil ||
+		params.BIOSType() != nil ||
+		params.Memory() != nil ||
+		params.CPU() != nil ||
+		params.TimeZone() != nil ||
+		params.EmulatedMachine() != nil ||
+		params.CustomCompatibilityVersion() != nil ||
+		params.RemoveCustomCompatibilityVersion()) {
+		// The engine stores changes it cannot apply to a running VM as the next run configuration.
+		vm.nextRunConfigurationExists = true
+	}
 	m.vms[id] = vm
+	m.mutationListeners.notify(ResourceTypeVM, string(id), "", MutationTypeUpdated)
 
 	return vm, nil
 }
+
+// mergeVMMemoryUpdate returns the memory and memory policy of a VM after applying the changes in params.
+func mergeVMMemoryUpdate(memory int64, currentPolicy MemoryPolicy, params UpdateVMParameters) (int64, *memoryPolicy) {
+	if newMemory := params.Memory(); newMemory != nil {
+		memory = *newMemory
+	}
+	memPolicy := &memoryPolicy{}
+	if currentPolicy != nil {
+		memPolicy.guaranteed = currentPolicy.Guaranteed()
+		memPolicy.max = currentPolicy.Max()
+		memPolicy.ballooning = currentPolicy.Ballooning()
+	}
+	if policyParams := params.MemoryPolicy(); policyParams != nil {
+		if guaranteed := policyParams.Guaranteed(); guaranteed != nil {
+			memPolicy.guaranteed = guaranteed
+		}
+		if max := policyParams.Max(); max != nil {
+			memPolicy.max = max
+		}
+		if ballooning := policyParams.Ballooning(); ballooning != nil {
+			memPolicy.ballooning = *ballooning
+		}
+	}
+	return memory, memPolicy
+}
+
+// validateVMMemoryUpdate validates the memory changes in params against the values of the current VM that are left
+// unchanged.
+func validateVMMemoryUpdate(current VM, params UpdateVMParameters) error {
+	memory, memPolicy := mergeVMMemoryUpdate(current.Memory(), current.MemoryPolicy(), params)
+	return validateVMMemory(&memory, memPolicy.guaranteed, memPolicy.max)
+}
+
+// updateVMMemory applies the memory changes to the mock VM after validating them against the values that are left
+// unchanged.
+func (m *mockClient) updateVMMemory(vm *vm, params UpdateVMParameters) (*vm, error) {
+	var currentPolicy MemoryPolicy
+	if vm.memoryPolicy != nil {
+		currentPolicy = vm.memoryPolicy
+	}
+	memory, memPolicy := mergeVMMemoryUpdate(vm.memory, currentPolicy, params)
+	if err := validateVMMemory(&memory, memPolicy.guaranteed, memPolicy.max); err != nil {
+		return nil, err
+	}
+	return vm.withMemory(memory, memPolicy), nil
+}
@@ -0,0 +1,39 @@
+// This file contains tests that need to lower the cluster level of the mock. It is therefore excluded from the
+// testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestUpdateVMRejectsUEFIOnOldCluster(t *testing.T) {
+	t.Parallel()
+	client := NewMock()
+	mock := client.(*mockClient)
+
+	var clusterID ClusterID
+	mock.lock.Lock()
+	for id, c := range mock.clusters {
+		clusterID = id
+		mock.clusters[id] = c.withCompatibilityVersion(NewVersion(4, 3))
+		break
+	}
+	mock.lock.Unlock()
+
+	vm, err := client.CreateVM(clusterID, DefaultBlankTemplateID, "test", nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	_, err = client.UpdateVM(vm.ID(), UpdateVMParams().MustWithBIOSType(VMBIOSTypeQ35OVMF))
+	if !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("switching a VM on a 4.3 cluster to UEFI did not fail with %s (%v)", EUnsupported, err)
+	}
+	updatedVM, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.BIOSType() != vm.BIOSType() {
+		t.Fatalf("the BIOS type of VM %s changed despite the error (%s)", vm.ID(), updatedVM.BIOSType())
+	}
+}
@@ -0,0 +1,132 @@
+package ovirtclient
+
+import (
+	"reflect"
+	"strings"
+)
+
+// vmUpdatePrecondition describes a VM field that UpdateVM can change. It is used to check that the field still has
+// the value the caller expects before the update is applied.
+type vmUpdatePrecondition struct {
+	field   string
+	touched func(params UpdateVMParameters) bool
+	value   func(vm VMData) interface{}
+}
+
+var vmUpdatePreconditions = []vmUpdatePrecondition{
+	{
+		"name",
+		func(params UpdateVMParameters) bool { return params.Name() != nil },
+		func(vm VMData) interface{} { return vm.Name() },
+	},
+	{
+		"comment",
+		func(params UpdateVMParameters) bool { return params.Comment() != nil },
+		func(vm VMData) interface{} { return vm.Comment() },
+	},
+	{
+		"description",
+		func(params UpdateVMParameters) bool { return params.Description() != nil },
+		func(vm VMData) interface{} { return vm.Description() },
+	},
+	{
+		"os",
+		func(params UpdateVMParameters) bool { return params.OS() != nil },
+		func(vm VMData) interface{} {
+			bootDevices := vm.OS().BootDevices()
+			if len(bootDevices) == 0 {
+				bootDevices = nil
+			}
+			return []interface{}{vm.OS().Type(), bootDevices}
+		},
+	},
+	{
+		"BIOS type",
+		func(params UpdateVMParameters) bool { return params.BIOSType() != nil },
+		func(vm VMData) interface{} { return vm.BIOSType() },
+	},
+	{
+		"memory",
+		func(params UpdateVMParameters) bool { return params.Memory() != nil },
+		func(vm VMData) interface{} { return vm.Memory() },
+	},
+	{
+		"memory policy",
+		func(params UpdateVMParameters) bool { return params.MemoryPolicy() != nil },
+		func(vm VMData) interface{} {
+			memoryPolicy := vm.MemoryPolicy()
+			return []interface{}{memoryPolicy.Guaranteed(), memoryPolicy.Max(), memoryPolicy.Ballooning()}
+		},
+	},
+	{
+		"CPU",
+		func(params UpdateVMParameters) bool { return params.CPU() != nil },
+		func(vm VMData) interface{} {
+			topo := vm.CPU().Topo()
+			return []interface{}{topo.Cores(), topo.Threads(), topo.Sockets(), vm.CPU().Mode()}
+		},
+	},
+	{
+		"time zone",
+		func(params UpdateVMParameters) bool { return params.TimeZone() != nil },
+		func(vm VMData) interface{} { return vm.TimeZone() },
+	},
+	{
+		"delete protection",
+		func(params UpdateVMParameters) bool { return params.DeleteProtected() != nil },
+		func(vm VMData) interface{} { return vm.DeleteProtected() },
+	},
+	{
+		"emulated machine",
+		func(params UpdateVMParameters) bool { return params.EmulatedMachine() != nil },
+		func(vm VMData) interface{} { return vm.EmulatedMachine() },
+	},
+	{
+		"custom compatibility version",
+		func(params UpdateVMParameters) bool {
+			return params.CustomCompatibilityVersion() != nil || params.RemoveCustomCompatibilityVersion()
+		},
+		func(vm VMData) interface{} {
+			if version := vm.CustomCompatibilityVersion(); version != nil {
+				return version.String()
+			}
+			return ""
+		},
+	},
+}
+
+// checkVMUpdatePrecondition compares the fields the update changes between the expected state in the parameters and
+// the current state of the VM. Fields the update does not touch are ignored, so unrelated changes such as the VM
+// status do not cause a conflict.
+func checkVMUpdatePrecondition(params UpdateVMParameters, current VMData) error {
+	expected := params.ExpectedState()
+	if expected == nil {
+		return nil
+	}
+	if expected.ID() != current.ID() {
+		return newError(
+			EBadArgument,
+			"the expected state is for VM %s, but VM %s is being updated",
+			expected.ID(),
+			current.ID(),
+		)
+	}
+	var changed []string
+	for _, precondition := range vmUpdatePreconditions {
+		if !precondition.touched(params) {
+			continue
+		}
+		if !reflect.DeepEqual(precondition.value(expected), precondition.value(current)) {
+			changed = append(changed, precondition.field)
+		}
+	}
+	if len(changed) > 0 {
+		return newError(
+			EObjectChanged,
+			"VM %s was changed since it was fetched, not updating it (changed fields: %s)",
+			current.ID(),
+			strings.Join(changed, ", "),
+		)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ func (o *oVirtClient) WaitForVMStatus(id VMID, status VMStatus, retries ...Retry
 	retries = defaultRetries(retries, defaultLongTimeouts(o))
 	err = retry(
 		fmt.Sprintf("waiting for VM %s status %s", id, status),
+		reading(ResourceTypeVM, string(id)).viaClient(),
 		o.logger,
 		retries,
 		func() error {
@@ -27,6 +28,7 @@ func (m *mockClient) WaitForVMStatus(id VMID, status VMStatus, retries ...RetryS
 	retries = defaultRetries(retries, defaultLongTimeouts(m))
 	err = retry(
 		fmt.Sprintf("waiting for VM %s status %s", id, status),
+		reading(ResourceTypeVM, string(id)),
 		m.logger,
 		retries,
 		func() error {
@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForVMUnlock(id VMID, retries ...RetryStrategy) (VM, error) {
+	return waitForVMUnlock(o, o.logger, id, defaultRetries(retries, defaultLongTimeouts(o)))
+}
+
+func (m *mockClient) WaitForVMUnlock(id VMID, retries ...RetryStrategy) (VM, error) {
+	return waitForVMUnlock(m, m.logger, id, defaultRetries(retries, defaultLongTimeouts(m)))
+}
+
+// waitForVMUnlock contains the waiting logic shared between the live and mock clients. The engine reports the VM as
+// image_locked while the disks are copied from the template, but the disks themselves may stay locked for a little
+// longer, so both are checked.
+func waitForVMUnlock(client Client, logger Logger, id VMID, retries []RetryStrategy) (vm VM, err error) {
+	err = retry(
+		fmt.Sprintf("waiting for VM %s to be unlocked", id),
+		reading(ResourceTypeVM, string(id)),
+		logger,
+		retries,
+		func() error {
+			vm, err = client.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			if vm.Status() == VMStatusImageLocked {
+				return newError(EPending, "VM %s is in status %s", id, vm.Status())
+			}
+			attachments, err := client.ListDiskAttachments(id, retries...)
+			if err != nil {
+				return err
+			}
+			for _, attachment := range attachments {
+				disk, err := client.GetDisk(attachment.DiskID(), retries...)
+				if err != nil {
+					return err
+				}
+				switch disk.Status() {
+				case DiskStatusOK:
+				case DiskStatusLocked:
+					return newError(EPending, "disk %s of VM %s is in status %s", disk.ID(), id, disk.Status())
+				default:
+					return newError(
+						EUnexpectedDiskStatus,
+						"disk %s of VM %s is in status %s",
+						disk.ID(),
+						id,
+						disk.Status(),
+					)
+				}
+			}
+			return nil
+		})
+	return vm, err
+}
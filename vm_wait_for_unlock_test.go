@@ -0,0 +1,42 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCreationWaitsForUnlock(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	startVM := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	assertCanAttachDisk(t, startVM, disk)
+	tpl := assertCanCreateTemplate(t, helper, startVM)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+
+	vm := assertCanCreateVMFromTemplate(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		tpl.ID(),
+		ovirtclient.NewCreateVMParams().WithWaitForUnlock(true),
+	)
+	if vm.Status() == ovirtclient.VMStatusImageLocked {
+		t.Fatalf("VM %s is still image locked after creation.", vm.ID())
+	}
+	attachments, err := vm.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of VM %s (%v)", vm.ID(), err)
+	}
+	for _, attachment := range attachments {
+		vmDisk, err := attachment.Disk()
+		if err != nil {
+			t.Fatalf("Failed to fetch disk %s (%v)", attachment.DiskID(), err)
+		}
+		if vmDisk.Status() != ovirtclient.DiskStatusOK {
+			t.Fatalf("Disk %s is in status %s after VM creation.", vmDisk.ID(), vmDisk.Status())
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+// VMWatchdogID is the identifier for watchdog devices on a VM.
+type VMWatchdogID string
+
+// WatchdogClient lists the methods to access and manipulate watchdog devices on VMs. A watchdog triggers the
+// configured action when the guest operating system stops responding, which high availability setups rely on to
+// recover hung VMs. A VM can have at most one watchdog.
+type WatchdogClient interface {
+	// ListVMWatchdogs lists the watchdog devices on a VM.
+	ListVMWatchdogs(vmID VMID, retries ...RetryStrategy) ([]VMWatchdog, error)
+	// AddVMWatchdog adds a watchdog device with the specified model and action to a VM.
+	AddVMWatchdog(vmID VMID, model WatchdogModel, action WatchdogAction, retries ...RetryStrategy) (VMWatchdog, error)
+	// UpdateVMWatchdog changes the model or action of a watchdog device.
+	UpdateVMWatchdog(
+		vmID VMID,
+		watchdogID VMWatchdogID,
+		params UpdateWatchdogParameters,
+		retries ...RetryStrategy,
+	) (VMWatchdog, error)
+	// RemoveVMWatchdog removes a watchdog device from a VM.
+	RemoveVMWatchdog(vmID VMID, watchdogID VMWatchdogID, retries ...RetryStrategy) error
+}
+
+// VMWatchdogData contains the data for VMWatchdog objects.
+type VMWatchdogData interface {
+	ID() VMWatchdogID
+	VMID() VMID
+	// Model is the emulated watchdog hardware.
+	Model() WatchdogModel
+	// Action is what happens to the VM when the watchdog fires.
+	Action() WatchdogAction
+}
+
+// VMWatchdog is an object representing a watchdog device on a virtual machine.
+type VMWatchdog interface {
+	VMWatchdogData
+
+	// Update changes the watchdog device.
+	Update(params UpdateWatchdogParameters, retries ...RetryStrategy) (VMWatchdog, error)
+	// Remove removes the watchdog device.
+	Remove(retries ...RetryStrategy) error
+}
+
+// WatchdogModel is the emulated watchdog hardware.
+type WatchdogModel string
+
+const (
+	// WatchdogModelI6300ESB is the Intel 6300ESB watchdog, supported on x86 VMs.
+	WatchdogModelI6300ESB WatchdogModel = "i6300esb"
+	// WatchdogModelDiag288 is the diag288 watchdog, supported on s390x VMs.
+	WatchdogModelDiag288 WatchdogModel = "diag288"
+)
+
+// Validate validates the watchdog model.
+func (w WatchdogModel) Validate() error {
+	for _, model := range WatchdogModelValues() {
+		if model == w {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid watchdog model: %s must be one of: %v", w, WatchdogModelValues())
+}
+
+// WatchdogModelList is a list of WatchdogModel values.
+type WatchdogModelList []WatchdogModel
+
+// Strings creates a string list of the values.
+func (l WatchdogModelList) Strings() []string {
+	result := make([]string, len(l))
+	for i, model := range l {
+		result[i] = string(model)
+	}
+	return result
+}
+
+// WatchdogModelValues returns all possible WatchdogModel values.
+func WatchdogModelValues() WatchdogModelList {
+	return []WatchdogModel{
+		WatchdogModelI6300ESB,
+		WatchdogModelDiag288,
+	}
+}
+
+// WatchdogAction is the action taken when a watchdog fires.
+type WatchdogAction string
+
+const (
+	// WatchdogActionNone takes no action, but the event is logged.
+	WatchdogActionNone WatchdogAction = "none"
+	// WatchdogActionReset resets the VM.
+	WatchdogActionReset WatchdogAction = "reset"
+	// WatchdogActionPowerOff powers off the VM. VMs marked as highly available are restarted by the engine.
+	WatchdogActionPowerOff WatchdogAction = "poweroff"
+	// WatchdogActionPause pauses the VM.
+	WatchdogActionPause WatchdogAction = "pause"
+	// WatchdogActionDump creates a memory dump of the VM and then pauses it.
+	WatchdogActionDump WatchdogAction = "dump"
+)
+
+// Validate validates the watchdog action.
+func (w WatchdogAction) Validate() error {
+	for _, action := range WatchdogActionValues() {
+		if action == w {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid watchdog action: %s must be one of: %v", w, WatchdogActionValues())
+}
+
+// WatchdogActionList is a list of WatchdogAction values.
+type WatchdogActionList []WatchdogAction
+
+// Strings creates a string list of the values.
+func (l WatchdogActionList) Strings() []string {
+	result := make([]string, len(l))
+	for i, action := range l {
+		result[i] = string(action)
+	}
+	return result
+}
+
+// WatchdogActionValues returns all possible WatchdogAction values.
+func WatchdogActionValues() WatchdogActionList {
+	return []WatchdogAction{
+		WatchdogActionNone,
+		WatchdogActionReset,
+		WatchdogActionPowerOff,
+		WatchdogActionPause,
+		WatchdogActionDump,
+	}
+}
+
+// UpdateWatchdogParameters contains the changes to apply to a watchdog device.
+type UpdateWatchdogParameters interface {
+	// Model returns the new watchdog model, or nil if the model should not be changed.
+	Model() *WatchdogModel
+	// Action returns the new watchdog action, or nil if the action should not be changed.
+	Action() *WatchdogAction
+}
+
+// BuildableUpdateWatchdogParameters is a buildable version of UpdateWatchdogParameters.
+type BuildableUpdateWatchdogParameters interface {
+	UpdateWatchdogParameters
+
+	// WithModel sets the new watchdog model.
+	WithModel(model WatchdogModel) (BuildableUpdateWatchdogParameters, error)
+	// MustWithModel is identical to WithModel, but panics instead of returning an error.
+	MustWithModel(model WatchdogModel) BuildableUpdateWatchdogParameters
+
+	// WithAction sets the new watchdog action.
+	WithAction(action WatchdogAction) (BuildableUpdateWatchdogParameters, error)
+	// MustWithAction is identical to WithAction, but panics instead of returning an error.
+	MustWithAction(action WatchdogAction) BuildableUpdateWatchdogParameters
+}
+
+// UpdateWatchdogParams creates a buildable set of parameters for updating a watchdog device.
+func UpdateWatchdogParams() BuildableUpdateWatchdogParameters {
+	return &updateWatchdogParams{}
+}
+
+type updateWatchdogParams struct {
+	model  *WatchdogModel
+	action *WatchdogAction
+}
+
+func (u *updateWatchdogParams) Model() *WatchdogModel {
+	return u.model
+}
+
+func (u *updateWatchdogParams) Action() *WatchdogAction {
+	return u.action
+}
+
+func (u *updateWatchdogParams) WithModel(model WatchdogModel) (BuildableUpdateWatchdogParameters, error) {
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+	u.model = &model
+	return u, nil
+}
+
+func (u *updateWatchdogParams) MustWithModel(model WatchdogModel) BuildableUpdateWatchdogParameters {
+	builder, err := u.WithModel(model)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateWatchdogParams) WithAction(action WatchdogAction) (BuildableUpdateWatchdogParameters, error) {
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	u.action = &action
+	return u, nil
+}
+
+func (u *updateWatchdogParams) MustWithAction(action WatchdogAction) BuildableUpdateWatchdogParameters {
+	builder, err := u.WithAction(action)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type vmWatchdog struct {
+	client Client
+
+	id     VMWatchdogID
+	vmID   VMID
+	model  WatchdogModel
+	action WatchdogAction
+}
+
+func (v *vmWatchdog) ID() VMWatchdogID {
+	return v.id
+}
+
+func (v *vmWatchdog) VMID() VMID {
+	return v.vmID
+}
+
+func (v *vmWatchdog) Model() WatchdogModel {
+	return v.model
+}
+
+func (v *vmWatchdog) Action() WatchdogAction {
+	return v.action
+}
+
+func (v *vmWatchdog) Update(params UpdateWatchdogParameters, retries ...RetryStrategy) (VMWatchdog, error) {
+	return v.client.UpdateVMWatchdog(v.vmID, v.id, params, retries...)
+}
+
+func (v *vmWatchdog) Remove(retries ...RetryStrategy) error {
+	return v.client.RemoveVMWatchdog(v.vmID, v.id, retries...)
+}
+
+func convertSDKWatchdog(sdkObject *ovirtsdk.Watchdog, vmID VMID, client Client) (VMWatchdog, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("watchdog", "id")
+	}
+	model, ok := sdkObject.Model()
+	if !ok {
+		return nil, newFieldNotFound("watchdog", "model")
+	}
+	action, ok := sdkObject.Action()
+	if !ok {
+		return nil, newFieldNotFound("watchdog", "action")
+	}
+	return &vmWatchdog{
+		client: client,
+		id:     VMWatchdogID(id),
+		vmID:   vmID,
+		model:  WatchdogModel(model),
+		action: WatchdogAction(action),
+	}, nil
+}
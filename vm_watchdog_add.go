@@ -0,0 +1,98 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddVMWatchdog(
+	vmID VMID,
+	model WatchdogModel,
+	action WatchdogAction,
+	retries ...RetryStrategy,
+) (result VMWatchdog, err error) {
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkWatchdog, err := ovirtsdk.NewWatchdogBuilder().
+		Model(ovirtsdk.WatchdogModel(model)).
+		Action(ovirtsdk.WatchdogAction(action)).
+		Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build watchdog for VM %s", vmID)
+	}
+	err = retry(
+		fmt.Sprintf("adding %s watchdog to VM %s", model, vmID),
+		mutating(ResourceTypeWatchdog, "").
+			withParameters(map[string]string{
+				"vm_id":  string(vmID),
+				"model":  string(model),
+				"action": string(action),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				WatchdogsService().
+				Add().
+				Watchdog(sdkWatchdog).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			watchdog, ok := resp.Watchdog()
+			if !ok {
+				return newFieldNotFound("watchdog add response", "watchdog")
+			}
+			result, err = convertSDKWatchdog(watchdog, vmID, o)
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeWatchdog, string(result.ID()), string(vmID), MutationTypeCreated)
+	}
+	return result, err
+}
+
+func (m *mockClient) AddVMWatchdog(
+	vmID VMID,
+	model WatchdogModel,
+	action WatchdogAction,
+	_ ...RetryStrategy,
+) (VMWatchdog, error) {
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	if len(m.watchdogsByVM[vmID]) > 0 {
+		return nil, newError(EConflict, "VM %s already has a watchdog", vmID)
+	}
+	watchdog := &vmWatchdog{
+		client: m,
+		id:     VMWatchdogID(m.GenerateUUID()),
+		vmID:   vmID,
+		model:  model,
+		action: action,
+	}
+	m.watchdogsByVM[vmID] = append(m.watchdogsByVM[vmID], watchdog)
+	m.mutationListeners.notify(ResourceTypeWatchdog, string(watchdog.id), string(vmID), MutationTypeCreated)
+	return watchdog, nil
+}
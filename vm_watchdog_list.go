@@ -0,0 +1,52 @@
+package ovirtclient
+
+import "fmt"
+
+func (o *oVirtClient) ListVMWatchdogs(vmID VMID, retries ...RetryStrategy) (result []VMWatchdog, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing watchdogs for VM %s", vmID),
+		reading(ResourceTypeVM, string(vmID)),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				WatchdogsService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			watchdogList, ok := resp.Watchdogs()
+			if !ok {
+				return newFieldNotFound("watchdog list response", "watchdogs")
+			}
+			result = make([]VMWatchdog, len(watchdogList.Slice()))
+			for i, w := range watchdogList.Slice() {
+				result[i], err = convertSDKWatchdog(w, vmID, o)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListVMWatchdogs(vmID VMID, _ ...RetryStrategy) ([]VMWatchdog, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := make([]VMWatchdog, len(m.watchdogsByVM[vmID]))
+	for i, watchdog := range m.watchdogsByVM[vmID] {
+		result[i] = watchdog
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package ovirtclient
+
+import "fmt"
+
+func (o *oVirtClient) RemoveVMWatchdog(vmID VMID, watchdogID VMWatchdogID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing watchdog %s from VM %s", watchdogID, vmID),
+		mutating(ResourceTypeWatchdog, string(watchdogID)).
+			withParameters(map[string]string{
+				"vm_id": string(vmID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			_, err = o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				WatchdogsService().
+				WatchdogService(string(watchdogID)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeWatchdog, string(watchdogID), string(vmID), MutationTypeRemoved)
+	}
+	return err
+}
+
+func (m *mockClient) RemoveVMWatchdog(vmID VMID, watchdogID VMWatchdogID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	watchdogs := m.watchdogsByVM[vmID]
+	for i, watchdog := range watchdogs {
+		if watchdog.id == watchdogID {
+			m.watchdogsByVM[vmID] = append(watchdogs[:i:i], watchdogs[i+1:]...)
+			m.mutationListeners.notify(ResourceTypeWatchdog, string(watchdogID), string(vmID), MutationTypeRemoved)
+			return nil
+		}
+	}
+	return newError(ENotFound, "watchdog with ID %s not found on VM %s", watchdogID, vmID)
+}
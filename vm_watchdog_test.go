@@ -0,0 +1,52 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMWatchdogLifecycle(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), ovirtclient.NewCreateVMParams())
+
+	watchdog, err := vm.AddWatchdog(ovirtclient.WatchdogModelI6300ESB, ovirtclient.WatchdogActionReset)
+	if err != nil {
+		t.Fatalf("Failed to add watchdog to VM %s (%v)", vm.ID(), err)
+	}
+	if watchdog.Model() != ovirtclient.WatchdogModelI6300ESB || watchdog.Action() != ovirtclient.WatchdogActionReset {
+		t.Fatalf("Incorrect watchdog settings (model: %s, action: %s)", watchdog.Model(), watchdog.Action())
+	}
+
+	updatedWatchdog, err := watchdog.Update(
+		ovirtclient.UpdateWatchdogParams().MustWithAction(ovirtclient.WatchdogActionPowerOff),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update watchdog %s (%v)", watchdog.ID(), err)
+	}
+	if updatedWatchdog.Action() != ovirtclient.WatchdogActionPowerOff {
+		t.Fatalf("Watchdog action not updated (%s)", updatedWatchdog.Action())
+	}
+	if updatedWatchdog.Model() != ovirtclient.WatchdogModelI6300ESB {
+		t.Fatalf("Watchdog model changed unexpectedly (%s)", updatedWatchdog.Model())
+	}
+
+	watchdogs, err := vm.ListWatchdogs()
+	if err != nil {
+		t.Fatalf("Failed to list watchdogs on VM %s (%v)", vm.ID(), err)
+	}
+	if len(watchdogs) != 1 || watchdogs[0].ID() != watchdog.ID() {
+		t.Fatalf("Incorrect watchdogs listed on VM %s: %v", vm.ID(), watchdogs)
+	}
+
+	if err := updatedWatchdog.Remove(); err != nil {
+		t.Fatalf("Failed to remove watchdog %s (%v)", watchdog.ID(), err)
+	}
+	watchdogs, err = vm.ListWatchdogs()
+	if err != nil {
+		t.Fatalf("Failed to list watchdogs on VM %s (%v)", vm.ID(), err)
+	}
+	if len(watchdogs) != 0 {
+		t.Fatalf("Watchdogs still present on VM %s after removal.", vm.ID())
+	}
+}
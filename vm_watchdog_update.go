@@ -0,0 +1,88 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateVMWatchdog(
+	vmID VMID,
+	watchdogID VMWatchdogID,
+	params UpdateWatchdogParameters,
+	retries ...RetryStrategy,
+) (result VMWatchdog, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkWatchdog := &ovirtsdk.Watchdog{}
+	sdkWatchdog.SetId(string(watchdogID))
+	if model := params.Model(); model != nil {
+		sdkWatchdog.SetModel(ovirtsdk.WatchdogModel(*model))
+	}
+	if action := params.Action(); action != nil {
+		sdkWatchdog.SetAction(ovirtsdk.WatchdogAction(*action))
+	}
+	err = retry(
+		fmt.Sprintf("updating watchdog %s on VM %s", watchdogID, vmID),
+		mutating(ResourceTypeWatchdog, string(watchdogID)).
+			withParameters(map[string]string{
+				"vm_id": string(vmID),
+			}),
+		o.logger,
+		retries,
+		func() error {
+			resp, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				WatchdogsService().
+				WatchdogService(string(watchdogID)).
+				Update().
+				Watchdog(sdkWatchdog).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
+			if err != nil {
+				return err
+			}
+			watchdog, ok := resp.Watchdog()
+			if !ok {
+				return newFieldNotFound("watchdog update response", "watchdog")
+			}
+			result, err = convertSDKWatchdog(watchdog, vmID, o)
+			return err
+		},
+	)
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeWatchdog, string(watchdogID), string(vmID), MutationTypeUpdated)
+	}
+	return result, err
+}
+
+func (m *mockClient) UpdateVMWatchdog(
+	vmID VMID,
+	watchdogID VMWatchdogID,
+	params UpdateWatchdogParameters,
+	_ ...RetryStrategy,
+) (VMWatchdog, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for i, watchdog := range m.watchdogsByVM[vmID] {
+		if watchdog.id != watchdogID {
+			continue
+		}
+		updated := *watchdog
+		if model := params.Model(); model != nil {
+			updated.model = *model
+		}
+		if action := params.Action(); action != nil {
+			updated.action = *action
+		}
+		m.watchdogsByVM[vmID][i] = &updated
+		m.mutationListeners.notify(ResourceTypeWatchdog, string(watchdogID), string(vmID), MutationTypeUpdated)
+		return &updated, nil
+	}
+	return nil, newError(ENotFound, "watchdog with ID %s not found on VM %s", watchdogID, vmID)
+}
@@ -21,6 +21,12 @@ func (o *oVirtClient) CreateVNICProfile(
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("creating VNIC profile %s", name),
+		mutating(ResourceTypeVNICProfile, "").
+			withParameters(map[string]string{
+				"name":       name,
+				"network_id": string(networkID),
+			}).
+			withResultID(func() string { return string(result.ID()) }),
 		o.logger,
 		retries,
 		func() error {
@@ -28,7 +34,10 @@ func (o *oVirtClient) CreateVNICProfile(
 			profileBuilder.Name(name)
 			profileBuilder.Network(ovirtsdk.NewNetworkBuilder().Id(string(networkID)).MustBuild())
 			req := o.conn.SystemService().VnicProfilesService().Add()
-			response, err := req.Profile(profileBuilder.MustBuild()).Send()
+			response, err := req.
+				Profile(profileBuilder.MustBuild()).
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -39,6 +48,9 @@ func (o *oVirtClient) CreateVNICProfile(
 			result, err = convertSDKVNICProfile(profile, o)
 			return err
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVNICProfile, string(result.ID()), "", MutationTypeCreated)
+	}
 	return result, err
 }
 
@@ -61,7 +73,7 @@ func (m *mockClient) CreateVNICProfile(
 
 	for _, vnicProfile := range m.vnicProfiles {
 		if vnicProfile.name == name {
-			return nil, newError(EConflict, "VNIC profile name is already in use")
+			return nil, newError(ENameInUse, "VNIC profile name is already in use")
 		}
 	}
 
@@ -73,6 +85,7 @@ func (m *mockClient) CreateVNICProfile(
 		networkID: networkID,
 		name:      name,
 	}
+	m.mutationListeners.notify(ResourceTypeVNICProfile, string(id), "", MutationTypeCreated)
 
 	return m.vnicProfiles[id], nil
 }
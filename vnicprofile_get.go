@@ -10,10 +10,17 @@ func (o *oVirtClient) GetVNICProfile(id VNICProfileID, retries ...RetryStrategy)
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting VNIC profile %s", id),
+		reading(ResourceTypeVNICProfile, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VnicProfilesService().ProfileService(string(id)).Get().Send()
+			response, err := o.conn.
+				SystemService().
+				VnicProfilesService().
+				ProfileService(string(id)).
+				Get().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
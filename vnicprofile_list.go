@@ -7,10 +7,16 @@ func (o *oVirtClient) ListVNICProfiles(retries ...RetryStrategy) (result []VNICP
 	result = []VNICProfile{}
 	err = retry(
 		"listing VNIC profiles",
+		reading(ResourceTypeVNICProfile, ""),
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VnicProfilesService().List().Send()
+			response, e := o.conn.
+				SystemService().
+				VnicProfilesService().
+				List().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if e != nil {
 				return e
 			}
@@ -8,15 +8,25 @@ func (o *oVirtClient) RemoveVNICProfile(id VNICProfileID, retries ...RetryStrate
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("removing VNIC profile %s", id),
+		mutating(ResourceTypeVNICProfile, string(id)),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VnicProfilesService().ProfileService(string(id)).Remove().Send()
+			_, err := o.conn.
+				SystemService().
+				VnicProfilesService().
+				ProfileService(string(id)).
+				Remove().
+				Query("correlation_id", requestCorrelationID(retries)).
+				Send()
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	if err == nil {
+		o.mutationListeners.notify(ResourceTypeVNICProfile, string(id), "", MutationTypeRemoved)
+	}
 	return
 }
 
@@ -29,6 +39,7 @@ func (m *mockClient) RemoveVNICProfile(id VNICProfileID, _ ...RetryStrategy) err
 	}
 
 	delete(m.vnicProfiles, id)
+	m.mutationListeners.notify(ResourceTypeVNICProfile, string(id), "", MutationTypeRemoved)
 
 	return nil
 }
@@ -0,0 +1,129 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// WarningCode is a code for the kind of non-fatal condition reported in a Warning.
+type WarningCode string
+
+// WRetried indicates that a call succeeded, but only after one or more failed attempts.
+const WRetried WarningCode = "retried"
+
+// WFormatConverted indicates that the image format requested for a download differs from the disk format. The live
+// client has the engine convert the data, while the mock client provides the data in the disk format unmodified.
+const WFormatConverted WarningCode = "format_converted"
+
+// WDeprecated indicates that a deprecated function was called.
+const WDeprecated WarningCode = "deprecated"
+
+// WCleanupFailed indicates that cleaning up after a failed operation did not succeed and resources may have been
+// left behind.
+const WCleanupFailed WarningCode = "cleanup_failed"
+
+// Warning is a non-fatal condition that happened during a call. The call itself may still have succeeded.
+type Warning interface {
+	// Code returns the code for the warning.
+	Code() WarningCode
+	// Message returns the human-readable description of the warning.
+	Message() string
+	// String returns the code and the message as a string.
+	String() string
+}
+
+// WarningCollector collects the warnings that happen during one or more calls. It implements the RetryStrategy
+// interface, so it can be passed to any call alongside the retry strategies, without altering the retry behavior:
+//
+//	warnings := ovirtclient.CollectWarnings()
+//	disk, err := client.CreateDisk(storageDomainID, ovirtclient.ImageFormatRaw, size, nil, warnings)
+//	if err != nil {
+//	    //...
+//	}
+//	for _, warning := range warnings.Warnings() {
+//	    fmt.Println(warning.String())
+//	}
+//
+// Warnings are also logged on the warning level regardless of whether a collector is passed.
+type WarningCollector interface {
+	RetryStrategy
+
+	// Warnings returns the warnings collected so far.
+	Warnings() []Warning
+}
+
+// CollectWarnings creates a new WarningCollector. The same collector can be passed to multiple calls, and it is safe
+// for concurrent use.
+func CollectWarnings() WarningCollector {
+	return &warningCollector{
+		lock: &sync.Mutex{},
+	}
+}
+
+// warningSink is the internal interface the retry function uses to find warning collectors among the retry
+// strategies.
+type warningSink interface {
+	addWarning(w Warning)
+}
+
+type warningCollector struct {
+	callOption
+
+	lock     *sync.Mutex
+	warnings []Warning
+}
+
+func (w *warningCollector) Warnings() []Warning {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	result := make([]Warning, len(w.warnings))
+	copy(result, w.warnings)
+	return result
+}
+
+func (w *warningCollector) addWarning(warning Warning) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.warnings = append(w.warnings, warning)
+}
+
+type warning struct {
+	code    WarningCode
+	message string
+}
+
+func (w warning) Code() WarningCode {
+	return w.code
+}
+
+func (w warning) Message() string {
+	return w.message
+}
+
+func (w warning) String() string {
+	return fmt.Sprintf("%s: %s", w.code, w.message)
+}
+
+// warn logs a warning and passes it to all warning collectors found in the retries.
+func warn(
+	logger ovirtclientlog.Logger,
+	retries []RetryStrategy,
+	code WarningCode,
+	format string,
+	args ...interface{},
+) {
+	w := warning{
+		code:    code,
+		message: fmt.Sprintf(format, args...),
+	}
+	if logger != nil {
+		logger.Warningf("%s", w.message)
+	}
+	for _, r := range retries {
+		if sink, ok := r.(warningSink); ok {
+			sink.addWarning(w)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+package ovirtclient
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// WatchParameters are the optional parameters for WatchVM, WatchDisk and WatchHost.
+type WatchParameters interface {
+	// PollInterval returns the time between two checks of the watched resource.
+	PollInterval() time.Duration
+	// Logger returns the logger failed checks are logged to.
+	Logger() Logger
+}
+
+// BuildableWatchParameters is a buildable version of WatchParameters.
+type BuildableWatchParameters interface {
+	WatchParameters
+
+	// WithPollInterval sets the time between two checks of the watched resource.
+	WithPollInterval(interval time.Duration) (BuildableWatchParameters, error)
+	// MustWithPollInterval is identical to WithPollInterval, but panics instead of returning an error.
+	MustWithPollInterval(interval time.Duration) BuildableWatchParameters
+
+	// WithLogger sets the logger for failed checks.
+	WithLogger(logger Logger) (BuildableWatchParameters, error)
+	// MustWithLogger is identical to WithLogger, but panics instead of returning an error.
+	MustWithLogger(logger Logger) BuildableWatchParameters
+}
+
+// WatchParams creates a buildable set of WatchParameters. The default poll interval is 10 seconds and no logging takes
+// place.
+func WatchParams() BuildableWatchParameters {
+	return &watchParams{
+		pollInterval: 10 * time.Second,
+		logger:       ovirtclientlog.NewNOOPLogger(),
+	}
+}
+
+type watchParams struct {
+	pollInterval time.Duration
+	logger       Logger
+}
+
+func (w *watchParams) PollInterval() time.Duration {
+	return w.pollInterval
+}
+
+func (w *watchParams) Logger() Logger {
+	return w.logger
+}
+
+func (w *watchParams) WithPollInterval(interval time.Duration) (BuildableWatchParameters, error) {
+	if interval <= 0 {
+		return w, newError(EBadArgument, "the poll interval must be positive (%s given)", interval)
+	}
+	w.pollInterval = interval
+	return w, nil
+}
+
+func (w *watchParams) MustWithPollInterval(interval time.Duration) BuildableWatchParameters {
+	builder, err := w.WithPollInterval(interval)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (w *watchParams) WithLogger(logger Logger) (BuildableWatchParameters, error) {
+	if logger == nil {
+		return w, newError(EBadArgument, "the logger must not be nil")
+	}
+	w.logger = logger
+	return w, nil
+}
+
+func (w *watchParams) MustWithLogger(logger Logger) BuildableWatchParameters {
+	builder, err := w.WithLogger(logger)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// VMWatchEvent describes a change of a VM delivered by WatchVM.
+type VMWatchEvent interface {
+	// MutationType returns the kind of change. The first event has MutationTypeCreated and contains the state of the
+	// VM when the watch started.
+	MutationType() MutationType
+	// VM returns the new version of the VM, or the last known version if it has been removed.
+	VM() VM
+}
+
+// DiskWatchEvent describes a change of a disk delivered by WatchDisk.
+type DiskWatchEvent interface {
+	// MutationType returns the kind of change. The first event has MutationTypeCreated and contains the state of the
+	// disk when the watch started.
+	MutationType() MutationType
+	// Disk returns the new version of the disk, or the last known version if it has been removed.
+	Disk() Disk
+}
+
+// HostWatchEvent describes a change of a host delivered by WatchHost.
+type HostWatchEvent interface {
+	// MutationType returns the kind of change. The first event has MutationTypeCreated and contains the state of the
+	// host when the watch started.
+	MutationType() MutationType
+	// Host returns the new version of the host, or the last known version if it has been removed.
+	Host() Host
+}
+
+func (o *oVirtClient) WatchVM(
+	id VMID,
+	params WatchParameters,
+	retries ...RetryStrategy,
+) (<-chan VMWatchEvent, func(), error) {
+	return watchVM(o, id, params, retries)
+}
+
+func (m *mockClient) WatchVM(
+	id VMID,
+	params WatchParameters,
+	retries ...RetryStrategy,
+) (<-chan VMWatchEvent, func(), error) {
+	return watchVM(m, id, params, retries)
+}
+
+func (o *oVirtClient) WatchDisk(
+	id DiskID,
+	params WatchParameters,
+	retries ...RetryStrategy,
+) (<-chan DiskWatchEvent, func(), error) {
+	return watchDisk(o, id, params, retries)
+}
+
+func (m *mockClient) WatchDisk(
+	id DiskID,
+	params WatchParameters,
+	retries ...RetryStrategy,
+) (<-chan DiskWatchEvent, func(), error) {
+	return watchDisk(m, id, params, retries)
+}
+
+func (o *oVirtClient) WatchHost(
+	id HostID,
+	params WatchParameters,
+	retries ...RetryStrategy,
+) (<-chan HostWatchEvent, func(), error) {
+	return watchHost(o, id, params, retries)
+}
+
+func (m *mockClient) WatchHost(
+	id HostID,
+	params WatchParameters,
+	retries ...RetryStrategy,
+) (<-chan HostWatchEvent, func(), error) {
+	return watchHost(m, id, params, retries)
+}
+
+func watchVM(
+	client Client,
+	id VMID,
+	params WatchParameters,
+	retries []RetryStrategy,
+) (<-chan VMWatchEvent, func(), error) {
+	events := make(chan VMWatchEvent)
+	stop, err := startWatch(
+		client,
+		ResourceTypeVM,
+		string(id),
+		params,
+		func() (interface{}, error) {
+			return client.GetVM(id, retries...)
+		},
+		func(stop <-chan struct{}, mutationType MutationType, object interface{}) bool {
+			select {
+			case events <- vmWatchEvent{mutationType, object.(VM)}:
+				return true
+			case <-stop:
+				return false
+			}
+		},
+		func() {
+			close(events)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, stop, nil
+}
+
+func watchDisk(
+	client Client,
+	id DiskID,
+	params WatchParameters,
+	retries []RetryStrategy,
+) (<-chan DiskWatchEvent, func(), error) {
+	events := make(chan DiskWatchEvent)
+	stop, err := startWatch(
+		client,
+		ResourceTypeDisk,
+		string(id),
+		params,
+		func() (interface{}, error) {
+			return client.GetDisk(id, retries...)
+		},
+		func(stop <-chan struct{}, mutationType MutationType, object interface{}) bool {
+			select {
+			case events <- diskWatchEvent{mutationType, object.(Disk)}:
+				return true
+			case <-stop:
+				return false
+			}
+		},
+		func() {
+			close(events)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, stop, nil
+}
+
+func watchHost(
+	client Client,
+	id HostID,
+	params WatchParameters,
+	retries []RetryStrategy,
+) (<-chan HostWatchEvent, func(), error) {
+	events := make(chan HostWatchEvent)
+	stop, err := startWatch(
+		client,
+		ResourceTypeHost,
+		string(id),
+		params,
+		func() (interface{}, error) {
+			return client.GetHost(id, retries...)
+		},
+		func(stop <-chan struct{}, mutationType MutationType, object interface{}) bool {
+			select {
+			case events <- hostWatchEvent{mutationType, object.(Host)}:
+				return true
+			case <-stop:
+				return false
+			}
+		},
+		func() {
+			close(events)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, stop, nil
+}
+
+// startWatch fetches the resource and starts polling it in the background. Each change is passed to deliver, which
+// returns false if the watch has been stopped in the meantime. Mutations of the resource, or of resources belonging
+// to it, made through the client trigger an immediate check. The returned function stops the watch, after which
+// closeEvents is called.
+func startWatch(
+	client Client,
+	resourceType ResourceType,
+	id string,
+	params WatchParameters,
+	fetch func() (interface{}, error),
+	deliver func(stop <-chan struct{}, mutationType MutationType, object interface{}) bool,
+	closeEvents func(),
+) (func(), error) {
+	if params == nil {
+		params = WatchParams()
+	}
+	current, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	stop := make(chan struct{})
+	trigger := make(chan struct{}, 1)
+	removeListener := client.AddMutationListener(func(event MutationEvent) {
+		if (event.ResourceType() == resourceType && event.ResourceID() == id) || event.ParentID() == id {
+			select {
+			case trigger <- struct{}{}:
+			default:
+				// A check is already pending.
+			}
+		}
+	})
+
+	go func() {
+		defer closeEvents()
+		defer removeListener()
+
+		if !deliver(stop, MutationTypeCreated, current) {
+			return
+		}
+		last := resourceSnapshot(current)
+		ticker := time.NewTicker(params.PollInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			case <-trigger:
+			}
+			object, err := fetch()
+			if err != nil {
+				if HasErrorCode(err, ENotFound) {
+					deliver(stop, MutationTypeRemoved, current)
+					return
+				}
+				params.Logger().Warningf("failed to check %s %s for changes (%v)", resourceType, id, err)
+				continue
+			}
+			snapshot := resourceSnapshot(object)
+			if reflect.DeepEqual(last, snapshot) {
+				continue
+			}
+			current = object
+			last = snapshot
+			if !deliver(stop, MutationTypeUpdated, object) {
+				return
+			}
+		}
+	}()
+
+	once := &sync.Once{}
+	return func() {
+		once.Do(func() {
+			close(stop)
+		})
+	}, nil
+}
+
+// resourceDataInterfaces are the interfaces exposing the data of the resources that are checked for changes.
+var resourceDataInterfaces = []reflect.Type{
+	reflect.TypeOf((*VMData)(nil)).Elem(),
+	reflect.TypeOf((*DiskData)(nil)).Elem(),
+	reflect.TypeOf((*HostData)(nil)).Elem(),
+}
+
+// resourceSnapshot returns the results of the data functions of a resource for change detection. Comparing these
+// instead of the object itself ignores the client and other internal state. Since the functions are called when the
+// snapshot is taken, it also detects the changes the mock client makes to some objects in place.
+func resourceSnapshot(object interface{}) interface{} {
+	value := reflect.ValueOf(object)
+	if !value.IsValid() {
+		return object
+	}
+	for _, dataInterface := range resourceDataInterfaces {
+		if !value.Type().Implements(dataInterface) {
+			continue
+		}
+		snapshot := make(map[string][]interface{}, dataInterface.NumMethod())
+		for i := 0; i < dataInterface.NumMethod(); i++ {
+			method := dataInterface.Method(i)
+			if method.Type.NumIn() != 0 {
+				continue
+			}
+			results := value.MethodByName(method.Name).Call(nil)
+			snapshot[method.Name] = make([]interface{}, len(results))
+			for j, result := range results {
+				snapshot[method.Name][j] = result.Interface()
+			}
+		}
+		return snapshot
+	}
+	return object
+}
+
+type vmWatchEvent struct {
+	mutationType MutationType
+	vm           VM
+}
+
+func (v vmWatchEvent) MutationType() MutationType {
+	return v.mutationType
+}
+
+func (v vmWatchEvent) VM() VM {
+	return v.vm
+}
+
+type diskWatchEvent struct {
+	mutationType MutationType
+	disk         Disk
+}
+
+func (d diskWatchEvent) MutationType() MutationType {
+	return d.mutationType
+}
+
+func (d diskWatchEvent) Disk() Disk {
+	return d.disk
+}
+
+type hostWatchEvent struct {
+	mutationType MutationType
+	host         Host
+}
+
+func (h hostWatchEvent) MutationType() MutationType {
+	return h.mutationType
+}
+
+func (h hostWatchEvent) Host() Host {
+	return h.host
+}
@@ -0,0 +1,38 @@
+// This file contains tests for the unexported change detection of watches and inventories. It is therefore excluded
+// from the testpackage check.
+
+package ovirtclient //nolint:testpackage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceSnapshotIgnoresInternalState(t *testing.T) {
+	t.Parallel()
+	client := NewMock()
+	clusterID := firstMockClusterID(client.(*mockClient))
+	created, err := client.CreateVM(clusterID, DefaultBlankTemplateID, "test", nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	original := created.(*vm)
+
+	otherClient := *original
+	otherClient.client = NewMock()
+	if !reflect.DeepEqual(resourceSnapshot(original), resourceSnapshot(&otherClient)) {
+		t.Fatalf("a different client was detected as a change")
+	}
+	if reflect.DeepEqual(resourceSnapshot(original), resourceSnapshot(original.withName("renamed"))) {
+		t.Fatalf("a different name was not detected as a change")
+	}
+}
+
+func firstMockClusterID(m *mockClient) ClusterID {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for id := range m.clusters {
+		return id
+	}
+	return ""
+}
@@ -0,0 +1,120 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestWatchVMReportsChanges(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+
+	// The long poll interval makes sure the changes are picked up from the mutations of the client.
+	events, stop, err := client.WatchVM(
+		vm.ID(),
+		ovirtclient.WatchParams().
+			MustWithPollInterval(time.Hour).
+			MustWithLogger(ovirtclientlog.NewTestLogger(t)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to watch VM (%v)", err)
+	}
+	defer stop()
+
+	event := assertReceivesVMWatchEvent(t, events, ovirtclient.MutationTypeCreated)
+	if event.VM().ID() != vm.ID() {
+		t.Fatalf("Incorrect VM in initial event (expected: %s, got: %s)", vm.ID(), event.VM().ID())
+	}
+
+	newName := fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5))
+	if _, err := client.UpdateVM(vm.ID(), ovirtclient.UpdateVMParams().MustWithName(newName)); err != nil {
+		t.Fatalf("Failed to update VM (%v)", err)
+	}
+	event = assertReceivesVMWatchEvent(t, events, ovirtclient.MutationTypeUpdated)
+	if event.VM().Name() != newName {
+		t.Fatalf("Incorrect VM name in update event (expected: %s, got: %s)", newName, event.VM().Name())
+	}
+
+	if err := vm.Remove(); err != nil {
+		t.Fatalf("Failed to remove VM (%v)", err)
+	}
+	assertReceivesVMWatchEvent(t, events, ovirtclient.MutationTypeRemoved)
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatalf("Received an event after the VM has been removed.")
+		}
+	case <-time.After(time.Minute):
+		t.Fatalf("The event channel was not closed after the VM has been removed.")
+	}
+}
+
+func TestWatchHostStop(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Skipf("No hosts available.")
+	}
+
+	events, stop, err := client.WatchHost(hosts[0].ID(), nil)
+	if err != nil {
+		t.Fatalf("Failed to watch host (%v)", err)
+	}
+	event := <-events
+	if event.MutationType() != ovirtclient.MutationTypeCreated || event.Host().ID() != hosts[0].ID() {
+		t.Fatalf("Incorrect initial event (%s for host %s)", event.MutationType(), event.Host().ID())
+	}
+	stop()
+	stop()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatalf("Received an event after the watch has been stopped.")
+		}
+	case <-time.After(time.Minute):
+		t.Fatalf("The event channel was not closed after the watch has been stopped.")
+	}
+}
+
+func TestWatchDiskNotFound(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, _, err := helper.GetClient().WatchDisk(ovirtclient.DiskID(helper.GenerateRandomID(10)), nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Watching a non-existent disk did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func assertReceivesVMWatchEvent(
+	t *testing.T,
+	events <-chan ovirtclient.VMWatchEvent,
+	mutationType ovirtclient.MutationType,
+) ovirtclient.VMWatchEvent {
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatalf("The event channel has been closed while waiting for a %s event.", mutationType)
+		}
+		if event.MutationType() != mutationType {
+			t.Fatalf("Incorrect event type (expected: %s, got: %s)", mutationType, event.MutationType())
+		}
+		return event
+	case <-time.After(time.Minute):
+		t.Fatalf("No %s event received.", mutationType)
+	}
+	return nil
+}